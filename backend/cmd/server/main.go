@@ -2,19 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/broker"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/campaign"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/checksum"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/database"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/postgres"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/logging"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/reconciler"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/server"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/service"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
@@ -73,7 +78,7 @@ func run() error {
 	defer cancel()
 
 	// Checksum
-	generator := checksum.NewGenerator(config.ChecksumBinaryPath)
+	generator := checksum.NewGenerator(config.ChecksumBinaryPath, config.ChecksumAlgorithm)
 
 	// Persistence Layer
 	model, err := database.NewDatabaseModel(ctx, logger).AddDatabaseConnections(
@@ -100,15 +105,45 @@ func run() error {
 	notifier := postgres.NewNotifier(pool, config.Persistence.Postgres.NotificationChannel, logger)
 
 	// External Clients
-	factory := device.NewClientFactory(generator)
+	registry := device.NewRegistry(generator, config.MQTT)
+	defer registry.Close() // nolint:errcheck
+	for _, plugin := range config.DriverPlugins {
+		name, endpoint, ok := strings.Cut(plugin, "=")
+		if !ok {
+			return fmt.Errorf("invalid driver plugin '%s' (name=endpoint)", plugin)
+		}
+		if err := registry.RegisterPlugin(name, endpoint); err != nil {
+			return err
+		}
+		logger.Info("registered driver plugin", zap.String("driver", name), zap.String("endpoint", endpoint))
+	}
+
+	// Campaign Lifecycle
+	pollInterval := config.Campaign.PollInterval
+	campaigns := campaign.NewRunner(persistence, registry, config.Identifier, pollInterval, logger)
+
+	// Reconciliation Lifecycle
+	reconcileInterval := config.ReconcileInterval
+	if config.LeaseTTL <= reconcileInterval {
+		return fmt.Errorf(
+			"lease ttl (%s) must exceed the reconcile interval (%s)",
+			config.LeaseTTL,
+			reconcileInterval,
+		)
+	}
+	configs := reconciler.NewReconciler(
+		persistence, registry, config.Identifier, reconcileInterval, config.LeaseTTL, logger,
+	)
 
 	// Application Layer
-	monitorService := service.NewMonitorService(persistence, factory, config, logger)
+	monitorService := service.NewMonitorService(persistence, registry, campaigns, configs, config, logger)
 	monitorServer := server.NewMonitorServer(monitorService, logger)
 
 	// Worker Lifecycle
 	interval := config.StreamInterval
-	orchestrator := worker.NewOrchestrator(persistence, notifier, factory, interval, logger)
+	orchestrator := worker.NewOrchestrator(
+		persistence, notifier, registry, config.Identifier, interval, config.LeaseTTL, logger,
+	)
 
 	g, gctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
@@ -117,6 +152,17 @@ func run() error {
 	g.Go(func() error {
 		return orchestrator.Run(gctx)
 	})
+	g.Go(func() error {
+		return campaigns.Run(gctx)
+	})
+	g.Go(func() error {
+		return configs.Run(gctx)
+	})
+	if config.MQTT.Port > 0 {
+		g.Go(func() error {
+			return startBroker(gctx, config, logger)
+		})
+	}
 
 	// Server Lifecycle
 	g.Go(func() error {
@@ -155,14 +201,54 @@ func startServer(ctx context.Context, config types.Config, srv *server.Server, l
 	return grpcServer.Serve(lis)
 }
 
+func startBroker(ctx context.Context, config types.Config, logger *zap.Logger) error {
+	accounts, err := brokerAccounts(config.MQTT)
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.MQTT.Port))
+	if err != nil {
+		return fmt.Errorf("failed to register listener on port %d: %w", config.MQTT.Port, err)
+	}
+	logger.Info("server started (mqtt)", zap.Int("port", config.MQTT.Port))
+	defer logger.Info("shutting down server (mqtt)")
+	return broker.NewBroker(accounts, logger).Serve(ctx, lis)
+}
+
+// brokerAccounts grants the monitor all topics and each device the topics of its identifier
+func brokerAccounts(config types.MQTT) (map[string]broker.Account, error) {
+	if config.Password == "" {
+		return nil, errors.New("missing password of embedded broker (mqtt)")
+	}
+	accounts := map[string]broker.Account{config.Username: broker.MonitorAccount(config.Password)}
+	for _, device := range config.Devices {
+		identifier, password, ok := strings.Cut(device, ":")
+		if !ok || identifier == "" || password == "" {
+			return nil, fmt.Errorf("invalid device credentials %q, expected id:password (mqtt)", identifier)
+		}
+		accounts[identifier] = broker.DeviceAccount(identifier, password)
+	}
+	return accounts, nil
+}
+
 func startGateway(ctx context.Context, config types.Config, logger *zap.Logger) error {
-	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	endpoint := fmt.Sprintf("%s:%d", config.GatewayHost, config.Port)
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create client (gateway): %w", err)
+	}
+	defer conn.Close() //nolint:errcheck
+	client := monitorv1.NewMonitorClient(conn)
+	mux := runtime.NewServeMux(runtime.WithMiddlewares(server.StreamMiddleware(client)))
 
 	if err := monitorv1.RegisterMonitorHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
 		return fmt.Errorf("failed to register handler (gateway): %w", err)
 	}
+	err = mux.HandlePath(http.MethodGet, "/v1/export/diagnostics", server.ExportHandler(mux, client))
+	if err != nil {
+		return fmt.Errorf("failed to register export handler (gateway): %w", err)
+	}
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.GatewayPort),
 		Handler: server.CORSMiddleware(mux),
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the monitor endpoints (contexts), stored as YAML:
+//
+//	current-context: local
+//	contexts:
+//	  local:
+//	    endpoint: localhost:8080
+type Config struct {
+	CurrentContext string             `json:"current-context,omitempty" yaml:"current-context,omitempty"`
+	Contexts       map[string]Context `json:"contexts,omitempty"        yaml:"contexts,omitempty"`
+}
+
+type Context struct {
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+}
+
+// DefaultConfigPath is ubiquitictl/config.yaml in the user config directory
+// (e.g. ~/.config/ubiquitictl/config.yaml)
+func DefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "ubiquitictl", "config.yaml")
+}
+
+// LoadConfig reads the config file, a missing file is an empty config
+func LoadConfig(path string) (Config, error) {
+	config := Config{Contexts: make(map[string]Context)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return config, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	if config.Contexts == nil {
+		config.Contexts = make(map[string]Context)
+	}
+	return config, nil
+}
+
+func (c Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
+
+func contextCommand(env *environment, args []string) error {
+	return subcommand(env, "context", args, map[string]func(*environment, []string) error{
+		"list":   contextList,
+		"set":    contextSet,
+		"use":    contextUse,
+		"delete": contextDelete,
+	})
+}
+
+func contextList(env *environment, args []string) error {
+	fs := env.flags("context list", "context list")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	out, err := env.output()
+	if err != nil {
+		return err
+	}
+	config, err := LoadConfig(env.opts.config)
+	if err != nil {
+		return err
+	}
+	if !out.isTable() {
+		return out.value(config)
+	}
+	table := out.table("CURRENT", "NAME", "ENDPOINT")
+	for _, name := range slices.Sorted(maps.Keys(config.Contexts)) {
+		current := ""
+		if name == config.CurrentContext {
+			current = "*"
+		}
+		table.row(current, name, config.Contexts[name].Endpoint)
+	}
+	return table.flush()
+}
+
+func contextSet(env *environment, args []string) error {
+	fs := env.flags("context set", "context set <name> --endpoint <host:port>")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	endpoint := env.opts.endpoint
+	if len(positional) != 1 || endpoint == "" {
+		return fmt.Errorf("%w: context set requires a name and --endpoint", ErrorUsage)
+	}
+	config, err := LoadConfig(env.opts.config)
+	if err != nil {
+		return err
+	}
+	config.Contexts[positional[0]] = Context{Endpoint: endpoint}
+	if config.CurrentContext == "" {
+		config.CurrentContext = positional[0]
+	}
+	if err := config.Save(env.opts.config); err != nil {
+		return err
+	}
+	fmt.Fprintf(env.stdout, "context %q set to %s\n", positional[0], endpoint)
+	return nil
+}
+
+func contextUse(env *environment, args []string) error {
+	fs := env.flags("context use", "context use <name>")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("%w: context use requires a name", ErrorUsage)
+	}
+	config, err := LoadConfig(env.opts.config)
+	if err != nil {
+		return err
+	}
+	if _, ok := config.Contexts[positional[0]]; !ok {
+		return fmt.Errorf("context %q not found in %s", positional[0], env.opts.config)
+	}
+	config.CurrentContext = positional[0]
+	if err := config.Save(env.opts.config); err != nil {
+		return err
+	}
+	fmt.Fprintf(env.stdout, "switched to context %q\n", positional[0])
+	return nil
+}
+
+func contextDelete(env *environment, args []string) error {
+	fs := env.flags("context delete", "context delete <name>")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("%w: context delete requires a name", ErrorUsage)
+	}
+	config, err := LoadConfig(env.opts.config)
+	if err != nil {
+		return err
+	}
+	if _, ok := config.Contexts[positional[0]]; !ok {
+		return fmt.Errorf("context %q not found in %s", positional[0], env.opts.config)
+	}
+	delete(config.Contexts, positional[0])
+	if config.CurrentContext == positional[0] {
+		config.CurrentContext = ""
+	}
+	if err := config.Save(env.opts.config); err != nil {
+		return err
+	}
+	fmt.Fprintf(env.stdout, "context %q deleted\n", positional[0])
+	return nil
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func devicesCommand(env *environment, args []string) error {
+	return subcommand(env, "devices", args, map[string]func(*environment, []string) error{
+		"list":     devicesList,
+		"register": devicesRegister,
+		"update":   devicesUpdate,
+		"delete":   devicesDelete,
+		"import":   devicesImport,
+	})
+}
+
+func devicesList(env *environment, args []string) error {
+	fs := env.flags("devices list", "devices list")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	out, err := env.output()
+	if err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := env.unary()
+	defer cancel()
+	res, err := client.ListDevices(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	if !out.isTable() {
+		return out.message(res)
+	}
+	table := out.table("DEVICE ID", "ALIAS", "ENDPOINT", "ARCH", "OS", "PROTOCOLS", "SIGNING", "AGE")
+	for _, device := range res.GetDevices() {
+		protocols := make([]string, len(device.GetSupportedProtocols()))
+		for i, protocol := range device.GetSupportedProtocols() {
+			protocols[i] = enum(protocol, "PROTOCOL_")
+		}
+		table.row(
+			device.GetDeviceId(),
+			orDash(device.GetAlias()),
+			fmt.Sprintf("%s:%d", device.GetHost(), device.GetPort()),
+			orDash(device.GetArchitecture()),
+			orDash(device.GetOs()),
+			orDash(strings.Join(protocols, ",")),
+			enum(device.GetSigningAlgorithm(), "SIGNING_ALGORITHM_"),
+			age(device.GetCreatedAt()),
+		)
+	}
+	return table.flush()
+}
+
+func devicesRegister(env *environment, args []string) error {
+	var (
+		req                        monitorv1.RegisterDeviceRequest
+		snmp                       monitorv1.SnmpCredentials
+		protocol, signingAlgorithm string
+		snmpVersion, snmpAuth      string
+		snmpPriv                   string
+	)
+	fs := env.flags(
+		"devices register",
+		"devices register <device-id> --host <host> --port <port> --port-gateway <port> [--protocol grpc]",
+	)
+	fs.StringVar(&req.Alias, "alias", "", "device alias")
+	fs.StringVar(&req.Host, "host", "", "device host")
+	fs.Int64Var(&req.Port, "port", 0, "device port (grpc)")
+	fs.Int64Var(&req.PortGateway, "port-gateway", 0, "device port (http)")
+	fs.StringVar(
+		&protocol,
+		"protocol",
+		"grpc",
+		"protocol (http, http-stream, grpc, grpc-stream, mqtt, snmp, plugin)",
+	)
+	fs.StringVar(&req.Driver, "driver", "", "driver name (default: named after the protocol)")
+	fs.StringVar(&signingAlgorithm, "signing-algorithm", "", "signing algorithm (hmac-sha256, ed25519)")
+	fs.StringVar(&req.SigningKey, "signing-key", "", "signing key (base64)")
+	fs.StringVar(&snmpVersion, "snmp-version", "", "snmp version (v2c, v3)")
+	fs.StringVar(&snmp.Community, "snmp-community", "", "snmp community (v2c)")
+	fs.StringVar(&snmp.Username, "snmp-username", "", "snmp username (v3)")
+	fs.StringVar(&snmpAuth, "snmp-auth-protocol", "", "snmp auth protocol (md5, sha, sha256, sha512)")
+	fs.StringVar(&snmp.AuthPassphrase, "snmp-auth-passphrase", "", "snmp auth passphrase")
+	fs.StringVar(&snmpPriv, "snmp-priv-protocol", "", "snmp privacy protocol (des, aes, aes256)")
+	fs.StringVar(&snmp.PrivPassphrase, "snmp-priv-passphrase", "", "snmp privacy passphrase")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("%w: devices register requires a device id", ErrorUsage)
+	}
+	req.DeviceId = positional[0]
+	value, err := parseEnum(monitorv1.Protocol_value, "PROTOCOL_", protocol)
+	if err != nil {
+		return fmt.Errorf("%w (protocol)", err)
+	}
+	req.Protocol = monitorv1.Protocol(value)
+	if signingAlgorithm != "" {
+		value, err := parseEnum(monitorv1.SigningAlgorithm_value, "SIGNING_ALGORITHM_", signingAlgorithm)
+		if err != nil {
+			return fmt.Errorf("%w (signing-algorithm)", err)
+		}
+		req.SigningAlgorithm = monitorv1.SigningAlgorithm(value)
+	}
+	if snmpVersion != "" {
+		value, err := parseEnum(monitorv1.SnmpVersion_value, "SNMP_VERSION_", snmpVersion)
+		if err != nil {
+			return fmt.Errorf("%w (snmp-version)", err)
+		}
+		snmp.Version = monitorv1.SnmpVersion(value)
+		if snmpAuth != "" {
+			value, err := parseEnum(monitorv1.SnmpAuthProtocol_value, "SNMP_AUTH_PROTOCOL_", snmpAuth)
+			if err != nil {
+				return fmt.Errorf("%w (snmp-auth-protocol)", err)
+			}
+			snmp.AuthProtocol = monitorv1.SnmpAuthProtocol(value)
+		}
+		if snmpPriv != "" {
+			value, err := parseEnum(monitorv1.SnmpPrivProtocol_value, "SNMP_PRIV_PROTOCOL_", snmpPriv)
+			if err != nil {
+				return fmt.Errorf("%w (snmp-priv-protocol)", err)
+			}
+			snmp.PrivProtocol = monitorv1.SnmpPrivProtocol(value)
+		}
+		req.Snmp = &snmp
+	}
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrorUsage, err)
+	}
+	out, err := env.output()
+	if err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := env.unary()
+	defer cancel()
+	res, err := client.RegisterDevice(ctx, &req)
+	if err != nil {
+		return err
+	}
+	if !out.isTable() {
+		return out.message(res)
+	}
+	fmt.Fprintf(out.w, "device %q registered\n", res.GetDevice().GetDeviceId())
+	return nil
+}
+
+func devicesUpdate(env *environment, args []string) error {
+	var deviceStatus string
+	fs := env.flags("devices update", "devices update <device-id> --status <status>")
+	fs.StringVar(
+		&deviceStatus,
+		"status",
+		"",
+		"device status (healthy, degraded, error, maintenance, booting, offline)",
+	)
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 || deviceStatus == "" {
+		return fmt.Errorf("%w: devices update requires a device id and --status", ErrorUsage)
+	}
+	value, err := parseEnum(monitorv1.DeviceStatus_value, "DEVICE_STATUS_", deviceStatus)
+	if err != nil {
+		return fmt.Errorf("%w (status)", err)
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := env.unary()
+	defer cancel()
+	_, err = client.UpdateDevice(ctx, &monitorv1.UpdateDeviceRequest{
+		DeviceId:     positional[0],
+		DeviceStatus: monitorv1.DeviceStatus(value),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(env.stdout, "device %q updated\n", positional[0])
+	return nil
+}
+
+func devicesDelete(env *environment, args []string) error {
+	fs := env.flags("devices delete", "devices delete <device-id> [device-id ...]")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: devices delete requires a device id", ErrorUsage)
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	for _, deviceID := range positional {
+		ctx, cancel := env.unary()
+		_, err := client.DeleteDevice(ctx, &monitorv1.DeleteDeviceRequest{DeviceId: deviceID})
+		cancel()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(env.stdout, "device %q deleted\n", deviceID)
+	}
+	return nil
+}
+
+// devicesImport registers the devices of a YAML or CSV inventory file ("-" reads stdin), the
+// format is inferred from the file extension unless given.
+func devicesImport(env *environment, args []string) error {
+	var (
+		format string
+		prune  bool
+	)
+	fs := env.flags("devices import", "devices import <file> [--format yaml|csv] [--prune]")
+	fs.StringVar(&format, "format", "", "inventory format (yaml, csv), inferred from the file extension")
+	fs.BoolVar(&prune, "prune", false, "delete registered devices missing from the inventory")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("%w: devices import requires an inventory file", ErrorUsage)
+	}
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(positional[0])) {
+		case ".yaml", ".yml":
+			format = "yaml"
+		case ".csv":
+			format = "csv"
+		default:
+			return fmt.Errorf("%w: unknown inventory format of %q, use --format", ErrorUsage, positional[0])
+		}
+	}
+	value, err := parseEnum(monitorv1.InventoryFormat_value, "INVENTORY_FORMAT_", format)
+	if err != nil {
+		return fmt.Errorf("%w (format)", err)
+	}
+	var data []byte
+	if positional[0] == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(positional[0])
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read inventory: %w", err)
+	}
+	out, err := env.output()
+	if err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(env.ctx, max(env.opts.timeout, DefaultImportTimeout))
+	defer cancel()
+	res, err := client.ImportDevices(ctx, &monitorv1.ImportDevicesRequest{
+		Data:   data,
+		Format: monitorv1.InventoryFormat(value),
+		Prune:  prune,
+	})
+	if err != nil {
+		return err
+	}
+	if !out.isTable() {
+		return out.message(res)
+	}
+	table := out.table("LINE", "DEVICE ID", "STATUS", "ERROR")
+	for _, result := range res.GetResults() {
+		line := "-"
+		if result.GetLine() > 0 {
+			line = strconv.Itoa(int(result.GetLine()))
+		}
+		table.row(
+			line,
+			orDash(result.GetDeviceId()),
+			enum(result.GetStatus(), "IMPORT_STATUS_"),
+			orDash(result.GetError()),
+		)
+	}
+	if err := table.flush(); err != nil {
+		return err
+	}
+	if prune && !res.GetPruned() {
+		fmt.Fprintln(env.stderr, "prune skipped since entries failed")
+	}
+	return nil
+}
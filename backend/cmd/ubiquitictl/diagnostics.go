@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Clears the terminal and moves the cursor home before a watch table is redrawn
+const clearScreen = "\033[H\033[2J"
+
+var diagnosticsHeaders = []string{
+	"DEVICE ID", "STATUS", "CPU", "MEMORY", "TEMP", "DISK", "UPTIME", "FIRMWARE", "VERIFICATION", "UPDATED",
+}
+
+func diagnosticsCommand(env *environment, args []string) error {
+	return subcommand(env, "diagnostics", args, map[string]func(*environment, []string) error{
+		"get":   diagnosticsGet,
+		"watch": diagnosticsWatch,
+	})
+}
+
+func diagnosticsGet(env *environment, args []string) error {
+	fs := env.flags("diagnostics get", "diagnostics get <device-id> [device-id ...]")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return fmt.Errorf("%w: diagnostics get requires a device id", ErrorUsage)
+	}
+	out, err := env.output()
+	if err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	responses := make([]*monitorv1.DiagnosticsResponse, 0, len(positional))
+	for _, deviceID := range positional {
+		ctx, cancel := env.unary()
+		res, err := client.GetDiagnostics(ctx, &monitorv1.DiagnosticsRequest{DeviceId: deviceID})
+		cancel()
+		if err != nil {
+			return err
+		}
+		if !out.isTable() {
+			if err := out.message(res); err != nil {
+				return err
+			}
+			continue
+		}
+		responses = append(responses, res)
+	}
+	if !out.isTable() {
+		return nil
+	}
+	return diagnosticsTable(out, responses)
+}
+
+// diagnosticsWatch renders StreamDiagnostics of the devices (all registered devices if none
+// are given) until interrupted, the table is redrawn on every update.
+func diagnosticsWatch(env *environment, args []string) error {
+	fs := env.flags("diagnostics watch", "diagnostics watch [device-id ...]")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	out, err := env.output()
+	if err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		ctx, cancel := env.unary()
+		res, err := client.ListDevices(ctx, &emptypb.Empty{})
+		cancel()
+		if err != nil {
+			return err
+		}
+		for _, device := range res.GetDevices() {
+			positional = append(positional, device.GetDeviceId())
+		}
+		if len(positional) == 0 {
+			return errors.New("no registered devices to watch")
+		}
+	}
+	watchCtx, cancel := context.WithCancel(env.ctx)
+	defer cancel()
+	updates := make(chan *monitorv1.DiagnosticsResponse)
+	g, ctx := errgroup.WithContext(watchCtx)
+	for _, deviceID := range positional {
+		g.Go(func() error {
+			stream, err := client.StreamDiagnostics(ctx, &monitorv1.DiagnosticsRequest{DeviceId: deviceID})
+			if err != nil {
+				return err
+			}
+			for {
+				res, err := stream.Recv()
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return fmt.Errorf("stream of %s: %w", deviceID, err)
+				}
+				select {
+				case updates <- res:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		})
+	}
+	go func() {
+		_ = g.Wait()
+		close(updates)
+	}()
+	latest := make(map[string]*monitorv1.DiagnosticsResponse, len(positional))
+	for res := range updates {
+		if !out.isTable() {
+			if err := out.message(res); err != nil {
+				return err
+			}
+			continue
+		}
+		latest[res.GetDevice().GetDeviceId()] = res
+		fmt.Fprint(out.w, clearScreen)
+		fmt.Fprintf(out.w, "Watching %d device(s), press Ctrl+C to stop\n\n", len(positional))
+		responses := make([]*monitorv1.DiagnosticsResponse, 0, len(latest))
+		for _, deviceID := range positional {
+			if res, ok := latest[deviceID]; ok {
+				responses = append(responses, res)
+			}
+		}
+		if err := diagnosticsTable(out, responses); err != nil {
+			return err
+		}
+	}
+	// Interrupting the watch is the expected way to stop it
+	if err := g.Wait(); err != nil && env.ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+func diagnosticsTable(out *printer, responses []*monitorv1.DiagnosticsResponse) error {
+	table := out.table(diagnosticsHeaders...)
+	for _, res := range responses {
+		diag := res.GetDiagnostics()
+		table.row(
+			res.GetDevice().GetDeviceId(),
+			enum(diag.GetDeviceStatus(), "DEVICE_STATUS_"),
+			percent(diag.GetCpuUsage()),
+			percent(diag.GetMemoryUsage()),
+			strconv.FormatFloat(diag.GetTemperatureCelsius(), 'f', 1, 64)+" °C",
+			fmt.Sprintf("%s / %s", bytes(diag.GetDiskUsedBytes()), bytes(diag.GetDiskTotalBytes())),
+			(time.Duration(diag.GetUptimeSeconds()) * time.Second).String(),
+			orDash(diag.GetFirmwareVersion()),
+			enum(diag.GetVerificationStatus(), "VERIFICATION_STATUS_"),
+			age(res.GetUpdatedAt()),
+		)
+	}
+	return table.flush()
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// devices collects a repeatable --device flag
+type devices []string
+
+func (d *devices) String() string {
+	return strings.Join(*d, ",")
+}
+
+func (d *devices) Set(value string) error {
+	for id := range strings.SplitSeq(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			*d = append(*d, id)
+		}
+	}
+	return nil
+}
+
+// exportCommand streams ExportDiagnostics to a file (stdout by default), the export is not
+// bounded by the unary timeout.
+func exportCommand(env *environment, args []string) error {
+	var (
+		deviceIDs        devices
+		format, from, to string
+		file             string
+		since            time.Duration
+	)
+	fs := env.flags(
+		"export",
+		"export [--device <device-id>] [--from <time>] [--to <time>] [--format csv] [--file <path>]",
+	)
+	fs.Var(&deviceIDs, "device", "device to export, repeatable or comma separated (default all)")
+	fs.StringVar(&format, "format", "csv", "export format (csv, ndjson, parquet)")
+	fs.StringVar(&from, "from", "", "start of the period (RFC 3339)")
+	fs.StringVar(&to, "to", "", "end of the period (RFC 3339)")
+	fs.DurationVar(&since, "since", 0, "start of the period relative to now (e.g. 24h), instead of --from")
+	fs.StringVar(&file, "file", "", "file to write the export to (default stdout)")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return fmt.Errorf("%w: unexpected arguments %v", ErrorUsage, positional)
+	}
+	req := &monitorv1.ExportDiagnosticsRequest{DeviceIds: deviceIDs}
+	value, err := parseEnum(monitorv1.ExportFormat_value, "EXPORT_FORMAT_", format)
+	if err != nil {
+		return fmt.Errorf("%w (format)", err)
+	}
+	req.Format = monitorv1.ExportFormat(value)
+	if since > 0 {
+		req.From = timestamppb.New(time.Now().Add(-since))
+	}
+	if req.From, err = timestampFlag("from", from, req.From); err != nil {
+		return err
+	}
+	if req.To, err = timestampFlag("to", to, nil); err != nil {
+		return err
+	}
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrorUsage, err)
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	stream, err := client.ExportDiagnostics(env.ctx, req)
+	if err != nil {
+		return err
+	}
+	// Await the first chunk so that a failing export does not leave an empty file behind
+	res, recvErr := stream.Recv()
+	if recvErr != nil && !errors.Is(recvErr, io.EOF) {
+		return recvErr
+	}
+	if file == "" {
+		_, err = copyStream(env.stdout, stream, res, recvErr)
+		return err
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %w", err)
+	}
+	written, err := copyStream(f, stream, res, recvErr)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write export: %w", closeErr)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(env.stderr, "exported %s to %s\n", bytes(uint64(written)), file)
+	return nil
+}
+
+// copyStream writes the received chunk and the remainder of the stream
+func copyStream(
+	w io.Writer,
+	stream monitorv1.Monitor_ExportDiagnosticsClient,
+	res *monitorv1.ExportDiagnosticsResponse,
+	err error,
+) (int64, error) {
+	var written int64
+	for err == nil {
+		n, writeErr := w.Write(res.GetData())
+		written += int64(n)
+		if writeErr != nil {
+			return written, fmt.Errorf("failed to write export: %w", writeErr)
+		}
+		res, err = stream.Recv()
+	}
+	if !errors.Is(err, io.EOF) {
+		return written, err
+	}
+	return written, nil
+}
+
+// timestampFlag parses an RFC 3339 flag value, an empty value keeps the fallback
+func timestampFlag(name, value string, fallback *timestamppb.Timestamp) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid --%s: %w", ErrorUsage, name, err)
+	}
+	return timestamppb.New(parsed), nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type health struct {
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	Status   string `json:"status"   yaml:"status"`
+	Latency  string `json:"latency"  yaml:"latency"`
+}
+
+func healthCommand(env *environment, args []string) error {
+	fs := env.flags("health", "health")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	out, err := env.output()
+	if err != nil {
+		return err
+	}
+	endpoint, err := env.resolveEndpoint()
+	if err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := env.unary()
+	defer cancel()
+	started := time.Now()
+	if _, err := client.GetHealth(ctx, &emptypb.Empty{}); err != nil {
+		return err
+	}
+	result := health{
+		Endpoint: endpoint,
+		Status:   "healthy",
+		Latency:  time.Since(started).Round(time.Microsecond).String(),
+	}
+	if !out.isTable() {
+		return out.value(result)
+	}
+	table := out.table("ENDPOINT", "STATUS", "LATENCY")
+	table.row(result.Endpoint, result.Status, result.Latency)
+	return table.flush()
+}
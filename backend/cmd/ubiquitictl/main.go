@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitUsage = 2
+)
+
+const (
+	DefaultEndpoint = "localhost:8080"
+	DefaultOutput   = OutputTable
+	DefaultTimeout  = 10 * time.Second
+	// Imports probe every device of the inventory
+	DefaultImportTimeout = 60 * time.Second
+)
+
+var (
+	ErrorUsage = errors.New("invalid usage")
+	// Flag errors are reported by the flag package
+	ErrorFlags = errors.New("invalid flags")
+)
+
+// options are the global flags, accepted before and after the command
+type options struct {
+	config   string
+	context  string
+	endpoint string
+	output   string
+	timeout  time.Duration
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.config, "config", o.config, "path to the config file")
+	fs.StringVar(&o.context, "context", o.context, "context to use instead of the current context")
+	fs.StringVar(&o.endpoint, "endpoint", o.endpoint, "monitor endpoint (host:port), overrides the context")
+	fs.StringVar(&o.output, "o", o.output, "output format (table, json, yaml)")
+	fs.StringVar(&o.output, "output", o.output, "output format (table, json, yaml)")
+	fs.DurationVar(&o.timeout, "timeout", o.timeout, "timeout of unary requests")
+}
+
+type command struct {
+	name        string
+	description string
+	run         func(env *environment, args []string) error
+}
+
+var commands = []command{
+	{"devices", "list, register, import, update and delete devices", devicesCommand},
+	{"diagnostics", "get or watch device diagnostics", diagnosticsCommand},
+	{"export", "export diagnostics samples (csv, ndjson, parquet)", exportCommand},
+	{"health", "check the health of the monitor", healthCommand},
+	{"context", "manage monitor endpoints", contextCommand},
+	{"version", "print the version", versionCommand},
+}
+
+// environment is shared by the commands, the client connects on first use
+type environment struct {
+	ctx     context.Context
+	opts    *options
+	stdout  io.Writer
+	stderr  io.Writer
+	conn    *grpc.ClientConn
+	printer *printer
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	cancel()
+	os.Exit(code)
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	opts := &options{
+		config:  os.Getenv("UBIQUITICTL_CONFIG"),
+		context: os.Getenv("UBIQUITICTL_CONTEXT"),
+		output:  DefaultOutput,
+		timeout: DefaultTimeout,
+	}
+	if opts.config == "" {
+		opts.config = DefaultConfigPath()
+	}
+	fs := flag.NewFlagSet("ubiquitictl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: ubiquitictl [flags] <command> [args]")
+		fmt.Fprintln(stderr, "\nCommands:")
+		for _, cmd := range commands {
+			fmt.Fprintf(stderr, "  %-12s %s\n", cmd.name, cmd.description)
+		}
+		fmt.Fprintln(stderr, "\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return ExitUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return ExitUsage
+	}
+	env := &environment{ctx: ctx, opts: opts, stdout: stdout, stderr: stderr}
+	defer env.close()
+	for _, cmd := range commands {
+		if cmd.name != fs.Arg(0) {
+			continue
+		}
+		err := cmd.run(env, fs.Args()[1:])
+		switch {
+		case err == nil:
+			return ExitOK
+		case errors.Is(err, flag.ErrHelp):
+			return ExitOK
+		case errors.Is(err, ErrorFlags):
+			return ExitUsage
+		case errors.Is(err, ErrorUsage):
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return ExitUsage
+		default:
+			if s, ok := status.FromError(err); ok {
+				fmt.Fprintf(stderr, "Error: %s (%s)\n", s.Message(), s.Code())
+			} else {
+				fmt.Fprintf(stderr, "Error: %v\n", err)
+			}
+			return ExitError
+		}
+	}
+	fmt.Fprintf(stderr, "Error: unknown command %q\n", fs.Arg(0))
+	fs.Usage()
+	return ExitUsage
+}
+
+// client connects to the endpoint of the flag, the selected context or the default endpoint
+func (e *environment) client() (monitorv1.MonitorClient, error) {
+	if e.conn == nil {
+		endpoint, err := e.resolveEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for %s: %w", endpoint, err)
+		}
+		e.conn = conn
+	}
+	return monitorv1.NewMonitorClient(e.conn), nil
+}
+
+func (e *environment) resolveEndpoint() (string, error) {
+	if e.opts.endpoint != "" {
+		return e.opts.endpoint, nil
+	}
+	config, err := LoadConfig(e.opts.config)
+	if err != nil {
+		return "", err
+	}
+	name := e.opts.context
+	if name == "" {
+		name = config.CurrentContext
+	}
+	if name == "" {
+		return DefaultEndpoint, nil
+	}
+	monitor, ok := config.Contexts[name]
+	if !ok {
+		return "", fmt.Errorf("context %q not found in %s", name, e.opts.config)
+	}
+	return monitor.Endpoint, nil
+}
+
+// output returns the printer of the output flag, validated after the command flags are parsed
+func (e *environment) output() (*printer, error) {
+	if e.printer == nil {
+		printer, err := newPrinter(e.opts.output, e.stdout)
+		if err != nil {
+			return nil, err
+		}
+		e.printer = printer
+	}
+	return e.printer, nil
+}
+
+func (e *environment) unary() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(e.ctx, e.opts.timeout)
+}
+
+func (e *environment) close() {
+	if e.conn != nil {
+		_ = e.conn.Close()
+	}
+}
+
+// flags returns a flag set of a (sub)command that also accepts the global flags
+func (e *environment) flags(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	e.opts.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: ubiquitictl %s\n", usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse allows flags after positional arguments, which the flag package stops at
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: %w", ErrorFlags, err)
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// subcommand dispatches to the subcommand named by the first argument
+func subcommand(
+	env *environment,
+	name string,
+	args []string,
+	subcommands map[string]func(*environment, []string) error,
+) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: missing %s subcommand (%s)", ErrorUsage, name, names(subcommands))
+	}
+	run, ok := subcommands[args[0]]
+	if !ok {
+		return fmt.Errorf("%w: unknown %s subcommand %q (%s)", ErrorUsage, name, args[0], names(subcommands))
+	}
+	return run(env, args[1:])
+}
+
+func versionCommand(env *environment, _ []string) error {
+	fmt.Fprintf(env.stdout, "ubiquitictl %s (%s)\n", types.Version, types.Revision)
+	return nil
+}
+
+func names[T any](subcommands map[string]T) string {
+	return strings.Join(slices.Sorted(maps.Keys(subcommands)), ", ")
+}
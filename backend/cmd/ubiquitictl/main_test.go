@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v3"
+)
+
+// monitor serves the devices of a fleet, updates of unknown devices are not found
+type monitor struct {
+	monitorv1.UnimplementedMonitorServer
+	devices []*monitorv1.Device
+	updates []*monitorv1.UpdateDeviceRequest
+}
+
+func (m *monitor) GetHealth(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (m *monitor) ListDevices(ctx context.Context, _ *emptypb.Empty) (*monitorv1.ListDevicesResponse, error) {
+	return &monitorv1.ListDevicesResponse{Devices: m.devices}, nil
+}
+
+func (m *monitor) UpdateDevice(
+	ctx context.Context,
+	req *monitorv1.UpdateDeviceRequest,
+) (*emptypb.Empty, error) {
+	for _, device := range m.devices {
+		if device.GetDeviceId() == req.GetDeviceId() {
+			m.updates = append(m.updates, req)
+			return &emptypb.Empty{}, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "device %s not found", req.GetDeviceId())
+}
+
+func serve(t *testing.T, m *monitor) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	monitorv1.RegisterMonitorServer(server, m)
+	go server.Serve(listener) // nolint:errcheck
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func TestRun(t *testing.T) {
+	m := &monitor{devices: []*monitorv1.Device{
+		{
+			DeviceId:           "router-001",
+			Host:               "router",
+			Port:               8080,
+			Architecture:       "arm64",
+			SupportedProtocols: []monitorv1.Protocol{monitorv1.Protocol_PROTOCOL_GRPC},
+		},
+		{DeviceId: "switch-001", Host: "switch", Port: 8080, Architecture: "amd64"},
+	}}
+	endpoint := serve(t, m)
+	config := filepath.Join(t.TempDir(), "config.yaml")
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stdout []string
+		stderr string
+	}{
+		{
+			name:   "should print usage without command",
+			code:   ExitUsage,
+			stderr: "Usage: ubiquitictl",
+		},
+		{
+			name:   "should return usage of unknown command",
+			args:   []string{"unknown"},
+			code:   ExitUsage,
+			stderr: `unknown command "unknown"`,
+		},
+		{
+			name:   "should return usage of unknown global flag",
+			args:   []string{"--unknown", "devices", "list"},
+			code:   ExitUsage,
+			stderr: "flag provided but not defined",
+		},
+		{
+			name:   "should return usage of unknown command flag",
+			args:   []string{"devices", "list", "--unknown"},
+			code:   ExitUsage,
+			stderr: "flag provided but not defined",
+		},
+		{
+			name:   "should return usage of missing subcommand",
+			args:   []string{"devices"},
+			code:   ExitUsage,
+			stderr: "missing devices subcommand (delete, import, list, register, update)",
+		},
+		{
+			name:   "should return usage of unsupported output format",
+			args:   []string{"devices", "list", "-o", "xml", "--endpoint", endpoint},
+			code:   ExitUsage,
+			stderr: `unsupported output format "xml"`,
+		},
+		{
+			name:   "should return usage of invalid status",
+			args:   []string{"devices", "update", "router-001", "--status", "broken", "--endpoint", endpoint},
+			code:   ExitUsage,
+			stderr: "invalid value 'broken' (status)",
+		},
+		{
+			name: "should return ok of help",
+			args: []string{"devices", "list", "--help"},
+			code: ExitOK,
+		},
+		{
+			name:   "should print version",
+			args:   []string{"version"},
+			code:   ExitOK,
+			stdout: []string{"ubiquitictl "},
+		},
+		{
+			name:   "should print health",
+			args:   []string{"--endpoint", endpoint, "health"},
+			code:   ExitOK,
+			stdout: []string{"ENDPOINT", endpoint, "healthy"},
+		},
+		{
+			name: "should print devices as table",
+			args: []string{"devices", "list", "--endpoint", endpoint},
+			code: ExitOK,
+			stdout: []string{
+				"DEVICE ID",
+				"router-001   -       router:8080   arm64",
+				"grpc",
+				"switch-001   -       switch:8080   amd64",
+			},
+		},
+		{
+			name: "should update device status",
+			args: []string{
+				"devices",
+				"update",
+				"router-001",
+				"--status",
+				"DEVICE_STATUS_DEGRADED",
+				"--endpoint",
+				endpoint,
+			},
+			code:   ExitOK,
+			stdout: []string{`device "router-001" updated`},
+		},
+		{
+			name: "should return error of status code",
+			args: []string{
+				"devices",
+				"update",
+				"missing-001",
+				"--status",
+				"healthy",
+				"--endpoint",
+				endpoint,
+			},
+			code:   ExitError,
+			stderr: "Error: device missing-001 not found (NotFound)",
+		},
+		{
+			name:   "should return error of missing context",
+			args:   []string{"--context", "missing", "health"},
+			code:   ExitError,
+			stderr: `context "missing" not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			code := run(
+				context.Background(),
+				append([]string{"--config", config}, tt.args...),
+				&stdout,
+				&stderr,
+			)
+			if code != tt.code {
+				t.Fatalf("expected exit status %d, got %d (%s)", tt.code, code, stderr.String())
+			}
+			for _, expected := range tt.stdout {
+				if !strings.Contains(stdout.String(), expected) {
+					t.Errorf("expected output containing %q, got %q", expected, stdout.String())
+				}
+			}
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("expected error output containing %q, got %q", tt.stderr, stderr.String())
+			}
+		})
+	}
+	if len(m.updates) != 1 ||
+		m.updates[0].GetDeviceStatus() != monitorv1.DeviceStatus_DEVICE_STATUS_DEGRADED {
+		t.Errorf("expected a single update to degraded, got %v", m.updates)
+	}
+}
+
+func TestRun_Output(t *testing.T) {
+	m := &monitor{devices: []*monitorv1.Device{
+		{DeviceId: "router-001", Architecture: "arm64"},
+		{DeviceId: "switch-001", Architecture: "amd64"},
+	}}
+	endpoint := serve(t, m)
+	tests := []struct {
+		name      string
+		output    string
+		unmarshal func([]byte, any) error
+	}{
+		{name: "should print devices as json", output: "json", unmarshal: json.Unmarshal},
+		{name: "should print devices as yaml", output: "YAML", unmarshal: yaml.Unmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			args := []string{"--endpoint", endpoint, "devices", "list", "-o", tt.output}
+			if code := run(context.Background(), args, &stdout, &stderr); code != ExitOK {
+				t.Fatalf("expected exit status %d, got %d (%s)", ExitOK, code, stderr.String())
+			}
+			var document struct {
+				Devices []struct {
+					DeviceID     string `json:"device_id"    yaml:"device_id"`
+					Architecture string `json:"architecture" yaml:"architecture"`
+				} `json:"devices" yaml:"devices"`
+			}
+			if err := tt.unmarshal([]byte(stdout.String()), &document); err != nil {
+				t.Fatalf("expected %s document, got %q: %v", tt.output, stdout.String(), err)
+			}
+			architectures := make(map[string]string)
+			for _, device := range document.Devices {
+				architectures[device.DeviceID] = device.Architecture
+			}
+			if len(architectures) != 2 || architectures["router-001"] != "arm64" ||
+				architectures["switch-001"] != "amd64" {
+				t.Errorf("expected router-001 (arm64) and switch-001 (amd64), got %v", architectures)
+			}
+		})
+	}
+}
+
+func TestRun_Context(t *testing.T) {
+	endpoint := serve(t, &monitor{})
+	config := filepath.Join(t.TempDir(), "ubiquitictl", "config.yaml")
+	steps := []struct {
+		args   []string
+		code   int
+		stdout string
+	}{
+		{args: []string{"context", "set", "local"}, code: ExitUsage},
+		{args: []string{"context", "set", "local", "--endpoint", endpoint}, code: ExitOK},
+		{args: []string{"context", "set", "remote", "--endpoint", "remote:8080"}, code: ExitOK},
+		{args: []string{"context", "list"}, code: ExitOK, stdout: "*         local    " + endpoint},
+		{args: []string{"health"}, code: ExitOK, stdout: endpoint},
+		{args: []string{"context", "use", "missing"}, code: ExitError},
+		{args: []string{"context", "use", "remote"}, code: ExitOK},
+		{args: []string{"--context", "local", "health"}, code: ExitOK, stdout: endpoint},
+		{args: []string{"context", "delete", "remote"}, code: ExitOK},
+		{args: []string{"context", "list", "-o", "json"}, code: ExitOK, stdout: `"local": {`},
+	}
+	for _, step := range steps {
+		var stdout, stderr strings.Builder
+		args := append([]string{"--config", config}, step.args...)
+		if code := run(context.Background(), args, &stdout, &stderr); code != step.code {
+			t.Fatalf(
+				"expected exit status %d of %v, got %d (%s)",
+				step.code,
+				step.args,
+				code,
+				stderr.String(),
+			)
+		}
+		if !strings.Contains(stdout.String(), step.stdout) {
+			t.Errorf("expected output of %v containing %q, got %q", step.args, step.stdout, stdout.String())
+		}
+	}
+	loaded, err := LoadConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.CurrentContext != "" || len(loaded.Contexts) != 1 ||
+		loaded.Contexts["local"].Endpoint != endpoint {
+		t.Errorf("expected local context without current context, got %+v", loaded)
+	}
+}
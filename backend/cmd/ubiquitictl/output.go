@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/inventory"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// printer renders responses as a table (per command) or as JSON/YAML documents, the
+// documents use the JSON names of the proto fields.
+type printer struct {
+	format    string
+	w         io.Writer
+	documents int
+}
+
+func newPrinter(format string, w io.Writer) (*printer, error) {
+	switch strings.ToLower(format) {
+	case OutputTable, OutputJSON, OutputYAML:
+		return &printer{format: strings.ToLower(format), w: w}, nil
+	default:
+		return nil, fmt.Errorf(
+			"%w: unsupported output format %q (%s, %s, %s)",
+			ErrorUsage,
+			format,
+			OutputTable,
+			OutputJSON,
+			OutputYAML,
+		)
+	}
+}
+
+func (p *printer) isTable() bool {
+	return p.format == OutputTable
+}
+
+func (p *printer) message(m proto.Message) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return p.value(value)
+}
+
+func (p *printer) value(v any) error {
+	defer func() { p.documents++ }()
+	switch p.format {
+	case OutputYAML:
+		if p.documents > 0 {
+			fmt.Fprintln(p.w, "---")
+		}
+		encoder := yaml.NewEncoder(p.w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(v); err != nil {
+			return fmt.Errorf("failed to write yaml: %w", err)
+		}
+		return encoder.Close()
+	default:
+		encoder := json.NewEncoder(p.w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(v); err != nil {
+			return fmt.Errorf("failed to write json: %w", err)
+		}
+		return nil
+	}
+}
+
+type table struct {
+	w *tabwriter.Writer
+}
+
+func (p *printer) table(headers ...string) *table {
+	t := &table{w: tabwriter.NewWriter(p.w, 0, 0, 3, ' ', 0)}
+	t.row(headers...)
+	return t
+}
+
+func (t *table) row(values ...string) {
+	fmt.Fprintln(t.w, strings.Join(values, "\t"))
+}
+
+func (t *table) flush() error {
+	return t.w.Flush()
+}
+
+// enum formats a proto enum value without its prefix (e.g. PROTOCOL_GRPC_STREAM as grpc-stream)
+func enum(value fmt.Stringer, prefix string) string {
+	name := strings.TrimPrefix(value.String(), prefix)
+	if name == "UNSPECIFIED" {
+		return "-"
+	}
+	return strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+}
+
+// parseEnum parses the enum value of a flag, invalid values are usage errors
+func parseEnum(values map[string]int32, prefix, value string) (int32, error) {
+	number, err := inventory.ParseEnum(values, prefix, value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrorUsage, err)
+	}
+	return number, nil
+}
+
+func age(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return time.Since(ts.AsTime()).Truncate(time.Second).String()
+}
+
+func percent(value float64) string {
+	return fmt.Sprintf("%.1f%%", value)
+}
+
+func bytes(value uint64) string {
+	const unit = 1024
+	if value < unit {
+		return fmt.Sprintf("%d B", value)
+	}
+	div, exp := uint64(unit), 0
+	for n := value / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(value)/float64(div), "KMGTPE"[exp])
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,138 @@
+package anomaly
+
+import (
+	"math"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+const (
+	// Time constants of the exponentially weighted baseline and of the recent level that is
+	// compared against the baseline to detect drift
+	BaselineWindow = 6 * time.Hour
+	DriftWindow    = 15 * time.Minute
+	// Samples observed before anomalies are reported, until then the baseline is the plain
+	// average of the samples
+	WarmupSamples = 30
+	// Drift is a sustained shift of the recent level and is reported at a fraction of the
+	// spike threshold
+	DriftRatio = 0.5
+	// An anomalous metric recovers once its score falls below this fraction of the threshold
+	RecoveryRatio = 0.5
+	// Floors of the standard deviation so that a flat metric does not report every change
+	MinDeviationRatio = 0.05
+	MinDeviation      = 1e-3
+)
+
+// State is the baseline of a metric of a device
+type State struct {
+	Mean        float64
+	Variance    float64
+	FastMean    float64
+	Samples     int64
+	SpikeActive bool
+	DriftActive bool
+	Observed    time.Time
+}
+
+type Detection struct {
+	Kind      types.AnomalyKind
+	Value     float64
+	Baseline  float64
+	Deviation float64
+	Score     float64
+}
+
+// Values returns the metrics of a diagnostics sample, offline and booting devices report no
+// metrics since their samples would distort the baselines.
+func Values(diag types.DeviceDiagnostics) map[types.Metric]float64 {
+	switch diag.DeviceStatus {
+	case types.DeviceStatusOffline, types.DeviceStatusBooting:
+		return nil
+	}
+	values := map[types.Metric]float64{
+		types.MetricCpu:         diag.CPU,
+		types.MetricMemory:      diag.Memory,
+		types.MetricTemperature: diag.Temperature,
+		types.MetricLoad:        diag.LoadAverage.One,
+		types.MetricProcesses:   float64(diag.Processes),
+	}
+	if diag.DiskTotal > 0 {
+		values[types.MetricDisk] = float64(diag.DiskUsed) / float64(diag.DiskTotal) * 100
+	}
+	return values
+}
+
+// Observe updates the baseline with a sample and returns the anomalies the sample starts. A
+// spike is a sudden change from the recent level of the metric and drift is a shift of the
+// recent level from the baseline, the score of an anomaly is the change in standard deviations
+// of the baseline. An anomalous metric is not reported again until it recovers, a zero
+// threshold updates the baseline without detecting anomalies. Samples that are not newer than
+// the latest observed sample are ignored.
+func Observe(state *State, value float64, at time.Time, threshold float64) []Detection {
+	if state.Samples == 0 {
+		*state = State{Mean: value, FastMean: value, Samples: 1, Observed: at}
+		return nil
+	}
+	if !at.After(state.Observed) {
+		return nil
+	}
+	var (
+		detections []Detection
+		detect     = threshold > 0 && state.Samples >= WarmupSamples
+		recent     = state.FastMean
+		deviation  = state.deviation()
+	)
+	spike := math.Abs(value-recent) / deviation
+	if transition(&state.SpikeActive, spike, threshold, detect) {
+		detections = append(detections, Detection{
+			Kind:      types.AnomalyKindSpike,
+			Value:     value,
+			Baseline:  recent,
+			Deviation: value - recent,
+			Score:     spike,
+		})
+	}
+	// Outliers are clamped to the threshold so that a spike barely moves the averages while a
+	// sustained shift is still absorbed
+	if threshold > 0 {
+		value = min(max(value, recent-threshold*deviation), recent+threshold*deviation)
+	}
+	elapsed := at.Sub(state.Observed).Seconds()
+	weight := func(window time.Duration) float64 {
+		return max(1-math.Exp(-elapsed/window.Seconds()), 1/float64(state.Samples+1))
+	}
+	// The variance is that of the samples around the recent level, so that drift does not
+	// inflate it
+	alpha := weight(BaselineWindow)
+	state.Mean += alpha * (value - state.Mean)
+	state.Variance = (1-alpha)*state.Variance + alpha*(value-recent)*(value-recent)
+	state.FastMean += weight(DriftWindow) * (value - state.FastMean)
+	state.Samples++
+	state.Observed = at
+
+	drift := math.Abs(state.FastMean-state.Mean) / state.deviation()
+	if transition(&state.DriftActive, drift, threshold*DriftRatio, detect) {
+		detections = append(detections, Detection{
+			Kind:      types.AnomalyKindDrift,
+			Value:     state.FastMean,
+			Baseline:  state.Mean,
+			Deviation: state.FastMean - state.Mean,
+			Score:     drift,
+		})
+	}
+	return detections
+}
+
+// transition updates whether a metric is anomalous and reports whether the score starts an
+// anomaly, an anomaly ends once its score falls below the recovery ratio of the threshold.
+func transition(active *bool, score, threshold float64, detect bool) bool {
+	started := !*active
+	*active = detect && (score >= threshold || *active && score >= threshold*RecoveryRatio)
+	return *active && started
+}
+
+func (s *State) deviation() float64 {
+	return max(math.Sqrt(s.Variance), MinDeviationRatio*math.Abs(s.Mean), MinDeviation)
+}
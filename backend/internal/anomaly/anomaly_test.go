@@ -0,0 +1,235 @@
+package anomaly
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+// noise is a deterministic jitter of about one unit around a level
+var noise = []float64{-1, 0.5, 1, -0.5, 0, 0.8, -0.8, 0.3}
+
+// series returns samples of a level with jitter, shift moves the value of a sample off the
+// level.
+func series(level float64, samples int, shift func(i int) float64) []float64 {
+	values := make([]float64, samples)
+	for i := range values {
+		values[i] = level + noise[i%len(noise)] + shift(i)
+	}
+	return values
+}
+
+func none(int) float64 { return 0 }
+
+type detected struct {
+	sample int
+	kind   types.AnomalyKind
+}
+
+func TestObserve(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []float64
+		threshold float64
+		expected  []detected
+	}{
+		{
+			name:      "should report no anomalies of a steady metric",
+			values:    series(50, 200, none),
+			threshold: 4,
+		},
+		{
+			name: "should report no anomalies during warmup",
+			values: series(50, 40, func(i int) float64 {
+				if i == WarmupSamples/2 {
+					return 40
+				}
+				return 0
+			}),
+			threshold: 4,
+		},
+		{
+			name: "should report spike once until recovered",
+			values: series(50, 200, func(i int) float64 {
+				if i >= 100 && i < 103 || i == 150 {
+					return 40
+				}
+				return 0
+			}),
+			threshold: 4,
+			expected: []detected{
+				{sample: 100, kind: types.AnomalyKindSpike},
+				{sample: 150, kind: types.AnomalyKindSpike},
+			},
+		},
+		{
+			name: "should report drift of a gradual shift",
+			values: series(50, 300, func(i int) float64 {
+				if i < 100 {
+					return 0
+				}
+				return min(float64(i-100)*0.2, 20)
+			}),
+			threshold: 4,
+			expected:  []detected{{kind: types.AnomalyKindDrift}},
+		},
+		{
+			name: "should report no anomalies without threshold",
+			values: series(50, 200, func(i int) float64 {
+				if i >= 100 {
+					return 40
+				}
+				return 0
+			}),
+		},
+	}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				state  State
+				result []detected
+			)
+			for i, value := range tt.values {
+				at := start.Add(time.Duration(i) * time.Minute)
+				for _, detection := range Observe(&state, value, at, tt.threshold) {
+					if detection.Score < tt.threshold*DriftRatio {
+						t.Errorf(
+							"expected score of %s above threshold, got %.2f",
+							detection.Kind,
+							detection.Score,
+						)
+					}
+					result = append(result, detected{sample: i, kind: detection.Kind})
+				}
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("expected anomalies %v, got %v", tt.expected, result)
+			}
+			for i, expected := range tt.expected {
+				// The sample that starts drift depends on the averaging windows
+				if expected.kind == types.AnomalyKindDrift {
+					expected.sample = result[i].sample
+				}
+				if result[i] != expected {
+					t.Errorf("expected anomaly %v, got %v", expected, result[i])
+				}
+			}
+		})
+	}
+}
+
+func TestObserve_Detection(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var state State
+	for i, value := range series(50, 60, none) {
+		Observe(&state, value, start.Add(time.Duration(i)*time.Minute), 4)
+	}
+	at := start.Add(time.Hour)
+	detections := Observe(&state, 90, at, 4)
+	if len(detections) != 1 || detections[0].Kind != types.AnomalyKindSpike {
+		t.Fatalf("expected a spike, got %v", detections)
+	}
+	spike := detections[0]
+	if spike.Value != 90 || spike.Deviation != spike.Value-spike.Baseline {
+		t.Errorf("expected deviation of 90 from baseline, got %+v", spike)
+	}
+	if spike.Baseline < 49 || spike.Baseline > 51 {
+		t.Errorf("expected baseline of about 50, got %.2f", spike.Baseline)
+	}
+	// The spike is clamped to the threshold so that it barely moves the baseline
+	if state.Mean > 51 || state.FastMean > 55 {
+		t.Errorf("expected clamped averages, got mean %.2f and recent %.2f", state.Mean, state.FastMean)
+	}
+	observed := state
+	if detections := Observe(&state, 90, at, 4); detections != nil || state != observed {
+		t.Errorf("expected sample of observed time to be ignored, got %v", detections)
+	}
+	if detections := Observe(&state, 90, at.Add(-time.Minute), 4); detections != nil || state != observed {
+		t.Errorf("expected sample before observed time to be ignored, got %v", detections)
+	}
+}
+
+func TestObserve_Flat(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var (
+		state State
+		kinds []types.AnomalyKind
+	)
+	// A flat metric has no variance, the deviation floor keeps small changes from being reported
+	for i := range 100 {
+		value := 20.0
+		if i >= 50 {
+			value = 20.5
+		}
+		for _, detection := range Observe(&state, value, start.Add(time.Duration(i)*time.Minute), 3) {
+			kinds = append(kinds, detection.Kind)
+		}
+	}
+	if len(kinds) > 0 {
+		t.Errorf("expected no anomalies of a change within the deviation floor, got %v", kinds)
+	}
+}
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		diag     types.DeviceDiagnostics
+		expected []types.Metric
+	}{
+		{
+			name: "should report metrics of a healthy device",
+			diag: types.DeviceDiagnostics{
+				DeviceStatus: types.DeviceStatusHealthy,
+				DiskUsed:     25,
+				DiskTotal:    100,
+			},
+			expected: []types.Metric{
+				types.MetricCpu,
+				types.MetricDisk,
+				types.MetricLoad,
+				types.MetricMemory,
+				types.MetricProcesses,
+				types.MetricTemperature,
+			},
+		},
+		{
+			name: "should omit disk without total",
+			diag: types.DeviceDiagnostics{DeviceStatus: types.DeviceStatusDegraded},
+			expected: []types.Metric{
+				types.MetricCpu,
+				types.MetricLoad,
+				types.MetricMemory,
+				types.MetricProcesses,
+				types.MetricTemperature,
+			},
+		},
+		{
+			name: "should report no metrics of an offline device",
+			diag: types.DeviceDiagnostics{DeviceStatus: types.DeviceStatusOffline, DiskTotal: 100},
+		},
+		{
+			name: "should report no metrics of a booting device",
+			diag: types.DeviceDiagnostics{DeviceStatus: types.DeviceStatusBooting, DiskTotal: 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := Values(tt.diag)
+			var metrics []types.Metric
+			for metric := range values {
+				metrics = append(metrics, metric)
+			}
+			slices.Sort(metrics)
+			slices.Sort(tt.expected)
+			if !slices.Equal(metrics, tt.expected) {
+				t.Errorf("expected metrics %v, got %v", tt.expected, metrics)
+			}
+			if disk, ok := values[types.MetricDisk]; ok && disk != 25 {
+				t.Errorf("expected disk usage of 25%%, got %.2f", disk)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package availability
+
+import (
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+// Compute replays the status history of a device until the end of the period. Time before
+// the first known status is not covered, maintenance is left out if excluded. Entering an
+// unavailable status from any other status counts as a failure.
+func Compute(history types.StatusHistory, to time.Time, excludeMaintenance bool) types.Availability {
+	result := types.Availability{Statuses: make(map[types.DeviceStatus]time.Duration)}
+	if history.Status == nil || history.Since == nil {
+		return result
+	}
+	status := types.DeviceStatusFromString(*history.Status)
+	since := *history.Since
+	add := func(until time.Time) {
+		if until.After(since) && !(excludeMaintenance && status == types.DeviceStatusMaintenance) {
+			result.Statuses[status] += until.Sub(since)
+		}
+	}
+	for _, transition := range history.Transitions {
+		if transition.Transitioned == nil || !transition.Transitioned.After(since) {
+			continue
+		}
+		if !transition.Transitioned.Before(to) {
+			break
+		}
+		add(*transition.Transitioned)
+		next := types.DeviceStatusFromString(deref(transition.ToStatus))
+		if next.IsUnavailable() && !status.IsUnavailable() {
+			result.Failures++
+		}
+		status, since = next, *transition.Transitioned
+	}
+	add(to)
+	return result
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
@@ -0,0 +1,123 @@
+package availability
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+// history seeds the status history of a device starting at start, every transition is given
+// as the offset from start and the status entered.
+func history(start time.Time, status types.DeviceStatus, transitions ...any) types.StatusHistory {
+	initial := string(status)
+	result := types.StatusHistory{Status: &initial, Since: &start}
+	for i := 0; i < len(transitions); i += 2 {
+		at := start.Add(transitions[i].(time.Duration))
+		to := string(transitions[i+1].(types.DeviceStatus))
+		result.Transitions = append(result.Transitions, types.StatusTransition{
+			ToStatus:     &to,
+			Transitioned: &at,
+		})
+	}
+	return result
+}
+
+func TestCompute(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := start.Add(10 * time.Hour)
+	tests := []struct {
+		name               string
+		history            types.StatusHistory
+		excludeMaintenance bool
+		statuses           map[types.DeviceStatus]time.Duration
+		failures           int
+		available          float64
+	}{
+		{
+			name:      "should report full availability without transitions",
+			history:   history(start, types.DeviceStatusHealthy),
+			statuses:  map[types.DeviceStatus]time.Duration{types.DeviceStatusHealthy: 10 * time.Hour},
+			available: 100,
+		},
+		{
+			name: "should count downtime and failures",
+			history: history(start, types.DeviceStatusHealthy,
+				2*time.Hour, types.DeviceStatusError,
+				3*time.Hour, types.DeviceStatusDegraded,
+				5*time.Hour, types.DeviceStatusOffline,
+				6*time.Hour, types.DeviceStatusError,
+				7*time.Hour, types.DeviceStatusHealthy,
+			),
+			statuses: map[types.DeviceStatus]time.Duration{
+				types.DeviceStatusHealthy:  5 * time.Hour,
+				types.DeviceStatusError:    2 * time.Hour,
+				types.DeviceStatusDegraded: 2 * time.Hour,
+				types.DeviceStatusOffline:  time.Hour,
+			},
+			failures:  2,
+			available: 70,
+		},
+		{
+			name: "should count maintenance as unavailable",
+			history: history(start, types.DeviceStatusHealthy,
+				5*time.Hour, types.DeviceStatusMaintenance,
+				7*time.Hour, types.DeviceStatusBooting,
+				8*time.Hour, types.DeviceStatusHealthy,
+			),
+			statuses: map[types.DeviceStatus]time.Duration{
+				types.DeviceStatusHealthy:     7 * time.Hour,
+				types.DeviceStatusMaintenance: 2 * time.Hour,
+				types.DeviceStatusBooting:     time.Hour,
+			},
+			available: 70,
+		},
+		{
+			name: "should exclude maintenance from period",
+			history: history(start, types.DeviceStatusHealthy,
+				5*time.Hour, types.DeviceStatusMaintenance,
+				7*time.Hour, types.DeviceStatusBooting,
+				8*time.Hour, types.DeviceStatusHealthy,
+			),
+			excludeMaintenance: true,
+			statuses: map[types.DeviceStatus]time.Duration{
+				types.DeviceStatusHealthy: 7 * time.Hour,
+				types.DeviceStatusBooting: time.Hour,
+			},
+			available: 87.5,
+		},
+		{
+			name: "should ignore transitions outside of period",
+			history: history(start, types.DeviceStatusError,
+				-time.Hour, types.DeviceStatusHealthy,
+				4*time.Hour, types.DeviceStatusHealthy,
+				12*time.Hour, types.DeviceStatusError,
+			),
+			statuses: map[types.DeviceStatus]time.Duration{
+				types.DeviceStatusError:   4 * time.Hour,
+				types.DeviceStatusHealthy: 6 * time.Hour,
+			},
+			available: 60,
+		},
+		{
+			name:     "should report empty availability without known status",
+			history:  types.StatusHistory{},
+			statuses: map[types.DeviceStatus]time.Duration{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Compute(tt.history, to, tt.excludeMaintenance)
+			if !maps.Equal(result.Statuses, tt.statuses) {
+				t.Errorf("expected statuses %v, got %v", tt.statuses, result.Statuses)
+			}
+			if result.Failures != tt.failures {
+				t.Errorf("expected %d failures, got %d", tt.failures, result.Failures)
+			}
+			if available := result.Available(); available != tt.available {
+				t.Errorf("expected availability %.2f, got %.2f", tt.available, available)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package broker
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultConnectTimeout time.Duration = 10 * time.Second
+	DefaultWriteTimeout   time.Duration = 5 * time.Second
+)
+
+// Broker is an MQTT 3.1.1 broker embedded in the monitor for devices that can only publish
+// telemetry. It supports what the device publishers and the monitor clients rely on: clean
+// sessions, subscriptions with + and # wildcards, retained messages and publishes of QoS 0 to 2
+// which are delivered to subscribers at QoS 0. Persistent sessions and wills are not supported.
+// Clients authenticate with the username and password of an account and may only publish and
+// subscribe to the topics of their account.
+type Broker struct {
+	mu       sync.RWMutex
+	sessions map[string]*session
+	retained map[string][]byte
+	accounts map[string]Account
+	logger   *zap.Logger
+}
+
+// Account grants a client the topics covered by its publish and subscribe filters, a
+// subscription is only granted if all topics it matches are covered.
+type Account struct {
+	Password  string
+	Publish   []string
+	Subscribe []string
+}
+
+type session struct {
+	id      string
+	conn    net.Conn
+	account Account
+	mu      sync.Mutex // guards filters
+	writeMu sync.Mutex
+	filters map[string]struct{}
+}
+
+// NewBroker creates a broker accepting the accounts by username
+func NewBroker(accounts map[string]Account, logger *zap.Logger) *Broker {
+	return &Broker{
+		sessions: make(map[string]*session),
+		retained: make(map[string][]byte),
+		accounts: accounts,
+		logger:   logger,
+	}
+}
+
+// MonitorAccount grants all topics
+func MonitorAccount(password string) Account {
+	return Account{Password: password, Publish: []string{"#"}, Subscribe: []string{"#"}}
+}
+
+// DeviceAccount grants a device publishing its diagnostics and health and receiving its commands
+func DeviceAccount(deviceID, password string) Account {
+	return Account{
+		Password:  password,
+		Publish:   []string{devicev1.DiagnosticsTopic(deviceID), devicev1.HealthTopic(deviceID)},
+		Subscribe: []string{devicev1.CommandTopic(deviceID)},
+	}
+}
+
+// Serve accepts connections until the context is done, which closes the open connections
+func (b *Broker) Serve(ctx context.Context, lis net.Listener) error {
+	stop := context.AfterFunc(ctx, func() { _ = lis.Close() })
+	defer stop()
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to accept connection (mqtt): %w", err)
+		}
+		go b.handle(ctx, conn)
+	}
+}
+
+func (b *Broker) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+	s, keepalive, err := b.connect(conn)
+	if err != nil {
+		b.logger.Debug(
+			"connection refused (mqtt)",
+			zap.String("remote", conn.RemoteAddr().String()),
+			zap.Error(err),
+		)
+		return
+	}
+	b.register(s)
+	defer b.unregister(s)
+	for {
+		var deadline time.Time
+		if keepalive > 0 {
+			deadline = time.Now().Add(keepalive)
+		}
+		_ = conn.SetReadDeadline(deadline)
+		packet, err := packets.ReadPacket(conn)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				b.logger.Debug("connection closed (mqtt)", zap.String("client", s.id), zap.Error(err))
+			}
+			return
+		}
+		var response packets.ControlPacket
+		switch p := packet.(type) {
+		case *packets.PublishPacket:
+			// Denied messages are dropped but still acknowledged, MQTT 3.1.1 has no negative
+			// acknowledgement of a publish
+			if permits(s.account.Publish, p.TopicName) {
+				b.publish(p)
+			} else {
+				b.logger.Debug(
+					"publish denied (mqtt)",
+					zap.String("client", s.id),
+					zap.String("topic", p.TopicName),
+				)
+			}
+			switch p.Qos {
+			case 1:
+				puback := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+				puback.MessageID = p.MessageID
+				response = puback
+			case 2:
+				pubrec := packets.NewControlPacket(packets.Pubrec).(*packets.PubrecPacket)
+				pubrec.MessageID = p.MessageID
+				response = pubrec
+			}
+		case *packets.PubrelPacket:
+			pubcomp := packets.NewControlPacket(packets.Pubcomp).(*packets.PubcompPacket)
+			pubcomp.MessageID = p.MessageID
+			response = pubcomp
+		case *packets.SubscribePacket:
+			suback := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+			suback.MessageID = p.MessageID
+			suback.ReturnCodes = s.subscribe(p.Topics)
+			if err := s.write(suback); err != nil {
+				return
+			}
+			b.sendRetained(s, p.Topics)
+		case *packets.UnsubscribePacket:
+			s.unsubscribe(p.Topics)
+			unsuback := packets.NewControlPacket(packets.Unsuback).(*packets.UnsubackPacket)
+			unsuback.MessageID = p.MessageID
+			response = unsuback
+		case *packets.PingreqPacket:
+			response = packets.NewControlPacket(packets.Pingresp)
+		case *packets.DisconnectPacket:
+			return
+		}
+		if response != nil {
+			if err := s.write(response); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// connect reads the CONNECT packet of a connection and acknowledges it, the returned keep-alive
+// is the interval after which a silent client is disconnected (1.5 times its keep-alive).
+func (b *Broker) connect(conn net.Conn) (*session, time.Duration, error) {
+	_ = conn.SetReadDeadline(time.Now().Add(DefaultConnectTimeout))
+	packet, err := packets.ReadPacket(conn)
+	if err != nil {
+		return nil, 0, err
+	}
+	connect, ok := packet.(*packets.ConnectPacket)
+	if !ok {
+		return nil, 0, fmt.Errorf("expected connect packet, received %s", packet.String())
+	}
+	connack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+	connack.ReturnCode = connect.Validate()
+	account, ok := b.accounts[connect.Username]
+	if connack.ReturnCode == packets.Accepted && (!ok || !connect.PasswordFlag ||
+		subtle.ConstantTimeCompare(connect.Password, []byte(account.Password)) != 1) {
+		connack.ReturnCode = packets.ErrRefusedBadUsernameOrPassword
+	}
+	s := &session{
+		id:      connect.ClientIdentifier,
+		conn:    conn,
+		account: account,
+		filters: make(map[string]struct{}),
+	}
+	if s.id == "" {
+		s.id = conn.RemoteAddr().String()
+	}
+	if err := s.write(connack); err != nil {
+		return nil, 0, err
+	}
+	if connack.ReturnCode != packets.Accepted {
+		return nil, 0, packets.ConnErrors[connack.ReturnCode]
+	}
+	return s, time.Duration(connect.Keepalive) * time.Second * 3 / 2, nil
+}
+
+// register adds a session, an existing session of the same client is closed
+func (b *Broker) register(s *session) {
+	b.mu.Lock()
+	previous := b.sessions[s.id]
+	b.sessions[s.id] = s
+	b.mu.Unlock()
+	if previous != nil {
+		_ = previous.conn.Close()
+	}
+	b.logger.Debug("client connected (mqtt)", zap.String("client", s.id))
+}
+
+func (b *Broker) unregister(s *session) {
+	b.mu.Lock()
+	if b.sessions[s.id] == s {
+		delete(b.sessions, s.id)
+	}
+	b.mu.Unlock()
+	b.logger.Debug("client disconnected (mqtt)", zap.String("client", s.id))
+}
+
+// publish delivers a message to the matching subscriptions and keeps it if it is retained, a
+// retained message without payload clears the retained message of its topic.
+func (b *Broker) publish(p *packets.PublishPacket) {
+	if strings.ContainsAny(p.TopicName, "+#") {
+		return
+	}
+	b.mu.Lock()
+	if p.Retain {
+		if len(p.Payload) == 0 {
+			delete(b.retained, p.TopicName)
+		} else {
+			b.retained[p.TopicName] = p.Payload
+		}
+	}
+	subscribers := make([]*session, 0, len(b.sessions))
+	for _, s := range b.sessions {
+		if s.matches(p.TopicName) {
+			subscribers = append(subscribers, s)
+		}
+	}
+	b.mu.Unlock()
+	for _, s := range subscribers {
+		if err := s.send(p.TopicName, p.Payload, false); err != nil {
+			_ = s.conn.Close()
+		}
+	}
+}
+
+func (b *Broker) sendRetained(s *session, filters []string) {
+	b.mu.RLock()
+	messages := make(map[string][]byte)
+	for topic, payload := range b.retained {
+		for _, filter := range filters {
+			if Match(filter, topic) {
+				messages[topic] = payload
+				break
+			}
+		}
+	}
+	b.mu.RUnlock()
+	for topic, payload := range messages {
+		if err := s.send(topic, payload, true); err != nil {
+			_ = s.conn.Close()
+			return
+		}
+	}
+}
+
+// subscribe adds the valid filters the account permits, the returned codes grant QoS 0 or
+// reject a filter (0x80)
+func (s *session) subscribe(filters []string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	codes := make([]byte, len(filters))
+	for i, filter := range filters {
+		if !Valid(filter) || !permits(s.account.Subscribe, filter) {
+			codes[i] = 0x80
+			continue
+		}
+		s.filters[filter] = struct{}{}
+	}
+	return codes
+}
+
+func (s *session) unsubscribe(filters []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, filter := range filters {
+		delete(s.filters, filter)
+	}
+}
+
+func (s *session) matches(topic string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for filter := range s.filters {
+		if Match(filter, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *session) send(topic string, payload []byte, retain bool) error {
+	publish := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+	publish.TopicName = topic
+	publish.Payload = payload
+	publish.Retain = retain
+	return s.write(publish)
+}
+
+func (s *session) write(packet packets.ControlPacket) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(DefaultWriteTimeout))
+	return packet.Write(s.conn)
+}
+
+// permits reports whether one of the granted filters covers a topic or filter: each level of
+// the filter is equal to the level of the grant, is matched by + or follows #.
+func permits(granted []string, filter string) bool {
+	filters := strings.Split(filter, "/")
+	for _, grant := range granted {
+		grants := strings.Split(grant, "/")
+		for i, level := range grants {
+			if level == "#" {
+				return true
+			}
+			if i >= len(filters) || filters[i] == "#" || (level != "+" && level != filters[i]) {
+				break
+			}
+			if i == len(grants)-1 && len(grants) == len(filters) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Valid reports whether a topic filter is well-formed: # may only be the last level and
+// wildcards must occupy a whole level.
+func Valid(filter string) bool {
+	if filter == "" {
+		return false
+	}
+	levels := strings.Split(filter, "/")
+	for i, level := range levels {
+		if strings.Contains(level, "#") && (level != "#" || i != len(levels)-1) {
+			return false
+		}
+		if strings.Contains(level, "+") && level != "+" {
+			return false
+		}
+	}
+	return true
+}
+
+// Match reports whether a topic matches a filter, + matches a single level and # the remaining
+// levels (including none). Topics starting with $ are not matched by leading wildcards.
+func Match(filter, topic string) bool {
+	if strings.HasPrefix(topic, "$") && (strings.HasPrefix(filter, "+") || strings.HasPrefix(filter, "#")) {
+		return false
+	}
+	filters, topics := strings.Split(filter, "/"), strings.Split(topic, "/")
+	for i, level := range filters {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topics) || (level != "+" && level != topics[i]) {
+			return false
+		}
+	}
+	return len(filters) == len(topics)
+}
@@ -0,0 +1,327 @@
+package broker
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"go.uber.org/zap"
+)
+
+const (
+	device  = "router-001"
+	timeout = 2 * time.Second
+)
+
+// serve starts a broker accepting the monitor and the device router-001
+func serve(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	accounts := map[string]Account{
+		"monitor": MonitorAccount("secret"),
+		device:    DeviceAccount(device, "device-secret"),
+	}
+	go func() { _ = NewBroker(accounts, zap.NewNop()).Serve(ctx, lis) }()
+	return lis.Addr().String()
+}
+
+// dial connects a client and returns the connection and the return code of the broker
+func dial(t *testing.T, address, username, password string) (net.Conn, byte) {
+	t.Helper()
+	conn, err := net.Dial("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	connect := packets.NewControlPacket(packets.Connect).(*packets.ConnectPacket)
+	connect.ProtocolName = "MQTT"
+	connect.ProtocolVersion = 4
+	connect.CleanSession = true
+	connect.ClientIdentifier = username
+	if username != "" {
+		connect.UsernameFlag = true
+		connect.Username = username
+	}
+	if password != "" {
+		connect.PasswordFlag = true
+		connect.Password = []byte(password)
+	}
+	write(t, conn, connect)
+	return conn, read(t, conn).(*packets.ConnackPacket).ReturnCode
+}
+
+func write(t *testing.T, conn net.Conn, packet packets.ControlPacket) {
+	t.Helper()
+	if err := packet.Write(conn); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func read(t *testing.T, conn net.Conn) packets.ControlPacket {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(timeout))
+	packet, err := packets.ReadPacket(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return packet
+}
+
+// silent reports whether no packet is received within a short interval
+func silent(conn net.Conn) bool {
+	_ = conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	_, err := packets.ReadPacket(conn)
+	return err != nil
+}
+
+func subscribe(t *testing.T, conn net.Conn, filters ...string) []byte {
+	t.Helper()
+	subscribe := packets.NewControlPacket(packets.Subscribe).(*packets.SubscribePacket)
+	subscribe.MessageID = 1
+	subscribe.Topics = filters
+	subscribe.Qoss = make([]byte, len(filters))
+	write(t, conn, subscribe)
+	return read(t, conn).(*packets.SubackPacket).ReturnCodes
+}
+
+func publish(t *testing.T, conn net.Conn, topic string, qos byte, retain bool, payload string) {
+	t.Helper()
+	publish := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+	publish.TopicName = topic
+	publish.Qos = qos
+	publish.Retain = retain
+	publish.MessageID = 7
+	publish.Payload = []byte(payload)
+	write(t, conn, publish)
+}
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		filter   string
+		expected bool
+	}{
+		{filter: "devices/router-001/diagnostics", expected: true},
+		{filter: "devices/+/diagnostics", expected: true},
+		{filter: "devices/#", expected: true},
+		{filter: "#", expected: true},
+		{filter: "+", expected: true},
+		{filter: "", expected: false},
+		{filter: "devices/#/diagnostics", expected: false},
+		{filter: "devices/router#", expected: false},
+		{filter: "devices/router+/diagnostics", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			if result := Valid(tt.filter); result != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		topic    string
+		expected bool
+	}{
+		{name: "should match equal topic", filter: "a/b", topic: "a/b", expected: true},
+		{name: "should match single level", filter: "a/+/c", topic: "a/b/c", expected: true},
+		{name: "should match remaining levels", filter: "a/#", topic: "a/b/c", expected: true},
+		{name: "should match parent level", filter: "a/#", topic: "a", expected: true},
+		{name: "should match all topics", filter: "#", topic: "a/b", expected: true},
+		{name: "should not match other topic", filter: "a/b", topic: "a/c", expected: false},
+		{name: "should not match more levels", filter: "a/+", topic: "a/b/c", expected: false},
+		{name: "should not match fewer levels", filter: "a/+/c", topic: "a/b", expected: false},
+		{name: "should not match system topic", filter: "#", topic: "$SYS/uptime", expected: false},
+		{
+			name:     "should not match system topic level",
+			filter:   "+/uptime",
+			topic:    "$SYS/uptime",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Match(tt.filter, tt.topic); result != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPermits(t *testing.T) {
+	account := DeviceAccount(device, "device-secret")
+	tests := []struct {
+		name     string
+		granted  []string
+		filter   string
+		expected bool
+	}{
+		{
+			name:     "should permit topic of device",
+			granted:  account.Publish,
+			filter:   "devices/router-001/health",
+			expected: true,
+		},
+		{
+			name:    "should not permit topic of other device",
+			granted: account.Publish,
+			filter:  "devices/a/health",
+		},
+		{name: "should not permit wildcard", granted: account.Subscribe, filter: "devices/+/commands"},
+		{
+			name:     "should permit narrower wildcard",
+			granted:  []string{"#"},
+			filter:   "devices/+",
+			expected: true,
+		},
+		{name: "should not permit wider wildcard", granted: []string{"devices/+"}, filter: "devices/#"},
+		{name: "should permit same wildcard", granted: []string{"#"}, filter: "#", expected: true},
+		{
+			name:     "should permit topic of wildcard",
+			granted:  []string{"#"},
+			filter:   "devices/a",
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := permits(tt.granted, tt.filter); result != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestBroker_Connect(t *testing.T) {
+	address := serve(t)
+	tests := []struct {
+		name     string
+		username string
+		password string
+		expected byte
+	}{
+		{name: "should accept monitor", username: "monitor", password: "secret"},
+		{name: "should accept device", username: device, password: "device-secret"},
+		{
+			name:     "should refuse invalid password",
+			username: "monitor",
+			password: "device-secret",
+			expected: packets.ErrRefusedBadUsernameOrPassword,
+		},
+		{
+			name:     "should refuse unknown user",
+			username: "router-002",
+			password: "device-secret",
+			expected: packets.ErrRefusedBadUsernameOrPassword,
+		},
+		{
+			name:     "should refuse missing credentials",
+			expected: packets.ErrRefusedBadUsernameOrPassword,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, code := dial(t, address, tt.username, tt.password); code != tt.expected {
+				t.Errorf("expected return code %d, got %d", tt.expected, code)
+			}
+		})
+	}
+}
+
+func TestBroker_Publish(t *testing.T) {
+	address := serve(t)
+	monitor, _ := dial(t, address, "monitor", "secret")
+	if codes := subscribe(t, monitor, "devices/+/diagnostics"); !bytes.Equal(codes, []byte{0}) {
+		t.Fatalf("expected subscription to be granted, got %v", codes)
+	}
+	router, _ := dial(t, address, device, "device-secret")
+	topic := "devices/router-001/diagnostics"
+
+	delivered := func(t *testing.T, payload string) {
+		t.Helper()
+		p, ok := read(t, monitor).(*packets.PublishPacket)
+		if !ok || p.TopicName != topic || string(p.Payload) != payload || p.Qos != 0 {
+			t.Fatalf("expected %q delivered at qos 0, got %v", payload, p)
+		}
+	}
+	t.Run("should deliver message of qos 0", func(t *testing.T) {
+		publish(t, router, topic, 0, false, "qos-0")
+		delivered(t, "qos-0")
+		if !silent(router) {
+			t.Error("expected no acknowledgement")
+		}
+	})
+	t.Run("should acknowledge and deliver message of qos 1", func(t *testing.T) {
+		publish(t, router, topic, 1, false, "qos-1")
+		if puback, ok := read(t, router).(*packets.PubackPacket); !ok || puback.MessageID != 7 {
+			t.Fatalf("expected puback, got %v", puback)
+		}
+		delivered(t, "qos-1")
+	})
+	t.Run("should complete and deliver message of qos 2", func(t *testing.T) {
+		publish(t, router, topic, 2, false, "qos-2")
+		if pubrec, ok := read(t, router).(*packets.PubrecPacket); !ok || pubrec.MessageID != 7 {
+			t.Fatalf("expected pubrec, got %v", pubrec)
+		}
+		pubrel := packets.NewControlPacket(packets.Pubrel).(*packets.PubrelPacket)
+		pubrel.MessageID = 7
+		write(t, router, pubrel)
+		if pubcomp, ok := read(t, router).(*packets.PubcompPacket); !ok || pubcomp.MessageID != 7 {
+			t.Fatalf("expected pubcomp, got %v", pubcomp)
+		}
+		delivered(t, "qos-2")
+	})
+	t.Run("should acknowledge and drop message to topic of other device", func(t *testing.T) {
+		publish(t, router, "devices/router-002/diagnostics", 1, false, "spoofed")
+		if _, ok := read(t, router).(*packets.PubackPacket); !ok {
+			t.Fatal("expected puback")
+		}
+		if !silent(monitor) {
+			t.Error("expected message to be dropped")
+		}
+	})
+	t.Run("should refuse subscription to topics of other devices", func(t *testing.T) {
+		codes := subscribe(t, router, "devices/router-001/commands", "devices/+/commands", "devices/#")
+		if !bytes.Equal(codes, []byte{0, 0x80, 0x80}) {
+			t.Errorf("expected only own commands to be granted, got %v", codes)
+		}
+	})
+}
+
+func TestBroker_Retained(t *testing.T) {
+	address := serve(t)
+	router, _ := dial(t, address, device, "device-secret")
+	topic := "devices/router-001/health"
+	publish(t, router, topic, 0, true, "healthy")
+
+	t.Run("should send retained message to new subscriber", func(t *testing.T) {
+		monitor, _ := dial(t, address, "monitor", "secret")
+		subscribe(t, monitor, "devices/+/health")
+		p, ok := read(t, monitor).(*packets.PublishPacket)
+		if !ok || p.TopicName != topic || string(p.Payload) != "healthy" || !p.Retain {
+			t.Fatalf("expected retained message, got %v", p)
+		}
+	})
+	t.Run("should clear retained message without payload", func(t *testing.T) {
+		publish(t, router, topic, 0, true, "")
+		// The broker handles the packets of a connection in order, a ping completes the publish
+		write(t, router, packets.NewControlPacket(packets.Pingreq))
+		read(t, router)
+		monitor, _ := dial(t, address, "monitor", "secret")
+		subscribe(t, monitor, "devices/+/health")
+		if !silent(monitor) {
+			t.Error("expected no retained message")
+		}
+	})
+}
@@ -0,0 +1,403 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"go.uber.org/zap"
+)
+
+const DefaultQueueSize = 16
+
+var (
+	ErrorCampaignCancelled = errors.New("campaign cancelled")
+	ErrorWaveTimeout       = errors.New("wave timeout exceeded before devices reported healthy")
+	ErrorUpgradeFailed     = errors.New("firmware upgrade failed")
+)
+
+type PersistenceProvider interface {
+	GetDevice(ctx context.Context, deviceID string) (types.Device, error)
+	GetDiagnostics(ctx context.Context, deviceID string) (types.Diagnostics, error)
+	UpdateCampaign(
+		ctx context.Context,
+		campaignID string,
+		status types.CampaignStatus,
+		wave int,
+		message string,
+	) error
+	UpdateCampaignDevice(
+		ctx context.Context,
+		campaignID string,
+		deviceID string,
+		status types.CampaignDeviceStatus,
+		message string,
+	) error
+	InterruptCampaigns(ctx context.Context, monitorID string) (int64, error)
+}
+
+type DeviceProvider interface {
+	CreateClient(config device.Config) (device.Client, error)
+	ControlConfig(ctx context.Context, device types.Device) (device.Config, error)
+}
+
+// Campaign Runner
+type Runner struct {
+	persistence PersistenceProvider
+	device      DeviceProvider
+	monitorID   string
+	interval    time.Duration
+	queue       chan types.Campaign
+	running     map[string]context.CancelCauseFunc
+	mu          sync.Mutex
+	logger      *zap.Logger
+}
+
+func NewRunner(
+	persistence PersistenceProvider,
+	device DeviceProvider,
+	monitorID string,
+	interval time.Duration,
+	logger *zap.Logger,
+) *Runner {
+	return &Runner{
+		persistence: persistence,
+		device:      device,
+		monitorID:   monitorID,
+		interval:    interval,
+		queue:       make(chan types.Campaign, DefaultQueueSize),
+		running:     make(map[string]context.CancelCauseFunc),
+		logger:      logger,
+	}
+}
+
+// Run executes submitted campaigns until the context is done. Campaigns are executed in
+// memory, unfinished campaigns of a previous run of the monitor are halted on startup.
+func (r *Runner) Run(ctx context.Context) error {
+	interrupted, err := r.persistence.InterruptCampaigns(ctx, r.monitorID)
+	if err != nil {
+		return err
+	}
+	if interrupted > 0 {
+		r.logger.Warn("halted interrupted campaigns", zap.Int64("campaigns", interrupted))
+	}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case campaign := <-r.queue:
+			id := deref(campaign.ID)
+			cctx, cancel := context.WithCancelCause(ctx)
+			r.mu.Lock()
+			r.running[id] = cancel
+			r.mu.Unlock()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer r.finish(id)
+				r.run(cctx, campaign)
+			}()
+		}
+	}
+}
+
+func (r *Runner) Start(ctx context.Context, campaign types.Campaign) error {
+	select {
+	case r.queue <- campaign:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Cancel stops a running campaign, upgrades already requested from devices are not reverted
+func (r *Runner) Cancel(campaignID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cancel, ok := r.running[campaignID]
+	if ok {
+		cancel(ErrorCampaignCancelled)
+	}
+	return ok
+}
+
+// Campaign (execution state)
+type execution struct {
+	id       string
+	campaign types.Campaign
+	statuses map[string]types.CampaignDeviceStatus
+	mu       sync.Mutex
+	logger   *zap.Logger
+}
+
+// run upgrades the waves in order, a wave only starts once every device of the previous
+// wave reports the target version and a healthy status. A failed wave halts the campaign
+// and, depending on the failure policy, rolls back the upgraded devices.
+func (r *Runner) run(ctx context.Context, campaign types.Campaign) {
+	exec := &execution{
+		id:       deref(campaign.ID),
+		campaign: campaign,
+		statuses: make(map[string]types.CampaignDeviceStatus, len(campaign.Devices)),
+		logger:   r.logger.With(zap.String("campaign_id", deref(campaign.ID))),
+	}
+	for _, dev := range campaign.Devices {
+		exec.statuses[deref(dev.DeviceID)] = types.CampaignDeviceStatusFromString(deref(dev.Status))
+	}
+	waves := waves(campaign.Devices)
+	target := deref(campaign.TargetVersion)
+	exec.logger.Info("campaign started", zap.String("target_version", target), zap.Int("waves", len(waves)))
+	for i, wave := range waves {
+		r.update(ctx, exec, types.CampaignStatusRunning, i+1, "")
+		err := r.wave(ctx, exec, wave, func(types.CampaignDevice) string {
+			return target
+		}, types.CampaignDeviceStatusUpgraded)
+		if err == nil {
+			continue
+		}
+		if errors.Is(context.Cause(ctx), ErrorCampaignCancelled) {
+			r.skip(ctx, exec)
+			r.update(ctx, exec, types.CampaignStatusCancelled, i+1, "")
+			exec.logger.Info("campaign cancelled", zap.Int("wave", i+1))
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		r.skip(ctx, exec)
+		message := fmt.Sprintf("wave %d failed: %s", i+1, err)
+		exec.logger.Warn("campaign wave failed", zap.Int("wave", i+1), zap.Error(err))
+		if types.FailurePolicyFromString(deref(campaign.FailurePolicy)) != types.FailurePolicyRollback {
+			r.update(ctx, exec, types.CampaignStatusHalted, i+1, message)
+			return
+		}
+		if err := r.rollback(ctx, exec, waves[:i+1]); err != nil {
+			message = fmt.Sprintf("%s, rollback failed: %s", message, err)
+			r.update(ctx, exec, types.CampaignStatusHalted, i+1, message)
+			return
+		}
+		r.update(ctx, exec, types.CampaignStatusRolledBack, i+1, message)
+		return
+	}
+	r.update(ctx, exec, types.CampaignStatusCompleted, len(waves), "")
+	exec.logger.Info("campaign completed")
+}
+
+// wave upgrades devices with at most max unavailable devices upgrading at the same time,
+// no further devices are started once a device of the wave failed.
+func (r *Runner) wave(
+	ctx context.Context,
+	exec *execution,
+	devices []types.CampaignDevice,
+	version func(types.CampaignDevice) string,
+	success types.CampaignDeviceStatus,
+) error {
+	timeout := time.Duration(deref(exec.campaign.WaveTimeout)) * time.Millisecond
+	wctx, cancel := context.WithTimeoutCause(ctx, timeout, ErrorWaveTimeout)
+	defer cancel()
+	slots := make(chan struct{}, max(deref(exec.campaign.MaxUnavailable), 1))
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed error
+	)
+	for _, dev := range devices {
+		select {
+		case slots <- struct{}{}:
+		case <-wctx.Done():
+		}
+		mu.Lock()
+		stop := failed != nil || wctx.Err() != nil
+		mu.Unlock()
+		if stop {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := r.upgrade(wctx, exec, dev, version(dev), success); err != nil {
+				mu.Lock()
+				failed = errors.Join(failed, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if failed == nil && wctx.Err() != nil {
+		return context.Cause(wctx)
+	}
+	return failed
+}
+
+// rollback upgrades the devices of the given waves that were upgraded back to their
+// previous version, starting with the latest wave.
+func (r *Runner) rollback(ctx context.Context, exec *execution, waves [][]types.CampaignDevice) error {
+	exec.logger.Info("rolling back campaign")
+	for _, wave := range slices.Backward(waves) {
+		devices := slices.DeleteFunc(slices.Clone(wave), func(dev types.CampaignDevice) bool {
+			return exec.status(deref(dev.DeviceID)) != types.CampaignDeviceStatusUpgraded
+		})
+		if len(devices) == 0 {
+			continue
+		}
+		err := r.wave(ctx, exec, devices, func(dev types.CampaignDevice) string {
+			return deref(dev.PreviousVersion)
+		}, types.CampaignDeviceStatusRolledBack)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) upgrade(
+	ctx context.Context,
+	exec *execution,
+	dev types.CampaignDevice,
+	version string,
+	success types.CampaignDeviceStatus,
+) error {
+	deviceID := deref(dev.DeviceID)
+	r.status(ctx, exec, deviceID, types.CampaignDeviceStatusUpgrading, "")
+	if err := r.upgradeDevice(ctx, deviceID, version); err != nil {
+		r.status(ctx, exec, deviceID, types.CampaignDeviceStatusFailed, err.Error())
+		return fmt.Errorf("device %s: %w", deviceID, err)
+	}
+	r.status(ctx, exec, deviceID, success, "")
+	return nil
+}
+
+// upgradeDevice requests the upgrade and waits until the monitored diagnostics report the
+// version and a healthy status, upgrades reported as failed by the device fail immediately.
+func (r *Runner) upgradeDevice(ctx context.Context, deviceID string, version string) error {
+	result, err := r.persistence.GetDevice(ctx, deviceID)
+	if err != nil {
+		return err
+	}
+	config, err := r.device.ControlConfig(ctx, result)
+	if err != nil {
+		return err
+	}
+	client, err := r.device.CreateClient(config)
+	if err != nil {
+		return err
+	}
+	defer client.Close() //nolint:errcheck
+	started := time.Now()
+	if err := client.UpgradeFirmware(ctx, version); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-ticker.C:
+		}
+		if upgrade, err := client.GetFirmwareUpgrade(ctx); err == nil &&
+			upgrade.Phase == types.UpgradePhaseFailed {
+			return fmt.Errorf("%w: %s", ErrorUpgradeFailed, upgrade.Error)
+		}
+		diag, err := r.persistence.GetDiagnostics(ctx, deviceID)
+		if err != nil {
+			continue
+		}
+		if diag.LastUpdated != nil && diag.LastUpdated.After(started) &&
+			deref(diag.Firmware) == version &&
+			types.DeviceStatusFromString(deref(diag.DeviceStatus)) == types.DeviceStatusHealthy {
+			return nil
+		}
+	}
+}
+
+// skip marks the devices that have not been started as skipped
+func (r *Runner) skip(ctx context.Context, exec *execution) {
+	for _, dev := range exec.campaign.Devices {
+		deviceID := deref(dev.DeviceID)
+		if exec.status(deviceID) == types.CampaignDeviceStatusPending {
+			r.status(ctx, exec, deviceID, types.CampaignDeviceStatusSkipped, "")
+		}
+	}
+}
+
+// update persists the campaign status, updates are persisted after cancellation as well
+func (r *Runner) update(
+	ctx context.Context,
+	exec *execution,
+	status types.CampaignStatus,
+	wave int,
+	message string,
+) {
+	err := r.persistence.UpdateCampaign(context.WithoutCancel(ctx), exec.id, status, wave, message)
+	if err != nil {
+		exec.logger.Error("failed to update campaign", zap.Error(err))
+	}
+}
+
+func (r *Runner) status(
+	ctx context.Context,
+	exec *execution,
+	deviceID string,
+	status types.CampaignDeviceStatus,
+	message string,
+) {
+	exec.mu.Lock()
+	exec.statuses[deviceID] = status
+	exec.mu.Unlock()
+	err := r.persistence.UpdateCampaignDevice(context.WithoutCancel(ctx), exec.id, deviceID, status, message)
+	if err != nil {
+		exec.logger.Error(
+			"failed to update campaign device",
+			zap.String("device_id", deviceID),
+			zap.Error(err),
+		)
+	}
+}
+
+func (r *Runner) finish(campaignID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cancel, ok := r.running[campaignID]; ok {
+		cancel(nil)
+		delete(r.running, campaignID)
+	}
+}
+
+func (e *execution) status(deviceID string) types.CampaignDeviceStatus {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.statuses[deviceID]
+}
+
+// waves groups the devices by wave in ascending order
+func waves(devices []types.CampaignDevice) [][]types.CampaignDevice {
+	var result [][]types.CampaignDevice
+	for _, dev := range devices {
+		wave := int(deref(dev.Wave))
+		for len(result) < wave {
+			result = append(result, nil)
+		}
+		if wave > 0 {
+			result[wave-1] = append(result[wave-1], dev)
+		}
+	}
+	return slices.DeleteFunc(result, func(wave []types.CampaignDevice) bool {
+		return len(wave) == 0
+	})
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
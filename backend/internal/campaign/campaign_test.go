@@ -0,0 +1,332 @@
+package campaign
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"go.uber.org/zap"
+)
+
+// fleet emulates the persistence and the devices of a campaign, devices report the
+// requested version as healthy on their next diagnostics unless their upgrade fails.
+type fleet struct {
+	mu        sync.Mutex
+	firmware  map[string]string
+	failing   map[string]bool
+	stalling  map[string]bool
+	upgrades  []string
+	campaign  types.CampaignStatus
+	wave      int
+	message   string
+	statuses  map[string]types.CampaignDeviceStatus
+	available int
+	upgrading int
+}
+
+func newFleet(firmware map[string]string) *fleet {
+	return &fleet{
+		firmware: firmware,
+		failing:  make(map[string]bool),
+		stalling: make(map[string]bool),
+		statuses: make(map[string]types.CampaignDeviceStatus),
+	}
+}
+
+func (f *fleet) GetDevice(ctx context.Context, deviceID string) (types.Device, error) {
+	return types.Device{Identifier: &deviceID}, nil
+}
+
+func (f *fleet) GetDiagnostics(ctx context.Context, deviceID string) (types.Diagnostics, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	firmware, status, updated := f.firmware[deviceID], string(types.DeviceStatusHealthy), time.Now()
+	return types.Diagnostics{
+		Identifier:   &deviceID,
+		Firmware:     &firmware,
+		DeviceStatus: &status,
+		LastUpdated:  &updated,
+	}, nil
+}
+
+func (f *fleet) UpdateCampaign(
+	ctx context.Context,
+	campaignID string,
+	status types.CampaignStatus,
+	wave int,
+	message string,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.campaign, f.wave, f.message = status, wave, message
+	return nil
+}
+
+func (f *fleet) UpdateCampaignDevice(
+	ctx context.Context,
+	campaignID string,
+	deviceID string,
+	status types.CampaignDeviceStatus,
+	message string,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statuses[deviceID] = status
+	return nil
+}
+
+func (f *fleet) InterruptCampaigns(ctx context.Context, monitorID string) (int64, error) {
+	return 0, nil
+}
+
+func (f *fleet) ControlConfig(ctx context.Context, dev types.Device) (device.Config, error) {
+	return device.Config{Identifier: deref(dev.Identifier)}, nil
+}
+
+func (f *fleet) CreateClient(config device.Config) (device.Client, error) {
+	return &client{fleet: f, deviceID: config.Identifier}, nil
+}
+
+type client struct {
+	device.Client
+	fleet    *fleet
+	deviceID string
+}
+
+func (c *client) UpgradeFirmware(ctx context.Context, version string) error {
+	c.fleet.mu.Lock()
+	defer c.fleet.mu.Unlock()
+	c.fleet.upgrades = append(c.fleet.upgrades, c.deviceID)
+	c.fleet.upgrading++
+	c.fleet.available = max(c.fleet.available, c.fleet.upgrading)
+	if !c.fleet.stalling[c.deviceID] {
+		c.fleet.firmware[c.deviceID] = version
+	}
+	return nil
+}
+
+func (c *client) GetFirmwareUpgrade(ctx context.Context) (*types.DeviceFirmwareUpgrade, error) {
+	c.fleet.mu.Lock()
+	defer c.fleet.mu.Unlock()
+	if c.fleet.failing[c.deviceID] {
+		return &types.DeviceFirmwareUpgrade{Phase: types.UpgradePhaseFailed, Error: "checksum mismatch"}, nil
+	}
+	return &types.DeviceFirmwareUpgrade{Phase: types.UpgradePhaseDownloading}, nil
+}
+
+func (c *client) Close() error {
+	c.fleet.mu.Lock()
+	defer c.fleet.mu.Unlock()
+	c.fleet.upgrading--
+	return nil
+}
+
+func newCampaign(policy types.FailurePolicy, maxUnavailable int32, waves ...[]string) types.Campaign {
+	var (
+		id      = "campaign-001"
+		target  = "2.0.0"
+		timeout = (500 * time.Millisecond).Milliseconds()
+		pending = string(types.CampaignDeviceStatusPending)
+		value   = string(policy)
+	)
+	campaign := types.Campaign{
+		ID:             &id,
+		TargetVersion:  &target,
+		MaxUnavailable: &maxUnavailable,
+		WaveTimeout:    &timeout,
+		FailurePolicy:  &value,
+	}
+	for i, devices := range waves {
+		for _, deviceID := range devices {
+			wave, previous := int32(i+1), "1.0.0" // nolint:gosec
+			campaign.Devices = append(campaign.Devices, types.CampaignDevice{
+				DeviceID:        &deviceID,
+				Wave:            &wave,
+				Status:          &pending,
+				PreviousVersion: &previous,
+			})
+		}
+	}
+	return campaign
+}
+
+func TestRunner_Run(t *testing.T) {
+	tests := []struct {
+		name      string
+		campaign  types.Campaign
+		failing   []string
+		stalling  []string
+		status    types.CampaignStatus
+		wave      int
+		message   string
+		firmware  map[string]string
+		statuses  map[string]types.CampaignDeviceStatus
+		upgrades  []string
+		available int
+	}{
+		{
+			name:     "should upgrade waves in order",
+			campaign: newCampaign(types.FailurePolicyHalt, 2, []string{"a", "b"}, []string{"c"}),
+			status:   types.CampaignStatusCompleted,
+			wave:     2,
+			firmware: map[string]string{"a": "2.0.0", "b": "2.0.0", "c": "2.0.0"},
+			statuses: map[string]types.CampaignDeviceStatus{
+				"a": types.CampaignDeviceStatusUpgraded,
+				"b": types.CampaignDeviceStatusUpgraded,
+				"c": types.CampaignDeviceStatusUpgraded,
+			},
+			upgrades:  []string{"a", "b", "c"},
+			available: 2,
+		},
+		{
+			name:     "should limit unavailable devices of a wave",
+			campaign: newCampaign(types.FailurePolicyHalt, 1, []string{"a", "b", "c"}),
+			status:   types.CampaignStatusCompleted,
+			wave:     1,
+			firmware: map[string]string{"a": "2.0.0", "b": "2.0.0", "c": "2.0.0"},
+			statuses: map[string]types.CampaignDeviceStatus{
+				"a": types.CampaignDeviceStatusUpgraded,
+				"b": types.CampaignDeviceStatusUpgraded,
+				"c": types.CampaignDeviceStatusUpgraded,
+			},
+			upgrades:  []string{"a", "b", "c"},
+			available: 1,
+		},
+		{
+			name:     "should halt campaign due to failed upgrade",
+			campaign: newCampaign(types.FailurePolicyHalt, 1, []string{"a", "b", "c"}, []string{"d"}),
+			failing:  []string{"b"},
+			status:   types.CampaignStatusHalted,
+			wave:     1,
+			message:  ErrorUpgradeFailed.Error(),
+			firmware: map[string]string{"a": "2.0.0", "b": "2.0.0", "c": "1.0.0", "d": "1.0.0"},
+			statuses: map[string]types.CampaignDeviceStatus{
+				"a": types.CampaignDeviceStatusUpgraded,
+				"b": types.CampaignDeviceStatusFailed,
+				"c": types.CampaignDeviceStatusSkipped,
+				"d": types.CampaignDeviceStatusSkipped,
+			},
+			upgrades:  []string{"a", "b"},
+			available: 1,
+		},
+		{
+			name:     "should halt campaign due to wave timeout",
+			campaign: newCampaign(types.FailurePolicyHalt, 1, []string{"a"}, []string{"b"}),
+			stalling: []string{"a"},
+			status:   types.CampaignStatusHalted,
+			wave:     1,
+			message:  ErrorWaveTimeout.Error(),
+			firmware: map[string]string{"a": "1.0.0", "b": "1.0.0"},
+			statuses: map[string]types.CampaignDeviceStatus{
+				"a": types.CampaignDeviceStatusFailed,
+				"b": types.CampaignDeviceStatusSkipped,
+			},
+			upgrades:  []string{"a"},
+			available: 1,
+		},
+		{
+			name: "should roll back upgraded devices in reverse wave order",
+			campaign: newCampaign(
+				types.FailurePolicyRollback, 1, []string{"a", "b"}, []string{"c", "d", "e"},
+			),
+			failing:  []string{"d"},
+			status:   types.CampaignStatusRolledBack,
+			wave:     2,
+			message:  ErrorUpgradeFailed.Error(),
+			firmware: map[string]string{"a": "1.0.0", "b": "1.0.0", "c": "1.0.0", "d": "2.0.0", "e": "1.0.0"},
+			statuses: map[string]types.CampaignDeviceStatus{
+				"a": types.CampaignDeviceStatusRolledBack,
+				"b": types.CampaignDeviceStatusRolledBack,
+				"c": types.CampaignDeviceStatusRolledBack,
+				"d": types.CampaignDeviceStatusFailed,
+				"e": types.CampaignDeviceStatusSkipped,
+			},
+			upgrades:  []string{"a", "b", "c", "d", "c", "a", "b"},
+			available: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			firmware := make(map[string]string)
+			for _, dev := range tt.campaign.Devices {
+				firmware[deref(dev.DeviceID)] = deref(dev.PreviousVersion)
+			}
+			f := newFleet(firmware)
+			for _, deviceID := range tt.failing {
+				f.failing[deviceID] = true
+			}
+			for _, deviceID := range tt.stalling {
+				f.stalling[deviceID] = true
+			}
+			runner := NewRunner(f, f, "monitor-001", 5*time.Millisecond, zap.NewNop())
+			runner.run(context.Background(), tt.campaign)
+
+			if f.campaign != tt.status || f.wave != tt.wave {
+				t.Errorf("expected campaign %s at wave %d, got %s at wave %d (%s)",
+					tt.status, tt.wave, f.campaign, f.wave, f.message)
+			}
+			if !strings.Contains(f.message, tt.message) {
+				t.Errorf("expected message containing %q, got %q", tt.message, f.message)
+			}
+			for deviceID, expected := range tt.firmware {
+				if f.firmware[deviceID] != expected {
+					t.Errorf("expected firmware %s of %s, got %s", expected, deviceID, f.firmware[deviceID])
+				}
+			}
+			for deviceID, expected := range tt.statuses {
+				if f.statuses[deviceID] != expected {
+					t.Errorf("expected status %s of %s, got %s", expected, deviceID, f.statuses[deviceID])
+				}
+			}
+			if tt.available == 1 && !slices.Equal(f.upgrades, tt.upgrades) {
+				t.Errorf("expected upgrades %v, got %v", tt.upgrades, f.upgrades)
+			}
+			if tt.available > 1 {
+				// Devices of a wave are upgraded concurrently, waves are not
+				slices.Sort(f.upgrades[:tt.available])
+				if !slices.Equal(f.upgrades, tt.upgrades) {
+					t.Errorf("expected upgrades %v, got %v", tt.upgrades, f.upgrades)
+				}
+			}
+			if f.available > tt.available {
+				t.Errorf("expected at most %d unavailable devices, got %d", tt.available, f.available)
+			}
+		})
+	}
+}
+
+func TestRunner_Cancel(t *testing.T) {
+	f := newFleet(map[string]string{"a": "1.0.0", "b": "1.0.0"})
+	f.stalling["a"] = true
+	runner := NewRunner(f, f, "monitor-001", 5*time.Millisecond, zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- runner.Run(ctx) }()
+
+	campaign := newCampaign(types.FailurePolicyRollback, 1, []string{"a"}, []string{"b"})
+	if err := runner.Start(ctx, campaign); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for !runner.Cancel(deref(campaign.ID)) {
+		if time.Now().After(deadline) {
+			t.Fatal("expected campaign to be running")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if f.campaign != types.CampaignStatusCancelled {
+		t.Errorf("expected campaign %s, got %s", types.CampaignStatusCancelled, f.campaign)
+	}
+	if f.statuses["b"] != types.CampaignDeviceStatusSkipped {
+		t.Errorf("expected status %s of b, got %s", types.CampaignDeviceStatusSkipped, f.statuses["b"])
+	}
+}
@@ -8,16 +8,26 @@ import (
 	"time"
 )
 
-const DefaultChecksumTimeout = 5 * time.Second
+const (
+	DefaultChecksumTimeout   = 5 * time.Second
+	DefaultChecksumAlgorithm = "sha256"
+)
 
 type Generator struct {
 	binaryPath string
+	algorithm  string
 	timeout    time.Duration
 }
 
-func NewGenerator(path string) *Generator {
+// NewGenerator creates a generator pinned to a hash algorithm, input is canonicalized
+// by the binary so devices and monitors agree on checksums regardless of JSON formatting.
+func NewGenerator(path, algorithm string) *Generator {
+	if algorithm == "" {
+		algorithm = DefaultChecksumAlgorithm
+	}
 	return &Generator{
 		binaryPath: path,
+		algorithm:  algorithm,
 		timeout:    DefaultChecksumTimeout,
 	}
 }
@@ -25,7 +35,7 @@ func NewGenerator(path string) *Generator {
 func (g *Generator) GenerateChecksum(ctx context.Context, data []byte) (string, error) {
 	ectx, cancel := context.WithTimeout(ctx, g.timeout)
 	defer cancel()
-	cmd := exec.CommandContext(ectx, g.binaryPath)
+	cmd := exec.CommandContext(ectx, g.binaryPath, "--algo", g.algorithm, "--canonical")
 	cmd.Stdin = bytes.NewReader(data)
 
 	var stdout, stderr bytes.Buffer
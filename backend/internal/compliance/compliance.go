@@ -0,0 +1,86 @@
+package compliance
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/version"
+)
+
+// Evaluate checks the reported versions of a device against the policies matching its labels.
+// A device without matching policies is compliant, a device with matching policies that has
+// not reported its versions is unknown. Each violation names the policy it violates.
+func Evaluate(
+	policies []types.CompliancePolicy,
+	labels map[string]string,
+	versions *types.DeviceVersions,
+) types.Compliance {
+	var (
+		matched    bool
+		violations []string
+	)
+	for _, policy := range policies {
+		if !policy.Applies(labels) {
+			continue
+		}
+		matched = true
+		if versions == nil {
+			continue
+		}
+		violations = append(violations, Violations(policy, versions)...)
+	}
+	switch {
+	case matched && versions == nil:
+		return types.Compliance{Status: types.ComplianceStatusUnknown}
+	case len(violations) > 0:
+		return types.Compliance{Status: types.ComplianceStatusNonCompliant, Violations: violations}
+	default:
+		return types.Compliance{Status: types.ComplianceStatusCompliant}
+	}
+}
+
+// Violations returns the violations of a policy by the versions of a device, a component that
+// has not been reported violates no policy.
+func Violations(policy types.CompliancePolicy, versions *types.DeviceVersions) []string {
+	var (
+		result    []string
+		name      = deref(policy.Name)
+		component = types.VersionComponentFromString(deref(policy.Component))
+		current   = versions.Version(component)
+		label     = component.Label()
+	)
+	if current == "" {
+		return nil
+	}
+	if minimum := deref(policy.MinVersion); minimum != "" && version.Compare(current, minimum) < 0 {
+		result = append(result, fmt.Sprintf(
+			"%s version '%s' is below the minimum version '%s' (policy '%s')",
+			label, current, minimum, name,
+		))
+	}
+	matches := func(pattern string) bool {
+		return version.Matches(pattern, current)
+	}
+	if len(policy.Allow) > 0 && !slices.ContainsFunc(policy.Allow, matches) {
+		result = append(result, fmt.Sprintf(
+			"%s version '%s' is not allowed (policy '%s')",
+			label, current, name,
+		))
+	}
+	if slices.ContainsFunc(policy.Deny, matches) {
+		result = append(result, fmt.Sprintf(
+			"%s version '%s' is denied (policy '%s')",
+			label, current, name,
+		))
+	}
+	return result
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/anomaly"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *PersistenceRepository) ListAnomalies(
+	ctx context.Context,
+	query types.AnomalyQuery,
+) ([]types.Anomaly, error) {
+	rows, err := r.pool.Query(ctx, `
+		select * from device_anomalies
+		where ($1 = '' or device_id = $1) and detected_at >= $2 and detected_at < $3
+		order by detected_at desc
+		limit $4
+	`, query.DeviceID, query.From, query.To, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to query anomalies (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	return collectAnomalies(rows)
+}
+
+// ListRecordedAnomalies returns the anomalies recorded after the given time in the order they
+// were recorded, of a device or of all devices if none is given.
+func (r *PersistenceRepository) ListRecordedAnomalies(
+	ctx context.Context,
+	deviceID string,
+	after time.Time,
+) ([]types.Anomaly, error) {
+	rows, err := r.pool.Query(ctx, `
+		select * from device_anomalies
+		where ($1 = '' or device_id = $1) and recorded_at > $2
+		order by recorded_at, id
+	`, deviceID, after)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to query anomalies (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	return collectAnomalies(rows)
+}
+
+// GetAnomalySettings returns the anomaly settings of a device, devices without settings use
+// the default sensitivity.
+func (r *PersistenceRepository) GetAnomalySettings(
+	ctx context.Context,
+	deviceID string,
+) (types.AnomalySettings, error) {
+	rows, err := r.pool.Query(ctx, `
+		select d.device_id, coalesce(s.sensitivity, $2) as sensitivity, s.updated_at
+		from devices d
+		left join device_anomaly_settings s on s.device_id = d.device_id
+		where d.device_id = $1
+	`, deviceID, types.SensitivityMedium)
+	if err != nil {
+		return types.AnomalySettings{}, fmt.Errorf(
+			"%w: failed to query anomaly settings (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectAnomalySettings(rows, deviceID)
+}
+
+func (r *PersistenceRepository) SaveAnomalySettings(
+	ctx context.Context,
+	deviceID string,
+	sensitivity types.Sensitivity,
+) (types.AnomalySettings, error) {
+	rows, err := r.pool.Query(ctx, `
+		insert into device_anomaly_settings (device_id, sensitivity)
+		select device_id, $2::anomaly_sensitivity from devices where device_id = $1
+		on conflict (device_id) do update set
+			sensitivity = excluded.sensitivity,
+			updated_at = now()
+		returning *
+	`, deviceID, sensitivity)
+	if err != nil {
+		return types.AnomalySettings{}, fmt.Errorf(
+			"%w: failed to save anomaly settings (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectAnomalySettings(rows, deviceID)
+}
+
+// saveAnomalies updates the metric baselines of a device with a diagnostics sample and records
+// the anomalies the sample starts, the baselines are selected with the diagnostics insert.
+func (r *PersistenceRepository) saveAnomalies(
+	ctx context.Context,
+	tx pgx.Tx,
+	diag types.DeviceDiagnostics,
+	sensitivity types.Sensitivity,
+	baselines []types.MetricBaseline,
+) error {
+	values := anomaly.Values(diag)
+	if len(values) == 0 {
+		return nil
+	}
+	states := make(map[types.Metric]anomaly.State, len(baselines))
+	for _, baseline := range baselines {
+		states[types.MetricFromString(deref(baseline.Metric))] = anomaly.State{
+			Mean:        deref(baseline.Mean),
+			Variance:    deref(baseline.Variance),
+			FastMean:    deref(baseline.FastMean),
+			Samples:     deref(baseline.Samples),
+			SpikeActive: deref(baseline.SpikeActive),
+			DriftActive: deref(baseline.DriftActive),
+			Observed:    deref(baseline.Observed),
+		}
+	}
+	batch := &pgx.Batch{}
+	metrics := make([]types.Metric, 0, len(values))
+	for metric := range values {
+		metrics = append(metrics, metric)
+	}
+	slices.Sort(metrics)
+	for _, metric := range metrics {
+		state := states[metric]
+		detections := anomaly.Observe(&state, values[metric], diag.Timestamp, sensitivity.Threshold())
+		batch.Queue(`
+			insert into device_metric_baselines (
+				device_id, metric, mean, variance, fast_mean, samples,
+				spike_active, drift_active, observed_at
+			) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			on conflict (device_id, metric) do update set
+				mean = excluded.mean,
+				variance = excluded.variance,
+				fast_mean = excluded.fast_mean,
+				samples = excluded.samples,
+				spike_active = excluded.spike_active,
+				drift_active = excluded.drift_active,
+				observed_at = excluded.observed_at
+		`,
+			diag.Identifier,
+			metric,
+			state.Mean,
+			state.Variance,
+			state.FastMean,
+			state.Samples,
+			state.SpikeActive,
+			state.DriftActive,
+			state.Observed,
+		)
+		for _, detection := range detections {
+			batch.Queue(`
+				insert into device_anomalies (
+					device_id, metric, kind, value, baseline, deviation, score, detected_at
+				) values ($1, $2, $3, $4, $5, $6, $7, $8)
+			`,
+				diag.Identifier,
+				metric,
+				detection.Kind,
+				detection.Value,
+				detection.Baseline,
+				detection.Deviation,
+				detection.Score,
+				diag.Timestamp,
+			)
+		}
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf(
+			"%w: failed to save metric baselines (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return nil
+}
+
+func collectAnomalies(rows pgx.Rows) ([]types.Anomaly, error) {
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.Anomaly])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect anomaly rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
+
+func collectAnomalySettings(rows pgx.Rows, deviceID string) (types.AnomalySettings, error) {
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[types.AnomalySettings])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return types.AnomalySettings{}, fmt.Errorf(
+			"%w: failed to retrieve device '%s'",
+			exceptions.ErrorNotFound,
+			deviceID,
+		)
+	}
+	if err != nil {
+		return types.AnomalySettings{}, fmt.Errorf(
+			"%w: failed to collect anomaly settings rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
@@ -0,0 +1,293 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateCampaign stores a campaign together with its targeted devices
+func (r *PersistenceRepository) CreateCampaign(
+	ctx context.Context,
+	campaign types.Campaign,
+) (types.Campaign, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return types.Campaign{}, fmt.Errorf(
+			"%w: failed to begin transaction (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+	rows, err := tx.Query(ctx, `
+		insert into firmware_campaigns (
+			monitor_id, target_version, selector, wave_size, max_unavailable, wave_timeout_ms,
+			failure_policy, status, total_waves, completed_at
+		) values (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, case when $10 then now() end
+		)
+		returning *
+	`,
+		campaign.MonitorID,
+		campaign.TargetVersion,
+		campaign.Selector,
+		campaign.WaveSize,
+		campaign.MaxUnavailable,
+		campaign.WaveTimeout,
+		campaign.FailurePolicy,
+		campaign.Status,
+		campaign.TotalWaves,
+		types.CampaignStatusFromString(deref(campaign.Status)) != types.CampaignStatusPending,
+	)
+	if err != nil {
+		return types.Campaign{}, fmt.Errorf(
+			"%w: failed to insert campaign (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[types.Campaign])
+	if err != nil {
+		return types.Campaign{}, fmt.Errorf(
+			"%w: failed to collect campaign rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	batch := &pgx.Batch{}
+	for _, device := range campaign.Devices {
+		batch.Queue(`
+			insert into firmware_campaign_devices (
+				campaign_id, device_id, wave, status, previous_version
+			) values (
+				$1, $2, $3, $4, $5
+			)
+			returning *
+		`,
+			result.ID,
+			device.DeviceID,
+			device.Wave,
+			device.Status,
+			device.PreviousVersion,
+		)
+	}
+	results := tx.SendBatch(ctx, batch)
+	for range campaign.Devices {
+		rows, err := results.Query()
+		if err != nil {
+			results.Close() // nolint:errcheck
+			return types.Campaign{}, fmt.Errorf(
+				"%w: failed to insert campaign devices (postgres): %w",
+				exceptions.ErrorInternal,
+				err,
+			)
+		}
+		device, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[types.CampaignDevice])
+		if err != nil {
+			results.Close() // nolint:errcheck
+			return types.Campaign{}, fmt.Errorf(
+				"%w: failed to collect campaign device rows (postgres): %w",
+				exceptions.ErrorInternal,
+				err,
+			)
+		}
+		result.Devices = append(result.Devices, device)
+	}
+	if err := results.Close(); err != nil {
+		return types.Campaign{}, fmt.Errorf(
+			"%w: failed to insert campaign devices (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return types.Campaign{}, fmt.Errorf(
+			"%w: failed to commit campaign (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
+
+func (r *PersistenceRepository) GetCampaign(ctx context.Context, campaignID string) (types.Campaign, error) {
+	rows, err := r.pool.Query(ctx, `select * from firmware_campaigns where id::text = $1`, campaignID)
+	if err != nil {
+		return types.Campaign{}, fmt.Errorf(
+			"%w: failed to query campaign (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[types.Campaign])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return types.Campaign{}, fmt.Errorf(
+				"%w: failed to retrieve campaign '%s': %w",
+				exceptions.ErrorNotFound,
+				campaignID,
+				err,
+			)
+		}
+		return types.Campaign{}, fmt.Errorf(
+			"%w: failed to collect campaign rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	devices, err := r.listCampaignDevices(ctx, []string{campaignID})
+	if err != nil {
+		return types.Campaign{}, err
+	}
+	result.Devices = devices[campaignID]
+	return result, nil
+}
+
+func (r *PersistenceRepository) ListCampaigns(ctx context.Context) ([]types.Campaign, error) {
+	rows, err := r.pool.Query(ctx, `select * from firmware_campaigns order by created_at desc`)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query campaigns (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.Campaign])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect campaign rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	ids := make([]string, len(result))
+	for i, campaign := range result {
+		ids[i] = deref(campaign.ID)
+	}
+	devices, err := r.listCampaignDevices(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i, campaign := range result {
+		result[i].Devices = devices[deref(campaign.ID)]
+	}
+	return result, nil
+}
+
+// UpdateCampaign sets the status, wave and error of a campaign, terminal statuses also set
+// the completion time.
+func (r *PersistenceRepository) UpdateCampaign(
+	ctx context.Context,
+	campaignID string,
+	status types.CampaignStatus,
+	wave int,
+	message string,
+) error {
+	_, err := r.pool.Exec(ctx, `
+		update firmware_campaigns set
+			status = $2,
+			current_wave = $3,
+			error = nullif($4, ''),
+			updated_at = now(),
+			completed_at = case when $5 then now() else null end
+		where id = $1
+	`, campaignID, status, wave, message, status.IsTerminal())
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to update campaign (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return nil
+}
+
+func (r *PersistenceRepository) UpdateCampaignDevice(
+	ctx context.Context,
+	campaignID string,
+	deviceID string,
+	status types.CampaignDeviceStatus,
+	message string,
+) error {
+	_, err := r.pool.Exec(ctx, `
+		update firmware_campaign_devices set
+			status = $3,
+			error = nullif($4, ''),
+			updated_at = now()
+		where campaign_id = $1 and device_id = $2
+	`, campaignID, deviceID, status, message)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to update campaign device (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return nil
+}
+
+// InterruptCampaigns halts the unfinished campaigns of a monitor, campaigns are executed in
+// memory and do not survive a restart of the monitor that created them.
+func (r *PersistenceRepository) InterruptCampaigns(ctx context.Context, monitorID string) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `
+		update firmware_campaigns set
+			status = $2,
+			error = 'interrupted by monitor restart',
+			updated_at = now(),
+			completed_at = now()
+		where monitor_id = $1 and status in ($3, $4)
+	`,
+		monitorID,
+		types.CampaignStatusHalted,
+		types.CampaignStatusPending,
+		types.CampaignStatusRunning,
+	)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"%w: failed to interrupt campaigns (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *PersistenceRepository) listCampaignDevices(
+	ctx context.Context,
+	campaignIDs []string,
+) (map[string][]types.CampaignDevice, error) {
+	result := make(map[string][]types.CampaignDevice, len(campaignIDs))
+	if len(campaignIDs) == 0 {
+		return result, nil
+	}
+	rows, err := r.pool.Query(ctx, `
+		select * from firmware_campaign_devices
+		where campaign_id = any($1::text[]::uuid[])
+		order by wave, device_id
+	`, campaignIDs)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query campaign devices (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	devices, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.CampaignDevice])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect campaign device rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	for _, device := range devices {
+		id := deref(device.CampaignID)
+		result[id] = append(result[id], device)
+	}
+	return result, nil
+}
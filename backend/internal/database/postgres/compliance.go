@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// SaveCompliancePolicy creates a compliance policy or replaces the policy with the same name.
+func (r *PersistenceRepository) SaveCompliancePolicy(
+	ctx context.Context,
+	policy types.CompliancePolicy,
+) (types.CompliancePolicy, error) {
+	rows, err := r.pool.Query(ctx, `
+		insert into compliance_policies (name, labels, component, min_version, allow, deny)
+		values (
+			$1, coalesce($2::jsonb, '{}'), $3, nullif($4, ''), coalesce($5::text[], '{}'),
+			coalesce($6::text[], '{}')
+		)
+		on conflict (name) do update set
+			labels = excluded.labels,
+			component = excluded.component,
+			min_version = excluded.min_version,
+			allow = excluded.allow,
+			deny = excluded.deny,
+			updated_at = now()
+		returning *
+	`,
+		deref(policy.Name),
+		policy.Labels,
+		deref(policy.Component),
+		deref(policy.MinVersion),
+		policy.Allow,
+		policy.Deny,
+	)
+	if err != nil {
+		return types.CompliancePolicy{}, fmt.Errorf(
+			"%w: failed to save compliance policy (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[types.CompliancePolicy])
+	if err != nil {
+		return types.CompliancePolicy{}, fmt.Errorf(
+			"%w: failed to collect compliance policy rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
+
+func (r *PersistenceRepository) ListCompliancePolicies(
+	ctx context.Context,
+) ([]types.CompliancePolicy, error) {
+	rows, err := r.pool.Query(ctx, `select * from compliance_policies order by name`)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query compliance policies (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.CompliancePolicy])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect compliance policy rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
+
+func (r *PersistenceRepository) DeleteCompliancePolicy(ctx context.Context, name string) error {
+	tag, err := r.pool.Exec(ctx, `delete from compliance_policies where name = $1`, name)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to delete compliance policy (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: failed to retrieve compliance policy '%s'", exceptions.ErrorNotFound, name)
+	}
+	return nil
+}
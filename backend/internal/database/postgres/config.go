@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// SaveDeviceConfig stores the desired config of a device, replacing a previous config resets
+// its reconciliation status.
+func (r *PersistenceRepository) SaveDeviceConfig(
+	ctx context.Context,
+	deviceID string,
+	desired types.DesiredConfig,
+) (types.DeviceConfig, error) {
+	rows, err := r.pool.Query(ctx, `
+		insert into device_configs (
+			device_id, device_status, stream_interval_ms, labels, config, drift_policy
+		) values (
+			$1, nullif($2, '')::device_status, nullif($3, 0), coalesce($4::jsonb, '{}'),
+			coalesce($5::jsonb, '{}'), $6
+		)
+		on conflict (device_id) do update set
+			device_status = excluded.device_status,
+			stream_interval_ms = excluded.stream_interval_ms,
+			labels = excluded.labels,
+			config = excluded.config,
+			drift_policy = excluded.drift_policy,
+			status = 'CONFIG_STATUS_PENDING',
+			drift = '{}',
+			error = null,
+			updated_at = now()
+		returning *
+	`,
+		deviceID,
+		desired.DeviceStatus,
+		desired.StreamInterval.Milliseconds(),
+		desired.Labels,
+		desired.Config,
+		desired.DriftPolicy,
+	)
+	if err != nil {
+		return types.DeviceConfig{}, fmt.Errorf(
+			"%w: failed to save device config (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[types.DeviceConfig])
+	if err != nil {
+		return types.DeviceConfig{}, fmt.Errorf(
+			"%w: failed to collect device config rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
+
+func (r *PersistenceRepository) GetDeviceConfig(
+	ctx context.Context,
+	deviceID string,
+) (types.DeviceConfig, error) {
+	rows, err := r.pool.Query(ctx, `select * from device_configs where device_id = $1`, deviceID)
+	if err != nil {
+		return types.DeviceConfig{}, fmt.Errorf(
+			"%w: failed to query device config (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectDeviceConfig(rows, deviceID)
+}
+
+func (r *PersistenceRepository) ListDeviceConfigs(ctx context.Context) ([]types.DeviceConfig, error) {
+	rows, err := r.pool.Query(ctx, `select * from device_configs order by device_id`)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query device configs (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.DeviceConfig])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect device config rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
+
+func (r *PersistenceRepository) DeleteDeviceConfig(ctx context.Context, deviceID string) error {
+	tag, err := r.pool.Exec(ctx, `delete from device_configs where device_id = $1`, deviceID)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to delete device config (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: failed to retrieve device config '%s'", exceptions.ErrorNotFound, deviceID)
+	}
+	return nil
+}
+
+// UpdateDeviceConfigStatus records the outcome of a reconciliation, applied marks the config
+// as (re)applied to the device and reapplied counts a drift correction.
+func (r *PersistenceRepository) UpdateDeviceConfigStatus(
+	ctx context.Context,
+	deviceID string,
+	status types.ConfigStatus,
+	drift []string,
+	message string,
+	applied bool,
+) (types.DeviceConfig, error) {
+	rows, err := r.pool.Query(ctx, `
+		update device_configs set
+			status = $2,
+			drift = coalesce($3::text[], '{}'),
+			error = nullif($4, ''),
+			applied_at = case when $5 then now() else applied_at end,
+			reapply_count = reapply_count + case when $6 then 1 else 0 end,
+			reconciled_at = now()
+		where device_id = $1
+		returning *
+	`, deviceID, status, drift, message, applied, status == types.ConfigStatusReapplied)
+	if err != nil {
+		return types.DeviceConfig{}, fmt.Errorf(
+			"%w: failed to update device config status (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectDeviceConfig(rows, deviceID)
+}
+
+func collectDeviceConfig(rows pgx.Rows, deviceID string) (types.DeviceConfig, error) {
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[types.DeviceConfig])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return types.DeviceConfig{}, fmt.Errorf(
+				"%w: failed to retrieve device config '%s': %w",
+				exceptions.ErrorNotFound,
+				deviceID,
+				err,
+			)
+		}
+		return types.DeviceConfig{}, fmt.Errorf(
+			"%w: failed to collect device config rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListSnapshots returns the latest diagnostics of every device
+func (r *PersistenceRepository) ListSnapshots(ctx context.Context) ([]types.Diagnostics, error) {
+	rows, err := r.pool.Query(ctx, `select * from device_diagnostics_snapshot order by device_id`)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query diagnostics snapshots (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.Diagnostics])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect diagnostic rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
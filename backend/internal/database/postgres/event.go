@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// Events of devices that are not registered (e.g. of a worker of a deleted device) are dropped
+const insertDeviceEvent = `
+	insert into device_events (device_id, type, severity, message, details, occurred_at)
+	select device_id, $2::event_type, $3::event_severity, $4::text, coalesce($5::jsonb, '{}'),
+		$6::timestamptz
+	from devices where device_id = $1
+`
+
+func (r *PersistenceRepository) SaveDeviceEvent(ctx context.Context, record types.EventRecord) error {
+	if _, err := r.pool.Exec(ctx, insertDeviceEvent, eventArguments(record)...); err != nil {
+		return fmt.Errorf(
+			"%w: failed to insert device event (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return nil
+}
+
+func (r *PersistenceRepository) ListDeviceEvents(
+	ctx context.Context,
+	query types.DeviceEventQuery,
+) ([]types.DeviceEvent, error) {
+	kinds := make([]string, len(query.Types))
+	for i, kind := range query.Types {
+		kinds[i] = kind.String()
+	}
+	rows, err := r.pool.Query(ctx, `
+		select * from device_events
+		where ($1 = '' or device_id = $1)
+			and (cardinality($2::event_type[]) = 0 or type = any($2::event_type[]))
+			and ($3 = '' or severity >= $3::event_severity)
+			and occurred_at >= $4 and occurred_at < $5
+		order by occurred_at desc, recorded_at desc
+		limit $6
+	`, query.DeviceID, kinds, query.Severity, query.From, query.To, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query device events (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectDeviceEvents(rows)
+}
+
+// ListRecordedDeviceEvents returns the events recorded after the given time in the order they
+// were recorded, of a device or of all devices if none is given.
+func (r *PersistenceRepository) ListRecordedDeviceEvents(
+	ctx context.Context,
+	deviceID string,
+	after time.Time,
+) ([]types.DeviceEvent, error) {
+	rows, err := r.pool.Query(ctx, `
+		select * from device_events
+		where ($1 = '' or device_id = $1) and recorded_at > $2
+		order by recorded_at, id
+	`, deviceID, after)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query device events (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectDeviceEvents(rows)
+}
+
+func eventArguments(record types.EventRecord) []any {
+	return []any{
+		record.DeviceID,
+		record.Type,
+		record.Severity,
+		record.Message,
+		record.Details,
+		record.Occurred,
+	}
+}
+
+func collectDeviceEvents(rows pgx.Rows) ([]types.DeviceEvent, error) {
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.DeviceEvent])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect device event rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
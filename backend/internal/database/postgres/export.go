@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// DefaultExportFetchSize is the number of rows fetched from the export cursor at once
+const DefaultExportFetchSize = 1000
+
+// ExportDiagnostics passes the samples matching the query to fn in chunks ordered by time,
+// the samples are fetched through a cursor so only one chunk is held in memory at a time.
+func (r *PersistenceRepository) ExportDiagnostics(
+	ctx context.Context,
+	query types.ExportQuery,
+	fn func([]types.Diagnostics) error,
+) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+	_, err = tx.Exec(ctx, `
+		declare export_diagnostics no scroll cursor for
+		select
+			d.device_id, dd.hardware_version, dd.software_version, dd.firmware_version,
+			dd.cpu_usage, dd.memory_usage, dd.device_status, dd.checksum, dd.verification_status,
+			dd.uptime_seconds, dd.load_average_1m, dd.load_average_5m, dd.load_average_15m,
+			dd.temperature_celsius, dd.disk_used_bytes, dd.disk_total_bytes, dd.process_count,
+			dd.timestamp as last_updated
+		from device_diagnostics dd
+		join devices d on d.id = dd.device_id
+		where (coalesce(cardinality($1::text[]), 0) = 0 or d.device_id = any($1))
+			and dd.timestamp >= $2 and dd.timestamp < $3
+		order by dd.timestamp, d.device_id
+	`, query.DeviceIDs, query.From, query.To)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to declare export cursor (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	for {
+		rows, err := tx.Query(
+			ctx,
+			fmt.Sprintf(`fetch forward %d from export_diagnostics`, DefaultExportFetchSize),
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"%w: failed to fetch export chunk (postgres): %w",
+				exceptions.ErrorInternal,
+				err,
+			)
+		}
+		chunk, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[types.Diagnostics])
+		if err != nil {
+			return fmt.Errorf(
+				"%w: failed to collect diagnostic rows (postgres): %w",
+				exceptions.ErrorInternal,
+				err,
+			)
+		}
+		if len(chunk) == 0 {
+			return nil
+		}
+		if err := fn(chunk); err != nil {
+			return err
+		}
+		if len(chunk) < DefaultExportFetchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListHourlyAggregates returns the hourly averages of the metrics of a device (all devices if
+// none is given) over a period, ordered by device and hour. Samples of offline or booting
+// devices are left out.
+func (r *PersistenceRepository) ListHourlyAggregates(
+	ctx context.Context,
+	deviceID string,
+	from time.Time,
+	to time.Time,
+) ([]types.HourlyAggregate, error) {
+	rows, err := r.pool.Query(ctx, `
+		select
+			d.device_id,
+			date_trunc('hour', dd.timestamp) as hour,
+			avg(dd.cpu_usage) as cpu_usage,
+			avg(dd.memory_usage) as memory_usage,
+			avg(
+				case when dd.disk_total_bytes > 0
+				then dd.disk_used_bytes::double precision / dd.disk_total_bytes * 100 end
+			) as disk_usage,
+			avg(dd.temperature_celsius) as temperature_celsius,
+			count(*) as samples
+		from device_diagnostics dd
+		join devices d on d.id = dd.device_id
+		where ($1 = '' or d.device_id = $1)
+			and dd.timestamp >= $2 and dd.timestamp < $3
+			and dd.device_status not in ('DEVICE_STATUS_OFFLINE', 'DEVICE_STATUS_BOOTING')
+		group by d.device_id, hour
+		order by d.device_id, hour
+	`, deviceID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query hourly aggregates (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.HourlyAggregate])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect hourly aggregate rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
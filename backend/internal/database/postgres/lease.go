@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/jackc/pgx/v5"
+)
+
+// AcquireDeviceLease acquires or renews the lease of a monitor on a device, a lease held by
+// another monitor is only taken over once it expired. It reports whether the monitor holds
+// the lease.
+func (r *PersistenceRepository) AcquireDeviceLease(
+	ctx context.Context,
+	deviceID string,
+	monitorID string,
+	ttl time.Duration,
+) (bool, error) {
+	var holder string
+	err := r.pool.QueryRow(ctx, `
+		insert into device_leases (device_id, monitor_id, expires_at)
+		values ($1, $2, now() + $3 * interval '1 millisecond')
+		on conflict (device_id) do update set
+			monitor_id = excluded.monitor_id,
+			expires_at = excluded.expires_at,
+			acquired_at = case
+				when device_leases.monitor_id = excluded.monitor_id then device_leases.acquired_at
+				else now()
+			end
+		where device_leases.monitor_id = excluded.monitor_id or device_leases.expires_at < now()
+		returning monitor_id
+	`, deviceID, monitorID, ttl.Milliseconds()).Scan(&holder)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf(
+			"%w: failed to acquire device lease (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return holder == monitorID, nil
+}
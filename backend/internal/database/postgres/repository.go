@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/throughput"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -34,11 +35,25 @@ func (r *PersistenceRepository) RegisterDevice(
 	for i, p := range status.SupportedProtocols {
 		protocols[i] = p.String()
 	}
+	var algorithm, key *string
+	if reg.Signing.Algorithm != "" {
+		value := reg.Signing.Algorithm.String()
+		algorithm, key = &value, &reg.Signing.Key
+	}
+	var snmp *types.SnmpCredentials
+	if reg.Snmp.Version != "" {
+		snmp = &reg.Snmp
+	}
+	var driver *string
+	if reg.Driver != "" {
+		driver = &reg.Driver
+	}
 	rows, err := r.pool.Query(ctx, `
 		insert into devices (
-			device_id, alias, host, port, port_gateway, architecture, os, supported_protocols
+			device_id, alias, host, port, port_gateway, architecture, os, supported_protocols,
+			signing_algorithm, signing_key, snmp_credentials, driver
 		) values (
-			$1, $2, $3, $4, $5, $6, $7, $8
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 		)
 		on conflict (device_id) do update set
 			alias = excluded.alias,
@@ -48,6 +63,10 @@ func (r *PersistenceRepository) RegisterDevice(
 			architecture = excluded.architecture,
 			os = excluded.os,
 			supported_protocols = excluded.supported_protocols,
+			signing_algorithm = excluded.signing_algorithm,
+			signing_key = excluded.signing_key,
+			snmp_credentials = excluded.snmp_credentials,
+			driver = excluded.driver,
 			updated_at = excluded.updated_at
 		returning *
 	`,
@@ -59,6 +78,10 @@ func (r *PersistenceRepository) RegisterDevice(
 		status.Architecture,
 		status.OS,
 		protocols,
+		algorithm,
+		key,
+		snmp,
+		driver,
 	)
 	if err != nil {
 		return types.Device{}, fmt.Errorf(
@@ -122,6 +145,19 @@ func (r *PersistenceRepository) ListDevices(ctx context.Context) ([]types.Device
 	return result, nil
 }
 
+// DeleteDevice removes a device together with its diagnostics, transitions and config, the
+// deletion notifies the orchestrator to stop the worker of the device.
+func (r *PersistenceRepository) DeleteDevice(ctx context.Context, deviceID string) error {
+	tag, err := r.pool.Exec(ctx, `delete from devices where device_id = $1`, deviceID)
+	if err != nil {
+		return fmt.Errorf("%w: failed to delete device (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: failed to retrieve device '%s'", exceptions.ErrorNotFound, deviceID)
+	}
+	return nil
+}
+
 func (r *PersistenceRepository) GetDiagnostics(
 	ctx context.Context,
 	deviceID string,
@@ -150,19 +186,70 @@ func (r *PersistenceRepository) GetDiagnostics(
 			err,
 		)
 	}
+	if result.DiagnosticsID != nil {
+		interfaces, err := r.listInterfaces(ctx, []string{*result.DiagnosticsID})
+		if err != nil {
+			return types.Diagnostics{}, err
+		}
+		result.Interfaces = interfaces[*result.DiagnosticsID]
+	}
 	return result, nil
 }
 
 func (r *PersistenceRepository) SaveDiagnostics(ctx context.Context, diag types.DeviceDiagnostics) error {
-	_, err := r.pool.Exec(ctx, `
-		insert into device_diagnostics (
-			device_id, cpu_usage, memory_usage, device_status,
-            hardware_version, software_version, firmware_version,
-            checksum, timestamp
-		) values (
-			(select id from devices where device_id = $1),
-            $2, $3, $4, $5, $6, $7, $8, $9
+	verification := diag.Verification
+	if verification == "" {
+		verification = types.VerificationStatusUnsigned
+	}
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+	var (
+		diagnosticsID, deviceID string
+		sensitivity             string
+		baselines               []types.MetricBaseline
+		previous                types.DeviceVersions
+	)
+	err = tx.QueryRow(ctx, `
+		with inserted as (
+			insert into device_diagnostics (
+				device_id, cpu_usage, memory_usage, device_status,
+				hardware_version, software_version, firmware_version,
+				checksum, verification_status, uptime_seconds,
+				load_average_1m, load_average_5m, load_average_15m,
+				temperature_celsius, disk_used_bytes, disk_total_bytes,
+				process_count, timestamp
+			) values (
+				(select id from devices where device_id = $1),
+				$2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+			)
+			returning id, device_id
 		)
+		-- The anomaly baselines of the device are locked until the sample is observed, the
+		-- previous versions are those of the latest earlier sample that reported versions
+		select
+			inserted.id,
+			inserted.device_id,
+			coalesce((select sensitivity from device_anomaly_settings where device_id = $1), ''),
+			(
+				select coalesce(jsonb_agg(b), '[]') from (
+					select * from device_metric_baselines where device_id = $1 for update
+				) b
+			),
+			coalesce(previous.hardware_version, ''),
+			coalesce(previous.software_version, ''),
+			coalesce(previous.firmware_version, '')
+		from inserted
+		left join lateral (
+			select hardware_version, software_version, firmware_version
+			from device_diagnostics
+			where device_id = inserted.device_id and timestamp < $18
+				and (hardware_version <> '' or software_version <> '' or firmware_version <> '')
+			order by timestamp desc
+			limit 1
+		) previous on true
 	`,
 		diag.Identifier,
 		diag.CPU,
@@ -172,7 +259,24 @@ func (r *PersistenceRepository) SaveDiagnostics(ctx context.Context, diag types.
 		diag.DeviceVersions.Software,
 		diag.DeviceVersions.Firmware,
 		diag.Checksum,
+		verification,
+		int64(diag.Uptime.Seconds()),
+		diag.LoadAverage.One,
+		diag.LoadAverage.Five,
+		diag.LoadAverage.Fifteen,
+		diag.Temperature,
+		diag.DiskUsed,
+		diag.DiskTotal,
+		diag.Processes,
 		diag.Timestamp,
+	).Scan(
+		&diagnosticsID,
+		&deviceID,
+		&sensitivity,
+		&baselines,
+		&previous.Hardware,
+		&previous.Software,
+		&previous.Firmware,
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -181,5 +285,219 @@ func (r *PersistenceRepository) SaveDiagnostics(ctx context.Context, diag types.
 			err,
 		)
 	}
+	if len(diag.Interfaces) > 0 {
+		if err := r.saveInterfaces(ctx, tx, diagnosticsID, deviceID, diag); err != nil {
+			return err
+		}
+	}
+	cause := types.TransitionCauseDevice
+	if diag.DeviceStatus == types.DeviceStatusOffline {
+		cause = types.TransitionCauseOffline
+	}
+	if err := r.saveTransition(ctx, tx, diag.Identifier, diag.DeviceStatus, cause, diag.Timestamp); err != nil {
+		return err
+	}
+	if err := r.saveAnomalies(ctx, tx, diag, types.SensitivityFromString(sensitivity), baselines); err != nil {
+		return err
+	}
+	if err := r.saveVersionChanges(ctx, tx, diag, previous); err != nil {
+		return err
+	}
+	if diag.ChecksumMismatch {
+		_, err := tx.Exec(ctx, insertDeviceEvent, eventArguments(types.EventRecord{
+			DeviceID: diag.Identifier,
+			Type:     types.EventTypeChecksumMismatch,
+			Severity: types.EventSeverityError,
+			Message:  "checksum of diagnostics does not match the reported checksum",
+			Details:  map[string]string{"checksum": diag.Checksum},
+			Occurred: diag.Timestamp,
+		})...)
+		if err != nil {
+			return fmt.Errorf(
+				"%w: failed to insert device event (postgres): %w",
+				exceptions.ErrorInternal,
+				err,
+			)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%w: failed to commit diagnostics (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	return nil
+}
+
+// saveInterfaces stores the interface counters of a diagnostics sample together with the
+// rates since the latest stored sample of the same interface.
+func (r *PersistenceRepository) saveInterfaces(
+	ctx context.Context,
+	tx pgx.Tx,
+	diagnosticsID string,
+	deviceID string,
+	diag types.DeviceDiagnostics,
+) error {
+	rows, err := tx.Query(ctx, `
+		select distinct on (name) * from device_interfaces
+		where device_id = $1 and timestamp < $2
+		order by name, timestamp desc
+	`, deviceID, diag.Timestamp)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to query previous interfaces (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	previous, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.Interface])
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to collect interface rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	samples := make(map[string]types.Interface, len(previous))
+	for _, sample := range previous {
+		samples[deref(sample.Name)] = sample
+	}
+	batch := &pgx.Batch{}
+	for _, iface := range diag.Interfaces {
+		var rates types.InterfaceRates
+		if sample, ok := samples[iface.Name]; ok && sample.Timestamp != nil {
+			rates = throughput.Rates(
+				sample.Counters(),
+				iface.Counters,
+				iface.CounterBits,
+				diag.Timestamp.Sub(*sample.Timestamp),
+			)
+		}
+		var state *string
+		if iface.LinkState != "" {
+			value := iface.LinkState.String()
+			state = &value
+		}
+		batch.Queue(`
+			insert into device_interfaces (
+				diagnostics_id, device_id, name, link_state,
+				rx_bytes, tx_bytes, rx_packets, tx_packets, rx_errors, tx_errors,
+				rx_bytes_rate, tx_bytes_rate, rx_packets_rate, tx_packets_rate,
+				rx_errors_rate, tx_errors_rate, timestamp
+			) values (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+			)
+		`,
+			diagnosticsID,
+			deviceID,
+			iface.Name,
+			state,
+			iface.Counters.RxBytes,
+			iface.Counters.TxBytes,
+			iface.Counters.RxPackets,
+			iface.Counters.TxPackets,
+			iface.Counters.RxErrors,
+			iface.Counters.TxErrors,
+			rates.RxBytes,
+			rates.TxBytes,
+			rates.RxPackets,
+			rates.TxPackets,
+			rates.RxErrors,
+			rates.TxErrors,
+			diag.Timestamp,
+		)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf(
+			"%w: failed to insert interfaces (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
 	return nil
 }
+
+func (r *PersistenceRepository) ListDiagnostics(
+	ctx context.Context,
+	deviceID string,
+	query types.DiagnosticsQuery,
+) ([]types.Diagnostics, error) {
+	rows, err := r.pool.Query(ctx, `
+		select
+			dd.id as diagnostics_id, d.device_id, dd.hardware_version, dd.software_version,
+			dd.firmware_version, dd.cpu_usage, dd.memory_usage, dd.device_status, dd.checksum,
+			dd.verification_status, dd.uptime_seconds, dd.load_average_1m, dd.load_average_5m,
+			dd.load_average_15m, dd.temperature_celsius, dd.disk_used_bytes, dd.disk_total_bytes,
+			dd.process_count, dd.timestamp as last_updated
+		from device_diagnostics dd
+		join devices d on d.id = dd.device_id
+		where d.device_id = $1 and dd.timestamp >= $2 and dd.timestamp < $3
+		order by dd.timestamp desc
+		limit $4
+	`, deviceID, query.From, query.To, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query diagnostics history (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[types.Diagnostics])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect diagnostic rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	ids := make([]string, len(result))
+	for i, diag := range result {
+		ids[i] = deref(diag.DiagnosticsID)
+	}
+	interfaces, err := r.listInterfaces(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i, diag := range result {
+		result[i].Interfaces = interfaces[deref(diag.DiagnosticsID)]
+	}
+	return result, nil
+}
+
+func (r *PersistenceRepository) listInterfaces(
+	ctx context.Context,
+	diagnosticsIDs []string,
+) (map[string][]types.Interface, error) {
+	result := make(map[string][]types.Interface, len(diagnosticsIDs))
+	if len(diagnosticsIDs) == 0 {
+		return result, nil
+	}
+	rows, err := r.pool.Query(ctx, `
+		select * from device_interfaces where diagnostics_id = any($1::text[]::uuid[]) order by name
+	`, diagnosticsIDs)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query interfaces (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	interfaces, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.Interface])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect interface rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	for _, iface := range interfaces {
+		id := deref(iface.DiagnosticsID)
+		result[id] = append(result[id], iface)
+	}
+	return result, nil
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
@@ -0,0 +1,234 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// RecordStatusTransition records a status change that is known before the device reports it,
+// later samples reporting the same status do not record another transition.
+func (r *PersistenceRepository) RecordStatusTransition(
+	ctx context.Context,
+	deviceID string,
+	status types.DeviceStatus,
+	cause types.TransitionCause,
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to begin transaction (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	defer tx.Rollback(ctx) // nolint:errcheck
+	if err := r.saveTransition(ctx, tx, deviceID, status, cause, time.Now()); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%w: failed to commit transition (postgres): %w", exceptions.ErrorInternal, err)
+	}
+	return nil
+}
+
+func (r *PersistenceRepository) ListStatusTransitions(
+	ctx context.Context,
+	deviceID string,
+	query types.DiagnosticsQuery,
+) ([]types.StatusTransition, error) {
+	rows, err := r.pool.Query(ctx, `
+		select * from device_status_transitions
+		where device_id = $1 and transitioned_at >= $2 and transitioned_at < $3
+		order by transitioned_at desc
+		limit $4
+	`, deviceID, query.From, query.To, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query status transitions (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.StatusTransition])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect status transition rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
+
+// ListStatusHistories returns the status history over a period of the given devices (all if
+// none are given). The initial status is the latest sample at the start of the period or,
+// for devices without earlier samples, the first sample within the period.
+func (r *PersistenceRepository) ListStatusHistories(
+	ctx context.Context,
+	from time.Time,
+	to time.Time,
+	deviceIDs []string,
+) ([]types.StatusHistory, error) {
+	rows, err := r.pool.Query(ctx, `
+		select
+			d.device_id,
+			coalesce(before.device_status, after.device_status) as device_status,
+			case when before.device_status is not null then $1 else after.timestamp end as since
+		from devices d
+		left join lateral (
+			select dd.device_status from device_diagnostics dd
+			where dd.device_id = d.id and dd.timestamp <= $1
+			order by dd.timestamp desc
+			limit 1
+		) before on true
+		left join lateral (
+			select dd.device_status, dd.timestamp from device_diagnostics dd
+			where dd.device_id = d.id and dd.timestamp > $1 and dd.timestamp < $2
+			order by dd.timestamp
+			limit 1
+		) after on true
+		where coalesce(cardinality($3::text[]), 0) = 0 or d.device_id = any($3)
+		order by d.device_id
+	`, from, to, deviceIDs)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query status histories (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.StatusHistory])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect status history rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	rows, err = r.pool.Query(ctx, `
+		select * from device_status_transitions
+		where (coalesce(cardinality($3::text[]), 0) = 0 or device_id = any($3))
+			and transitioned_at > $1 and transitioned_at < $2
+		order by device_id, transitioned_at
+	`, from, to, deviceIDs)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query status transitions (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	transitions, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.StatusTransition])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect status transition rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	histories := make(map[string][]types.StatusTransition, len(result))
+	for _, transition := range transitions {
+		id := deref(transition.DeviceID)
+		histories[id] = append(histories[id], transition)
+	}
+	for i, history := range result {
+		result[i].Transitions = histories[deref(history.DeviceID)]
+	}
+	return result, nil
+}
+
+// saveTransition records a transition when the status differs from the persisted status,
+// which is the target of the latest transition or, before the first transition, the status
+// of the previous sample. Samples older than the latest transition are ignored.
+func (r *PersistenceRepository) saveTransition(
+	ctx context.Context,
+	tx pgx.Tx,
+	deviceID string,
+	status types.DeviceStatus,
+	cause types.TransitionCause,
+	at time.Time,
+) error {
+	var (
+		previous *string
+		since    *time.Time
+	)
+	err := tx.QueryRow(ctx, `
+		select to_status, transitioned_at from device_status_transitions
+		where device_id = $1
+		order by transitioned_at desc
+		limit 1
+	`, deviceID).Scan(&previous, &since)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = tx.QueryRow(ctx, `
+			select
+				(
+					select dd.device_status from device_diagnostics dd
+					where dd.device_id = d.id and dd.timestamp < $2
+					order by dd.timestamp desc
+					limit 1
+				),
+				(select min(dd.timestamp) from device_diagnostics dd where dd.device_id = d.id)
+			from devices d
+			where d.device_id = $1
+		`, deviceID, at).Scan(&previous, &since)
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to query previous status (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	if previous == nil || since == nil || *previous == status.String() || !at.After(*since) {
+		return nil
+	}
+	from := types.DeviceStatusFromString(*previous)
+	if !from.CanTransition(status) {
+		r.logger.Warn(
+			"unexpected device status transition",
+			zap.String("device", deviceID),
+			zap.String("from", from.String()),
+			zap.String("to", status.String()),
+		)
+	}
+	_, err = tx.Exec(ctx, `
+		insert into device_status_transitions (
+			device_id, from_status, to_status, cause, duration_ms, transitioned_at
+		) values ($1, $2, $3, $4, $5, $6)
+	`, deviceID, from, status, cause, at.Sub(*since).Milliseconds(), at)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to insert status transition (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	severity := types.EventSeverityInfo
+	switch status {
+	case types.DeviceStatusDegraded, types.DeviceStatusError, types.DeviceStatusOffline:
+		severity = types.EventSeverityWarning
+	}
+	_, err = tx.Exec(ctx, insertDeviceEvent, eventArguments(types.EventRecord{
+		DeviceID: deviceID,
+		Type:     types.EventTypeStatusChanged,
+		Severity: severity,
+		Message:  fmt.Sprintf("status changed from %s to %s", from, status),
+		Details: map[string]string{
+			"from":  from.String(),
+			"to":    status.String(),
+			"cause": cause.String(),
+		},
+		Occurred: at,
+	})...)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed to insert device event (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return nil
+}
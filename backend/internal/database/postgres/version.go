@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/version"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (r *PersistenceRepository) ListVersionChanges(
+	ctx context.Context,
+	query types.VersionChangeQuery,
+) ([]types.VersionChange, error) {
+	rows, err := r.pool.Query(ctx, `
+		select * from device_version_changes
+		where ($1 = '' or device_id = $1) and ($2 = '' or component = $2::version_component)
+			and changed_at >= $3 and changed_at < $4
+		order by changed_at desc
+		limit $5
+	`, query.DeviceID, query.Component, query.From, query.To, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query version changes (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectVersionChanges(rows)
+}
+
+// ListRecordedVersionChanges returns the version changes recorded after the given time in the
+// order they were recorded, of a device and of a component or of all devices and components if
+// none are given.
+func (r *PersistenceRepository) ListRecordedVersionChanges(
+	ctx context.Context,
+	deviceID string,
+	component types.VersionComponent,
+	after time.Time,
+) ([]types.VersionChange, error) {
+	rows, err := r.pool.Query(ctx, `
+		select * from device_version_changes
+		where ($1 = '' or device_id = $1) and ($2 = '' or component = $2::version_component)
+			and recorded_at > $3
+		order by recorded_at, id
+	`, deviceID, component, after)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to query version changes (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return collectVersionChanges(rows)
+}
+
+// saveVersionChanges records the components whose version differs from the previous versions
+// of the device, which are selected with the diagnostics insert.
+func (r *PersistenceRepository) saveVersionChanges(
+	ctx context.Context,
+	tx pgx.Tx,
+	diag types.DeviceDiagnostics,
+	previous types.DeviceVersions,
+) error {
+	current := diag.DeviceVersions
+	changes := version.Changes(previous, current)
+	if len(changes) == 0 {
+		return nil
+	}
+	batch := &pgx.Batch{}
+	for _, change := range changes {
+		r.logger.Info(
+			"device version changed",
+			zap.String("device", diag.Identifier),
+			zap.String("component", change.Component.String()),
+			zap.String("from", change.Previous),
+			zap.String("to", change.Current),
+		)
+		batch.Queue(`
+			insert into device_version_changes (
+				device_id, component, previous_version, current_version, changed_at
+			) values ($1, $2, $3, $4, $5)
+		`, diag.Identifier, change.Component, change.Previous, change.Current, diag.Timestamp)
+		batch.Queue(insertDeviceEvent, eventArguments(types.EventRecord{
+			DeviceID: diag.Identifier,
+			Type:     types.EventTypeVersionChanged,
+			Severity: types.EventSeverityInfo,
+			Message: fmt.Sprintf(
+				"%s version changed from %s to %s",
+				change.Component.Label(),
+				change.Previous,
+				change.Current,
+			),
+			Details: map[string]string{
+				"component": change.Component.String(),
+				"previous":  change.Previous,
+				"current":   change.Current,
+			},
+			Occurred: diag.Timestamp,
+		})...)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf(
+			"%w: failed to save version changes (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return nil
+}
+
+func collectVersionChanges(rows pgx.Rows) ([]types.VersionChange, error) {
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[types.VersionChange])
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed to collect version change rows (postgres): %w",
+			exceptions.ErrorInternal,
+			err,
+		)
+	}
+	return result, nil
+}
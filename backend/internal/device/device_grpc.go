@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/signature"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
 	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // Device Client (gRPC)
@@ -19,9 +22,14 @@ type ClientGrpc struct {
 	client    devicev1.DeviceClient
 	config    Config
 	generator ChecksumGenerator
+	verifier  *signature.Verifier
 }
 
 func NewClientGrpc(config Config, generator ChecksumGenerator) (*ClientGrpc, error) {
+	verifier, err := signature.NewVerifier(config.Signing)
+	if err != nil {
+		return nil, fmt.Errorf("%w (grpc): %w", ErrorClientCreation, err)
+	}
 	endpoint := fmt.Sprintf("%s:%d", config.Host, config.Port)
 	conn, err := grpc.NewClient(
 		endpoint,
@@ -35,6 +43,7 @@ func NewClientGrpc(config Config, generator ChecksumGenerator) (*ClientGrpc, err
 		client:    devicev1.NewDeviceClient(conn),
 		config:    config,
 		generator: generator,
+		verifier:  verifier,
 	}, nil
 }
 
@@ -62,7 +71,7 @@ func (d *ClientGrpc) GetDiagnostics(ctx context.Context) (*types.DeviceDiagnosti
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform diagnostics request (grpc): %w", err)
 	}
-	return d.diagnostics(ctx, res), nil
+	return diagnostics(ctx, d.verifier, d.generator, res), nil
 }
 
 func (d *ClientGrpc) StreamDiagnostics(ctx context.Context) (<-chan *types.DeviceDiagnostics, <-chan error) {
@@ -96,7 +105,7 @@ func (d *ClientGrpc) StreamDiagnostics(ctx context.Context) (<-chan *types.Devic
 					errCh <- fmt.Errorf("failed to receive from diagnostics stream (grpc): %w", err)
 					return
 				}
-				ch <- d.diagnostics(ctx, res)
+				ch <- diagnostics(ctx, d.verifier, d.generator, res)
 			}
 		}
 	}()
@@ -111,33 +120,44 @@ func (d *ClientGrpc) UpdateDevice(ctx context.Context, status types.DeviceStatus
 	return nil
 }
 
-func (d *ClientGrpc) Close() error {
-	if d.conn != nil {
-		return d.conn.Close()
+func (d *ClientGrpc) GetFirmwareUpgrade(ctx context.Context) (*types.DeviceFirmwareUpgrade, error) {
+	res, err := d.client.GetFirmwareUpgrade(ctx, &devicev1.GetFirmwareUpgradeRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform firmware upgrade request (grpc): %w", err)
+	}
+	return upgrade(res.GetUpgrade()), nil
+}
+
+func (d *ClientGrpc) UpgradeFirmware(ctx context.Context, version string) error {
+	_, err := d.client.UpgradeFirmware(ctx, &devicev1.UpgradeFirmwareRequest{Version: version})
+	if err != nil {
+		return fmt.Errorf("failed to perform upgrade firmware request (grpc): %w", err)
+	}
+	return nil
+}
+
+func (d *ClientGrpc) Reboot(ctx context.Context, duration time.Duration) error {
+	req := &devicev1.RebootRequest{}
+	if duration > 0 {
+		req.Duration = durationpb.New(duration)
+	}
+	if _, err := d.client.Reboot(ctx, req); err != nil {
+		return fmt.Errorf("failed to perform reboot request (grpc): %w", err)
 	}
 	return nil
 }
 
-func (d *ClientGrpc) diagnostics(
-	ctx context.Context,
-	diag *devicev1.DiagnosticsResponse,
-) *types.DeviceDiagnostics {
-	checksum := diag.Checksum
-	comparison := diag.GenerateChecksum(ctx, d.generator)
-	if checksum != comparison {
-		diag.Checksum = "invalid-checksum"
+func (d *ClientGrpc) ApplyConfig(ctx context.Context, config types.DesiredConfig) error {
+	_, err := d.client.ApplyConfig(ctx, &devicev1.ApplyConfigRequest{Config: deviceConfig(config)})
+	if err != nil {
+		return fmt.Errorf("failed to perform apply config request (grpc): %w", err)
 	}
-	return &types.DeviceDiagnostics{
-		Identifier: diag.DeviceId,
-		DeviceVersions: types.DeviceVersions{
-			Hardware: diag.HardwareVersion,
-			Software: diag.SoftwareVersion,
-			Firmware: diag.FirmwareVersion,
-		},
-		CPU:          diag.CpuUsage,
-		Memory:       diag.MemoryUsage,
-		DeviceStatus: types.DeviceStatusFromString(diag.DeviceStatus.String()),
-		Checksum:     checksum,
-		Timestamp:    diag.Timestamp.AsTime(),
+	return nil
+}
+
+func (d *ClientGrpc) Close() error {
+	if d.conn != nil {
+		return d.conn.Close()
 	}
+	return nil
 }
@@ -1,17 +1,24 @@
 package device
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/signature"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
 	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // Device Client (HTTP)
@@ -20,9 +27,14 @@ type ClientHttp struct {
 	client    *http.Client
 	config    Config
 	generator ChecksumGenerator
+	verifier  *signature.Verifier
 }
 
-func NewClientHttp(config Config, generator ChecksumGenerator) *ClientHttp {
+func NewClientHttp(config Config, generator ChecksumGenerator) (*ClientHttp, error) {
+	verifier, err := signature.NewVerifier(config.Signing)
+	if err != nil {
+		return nil, fmt.Errorf("%w (http): %w", ErrorClientCreation, err)
+	}
 	url := fmt.Sprintf("http://%s:%d", config.Host, config.Port)
 	return &ClientHttp{
 		url: url,
@@ -34,7 +46,8 @@ func NewClientHttp(config Config, generator ChecksumGenerator) *ClientHttp {
 		},
 		config:    config,
 		generator: generator,
-	}
+		verifier:  verifier,
+	}, nil
 }
 
 func (d *ClientHttp) GetHealth(ctx context.Context) (*types.DeviceHealthStatus, error) {
@@ -104,7 +117,7 @@ func (d *ClientHttp) GetDiagnostics(ctx context.Context) (*types.DeviceDiagnosti
 	if err := protojson.Unmarshal(body, &res); err != nil {
 		return nil, fmt.Errorf("failed to decode diagnostics response (http): %w", err)
 	}
-	return d.diagnostics(ctx, &res), nil
+	return diagnostics(ctx, d.verifier, d.generator, &res), nil
 }
 
 func (d *ClientHttp) StreamDiagnostics(ctx context.Context) (<-chan *types.DeviceDiagnostics, <-chan error) {
@@ -123,8 +136,8 @@ func (d *ClientHttp) StreamDiagnostics(ctx context.Context) (<-chan *types.Devic
 			errCh <- fmt.Errorf("failed to create stream diagnostics request (http): %w", err)
 			return
 		}
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Accept", "application/json")
+		// Gateways without Server-Sent Events respond with the stream as chunked JSON
+		req.Header.Set("Accept", "text/event-stream, application/json")
 		response, err := d.client.Do(req)
 		if err != nil {
 			errCh <- fmt.Errorf("failed to perform stream diagnostics request (http): %w", err)
@@ -140,22 +153,26 @@ func (d *ClientHttp) StreamDiagnostics(ctx context.Context) (<-chan *types.Devic
 			errCh <- fmt.Errorf("failed request with status %d (http): %s", response.StatusCode, string(body))
 			return
 		}
-		decoder := json.NewDecoder(response.Body)
+		next := readChunk(json.NewDecoder(response.Body))
+		mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type"))
+		if mediaType == "text/event-stream" {
+			next = readEvent(bufio.NewReader(response.Body))
+		}
 		for {
 			select {
 			case <-ctx.Done():
 				errCh <- ctx.Err()
 				return
 			default:
-				var res devicev1.DiagnosticsResponse
-				if err := decoder.Decode(&res); err != nil {
+				res, err := next()
+				if err != nil {
 					if err == io.EOF {
 						return
 					}
-					errCh <- fmt.Errorf("failed to decode stream diagnostics response (http): %w", err)
+					errCh <- err
 					return
 				}
-				ch <- d.diagnostics(ctx, &res)
+				ch <- diagnostics(ctx, d.verifier, d.generator, res)
 			}
 		}
 	}()
@@ -189,31 +206,210 @@ func (d *ClientHttp) UpdateDevice(ctx context.Context, status types.DeviceStatus
 	return nil
 }
 
+func (d *ClientHttp) GetFirmwareUpgrade(ctx context.Context) (*types.DeviceFirmwareUpgrade, error) {
+	endpoint, err := url.JoinPath(d.url, "/v1/firmware")
+	if err != nil {
+		return nil, fmt.Errorf("failed to join url path (http): %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create firmware upgrade request (http): %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	response, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform firmware upgrade request (http): %w", err)
+	}
+	defer response.Body.Close() // nolint:errcheck
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body (http): %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed request with status %d (http): %s", response.StatusCode, string(body))
+	}
+	var res devicev1.GetFirmwareUpgradeResponse
+	if err := protojson.Unmarshal(body, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode firmware upgrade response (http): %w", err)
+	}
+	return upgrade(res.GetUpgrade()), nil
+}
+
+func (d *ClientHttp) UpgradeFirmware(ctx context.Context, version string) error {
+	endpoint, err := url.JoinPath(d.url, "/v1/firmware")
+	if err != nil {
+		return fmt.Errorf("failed to join url path (http): %w", err)
+	}
+	body := &devicev1.UpgradeFirmwareRequest{Version: version}
+	marshaled, err := protojson.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal upgrade firmware request (http): %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(marshaled))
+	if err != nil {
+		return fmt.Errorf("failed to create upgrade firmware request (http): %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	response, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to perform upgrade firmware request (http): %w", err)
+	}
+	defer response.Body.Close() // nolint:errcheck
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("failed request with status %d (http): %s", response.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (d *ClientHttp) Reboot(ctx context.Context, duration time.Duration) error {
+	endpoint, err := url.JoinPath(d.url, "/v1/reboot")
+	if err != nil {
+		return fmt.Errorf("failed to join url path (http): %w", err)
+	}
+	body := &devicev1.RebootRequest{}
+	if duration > 0 {
+		body.Duration = durationpb.New(duration)
+	}
+	marshaled, err := protojson.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reboot request (http): %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(marshaled))
+	if err != nil {
+		return fmt.Errorf("failed to create reboot request (http): %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	response, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to perform reboot request (http): %w", err)
+	}
+	defer response.Body.Close() // nolint:errcheck
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("failed request with status %d (http): %s", response.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (d *ClientHttp) ApplyConfig(ctx context.Context, config types.DesiredConfig) error {
+	endpoint, err := url.JoinPath(d.url, "/v1/config")
+	if err != nil {
+		return fmt.Errorf("failed to join url path (http): %w", err)
+	}
+	body := &devicev1.ApplyConfigRequest{Config: deviceConfig(config)}
+	marshaled, err := protojson.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal apply config request (http): %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(marshaled))
+	if err != nil {
+		return fmt.Errorf("failed to create apply config request (http): %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	response, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to perform apply config request (http): %w", err)
+	}
+	defer response.Body.Close() // nolint:errcheck
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("failed request with status %d (http): %s", response.StatusCode, string(body))
+	}
+	return nil
+}
+
 func (d *ClientHttp) Close() error {
 	d.client.CloseIdleConnections()
 	return nil
 }
 
-func (d *ClientHttp) diagnostics(
-	ctx context.Context,
-	diag *devicev1.DiagnosticsResponse,
-) *types.DeviceDiagnostics {
-	checksum := diag.Checksum
-	comparison := diag.GenerateChecksum(ctx, d.generator)
-	if checksum != comparison {
-		diag.Checksum = "invalid-checksum"
-	}
-	return &types.DeviceDiagnostics{
-		Identifier: diag.DeviceId,
-		DeviceVersions: types.DeviceVersions{
-			Hardware: diag.HardwareVersion,
-			Software: diag.SoftwareVersion,
-			Firmware: diag.FirmwareVersion,
-		},
-		CPU:          diag.CpuUsage,
-		Memory:       diag.MemoryUsage,
-		DeviceStatus: types.DeviceStatusFromString(diag.DeviceStatus.String()),
-		Checksum:     checksum,
-		Timestamp:    diag.Timestamp.AsTime(),
+// readChunk returns the next sample of a stream served as chunked JSON ({"result":...} or
+// {"error":...})
+func readChunk(decoder *json.Decoder) func() (*devicev1.DiagnosticsResponse, error) {
+	return func() (*devicev1.DiagnosticsResponse, error) {
+		var chunk struct {
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		}
+		if err := decoder.Decode(&chunk); err != nil {
+			if err == io.EOF {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to decode stream diagnostics response (http): %w", err)
+		}
+		if chunk.Error != nil {
+			return nil, streamError(chunk.Error)
+		}
+		var res devicev1.DiagnosticsResponse
+		if err := protojson.Unmarshal(chunk.Result, &res); err != nil {
+			return nil, fmt.Errorf("failed to decode stream diagnostics response (http): %w", err)
+		}
+		return &res, nil
+	}
+}
+
+// readEvent returns the sample of the next diagnostics event of a stream served as Server-Sent
+// Events, an error event ends the stream
+func readEvent(reader *bufio.Reader) func() (*devicev1.DiagnosticsResponse, error) {
+	return func() (*devicev1.DiagnosticsResponse, error) {
+		for {
+			event, data, err := readEventFields(reader)
+			if err != nil {
+				if err == io.EOF {
+					return nil, err
+				}
+				return nil, fmt.Errorf("failed to read stream diagnostics event (http): %w", err)
+			}
+			switch event {
+			case "error":
+				return nil, streamError(data)
+			case "diagnostics":
+				var res devicev1.DiagnosticsResponse
+				if err := protojson.Unmarshal(data, &res); err != nil {
+					return nil, fmt.Errorf("failed to decode stream diagnostics response (http): %w", err)
+				}
+				return &res, nil
+			}
+		}
+	}
+}
+
+// streamError returns the status of a failed stream
+func streamError(data []byte) error {
+	var st spb.Status
+	if err := protojson.Unmarshal(data, &st); err != nil {
+		return fmt.Errorf("failed to decode stream diagnostics error (http): %w", err)
+	}
+	return fmt.Errorf("failed stream diagnostics (http): %w", status.ErrorProto(&st))
+}
+
+// readEventFields reads the next event of a Server-Sent Events stream, comments (e.g.
+// keep-alives) and fields other than event and data are skipped.
+func readEventFields(reader *bufio.Reader) (string, []byte, error) {
+	var (
+		event = "message"
+		data  [][]byte
+	)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return "", nil, err
+		}
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 {
+			if len(data) == 0 {
+				continue
+			}
+			return event, bytes.Join(data, []byte("\n")), nil
+		}
+		field, value, _ := bytes.Cut(line, []byte(":"))
+		value = bytes.TrimPrefix(value, []byte(" "))
+		switch string(field) {
+		case "event":
+			event = string(value)
+		case "data":
+			data = append(data, value)
+		}
 	}
 }
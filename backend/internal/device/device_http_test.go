@@ -0,0 +1,112 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// generator returns the same checksum for any payload
+type generator string
+
+func (g generator) GenerateChecksum(ctx context.Context, data []byte) (string, error) {
+	return string(g), nil
+}
+
+func TestClientHttp_StreamDiagnostics(t *testing.T) {
+	sample := func(id string) string {
+		return fmt.Sprintf(
+			`{"device_id":%q,"device_status":"DEVICE_STATUS_HEALTHY","checksum":"checksum"}`,
+			id,
+		)
+	}
+	failure := `{"code":14,"message":"device unavailable"}`
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		expected    []string
+		code        codes.Code
+	}{
+		{
+			name:        "should read server-sent events",
+			contentType: "text/event-stream",
+			body: ": keep-alive\n\n" +
+				"id: 2026-01-01T00:00:00Z\nevent: diagnostics\ndata: " + sample("router-001") + "\n\n" +
+				"event: other\ndata: {}\n\n" +
+				": keep-alive\n\n" +
+				"id: 2026-01-01T00:00:01Z\r\nevent: diagnostics\r\ndata: " + sample("router-002") + "\r\n\r\n",
+			expected: []string{"router-001", "router-002"},
+		},
+		{
+			name:        "should read error event",
+			contentType: "text/event-stream; charset=utf-8",
+			body: "event: diagnostics\ndata: " + sample("router-001") + "\n\n" +
+				"event: error\ndata: " + failure + "\n\n",
+			expected: []string{"router-001"},
+			code:     codes.Unavailable,
+		},
+		{
+			name:        "should read chunked json",
+			contentType: "application/json",
+			body: `{"result":` + sample("router-001") + "}\n" +
+				`{"result":` + sample("router-002") + "}\n",
+			expected: []string{"router-001", "router-002"},
+		},
+		{
+			name:        "should read error chunk",
+			contentType: "application/json",
+			body:        `{"result":` + sample("router-001") + "}\n" + `{"error":` + failure + "}\n",
+			expected:    []string{"router-001"},
+			code:        codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/diagnostics/stream" {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", tt.contentType)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			endpoint, _ := url.Parse(server.URL)
+			port, _ := strconv.ParseInt(endpoint.Port(), 10, 64)
+			client, err := NewClientHttp(
+				Config{Protocol: types.ProtocolHttp, Host: endpoint.Hostname(), Port: port},
+				generator("checksum"),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			diagCh, errCh := client.StreamDiagnostics(context.Background())
+			var identifiers []string
+			for diag := range diagCh {
+				if diag.DeviceStatus != types.DeviceStatusHealthy || diag.ChecksumMismatch {
+					t.Errorf("expected healthy sample with valid checksum, got %+v", diag)
+				}
+				identifiers = append(identifiers, diag.Identifier)
+			}
+			if fmt.Sprint(identifiers) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected samples of %v, got %v", tt.expected, identifiers)
+			}
+			err = <-errCh
+			if tt.code == codes.OK && err != nil {
+				t.Errorf("expected end of stream, got %v", err)
+			}
+			if tt.code != codes.OK && status.Code(err) != tt.code {
+				t.Errorf("expected error with code %s, got %v", tt.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package device
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/signature"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	DefaultMqttStreamBuffer     = 16
+	DefaultMqttDisconnectQuiet  = 250 // milliseconds
+	DefaultMqttClientIdentifier = "monitor"
+)
+
+// Device Client (MQTT)
+//
+// Devices publish their diagnostics and health to the broker (retained) and receive status
+// updates as commands, requests a device cannot serve through the broker are unsupported.
+type ClientMqtt struct {
+	client    mqtt.Client
+	lost      chan error
+	config    Config
+	generator ChecksumGenerator
+	verifier  *signature.Verifier
+}
+
+func NewClientMqtt(config Config, broker types.MQTT, generator ChecksumGenerator) (*ClientMqtt, error) {
+	if config.Identifier == "" {
+		return nil, fmt.Errorf("%w (mqtt): missing device identifier", ErrorClientCreation)
+	}
+	verifier, err := signature.NewVerifier(config.Signing)
+	if err != nil {
+		return nil, fmt.Errorf("%w (mqtt): %w", ErrorClientCreation, err)
+	}
+	d := &ClientMqtt{
+		lost:      make(chan error, 1),
+		config:    config,
+		generator: generator,
+		verifier:  verifier,
+	}
+	// Clients of the same device (e.g. a worker and a probe) need distinct identifiers, the
+	// broker closes the session of a client connecting with an identifier in use
+	identifier := fmt.Sprintf("%s-%s-%s", DefaultMqttClientIdentifier, config.Identifier, rand.Text()[:8])
+	options := mqtt.NewClientOptions().
+		AddBroker(broker.Broker).
+		SetClientID(identifier).
+		SetUsername(broker.Username).
+		SetPassword(broker.Password).
+		SetCleanSession(true).
+		SetAutoReconnect(false).
+		SetConnectTimeout(DefaultClientTimeout).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			select {
+			case d.lost <- err:
+			default:
+			}
+		})
+	d.client = mqtt.NewClient(options)
+	token := d.client.Connect()
+	if !token.WaitTimeout(DefaultClientTimeout) {
+		return nil, fmt.Errorf(
+			"%w (mqtt): timed out connecting to broker %s",
+			ErrorClientCreation,
+			broker.Broker,
+		)
+	}
+	if err := token.Error(); err != nil {
+		return nil, fmt.Errorf("%w (mqtt): %w", ErrorClientCreation, err)
+	}
+	return d, nil
+}
+
+func (d *ClientMqtt) GetHealth(ctx context.Context) (*types.DeviceHealthStatus, error) {
+	payload, err := d.retained(ctx, devicev1.HealthTopic(d.config.Identifier))
+	if err != nil {
+		return nil, err
+	}
+	var res devicev1.GetHealthResponse
+	if err := protojson.Unmarshal(payload, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode health message (mqtt): %w", err)
+	}
+	return &types.DeviceHealthStatus{
+		Identifier:         res.DeviceId,
+		SupportedProtocols: types.ProtocolFromDevice(res.SupportedProtocols),
+		Architecture:       res.Architecture,
+		OS:                 res.Os,
+		Updated:            res.UpdatedAt.AsTime(),
+	}, nil
+}
+
+func (d *ClientMqtt) GetDiagnostics(ctx context.Context) (*types.DeviceDiagnostics, error) {
+	payload, err := d.retained(ctx, devicev1.DiagnosticsTopic(d.config.Identifier))
+	if err != nil {
+		return nil, err
+	}
+	var res devicev1.DiagnosticsResponse
+	if err := protojson.Unmarshal(payload, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode diagnostics message (mqtt): %w", err)
+	}
+	return diagnostics(ctx, d.verifier, d.generator, &res), nil
+}
+
+// StreamDiagnostics relays the diagnostics published by the device, the retained sample
+// received on subscribing is skipped since it may have been published long before.
+func (d *ClientMqtt) StreamDiagnostics(ctx context.Context) (<-chan *types.DeviceDiagnostics, <-chan error) {
+	ch := make(chan *types.DeviceDiagnostics)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		defer close(errCh)
+		topic := devicev1.DiagnosticsTopic(d.config.Identifier)
+		messages := make(chan []byte, DefaultMqttStreamBuffer)
+		token := d.client.Subscribe(topic, 0, func(_ mqtt.Client, message mqtt.Message) {
+			if message.Retained() {
+				return
+			}
+			select {
+			case messages <- message.Payload():
+			case <-ctx.Done():
+			}
+		})
+		if err := wait(ctx, token); err != nil {
+			errCh <- fmt.Errorf("failed to subscribe to diagnostics (mqtt): %w", err)
+			return
+		}
+		defer d.client.Unsubscribe(topic)
+		for {
+			select {
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			case err := <-d.lost:
+				errCh <- fmt.Errorf("lost connection to broker (mqtt): %w", err)
+				return
+			case payload := <-messages:
+				var res devicev1.DiagnosticsResponse
+				if err := protojson.Unmarshal(payload, &res); err != nil {
+					errCh <- fmt.Errorf("failed to decode stream diagnostics message (mqtt): %w", err)
+					return
+				}
+				select {
+				case <-ctx.Done():
+					errCh <- ctx.Err()
+					return
+				case ch <- diagnostics(ctx, d.verifier, d.generator, &res):
+				}
+			}
+		}
+	}()
+	return ch, errCh
+}
+
+// UpdateDevice publishes the update as a command of the device, delivery is acknowledged by
+// the broker (QoS 1) and not by the device.
+func (d *ClientMqtt) UpdateDevice(ctx context.Context, status types.DeviceStatus) error {
+	command := &devicev1.DeviceCommand{
+		Command: &devicev1.DeviceCommand_UpdateDevice{
+			UpdateDevice: &devicev1.UpdateDeviceRequest{DeviceStatus: status.DeviceProto()},
+		},
+	}
+	payload, err := protojson.Marshal(command)
+	if err != nil {
+		return fmt.Errorf("failed to marshal update device command (mqtt): %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, DefaultClientTimeout)
+	defer cancel()
+	token := d.client.Publish(devicev1.CommandTopic(d.config.Identifier), 1, false, payload)
+	if err := wait(ctx, token); err != nil {
+		return fmt.Errorf("failed to publish update device command (mqtt): %w", err)
+	}
+	return nil
+}
+
+func (d *ClientMqtt) GetFirmwareUpgrade(ctx context.Context) (*types.DeviceFirmwareUpgrade, error) {
+	return nil, fmt.Errorf("%w: firmware upgrades are not published (mqtt)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientMqtt) UpgradeFirmware(ctx context.Context, version string) error {
+	return fmt.Errorf("%w: firmware upgrades are not supported as commands (mqtt)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientMqtt) Reboot(ctx context.Context, duration time.Duration) error {
+	return fmt.Errorf("%w: reboots are not supported as commands (mqtt)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientMqtt) ApplyConfig(ctx context.Context, config types.DesiredConfig) error {
+	return fmt.Errorf("%w: configs are not supported as commands (mqtt)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientMqtt) Close() error {
+	d.client.Disconnect(DefaultMqttDisconnectQuiet)
+	return nil
+}
+
+// retained returns the message retained by the broker for a topic, a device that has not
+// published to the topic is reported as not available.
+func (d *ClientMqtt) retained(ctx context.Context, topic string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultClientTimeout)
+	defer cancel()
+	messages := make(chan []byte, 1)
+	token := d.client.Subscribe(topic, 0, func(_ mqtt.Client, message mqtt.Message) {
+		select {
+		case messages <- message.Payload():
+		default:
+		}
+	})
+	if err := wait(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to %s (mqtt): %w", topic, err)
+	}
+	defer d.client.Unsubscribe(topic)
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w: device is not available (mqtt): %s", ErrorNotFound, topic)
+	case payload := <-messages:
+		return payload, nil
+	}
+}
+
+func wait(ctx context.Context, token mqtt.Token) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-token.Done():
+		return token.Error()
+	}
+}
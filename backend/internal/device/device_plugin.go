@@ -0,0 +1,178 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	driverv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/driver/v1"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Device Client (Plugin)
+//
+// Requests are issued to an out-of-process driver (driver.v1.Driver) which communicates with
+// the device, the connection to the plugin is shared by the clients of its devices. Requests
+// wait for the plugin to be reachable within the client timeout (streams until the heartbeat
+// of the worker). Diagnostics are mapped as reported by the plugin and are neither signed nor
+// checksummed.
+type ClientPlugin struct {
+	client driverv1.DriverClient
+	config Config
+}
+
+func NewClientPlugin(config Config, client driverv1.DriverClient) *ClientPlugin {
+	return &ClientPlugin{client: client, config: config}
+}
+
+func (d *ClientPlugin) GetHealth(ctx context.Context) (*types.DeviceHealthStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultClientTimeout)
+	defer cancel()
+	res, err := d.client.GetHealth(ctx, &driverv1.DeviceRequest{Device: d.device()})
+	if err != nil {
+		return nil, d.error("failed to perform health request", err)
+	}
+	return &types.DeviceHealthStatus{
+		Identifier:         d.config.Identifier,
+		SupportedProtocols: []types.Protocol{types.ProtocolPlugin},
+		Architecture:       res.GetArchitecture(),
+		OS:                 res.GetOs(),
+		Updated:            time.Now(),
+	}, nil
+}
+
+func (d *ClientPlugin) GetDiagnostics(ctx context.Context) (*types.DeviceDiagnostics, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultClientTimeout)
+	defer cancel()
+	res, err := d.client.GetDiagnostics(ctx, &driverv1.DeviceRequest{Device: d.device()})
+	if err != nil {
+		return nil, d.error("failed to perform diagnostics request", err)
+	}
+	return d.diagnostics(res), nil
+}
+
+func (d *ClientPlugin) StreamDiagnostics(
+	ctx context.Context,
+) (<-chan *types.DeviceDiagnostics, <-chan error) {
+	ch := make(chan *types.DeviceDiagnostics)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		defer close(errCh)
+		stream, err := d.client.StreamDiagnostics(ctx, &driverv1.DeviceRequest{Device: d.device()})
+		if err != nil {
+			errCh <- d.error("failed to create diagnostics stream", err)
+			return
+		}
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				errCh <- d.error("failed to receive from diagnostics stream", err)
+				return
+			}
+			select {
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			case ch <- d.diagnostics(res):
+			}
+		}
+	}()
+	return ch, errCh
+}
+
+func (d *ClientPlugin) UpdateDevice(ctx context.Context, status types.DeviceStatus) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultClientTimeout)
+	defer cancel()
+	_, err := d.client.UpdateDevice(ctx, &driverv1.UpdateDeviceRequest{
+		Device:       d.device(),
+		DeviceStatus: driverv1.DeviceStatus(driverv1.DeviceStatus_value[status.String()]),
+	})
+	if err != nil {
+		return d.error("failed to perform device update request", err)
+	}
+	return nil
+}
+
+func (d *ClientPlugin) GetFirmwareUpgrade(ctx context.Context) (*types.DeviceFirmwareUpgrade, error) {
+	return nil, fmt.Errorf("%w: firmware upgrades are not supported (plugin)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientPlugin) UpgradeFirmware(ctx context.Context, version string) error {
+	return fmt.Errorf("%w: firmware upgrades are not supported (plugin)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientPlugin) Reboot(ctx context.Context, duration time.Duration) error {
+	return fmt.Errorf("%w: reboots are not supported (plugin)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientPlugin) ApplyConfig(ctx context.Context, config types.DesiredConfig) error {
+	return fmt.Errorf("%w: configs are not supported (plugin)", ErrorUnsupportedProtocol)
+}
+
+// Close leaves the connection to the plugin open, it is closed with the registry
+func (d *ClientPlugin) Close() error {
+	return nil
+}
+
+func (d *ClientPlugin) device() *driverv1.Device {
+	return &driverv1.Device{
+		DeviceId: d.config.Identifier,
+		Host:     d.config.Host,
+		Port:     d.config.Port,
+	}
+}
+
+// error wraps the errors of the plugin, plugins report unknown or unreachable devices as
+// not found and requests they do not implement as unimplemented.
+func (d *ClientPlugin) error(message string, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return fmt.Errorf("%w: device is not available (plugin %s): %w", ErrorNotFound, d.config.Driver, err)
+	case codes.Unimplemented:
+		return fmt.Errorf("%w: %s (plugin %s): %w", ErrorUnsupportedProtocol, message, d.config.Driver, err)
+	}
+	return fmt.Errorf("%s (plugin %s): %w", message, d.config.Driver, err)
+}
+
+// diagnostics maps a sample reported by the plugin, samples without a timestamp are taken now
+func (d *ClientPlugin) diagnostics(res *driverv1.DiagnosticsResponse) *types.DeviceDiagnostics {
+	timestamp := res.GetTimestamp()
+	if timestamp == nil {
+		timestamp = timestamppb.Now()
+	}
+	result := sample(&devicev1.DiagnosticsResponse{
+		DeviceId:        d.config.Identifier,
+		HardwareVersion: res.GetHardwareVersion(),
+		SoftwareVersion: res.GetSoftwareVersion(),
+		FirmwareVersion: res.GetFirmwareVersion(),
+		CpuUsage:        res.GetCpuUsage(),
+		MemoryUsage:     res.GetMemoryUsage(),
+		DeviceStatus: devicev1.DeviceStatus(
+			devicev1.DeviceStatus_value[res.GetDeviceStatus().String()],
+		),
+		UptimeSeconds:      res.GetUptimeSeconds(),
+		LoadAverage_1M:     res.GetLoadAverage_1M(),
+		LoadAverage_5M:     res.GetLoadAverage_5M(),
+		LoadAverage_15M:    res.GetLoadAverage_15M(),
+		TemperatureCelsius: res.GetTemperatureCelsius(),
+		DiskUsedBytes:      res.GetDiskUsedBytes(),
+		DiskTotalBytes:     res.GetDiskTotalBytes(),
+		ProcessCount:       res.GetProcessCount(),
+		Timestamp:          timestamp,
+	})
+	result.Verification = types.VerificationStatusUnsigned
+	// Plugins not reporting a status report reachable devices
+	if result.DeviceStatus == "" {
+		result.DeviceStatus = types.DeviceStatusHealthy
+	}
+	return result
+}
@@ -0,0 +1,352 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/gosnmp/gosnmp"
+)
+
+// Object identifiers of the system (SNMPv2-MIB) and host resources (HOST-RESOURCES-MIB) groups
+const (
+	OidSysDescr          = ".1.3.6.1.2.1.1.1.0"
+	OidSysObjectID       = ".1.3.6.1.2.1.1.2.0"
+	OidSysUpTime         = ".1.3.6.1.2.1.1.3.0"
+	OidHrSystemProcesses = ".1.3.6.1.2.1.25.1.6.0"
+	OidHrStorageTable    = ".1.3.6.1.2.1.25.2.3.1"
+	OidHrStorageType     = ".1.3.6.1.2.1.25.2.3.1.2"
+	OidHrStorageUnits    = ".1.3.6.1.2.1.25.2.3.1.4"
+	OidHrStorageSize     = ".1.3.6.1.2.1.25.2.3.1.5"
+	OidHrStorageUsed     = ".1.3.6.1.2.1.25.2.3.1.6"
+	OidHrStorageRam      = ".1.3.6.1.2.1.25.2.1.2"
+	OidHrStorageDisk     = ".1.3.6.1.2.1.25.2.1.4"
+	OidHrProcessorLoad   = ".1.3.6.1.2.1.25.3.3.1.2"
+)
+
+// MaxVersionLength is the width of the version columns of the diagnostics (state.sql)
+const MaxVersionLength = 50
+
+// Device Client (SNMP)
+//
+// Agents are polled for the system and host resources groups, the diagnostics are mapped
+// from the agent's view of the device and are neither signed nor checksummed. Agents are not
+// controlled, requests besides health and diagnostics are unsupported.
+type ClientSnmp struct {
+	mu     sync.Mutex
+	client *gosnmp.GoSNMP
+	config Config
+}
+
+func NewClientSnmp(config Config) (*ClientSnmp, error) {
+	if config.Identifier == "" {
+		return nil, fmt.Errorf("%w (snmp): missing device identifier", ErrorClientCreation)
+	}
+	client := &gosnmp.GoSNMP{
+		Target:    config.Host,
+		Port:      uint16(config.Port), // nolint:gosec
+		Transport: "udp",
+		Timeout:   DefaultClientTimeout,
+		Retries:   1,
+		MaxOids:   gosnmp.MaxOids,
+	}
+	switch config.Snmp.Version {
+	case types.SnmpVersionV2c:
+		client.Version = gosnmp.Version2c
+		client.Community = config.Snmp.Community
+	case types.SnmpVersionV3:
+		client.Version = gosnmp.Version3
+		client.SecurityModel = gosnmp.UserSecurityModel
+		client.MsgFlags = gosnmp.NoAuthNoPriv
+		params := &gosnmp.UsmSecurityParameters{
+			UserName:                 config.Snmp.Username,
+			AuthenticationProtocol:   gosnmp.NoAuth,
+			AuthenticationPassphrase: config.Snmp.AuthPassphrase,
+			PrivacyProtocol:          gosnmp.NoPriv,
+			PrivacyPassphrase:        config.Snmp.PrivPassphrase,
+		}
+		if config.Snmp.AuthProtocol != "" {
+			client.MsgFlags = gosnmp.AuthNoPriv
+			params.AuthenticationProtocol = authProtocol(config.Snmp.AuthProtocol)
+		}
+		if config.Snmp.PrivProtocol != "" {
+			client.MsgFlags = gosnmp.AuthPriv
+			params.PrivacyProtocol = privProtocol(config.Snmp.PrivProtocol)
+		}
+		client.SecurityParameters = params
+	default:
+		return nil, fmt.Errorf(
+			"%w (snmp): unsupported version '%s'",
+			ErrorClientCreation,
+			config.Snmp.Version,
+		)
+	}
+	if err := client.Connect(); err != nil {
+		return nil, fmt.Errorf("%w (snmp): %w", ErrorClientCreation, err)
+	}
+	return &ClientSnmp{client: client, config: config}, nil
+}
+
+// GetHealth derives the operating system and architecture of the device from its system
+// description (e.g. "Linux gateway 6.1.0 #1 SMP x86_64").
+func (d *ClientSnmp) GetHealth(ctx context.Context) (*types.DeviceHealthStatus, error) {
+	values, err := d.get(ctx, OidSysDescr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform health request (snmp): %w", err)
+	}
+	fields := strings.Fields(octets(values[OidSysDescr]))
+	os, architecture := "unknown", "unknown"
+	if len(fields) > 0 {
+		os = strings.ToLower(fields[0])
+		architecture = machine(fields[len(fields)-1])
+	}
+	return &types.DeviceHealthStatus{
+		Identifier:         d.config.Identifier,
+		SupportedProtocols: []types.Protocol{types.ProtocolSnmp},
+		Architecture:       architecture,
+		OS:                 os,
+		Updated:            time.Now(),
+	}, nil
+}
+
+func (d *ClientSnmp) GetDiagnostics(ctx context.Context) (*types.DeviceDiagnostics, error) {
+	values, err := d.get(ctx, OidSysDescr, OidSysObjectID, OidSysUpTime, OidHrSystemProcesses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform diagnostics request (snmp): %w", err)
+	}
+	loads, err := d.walk(ctx, OidHrProcessorLoad)
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk processor table (snmp): %w", err)
+	}
+	storage, err := d.walk(ctx, OidHrStorageTable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk storage table (snmp): %w", err)
+	}
+	var cpu float64
+	for _, load := range loads {
+		cpu += float64(gosnmp.ToBigInt(load.Value).Int64())
+	}
+	if len(loads) > 0 {
+		cpu /= float64(len(loads))
+	}
+	memory, disk := storages(storage)
+	return &types.DeviceDiagnostics{
+		Identifier: d.config.Identifier,
+		DeviceVersions: types.DeviceVersions{
+			Hardware: truncate(strings.TrimPrefix(oid(values[OidSysObjectID]), ".")),
+			Software: release(octets(values[OidSysDescr])),
+		},
+		CPU:          cpu,
+		Memory:       memory.usage(),
+		DeviceStatus: types.DeviceStatusHealthy,
+		Verification: types.VerificationStatusUnsigned,
+		// sysUpTime is measured in hundredths of a second
+		Uptime:    time.Duration(gosnmp.ToBigInt(values[OidSysUpTime].Value).Int64()) * 10 * time.Millisecond,
+		DiskUsed:  disk.used,
+		DiskTotal: disk.total,
+		Processes: uint32(gosnmp.ToBigInt(values[OidHrSystemProcesses].Value).Uint64()), // nolint:gosec
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func (d *ClientSnmp) StreamDiagnostics(ctx context.Context) (<-chan *types.DeviceDiagnostics, <-chan error) {
+	ch := make(chan *types.DeviceDiagnostics)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("%w: agents are polled (snmp)", ErrorUnsupportedProtocol)
+	close(ch)
+	close(errCh)
+	return ch, errCh
+}
+
+func (d *ClientSnmp) UpdateDevice(ctx context.Context, status types.DeviceStatus) error {
+	return fmt.Errorf("%w: device updates are not supported (snmp)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientSnmp) GetFirmwareUpgrade(ctx context.Context) (*types.DeviceFirmwareUpgrade, error) {
+	return nil, fmt.Errorf("%w: firmware upgrades are not supported (snmp)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientSnmp) UpgradeFirmware(ctx context.Context, version string) error {
+	return fmt.Errorf("%w: firmware upgrades are not supported (snmp)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientSnmp) Reboot(ctx context.Context, duration time.Duration) error {
+	return fmt.Errorf("%w: reboots are not supported (snmp)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientSnmp) ApplyConfig(ctx context.Context, config types.DesiredConfig) error {
+	return fmt.Errorf("%w: configs are not supported (snmp)", ErrorUnsupportedProtocol)
+}
+
+func (d *ClientSnmp) Close() error {
+	return d.client.Conn.Close()
+}
+
+// get requests scalar objects of the agent, requests are serialized since the underlying
+// client is not safe for concurrent use.
+func (d *ClientSnmp) get(ctx context.Context, oids ...string) (map[string]gosnmp.SnmpPDU, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.client.Context = ctx
+	packet, err := d.client.Get(oids)
+	if err != nil {
+		return nil, fmt.Errorf("%w: device is not available (snmp): %w", ErrorNotFound, err)
+	}
+	if packet.Error != gosnmp.NoError {
+		return nil, fmt.Errorf("agent responded with error '%s'", packet.Error.String())
+	}
+	values := make(map[string]gosnmp.SnmpPDU, len(packet.Variables))
+	for _, variable := range packet.Variables {
+		switch variable.Type {
+		case gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView:
+			return nil, fmt.Errorf("agent does not expose object %s", variable.Name)
+		}
+		values[variable.Name] = variable
+	}
+	return values, nil
+}
+
+// walk retrieves the objects of a subtree with bulk requests
+func (d *ClientSnmp) walk(ctx context.Context, root string) ([]gosnmp.SnmpPDU, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.client.Context = ctx
+	values, err := d.client.BulkWalkAll(root)
+	if err != nil {
+		return nil, fmt.Errorf("%w: device is not available (snmp): %w", ErrorNotFound, err)
+	}
+	return values, nil
+}
+
+type storage struct {
+	used, total uint64
+}
+
+func (s storage) usage() float64 {
+	if s.total == 0 {
+		return 0
+	}
+	return float64(s.used) / float64(s.total) * 100
+}
+
+// storages sums the RAM and fixed disk entries of the storage table in bytes
+func storages(values []gosnmp.SnmpPDU) (memory, disk storage) {
+	type entry struct {
+		kind              string
+		units, size, used uint64
+	}
+	entries := make(map[string]*entry)
+	for _, value := range values {
+		column, index, found := strings.Cut(strings.TrimPrefix(value.Name, OidHrStorageTable+"."), ".")
+		if !found {
+			continue
+		}
+		e, exists := entries[index]
+		if !exists {
+			e = &entry{}
+			entries[index] = e
+		}
+		switch OidHrStorageTable + "." + column {
+		case OidHrStorageType:
+			e.kind = oid(value)
+		case OidHrStorageUnits:
+			e.units = gosnmp.ToBigInt(value.Value).Uint64()
+		case OidHrStorageSize:
+			e.size = gosnmp.ToBigInt(value.Value).Uint64()
+		case OidHrStorageUsed:
+			e.used = gosnmp.ToBigInt(value.Value).Uint64()
+		}
+	}
+	for _, e := range entries {
+		switch e.kind {
+		case OidHrStorageRam:
+			memory.used += e.used * e.units
+			memory.total += e.size * e.units
+		case OidHrStorageDisk:
+			disk.used += e.used * e.units
+			disk.total += e.size * e.units
+		}
+	}
+	return memory, disk
+}
+
+func octets(value gosnmp.SnmpPDU) string {
+	if b, ok := value.Value.([]byte); ok {
+		return string(b)
+	}
+	return ""
+}
+
+func oid(value gosnmp.SnmpPDU) string {
+	if s, ok := value.Value.(string); ok {
+		return "." + strings.TrimPrefix(s, ".")
+	}
+	return ""
+}
+
+// release returns the software version of a system description, the release of the uname
+// style descriptions of Unix agents (e.g. "Linux gateway 6.1.0-18-amd64 #1 SMP ... x86_64") or
+// the token following "Version" (e.g. Cisco IOS and Windows), otherwise the description.
+func release(description string) string {
+	fields := strings.Fields(description)
+	if len(fields) >= 3 && unicode.IsDigit(rune(fields[2][0])) {
+		return truncate(fields[2])
+	}
+	for i := range max(len(fields)-1, 0) {
+		if strings.EqualFold(fields[i], "version") {
+			return truncate(strings.TrimRight(fields[i+1], ",;"))
+		}
+	}
+	return truncate(strings.Join(fields, " "))
+}
+
+// truncate shortens a version to the width of the version columns of the diagnostics
+func truncate(value string) string {
+	if runes := []rune(value); len(runes) > MaxVersionLength {
+		return string(runes[:MaxVersionLength])
+	}
+	return value
+}
+
+// machine maps the machine hardware name of a system description to an architecture
+func machine(value string) string {
+	switch value {
+	case "x86_64", "amd64":
+		return "amd64"
+	case "aarch64", "arm64":
+		return "arm64"
+	case "armv7l", "armv6l", "arm":
+		return "arm"
+	}
+	return "unknown"
+}
+
+func authProtocol(protocol types.SnmpAuthProtocol) gosnmp.SnmpV3AuthProtocol {
+	switch protocol {
+	case types.SnmpAuthProtocolMd5:
+		return gosnmp.MD5
+	case types.SnmpAuthProtocolSha:
+		return gosnmp.SHA
+	case types.SnmpAuthProtocolSha256:
+		return gosnmp.SHA256
+	case types.SnmpAuthProtocolSha512:
+		return gosnmp.SHA512
+	}
+	return gosnmp.NoAuth
+}
+
+func privProtocol(protocol types.SnmpPrivProtocol) gosnmp.SnmpV3PrivProtocol {
+	switch protocol {
+	case types.SnmpPrivProtocolDes:
+		return gosnmp.DES
+	case types.SnmpPrivProtocolAes:
+		return gosnmp.AES
+	case types.SnmpPrivProtocolAes256:
+		return gosnmp.AES256
+	}
+	return gosnmp.NoPriv
+}
@@ -0,0 +1,54 @@
+package device
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRelease(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		expected    string
+	}{
+		{
+			name:        "should return release of uname description",
+			description: "Linux gateway-7e41 6.1.0-18-amd64 #1 SMP PREEMPT_DYNAMIC Debian 6.1.76-1 (2024-02-01) x86_64",
+			expected:    "6.1.0-18-amd64",
+		},
+		{
+			name: "should return version of cisco description",
+			description: "Cisco IOS Software, C2960 Software (C2960-LANBASEK9-M), Version 12.2(55)SE7, " +
+				"RELEASE SOFTWARE (fc1)\r\nTechnical Support: http://www.cisco.com/techsupport",
+			expected: "12.2(55)SE7",
+		},
+		{
+			name: "should return version of windows description",
+			description: "Hardware: Intel64 Family 6 Model 85 Stepping 7 AT/AT COMPATIBLE - " +
+				"Software: Windows Version 6.3 (Build 17763 Multiprocessor Free)",
+			expected: "6.3",
+		},
+		{
+			name:        "should return short description",
+			description: "  EdgeOS  ",
+			expected:    "EdgeOS",
+		},
+		{
+			name:        "should truncate unknown description",
+			description: strings.Repeat("description ", 10),
+			expected:    strings.Repeat("description ", 4) + "de",
+		},
+		{name: "should return empty version of empty description"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := release(tt.description)
+			if result != tt.expected {
+				t.Errorf("expected version %q, got %q", tt.expected, result)
+			}
+			if len(result) > MaxVersionLength {
+				t.Errorf("expected version of at most %d characters, got %d", MaxVersionLength, len(result))
+			}
+		})
+	}
+}
@@ -5,10 +5,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/signature"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-var _ = []Client{(*ClientGrpc)(nil), (*ClientHttp)(nil)}
+var _ = []Client{
+	(*ClientGrpc)(nil),
+	(*ClientHttp)(nil),
+	(*ClientMqtt)(nil),
+	(*ClientSnmp)(nil),
+	(*ClientPlugin)(nil),
+}
 
 const (
 	DefaultClientTimeout     = 3 * time.Second
@@ -26,6 +35,10 @@ type Client interface {
 	GetDiagnostics(ctx context.Context) (*types.DeviceDiagnostics, error)
 	StreamDiagnostics(ctx context.Context) (<-chan *types.DeviceDiagnostics, <-chan error)
 	UpdateDevice(ctx context.Context, status types.DeviceStatus) error
+	GetFirmwareUpgrade(ctx context.Context) (*types.DeviceFirmwareUpgrade, error)
+	UpgradeFirmware(ctx context.Context, version string) error
+	Reboot(ctx context.Context, duration time.Duration) error
+	ApplyConfig(ctx context.Context, config types.DesiredConfig) error
 	Close() error
 }
 
@@ -34,26 +47,121 @@ type ChecksumGenerator interface {
 }
 
 type Config struct {
-	Protocol types.Protocol
-	Host     string
-	Port     int64
+	Protocol   types.Protocol
+	Driver     string
+	Identifier string
+	Host       string
+	Port       int64
+	Signing    types.DeviceSigning
+	Snmp       types.SnmpCredentials
+}
+
+// diagnostics maps a sample verifying its signature and checksum, the checksum of a sample that
+// does not match the checksum generated for it is replaced by devicev1.DefaultInvalidChecksum.
+func diagnostics(
+	ctx context.Context,
+	verifier *signature.Verifier,
+	generator ChecksumGenerator,
+	diag *devicev1.DiagnosticsResponse,
+) *types.DeviceDiagnostics {
+	verification := verify(verifier, diag)
+	checksum := diag.Checksum
+	comparison := diag.GenerateChecksum(ctx, generator)
+	if checksum != comparison {
+		diag.Checksum = devicev1.DefaultInvalidChecksum
+	}
+	result := sample(diag)
+	result.Checksum = checksum
+	result.Verification = verification
+	result.ChecksumMismatch = checksum != comparison && comparison != devicev1.DefaultInvalidChecksum
+	return result
+}
+
+// sample maps the fields of a sample without verifying it
+func sample(diag *devicev1.DiagnosticsResponse) *types.DeviceDiagnostics {
+	return &types.DeviceDiagnostics{
+		Identifier: diag.DeviceId,
+		DeviceVersions: types.DeviceVersions{
+			Hardware: diag.HardwareVersion,
+			Software: diag.SoftwareVersion,
+			Firmware: diag.FirmwareVersion,
+		},
+		CPU:          diag.CpuUsage,
+		Memory:       diag.MemoryUsage,
+		DeviceStatus: types.DeviceStatusFromString(diag.DeviceStatus.String()),
+		Uptime:       time.Duration(diag.UptimeSeconds) * time.Second,
+		LoadAverage: types.LoadAverage{
+			One:     diag.LoadAverage_1M,
+			Five:    diag.LoadAverage_5M,
+			Fifteen: diag.LoadAverage_15M,
+		},
+		Temperature:    diag.TemperatureCelsius,
+		DiskUsed:       diag.DiskUsedBytes,
+		DiskTotal:      diag.DiskTotalBytes,
+		Processes:      diag.ProcessCount,
+		Interfaces:     interfaces(diag.Interfaces),
+		StreamInterval: diag.GetStreamInterval().AsDuration(),
+		Labels:         diag.GetLabels(),
+		Config:         diag.GetConfig(),
+		Timestamp:      diag.Timestamp.AsTime(),
+	}
+}
+
+func upgrade(res *devicev1.FirmwareUpgrade) *types.DeviceFirmwareUpgrade {
+	return &types.DeviceFirmwareUpgrade{
+		TargetVersion:   res.GetTargetVersion(),
+		PreviousVersion: res.GetPreviousVersion(),
+		Phase:           types.UpgradePhaseFromString(res.GetPhase().String()),
+		Error:           res.GetError(),
+	}
 }
 
-// Device Client Factory
-type factory struct {
-	generator ChecksumGenerator
+func deviceConfig(config types.DesiredConfig) *devicev1.DeviceConfig {
+	result := &devicev1.DeviceConfig{
+		Labels: config.Labels,
+		Config: config.Config,
+	}
+	if config.DeviceStatus != "" {
+		result.DeviceStatus = config.DeviceStatus.DeviceProto()
+	}
+	if config.StreamInterval > 0 {
+		result.StreamInterval = durationpb.New(config.StreamInterval)
+	}
+	return result
 }
 
-func NewClientFactory(generator ChecksumGenerator) *factory {
-	return &factory{generator: generator}
+func verify(verifier *signature.Verifier, diag *devicev1.DiagnosticsResponse) types.VerificationStatus {
+	payload, err := diag.SignaturePayload()
+	if err != nil {
+		return types.VerificationStatusInvalid
+	}
+	return verifier.Verify(payload, diag.Signature)
 }
 
-func (f *factory) CreateClient(config Config) (Client, error) {
-	if config.Protocol.IsGrpc() {
-		return NewClientGrpc(config, f.generator)
+func interfaces(values []*devicev1.NetworkInterface) []types.DeviceInterface {
+	result := make([]types.DeviceInterface, len(values))
+	for i, value := range values {
+		result[i] = types.DeviceInterface{
+			Name:      value.GetName(),
+			LinkState: types.LinkStateFromString(value.GetLinkState().String()),
+			Counters: types.InterfaceCounters{
+				RxBytes:   value.GetRxBytes(),
+				TxBytes:   value.GetTxBytes(),
+				RxPackets: value.GetRxPackets(),
+				TxPackets: value.GetTxPackets(),
+				RxErrors:  value.GetRxErrors(),
+				TxErrors:  value.GetTxErrors(),
+			},
+			CounterBits: value.GetCounterBits(),
+		}
 	}
-	if config.Protocol.IsHttp() {
-		return NewClientHttp(config, f.generator), nil
+	return result
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
 	}
-	return nil, fmt.Errorf("%w: %s", ErrorUnsupportedProtocol, config.Protocol.String())
+	var zero T
+	return zero
 }
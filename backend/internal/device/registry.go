@@ -0,0 +1,288 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	driverv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/driver/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Capabilities of a driver, workers stream the diagnostics of a device through drivers
+// supporting streaming and poll them otherwise. Requests issued to a device (e.g. updates)
+// prefer drivers supporting updates.
+type Capabilities struct {
+	Polling   bool
+	Streaming bool
+	Update    bool
+}
+
+type Constructor func(config Config) (Client, error)
+
+// Driver creates clients of devices, drivers are registered and looked up by their name which is
+// stored with each device. Built-in drivers are named after their protocol (e.g. grpc-stream),
+// devices registered with PROTOCOL_PLUGIN name their driver. Drivers of devices reached through
+// their gateway connect to the gateway port.
+type Driver struct {
+	Name         string
+	Protocol     types.Protocol
+	Capabilities Capabilities
+	Gateway      bool
+	New          Constructor
+}
+
+// Config returns the client configuration of a registered device
+func (d Driver) Config(device types.Device) Config {
+	port := deref(device.Port)
+	if d.Gateway {
+		port = deref(device.GatewayPort)
+	}
+	return Config{
+		Protocol:   d.Protocol,
+		Driver:     d.Name,
+		Identifier: deref(device.Identifier),
+		Host:       deref(device.Host),
+		Port:       port,
+		Signing:    device.Signing(),
+		Snmp:       device.Snmp(),
+	}
+}
+
+// Device Driver Registry
+type Registry struct {
+	mu      sync.RWMutex
+	entries []*entry
+	conns   []*grpc.ClientConn
+}
+
+type entry struct {
+	driver Driver
+	// describe requests the capabilities of a plugin, nil once they are known
+	describe func(ctx context.Context) (Capabilities, error)
+}
+
+// NewRegistry creates a registry of the built-in drivers, clients of devices publishing to
+// a broker (MQTT) connect to the configured broker. Drivers are preferred in registration order.
+func NewRegistry(generator ChecksumGenerator, broker types.MQTT) *Registry {
+	r := &Registry{}
+	grpcClient := func(config Config) (Client, error) { return NewClientGrpc(config, generator) }
+	httpClient := func(config Config) (Client, error) { return NewClientHttp(config, generator) }
+	for _, driver := range []Driver{
+		{
+			Name:         "grpc",
+			Protocol:     types.ProtocolGrpc,
+			Capabilities: Capabilities{Polling: true, Update: true},
+			New:          grpcClient,
+		},
+		{
+			Name:         "grpc-stream",
+			Protocol:     types.ProtocolGrpcStream,
+			Capabilities: Capabilities{Streaming: true, Update: true},
+			New:          grpcClient,
+		},
+		{
+			Name:         "http",
+			Protocol:     types.ProtocolHttp,
+			Capabilities: Capabilities{Polling: true, Update: true},
+			Gateway:      true,
+			New:          httpClient,
+		},
+		{
+			Name:         "http-stream",
+			Protocol:     types.ProtocolHttpStream,
+			Capabilities: Capabilities{Streaming: true, Update: true},
+			Gateway:      true,
+			New:          httpClient,
+		},
+		{
+			// Devices publish to the broker, the worker subscribes like to a stream
+			Name:         "mqtt",
+			Protocol:     types.ProtocolMqtt,
+			Capabilities: Capabilities{Streaming: true, Update: true},
+			New: func(config Config) (Client, error) {
+				return NewClientMqtt(config, broker, generator)
+			},
+		},
+		{
+			Name:         "snmp",
+			Protocol:     types.ProtocolSnmp,
+			Capabilities: Capabilities{Polling: true},
+			New:          func(config Config) (Client, error) { return NewClientSnmp(config) },
+		},
+	} {
+		r.entries = append(r.entries, &entry{driver: driver})
+	}
+	return r
+}
+
+// Register adds a driver to the registry, driver names are unique. Drivers without a protocol
+// serve devices registered with PROTOCOL_PLUGIN and the name of the driver.
+func (r *Registry) Register(driver Driver) error {
+	if driver.Protocol == "" {
+		driver.Protocol = types.ProtocolPlugin
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.register(&entry{driver: driver})
+}
+
+// RegisterPlugin adds an out-of-process driver served at the endpoint (driver.v1.Driver),
+// devices are registered with the plugin by its name. Plugins are connected lazily and
+// described once reachable, until then they are assumed to support polling only.
+func (r *Registry) RegisterPlugin(name, endpoint string) error {
+	// Plugins are restarted independently of the monitor, requests wait for the connection to
+	// be (re-)established which is attempted at least every second
+	backoffConfig := backoff.DefaultConfig
+	backoffConfig.MaxDelay = time.Second
+	conn, err := grpc.NewClient(
+		endpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoffConfig}),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+	)
+	if err != nil {
+		return fmt.Errorf("%w (plugin %s): %w", ErrorClientCreation, name, err)
+	}
+	client := driverv1.NewDriverClient(conn)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err = r.register(&entry{
+		driver: Driver{
+			Name:         name,
+			Protocol:     types.ProtocolPlugin,
+			Capabilities: Capabilities{Polling: true},
+			New: func(config Config) (Client, error) {
+				return NewClientPlugin(config, client), nil
+			},
+		},
+		describe: func(ctx context.Context) (Capabilities, error) {
+			res, err := client.Describe(ctx, &driverv1.DescribeRequest{})
+			if err != nil {
+				return Capabilities{}, fmt.Errorf("failed to describe driver (plugin %s): %w", name, err)
+			}
+			return Capabilities{
+				Polling:   res.GetCapabilities().GetPolling(),
+				Streaming: res.GetCapabilities().GetStreaming(),
+				Update:    res.GetCapabilities().GetUpdate(),
+			}, nil
+		},
+	})
+	if err != nil {
+		conn.Close() // nolint:errcheck
+		return err
+	}
+	r.conns = append(r.conns, conn)
+	return nil
+}
+
+func (r *Registry) register(e *entry) error {
+	if e.driver.Name == "" || e.driver.New == nil {
+		return errors.New("driver requires a name and a constructor")
+	}
+	if slices.ContainsFunc(r.entries, func(other *entry) bool { return other.driver.Name == e.driver.Name }) {
+		return fmt.Errorf("driver '%s' is already registered", e.driver.Name)
+	}
+	r.entries = append(r.entries, e)
+	return nil
+}
+
+// Lookup returns the driver registered with a name
+func (r *Registry) Lookup(name string) (Driver, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, e := range r.entries {
+		if e.driver.Name == name {
+			return e.driver, nil
+		}
+	}
+	return Driver{}, fmt.Errorf("%w: driver '%s' is not registered", ErrorUnsupportedProtocol, name)
+}
+
+// Resolve returns the drivers of a device in order of preference: the driver stored with the
+// device and the built-in drivers of its supported protocols.
+func (r *Registry) Resolve(ctx context.Context, device types.Device) []Driver {
+	names := []string{deref(device.Driver)}
+	for _, protocol := range deref(device.SupportedProtocols) {
+		names = append(names, types.Protocol(protocol).Driver())
+	}
+	r.mu.RLock()
+	var entries []*entry
+	for _, e := range r.entries {
+		if slices.Contains(names, e.driver.Name) {
+			entries = append(entries, e)
+		}
+	}
+	r.mu.RUnlock()
+	drivers := make([]Driver, len(entries))
+	for i, e := range entries {
+		drivers[i] = r.describe(ctx, e)
+	}
+	return drivers
+}
+
+// describe returns the driver of an entry, the capabilities of a plugin are requested
+// without holding the lock until the plugin has been reachable once.
+func (r *Registry) describe(ctx context.Context, e *entry) Driver {
+	r.mu.RLock()
+	driver, describe := e.driver, e.describe
+	r.mu.RUnlock()
+	if describe == nil {
+		return driver
+	}
+	ctx, cancel := context.WithTimeout(ctx, DefaultClientTimeout)
+	defer cancel()
+	capabilities, err := describe(ctx)
+	if err != nil {
+		return driver
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e.driver.Capabilities, e.describe = capabilities, nil
+	return e.driver
+}
+
+func (r *Registry) CreateClient(config Config) (Client, error) {
+	driver, err := r.Lookup(config.Driver)
+	if err != nil {
+		return nil, err
+	}
+	return driver.New(config)
+}
+
+// ControlConfig selects the client configuration for requests issued to a device (e.g.
+// updates) of the preferred driver supporting updates, devices without such a driver (e.g.
+// agents polled over SNMP) are not controllable.
+func (r *Registry) ControlConfig(ctx context.Context, device types.Device) (Config, error) {
+	if device.Host == nil || device.Port == nil {
+		return Config{}, errors.New("device has no supported protocols")
+	}
+	drivers := r.Resolve(ctx, device)
+	i := slices.IndexFunc(drivers, func(d Driver) bool { return d.Capabilities.Update })
+	if i < 0 {
+		return Config{}, fmt.Errorf(
+			"%w: no driver of device %s supports updates",
+			ErrorUnsupportedProtocol,
+			deref(device.Identifier),
+		)
+	}
+	return drivers[i].Config(device), nil
+}
+
+// Close closes the connections to plugins
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs []error
+	for _, conn := range r.conns {
+		errs = append(errs, conn.Close())
+	}
+	r.conns = nil
+	return errors.Join(errs...)
+}
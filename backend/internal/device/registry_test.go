@@ -0,0 +1,231 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+// registered returns a device registered with the driver supporting the protocols
+func registered(driver string, protocols ...types.Protocol) types.Device {
+	identifier, host := "router-001", "localhost"
+	port, gatewayPort := int64(8080), int64(8081)
+	supported := make([]string, len(protocols))
+	for i, protocol := range protocols {
+		supported[i] = protocol.String()
+	}
+	return types.Device{
+		Identifier:         &identifier,
+		Host:               &host,
+		Port:               &port,
+		GatewayPort:        &gatewayPort,
+		SupportedProtocols: &supported,
+		Driver:             &driver,
+	}
+}
+
+func names(drivers []Driver) []string {
+	result := make([]string, len(drivers))
+	for i, driver := range drivers {
+		result[i] = driver.Name
+	}
+	return result
+}
+
+func TestRegistry_Register(t *testing.T) {
+	constructor := func(config Config) (Client, error) { return nil, nil }
+	tests := []struct {
+		name   string
+		driver Driver
+		err    bool
+	}{
+		{name: "should register driver", driver: Driver{Name: "vendor", New: constructor}},
+		{
+			name:   "should return error due to name of built-in driver",
+			driver: Driver{Name: "grpc", New: constructor},
+			err:    true,
+		},
+		{name: "should return error due to missing name", driver: Driver{New: constructor}, err: true},
+		{name: "should return error due to missing constructor", driver: Driver{Name: "vendor"}, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry(generator("checksum"), types.MQTT{})
+			if err := r.Register(tt.driver); (err != nil) != tt.err {
+				t.Fatalf("expected error %t, got %v", tt.err, err)
+			}
+			if tt.err {
+				return
+			}
+			if err := r.Register(tt.driver); err == nil {
+				t.Error("expected error due to duplicate name")
+			}
+			driver, err := r.Lookup(tt.driver.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if driver.Protocol != types.ProtocolPlugin {
+				t.Errorf(
+					"expected driver without protocol to serve %s, got %s",
+					types.ProtocolPlugin,
+					driver.Protocol,
+				)
+			}
+		})
+	}
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	r := NewRegistry(generator("checksum"), types.MQTT{})
+	for _, protocol := range []types.Protocol{
+		types.ProtocolGrpc,
+		types.ProtocolGrpcStream,
+		types.ProtocolHttp,
+		types.ProtocolHttpStream,
+		types.ProtocolMqtt,
+		types.ProtocolSnmp,
+	} {
+		driver, err := r.Lookup(protocol.Driver())
+		if err != nil {
+			t.Fatalf("expected built-in driver of %s, got %v", protocol, err)
+		}
+		if driver.Protocol != protocol {
+			t.Errorf("expected driver %s of %s, got %s", driver.Name, protocol, driver.Protocol)
+		}
+	}
+	if _, err := r.Lookup("unknown"); !errors.Is(err, ErrorUnsupportedProtocol) {
+		t.Errorf("expected unsupported driver, got %v", err)
+	}
+}
+
+func TestRegistry_Resolve(t *testing.T) {
+	r := NewRegistry(generator("checksum"), types.MQTT{})
+	constructor := func(config Config) (Client, error) { return nil, nil }
+	for _, name := range []string{"vendor", "other"} {
+		if err := r.Register(Driver{Name: name, New: constructor}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name     string
+		device   types.Device
+		expected []string
+	}{
+		{
+			name:     "should order drivers of protocols by registration",
+			device:   registered("http", types.ProtocolSnmp, types.ProtocolHttp, types.ProtocolGrpcStream),
+			expected: []string{"grpc-stream", "http", "snmp"},
+		},
+		{
+			name:     "should resolve drivers of devices registered without driver",
+			device:   registered("", types.ProtocolGrpc),
+			expected: []string{"grpc"},
+		},
+		{
+			name:     "should resolve named driver only",
+			device:   registered("vendor", types.ProtocolPlugin),
+			expected: []string{"vendor"},
+		},
+		{
+			name:     "should resolve no driver of unknown driver",
+			device:   registered("unknown", types.ProtocolPlugin),
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := names(r.Resolve(context.Background(), tt.device))
+			if fmt.Sprint(result) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected drivers %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestRegistry_Describe(t *testing.T) {
+	r := NewRegistry(generator("checksum"), types.MQTT{})
+	var calls int
+	reachable := false
+	r.entries = append(r.entries, &entry{
+		driver: Driver{
+			Name:         "vendor",
+			Protocol:     types.ProtocolPlugin,
+			Capabilities: Capabilities{Polling: true},
+			New:          func(config Config) (Client, error) { return nil, nil },
+		},
+		describe: func(ctx context.Context) (Capabilities, error) {
+			calls++
+			if !reachable {
+				return Capabilities{}, errors.New("plugin unavailable")
+			}
+			return Capabilities{Streaming: true, Update: true}, nil
+		},
+	})
+	device := registered("vendor", types.ProtocolPlugin)
+
+	t.Run("should assume polling while plugin is unreachable", func(t *testing.T) {
+		drivers := r.Resolve(context.Background(), device)
+		if len(drivers) != 1 || drivers[0].Capabilities != (Capabilities{Polling: true}) {
+			t.Fatalf("expected polling driver, got %+v", drivers)
+		}
+		_, err := r.ControlConfig(context.Background(), device)
+		if !errors.Is(err, ErrorUnsupportedProtocol) {
+			t.Errorf("expected device without update driver, got %v", err)
+		}
+	})
+	t.Run("should describe plugin once reachable", func(t *testing.T) {
+		reachable = true
+		for range 2 {
+			drivers := r.Resolve(context.Background(), device)
+			if len(drivers) != 1 || drivers[0].Capabilities != (Capabilities{Streaming: true, Update: true}) {
+				t.Fatalf("expected described driver, got %+v", drivers)
+			}
+		}
+		if calls != 3 {
+			t.Errorf("expected plugin to be described until reachable, got %d requests", calls)
+		}
+	})
+}
+
+func TestRegistry_ControlConfig(t *testing.T) {
+	r := NewRegistry(generator("checksum"), types.MQTT{})
+	tests := []struct {
+		name     string
+		device   types.Device
+		driver   string
+		port     int64
+		expected error
+	}{
+		{
+			name:   "should prefer driver supporting updates",
+			device: registered("snmp", types.ProtocolSnmp, types.ProtocolGrpc),
+			driver: "grpc",
+			port:   8080,
+		},
+		{
+			name:   "should connect to gateway port",
+			device: registered("http-stream", types.ProtocolHttpStream),
+			driver: "http-stream",
+			port:   8081,
+		},
+		{
+			name:     "should return error due to device without update driver",
+			device:   registered("snmp", types.ProtocolSnmp),
+			expected: ErrorUnsupportedProtocol,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := r.ControlConfig(context.Background(), tt.device)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if config.Driver != tt.driver || config.Port != tt.port {
+				t.Errorf("expected driver %s on port %d, got %+v", tt.driver, tt.port, config)
+			}
+		})
+	}
+}
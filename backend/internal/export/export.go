@@ -0,0 +1,214 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/parquet-go/parquet-go"
+)
+
+// Writer encodes diagnostics samples in an export format, Close completes the export (e.g.
+// the parquet footer) without closing the underlying writer.
+type Writer interface {
+	Write(samples []types.Diagnostics) error
+	Close() error
+}
+
+// Record is the flat representation of an exported sample
+type Record struct {
+	DeviceID        string    `json:"device_id"        parquet:"device_id"`
+	Timestamp       time.Time `json:"timestamp"        parquet:"timestamp,timestamp(millisecond)"`
+	DeviceStatus    string    `json:"device_status"    parquet:"device_status"`
+	HardwareVersion string    `json:"hardware_version" parquet:"hardware_version"`
+	SoftwareVersion string    `json:"software_version" parquet:"software_version"`
+	FirmwareVersion string    `json:"firmware_version" parquet:"firmware_version"`
+	CPU             float64   `json:"cpu_usage"        parquet:"cpu_usage"`
+	Memory          float64   `json:"memory_usage"     parquet:"memory_usage"`
+	Checksum        string    `json:"checksum"         parquet:"checksum"`
+	Verification    string    `json:"verification"     parquet:"verification"`
+	Uptime          int64     `json:"uptime_seconds"   parquet:"uptime_seconds"`
+	LoadAverage1    float64   `json:"load_average_1m"  parquet:"load_average_1m"`
+	LoadAverage5    float64   `json:"load_average_5m"  parquet:"load_average_5m"`
+	LoadAverage15   float64   `json:"load_average_15m" parquet:"load_average_15m"`
+	Temperature     float64   `json:"temperature"      parquet:"temperature"`
+	DiskUsed        int64     `json:"disk_used_bytes"  parquet:"disk_used_bytes"`
+	DiskTotal       int64     `json:"disk_total_bytes" parquet:"disk_total_bytes"`
+	Processes       int32     `json:"process_count"    parquet:"process_count"`
+}
+
+var header = []string{
+	"device_id",
+	"timestamp",
+	"device_status",
+	"hardware_version",
+	"software_version",
+	"firmware_version",
+	"cpu_usage",
+	"memory_usage",
+	"checksum",
+	"verification",
+	"uptime_seconds",
+	"load_average_1m",
+	"load_average_5m",
+	"load_average_15m",
+	"temperature",
+	"disk_used_bytes",
+	"disk_total_bytes",
+	"process_count",
+}
+
+func NewRecord(diag types.Diagnostics) Record {
+	return Record{
+		DeviceID:        deref(diag.Identifier),
+		Timestamp:       deref(diag.LastUpdated).UTC(),
+		DeviceStatus:    deref(diag.DeviceStatus),
+		HardwareVersion: deref(diag.Hardware),
+		SoftwareVersion: deref(diag.Software),
+		FirmwareVersion: deref(diag.Firmware),
+		CPU:             deref(diag.CPU),
+		Memory:          deref(diag.Memory),
+		Checksum:        deref(diag.Checksum),
+		Verification:    deref(diag.Verification),
+		Uptime:          deref(diag.UptimeSeconds),
+		LoadAverage1:    deref(diag.LoadAverage1m),
+		LoadAverage5:    deref(diag.LoadAverage5m),
+		LoadAverage15:   deref(diag.LoadAverage15m),
+		Temperature:     deref(diag.Temperature),
+		DiskUsed:        deref(diag.DiskUsed),
+		DiskTotal:       deref(diag.DiskTotal),
+		Processes:       deref(diag.Processes),
+	}
+}
+
+func (r *Record) values() []string {
+	float := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return []string{
+		r.DeviceID,
+		r.Timestamp.Format(time.RFC3339Nano),
+		r.DeviceStatus,
+		r.HardwareVersion,
+		r.SoftwareVersion,
+		r.FirmwareVersion,
+		float(r.CPU),
+		float(r.Memory),
+		r.Checksum,
+		r.Verification,
+		strconv.FormatInt(r.Uptime, 10),
+		float(r.LoadAverage1),
+		float(r.LoadAverage5),
+		float(r.LoadAverage15),
+		float(r.Temperature),
+		strconv.FormatInt(r.DiskUsed, 10),
+		strconv.FormatInt(r.DiskTotal, 10),
+		strconv.FormatInt(int64(r.Processes), 10),
+	}
+}
+
+// ContentType returns the media type and file extension of an export format
+func ContentType(format types.ExportFormat) (string, string) {
+	switch format {
+	case types.ExportFormatNdjson:
+		return "application/x-ndjson", "ndjson"
+	case types.ExportFormatParquet:
+		return "application/vnd.apache.parquet", "parquet"
+	default:
+		return "text/csv", "csv"
+	}
+}
+
+func NewWriter(format types.ExportFormat, w io.Writer) (Writer, error) {
+	switch format {
+	case types.ExportFormatCsv:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(header); err != nil {
+			return nil, fmt.Errorf("failed to write csv header: %w", err)
+		}
+		return &csvWriter{writer: writer}, nil
+	case types.ExportFormatNdjson:
+		return &ndjsonWriter{encoder: json.NewEncoder(w)}, nil
+	case types.ExportFormatParquet:
+		return &parquetWriter{writer: parquet.NewGenericWriter[Record](w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// CSV Writer
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvWriter) Write(samples []types.Diagnostics) error {
+	for _, diag := range samples {
+		record := NewRecord(diag)
+		if err := w.writer.Write(record.values()); err != nil {
+			return fmt.Errorf("failed to write csv record: %w", err)
+		}
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *csvWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// NDJSON Writer
+type ndjsonWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *ndjsonWriter) Write(samples []types.Diagnostics) error {
+	for _, diag := range samples {
+		if err := w.encoder.Encode(NewRecord(diag)); err != nil {
+			return fmt.Errorf("failed to write ndjson record: %w", err)
+		}
+	}
+	return nil
+}
+
+func (w *ndjsonWriter) Close() error {
+	return nil
+}
+
+// Parquet Writer (one row group per chunk)
+type parquetWriter struct {
+	writer *parquet.GenericWriter[Record]
+}
+
+func (w *parquetWriter) Write(samples []types.Diagnostics) error {
+	records := make([]Record, len(samples))
+	for i, diag := range samples {
+		records[i] = NewRecord(diag)
+	}
+	if _, err := w.writer.Write(records); err != nil {
+		return fmt.Errorf("failed to write parquet records: %w", err)
+	}
+	if err := w.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write parquet row group: %w", err)
+	}
+	return nil
+}
+
+func (w *parquetWriter) Close() error {
+	if err := w.writer.Close(); err != nil {
+		return fmt.Errorf("failed to write parquet footer: %w", err)
+	}
+	return nil
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
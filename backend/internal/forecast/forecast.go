@@ -0,0 +1,205 @@
+package forecast
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+const (
+	// Forecasts are fitted over hourly aggregates with a daily season
+	Step   = time.Hour
+	Season = 24
+	// Minimum number of hourly aggregates of a linear fit, Holt-Winters requires two seasons
+	MinPoints = 3
+	// Confidence bands are 95% prediction intervals
+	Confidence = 1.96
+)
+
+var ErrorInsufficientData = errors.New("insufficient data to forecast")
+
+// Smoothing parameters searched when fitting Holt-Winters (level, trend and season)
+var (
+	alphas = []float64{0.01, 0.02, 0.05, 0.1, 0.2, 0.4, 0.6, 0.8}
+	betas  = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.2}
+	gammas = []float64{0.05, 0.1, 0.2, 0.4}
+)
+
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Fit is a fitted model projected over the horizon in hourly steps after the latest point,
+// the trend is the change per hour at the latest point.
+type Fit struct {
+	Model       types.ForecastModel
+	Current     float64
+	Trend       float64
+	Projections []types.ForecastPoint
+}
+
+// Linear fits a least squares line through the points, the bands are the prediction
+// intervals of the fit.
+func Linear(points []Point, horizon time.Duration) (Fit, error) {
+	if len(points) < MinPoints {
+		return Fit{}, ErrorInsufficientData
+	}
+	latest := points[len(points)-1]
+	n := float64(len(points))
+	var sumX, sumY float64
+	for _, p := range points {
+		sumX += hours(p.Time, latest.Time)
+		sumY += p.Value
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var sxx, sxy float64
+	for _, p := range points {
+		dx := hours(p.Time, latest.Time) - meanX
+		sxx += dx * dx
+		sxy += dx * (p.Value - meanY)
+	}
+	if sxx == 0 {
+		return Fit{}, ErrorInsufficientData
+	}
+	slope := sxy / sxx
+	intercept := meanY - slope*meanX
+	var sse float64
+	for _, p := range points {
+		residual := p.Value - (intercept + slope*hours(p.Time, latest.Time))
+		sse += residual * residual
+	}
+	deviation := math.Sqrt(sse / (n - 2))
+	fit := Fit{Model: types.ForecastModelLinear, Current: latest.Value, Trend: slope}
+	for h := 1; h <= steps(horizon); h++ {
+		x := float64(h)
+		value := intercept + slope*x
+		band := Confidence * deviation * math.Sqrt(1+1/n+(x-meanX)*(x-meanX)/sxx)
+		fit.Projections = append(fit.Projections, types.ForecastPoint{
+			Time:  latest.Time.Add(time.Duration(h) * Step),
+			Value: value,
+			Lower: value - band,
+			Upper: value + band,
+		})
+	}
+	return fit, nil
+}
+
+// HoltWinters fits additive Holt-Winters with a daily season, the smoothing parameters are
+// those minimizing the one step ahead errors. Missing hours are interpolated, the bands are
+// the prediction intervals of the additive model.
+func HoltWinters(points []Point, horizon time.Duration) (Fit, error) {
+	series := regular(points)
+	if len(series) < 2*Season {
+		return Fit{}, ErrorInsufficientData
+	}
+	best := model{sse: math.Inf(1)}
+	for _, alpha := range alphas {
+		for _, beta := range betas {
+			for _, gamma := range gammas {
+				if m := smooth(series, alpha, beta, gamma); m.sse < best.sse {
+					best = m
+				}
+			}
+		}
+	}
+	deviation := math.Sqrt(best.sse / float64(len(series)-Season))
+	latest := points[len(points)-1]
+	fit := Fit{Model: types.ForecastModelHoltWinters, Current: latest.Value, Trend: best.trend}
+	var variance float64
+	for h := 1; h <= steps(horizon); h++ {
+		value := best.level + float64(h)*best.trend + best.season[(h-1)%Season]
+		band := Confidence * deviation * math.Sqrt(1+variance)
+		fit.Projections = append(fit.Projections, types.ForecastPoint{
+			Time:  latest.Time.Add(time.Duration(h) * Step),
+			Value: value,
+			Lower: value - band,
+			Upper: value + band,
+		})
+		c := best.alpha * (1 + float64(h)*best.beta)
+		if h%Season == 0 {
+			c += best.gamma
+		}
+		variance += c * c
+	}
+	return fit, nil
+}
+
+// Crossing returns the time a fit reaches the threshold: the latest point if the metric is
+// already at the threshold, otherwise the first projection at the threshold (if any).
+func Crossing(fit Fit, latest time.Time, threshold float64) *time.Time {
+	if fit.Current >= threshold {
+		return &latest
+	}
+	for _, projection := range fit.Projections {
+		if projection.Value >= threshold {
+			return &projection.Time
+		}
+	}
+	return nil
+}
+
+type model struct {
+	alpha, beta, gamma float64
+	level, trend       float64
+	// Seasonal components of the hours following the latest point
+	season []float64
+	sse    float64
+}
+
+func smooth(series []float64, alpha, beta, gamma float64) model {
+	var first, second float64
+	for i := range Season {
+		first += series[i]
+		second += series[Season+i]
+	}
+	first, second = first/Season, second/Season
+	m := model{alpha: alpha, beta: beta, gamma: gamma, level: first, trend: (second - first) / Season}
+	season := make([]float64, len(series))
+	for i := range Season {
+		season[i] = series[i] - first
+	}
+	for t := Season; t < len(series); t++ {
+		predicted := m.level + m.trend + season[t-Season]
+		m.sse += (series[t] - predicted) * (series[t] - predicted)
+		level := alpha*(series[t]-season[t-Season]) + (1-alpha)*(m.level+m.trend)
+		m.trend = beta*(level-m.level) + (1-beta)*m.trend
+		m.level = level
+		season[t] = gamma*(series[t]-level) + (1-gamma)*season[t-Season]
+	}
+	m.season = season[len(series)-Season:]
+	return m
+}
+
+// regular returns the values of the points at every hour between the first and the latest
+// point, missing hours are interpolated between their neighbours.
+func regular(points []Point) []float64 {
+	if len(points) == 0 {
+		return nil
+	}
+	first := points[0].Time
+	series := make([]float64, int(hours(points[len(points)-1].Time, first))+1)
+	for i, p := range points {
+		index := int(hours(p.Time, first))
+		series[index] = p.Value
+		if i == 0 {
+			continue
+		}
+		previous := int(hours(points[i-1].Time, first))
+		for gap := previous + 1; gap < index; gap++ {
+			ratio := float64(gap-previous) / float64(index-previous)
+			series[gap] = points[i-1].Value + ratio*(p.Value-points[i-1].Value)
+		}
+	}
+	return series
+}
+
+func hours(t, reference time.Time) float64 {
+	return math.Round(t.Sub(reference).Hours())
+}
+
+func steps(horizon time.Duration) int {
+	return int(horizon / Step)
+}
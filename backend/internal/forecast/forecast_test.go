@@ -0,0 +1,270 @@
+package forecast
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+var start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// hourly returns a point of the series at every hour from start
+func hourly(n int, series func(hour int) float64) []Point {
+	points := make([]Point, n)
+	for i := range points {
+		points[i] = Point{Time: start.Add(time.Duration(i) * Step), Value: series(i)}
+	}
+	return points
+}
+
+// seasonal is a metric with a daily swing of 10 around 50, peaking at hour 6 of a day
+func seasonal(hour int) float64 {
+	return 50 + 10*math.Sin(2*math.Pi*float64(hour)/Season)
+}
+
+// rising is the seasonal metric rising 0.1 per hour
+func rising(hour int) float64 {
+	return seasonal(hour) + 0.1*float64(hour)
+}
+
+func TestLinear(t *testing.T) {
+	points := hourly(10, func(hour int) float64 { return 10 + 2*float64(hour) })
+	fit, err := Linear(points, 12*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fit.Model != types.ForecastModelLinear || fit.Current != 28 || math.Abs(fit.Trend-2) > 1e-9 {
+		t.Errorf("expected linear fit at 28 rising 2 per hour, got %+v", fit)
+	}
+	if len(fit.Projections) != 12 {
+		t.Fatalf("expected 12 hourly projections, got %d", len(fit.Projections))
+	}
+	latest := points[len(points)-1].Time
+	for i, projection := range fit.Projections {
+		h := i + 1
+		if !projection.Time.Equal(latest.Add(time.Duration(h) * Step)) {
+			t.Errorf(
+				"expected projection %d at %s, got %s",
+				h,
+				latest.Add(time.Duration(h)*Step),
+				projection.Time,
+			)
+		}
+		if expected := 28 + 2*float64(h); math.Abs(projection.Value-expected) > 1e-9 {
+			t.Errorf("expected projection %d of %.2f, got %.2f", h, expected, projection.Value)
+		}
+		// An exact line has no residuals and thus no band
+		if math.Abs(projection.Upper-projection.Lower) > 1e-9 {
+			t.Errorf(
+				"expected projection %d without band, got [%.2f, %.2f]",
+				h,
+				projection.Lower,
+				projection.Upper,
+			)
+		}
+	}
+}
+
+func TestLinear_Bands(t *testing.T) {
+	points := hourly(24, func(hour int) float64 { return 40 + float64(hour) + []float64{-2, 2}[hour%2] })
+	fit, err := Linear(points, 6*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := 0.0
+	for i, projection := range fit.Projections {
+		if projection.Lower >= projection.Value || projection.Upper <= projection.Value {
+			t.Errorf("expected projection %d within its band, got %+v", i+1, projection)
+		}
+		// Prediction intervals widen with the distance from the points
+		if width := projection.Upper - projection.Lower; width <= previous {
+			t.Errorf("expected band of projection %d wider than %.4f, got %.4f", i+1, previous, width)
+		} else {
+			previous = width
+		}
+	}
+}
+
+func TestLinear_InsufficientData(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Point
+	}{
+		{name: "should return error due to no points"},
+		{name: "should return error due to too few points", points: hourly(MinPoints-1, seasonal)},
+		{
+			name: "should return error due to points of a single hour",
+			points: []Point{
+				{Time: start, Value: 1},
+				{Time: start.Add(time.Minute), Value: 2},
+				{Time: start.Add(2 * time.Minute), Value: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Linear(tt.points, time.Hour); !errors.Is(err, ErrorInsufficientData) {
+				t.Errorf("expected %v, got %v", ErrorInsufficientData, err)
+			}
+		})
+	}
+}
+
+func TestHoltWinters(t *testing.T) {
+	tests := []struct {
+		name      string
+		series    func(hour int) float64
+		trend     float64
+		tolerance float64
+	}{
+		{name: "should project seasonal metric", series: seasonal, tolerance: 1e-6},
+		{
+			// The initial season is estimated from the first day, the trend within that day
+			// offsets the projections by up to 2
+			name:      "should project rising seasonal metric",
+			series:    rising,
+			trend:     0.1,
+			tolerance: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := hourly(7*Season, tt.series)
+			fit, err := HoltWinters(points, 2*Season*time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fit.Model != types.ForecastModelHoltWinters || fit.Current != points[len(points)-1].Value {
+				t.Errorf("expected holt-winters fit at the latest point, got %+v", fit)
+			}
+			if math.Abs(fit.Trend-tt.trend) > 1e-3 {
+				t.Errorf("expected trend of %.4f per hour, got %.4f", tt.trend, fit.Trend)
+			}
+			if len(fit.Projections) != 2*Season {
+				t.Fatalf("expected %d hourly projections, got %d", 2*Season, len(fit.Projections))
+			}
+			for i, projection := range fit.Projections {
+				expected := tt.series(len(points) + i)
+				if math.Abs(projection.Value-expected) > tt.tolerance {
+					t.Errorf(
+						"expected projection %d of about %.2f, got %.2f",
+						i+1,
+						expected,
+						projection.Value,
+					)
+				}
+				if projection.Lower > projection.Value || projection.Upper < projection.Value {
+					t.Errorf("expected projection %d within its band, got %+v", i+1, projection)
+				}
+			}
+		})
+	}
+}
+
+func TestHoltWinters_InsufficientData(t *testing.T) {
+	_, err := HoltWinters(hourly(2*Season-1, seasonal), time.Hour)
+	if !errors.Is(err, ErrorInsufficientData) {
+		t.Errorf("expected %v, got %v", ErrorInsufficientData, err)
+	}
+	// Missing hours are interpolated, the range of the points spans two seasons
+	points := slices.DeleteFunc(hourly(2*Season, seasonal), func(p Point) bool {
+		return p.Time.Hour()%3 == 1
+	})
+	if _, err := HoltWinters(points, time.Hour); err != nil {
+		t.Errorf("expected fit of points with missing hours, got %v", err)
+	}
+}
+
+func TestCrossing(t *testing.T) {
+	linear := hourly(10, func(hour int) float64 { return 10 + 2*float64(hour) })
+	latest := linear[len(linear)-1].Time
+	tests := []struct {
+		name      string
+		points    []Point
+		model     func([]Point, time.Duration) (Fit, error)
+		horizon   time.Duration
+		threshold float64
+		expected  *time.Time
+	}{
+		{
+			name:      "should cross threshold of linear projection",
+			points:    linear,
+			model:     Linear,
+			horizon:   24 * time.Hour,
+			threshold: 40,
+			expected:  timestamp(latest.Add(6 * time.Hour)),
+		},
+		{
+			name:      "should cross threshold at projection of exact value",
+			points:    linear,
+			model:     Linear,
+			horizon:   24 * time.Hour,
+			threshold: 30,
+			expected:  timestamp(latest.Add(time.Hour)),
+		},
+		{
+			name:      "should cross threshold at latest point",
+			points:    linear,
+			model:     Linear,
+			horizon:   24 * time.Hour,
+			threshold: 28,
+			expected:  timestamp(latest),
+		},
+		{
+			name:      "should not cross threshold beyond horizon",
+			points:    linear,
+			model:     Linear,
+			horizon:   5 * time.Hour,
+			threshold: 40,
+		},
+		{
+			name:      "should not cross threshold of falling metric",
+			points:    hourly(10, func(hour int) float64 { return 90 - float64(hour) }),
+			model:     Linear,
+			horizon:   24 * time.Hour,
+			threshold: 95,
+		},
+		{
+			// The latest point is the last hour of day 7, hour 5 of the next day (hour 173) is
+			// the first above 59 (59.66)
+			name:      "should cross threshold ahead of seasonal peak",
+			points:    hourly(7*Season, seasonal),
+			model:     HoltWinters,
+			horizon:   2 * Season * time.Hour,
+			threshold: 59,
+			expected:  timestamp(start.Add(173 * time.Hour)),
+		},
+		{
+			name:      "should not cross threshold above seasonal peak",
+			points:    hourly(7*Season, seasonal),
+			model:     HoltWinters,
+			horizon:   2 * Season * time.Hour,
+			threshold: 61,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fit, err := tt.model(tt.points, tt.horizon)
+			if err != nil {
+				t.Fatal(err)
+			}
+			crossing := Crossing(fit, tt.points[len(tt.points)-1].Time, tt.threshold)
+			switch {
+			case tt.expected == nil && crossing != nil:
+				t.Errorf("expected no crossing, got %s", crossing)
+			case tt.expected != nil && crossing == nil:
+				t.Errorf("expected crossing at %s, got none", tt.expected)
+			case tt.expected != nil && !crossing.Equal(*tt.expected):
+				t.Errorf("expected crossing at %s, got %s", tt.expected, crossing)
+			}
+		})
+	}
+}
+
+func timestamp(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,290 @@
+package inventory
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// Columns of a CSV inventory (and keys of a YAML inventory entry), the header row names the
+// columns in any order.
+var columns = []string{
+	"device_id",
+	"alias",
+	"host",
+	"port",
+	"port_gateway",
+	"protocol",
+	"signing_algorithm",
+	"signing_key",
+	"snmp_version",
+	"snmp_community",
+	"snmp_username",
+	"snmp_auth_protocol",
+	"snmp_auth_passphrase",
+	"snmp_priv_protocol",
+	"snmp_priv_passphrase",
+	"driver",
+}
+
+// Entry is a device of an inventory as a registration request, an entry that cannot be
+// decoded or fails validation carries the error (the request may be incomplete or nil).
+type Entry struct {
+	Line    int
+	Request *monitorv1.RegisterDeviceRequest
+	Error   error
+}
+
+type record struct {
+	DeviceID           string `yaml:"device_id"`
+	Alias              string `yaml:"alias"`
+	Host               string `yaml:"host"`
+	Port               int64  `yaml:"port"`
+	PortGateway        int64  `yaml:"port_gateway"`
+	Protocol           string `yaml:"protocol"`
+	SigningAlgorithm   string `yaml:"signing_algorithm"`
+	SigningKey         string `yaml:"signing_key"`
+	SnmpVersion        string `yaml:"snmp_version"`
+	SnmpCommunity      string `yaml:"snmp_community"`
+	SnmpUsername       string `yaml:"snmp_username"`
+	SnmpAuthProtocol   string `yaml:"snmp_auth_protocol"`
+	SnmpAuthPassphrase string `yaml:"snmp_auth_passphrase"`
+	SnmpPrivProtocol   string `yaml:"snmp_priv_protocol"`
+	SnmpPrivPassphrase string `yaml:"snmp_priv_passphrase"`
+	Driver             string `yaml:"driver"`
+}
+
+// Parse reads the entries of an inventory, an error is returned only if the inventory
+// itself is malformed. Entries are validated as registration requests and device
+// identifiers must be unique.
+func Parse(format types.InventoryFormat, data []byte) ([]Entry, error) {
+	var (
+		entries []Entry
+		err     error
+	)
+	switch format {
+	case types.InventoryFormatYaml:
+		entries, err = parseYAML(data)
+	case types.InventoryFormatCsv:
+		entries, err = parseCSV(data)
+	default:
+		return nil, fmt.Errorf("unsupported inventory format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("inventory does not list any devices")
+	}
+	listed := make(map[string]int, len(entries))
+	for i, entry := range entries {
+		if entry.Error != nil {
+			continue
+		}
+		if err := entry.Request.Validate(); err != nil {
+			entries[i].Error = err
+			continue
+		}
+		deviceID := entry.Request.GetDeviceId()
+		if line, ok := listed[deviceID]; ok && deviceID != "" {
+			entries[i].Error = fmt.Errorf("duplicate device_id '%s' (line %d)", deviceID, line)
+			continue
+		}
+		listed[deviceID] = entry.Line
+	}
+	return entries, nil
+}
+
+// YAML inventory:
+//
+//	devices:
+//	  - device_id: ubiquiti-device-router-3c2d
+//	    host: ubiquiti-device-router
+//	    port: 8080
+//	    port_gateway: 8081
+//	    protocol: grpc-stream
+func parseYAML(data []byte) ([]Entry, error) {
+	var document struct {
+		Devices []yaml.Node `yaml:"devices"`
+	}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse yaml inventory: %w", err)
+	}
+	entries := make([]Entry, len(document.Devices))
+	for i, node := range document.Devices {
+		entries[i].Line = node.Line
+		var rec record
+		if err := node.Decode(&rec); err != nil {
+			entries[i].Request = &monitorv1.RegisterDeviceRequest{DeviceId: rec.DeviceID}
+			entries[i].Error = fmt.Errorf("invalid entry: %w", err)
+			continue
+		}
+		entries[i].Request, entries[i].Error = rec.request()
+	}
+	return entries, nil
+}
+
+// CSV inventory with a header row, lines starting with # are ignored:
+//
+//	device_id,host,port,port_gateway,protocol
+//	ubiquiti-device-router-3c2d,ubiquiti-device-router,8080,8081,grpc-stream
+func parseCSV(data []byte) ([]Entry, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv inventory header: %w", err)
+	}
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(columns, header[i]) {
+			return nil, fmt.Errorf(
+				"unknown column '%s' in csv inventory (%s)",
+				column,
+				strings.Join(columns, ", "),
+			)
+		}
+	}
+	var entries []Entry
+	for {
+		values, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.Is(err, csv.ErrFieldCount) || !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read csv inventory: %w", err)
+			}
+			entries = append(entries, Entry{Line: parseErr.Line, Error: err})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		fields := make(map[string]string, len(header))
+		for i, column := range header {
+			fields[column] = strings.TrimSpace(values[i])
+		}
+		entry := Entry{Line: line}
+		rec, err := newRecord(fields)
+		if err != nil {
+			entry.Request, entry.Error = &monitorv1.RegisterDeviceRequest{DeviceId: rec.DeviceID}, err
+		} else {
+			entry.Request, entry.Error = rec.request()
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func newRecord(fields map[string]string) (record, error) {
+	rec := record{
+		DeviceID:           fields["device_id"],
+		Alias:              fields["alias"],
+		Host:               fields["host"],
+		Protocol:           fields["protocol"],
+		SigningAlgorithm:   fields["signing_algorithm"],
+		SigningKey:         fields["signing_key"],
+		SnmpVersion:        fields["snmp_version"],
+		SnmpCommunity:      fields["snmp_community"],
+		SnmpUsername:       fields["snmp_username"],
+		SnmpAuthProtocol:   fields["snmp_auth_protocol"],
+		SnmpAuthPassphrase: fields["snmp_auth_passphrase"],
+		SnmpPrivProtocol:   fields["snmp_priv_protocol"],
+		SnmpPrivPassphrase: fields["snmp_priv_passphrase"],
+		Driver:             fields["driver"],
+	}
+	for column, field := range map[string]*int64{"port": &rec.Port, "port_gateway": &rec.PortGateway} {
+		if fields[column] == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[column], 10, 64)
+		if err != nil {
+			return rec, fmt.Errorf("invalid %s '%s'", column, fields[column])
+		}
+		*field = value
+	}
+	return rec, nil
+}
+
+func (r *record) request() (*monitorv1.RegisterDeviceRequest, error) {
+	req := &monitorv1.RegisterDeviceRequest{
+		DeviceId:    r.DeviceID,
+		Alias:       r.Alias,
+		Host:        r.Host,
+		Port:        r.Port,
+		PortGateway: r.PortGateway,
+		SigningKey:  r.SigningKey,
+		Driver:      r.Driver,
+	}
+	if r.Protocol != "" {
+		value, err := ParseEnum(monitorv1.Protocol_value, "PROTOCOL_", r.Protocol)
+		if err != nil {
+			return req, fmt.Errorf("invalid protocol '%s'", r.Protocol)
+		}
+		req.Protocol = monitorv1.Protocol(value)
+	}
+	if r.SigningAlgorithm != "" {
+		value, err := ParseEnum(monitorv1.SigningAlgorithm_value, "SIGNING_ALGORITHM_", r.SigningAlgorithm)
+		if err != nil {
+			return req, fmt.Errorf("invalid signing_algorithm '%s'", r.SigningAlgorithm)
+		}
+		req.SigningAlgorithm = monitorv1.SigningAlgorithm(value)
+	}
+	if r.SnmpVersion != "" {
+		snmp, err := r.snmp()
+		if err != nil {
+			return req, err
+		}
+		req.Snmp = snmp
+	}
+	return req, nil
+}
+
+func (r *record) snmp() (*monitorv1.SnmpCredentials, error) {
+	snmp := &monitorv1.SnmpCredentials{
+		Community:      r.SnmpCommunity,
+		Username:       r.SnmpUsername,
+		AuthPassphrase: r.SnmpAuthPassphrase,
+		PrivPassphrase: r.SnmpPrivPassphrase,
+	}
+	value, err := ParseEnum(monitorv1.SnmpVersion_value, "SNMP_VERSION_", r.SnmpVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snmp_version '%s'", r.SnmpVersion)
+	}
+	snmp.Version = monitorv1.SnmpVersion(value)
+	if r.SnmpAuthProtocol != "" {
+		value, err := ParseEnum(monitorv1.SnmpAuthProtocol_value, "SNMP_AUTH_PROTOCOL_", r.SnmpAuthProtocol)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snmp_auth_protocol '%s'", r.SnmpAuthProtocol)
+		}
+		snmp.AuthProtocol = monitorv1.SnmpAuthProtocol(value)
+	}
+	if r.SnmpPrivProtocol != "" {
+		value, err := ParseEnum(monitorv1.SnmpPrivProtocol_value, "SNMP_PRIV_PROTOCOL_", r.SnmpPrivProtocol)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snmp_priv_protocol '%s'", r.SnmpPrivProtocol)
+		}
+		snmp.PrivProtocol = monitorv1.SnmpPrivProtocol(value)
+	}
+	return snmp, nil
+}
+
+// ParseEnum accepts the name of an enum value with or without its prefix (e.g. grpc-stream)
+func ParseEnum(values map[string]int32, prefix, value string) (int32, error) {
+	name := strings.ToUpper(strings.ReplaceAll(value, "-", "_"))
+	for _, key := range []string{prefix + name, name} {
+		if number, ok := values[key]; ok && number != 0 {
+			return number, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid value '%s'", value)
+}
@@ -0,0 +1,200 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"go.uber.org/zap"
+)
+
+const DefaultReconcileTimeout = 5 * time.Second
+
+type PersistenceProvider interface {
+	GetDevice(ctx context.Context, deviceID string) (types.Device, error)
+	ListDeviceConfigs(ctx context.Context) ([]types.DeviceConfig, error)
+	UpdateDeviceConfigStatus(
+		ctx context.Context,
+		deviceID string,
+		status types.ConfigStatus,
+		drift []string,
+		message string,
+		applied bool,
+	) (types.DeviceConfig, error)
+	AcquireDeviceLease(
+		ctx context.Context,
+		deviceID string,
+		monitorID string,
+		ttl time.Duration,
+	) (bool, error)
+}
+
+type DeviceProvider interface {
+	CreateClient(config device.Config) (device.Client, error)
+	ControlConfig(ctx context.Context, device types.Device) (device.Config, error)
+}
+
+// Configuration Reconciler
+type Reconciler struct {
+	persistence PersistenceProvider
+	device      DeviceProvider
+	monitorID   string
+	interval    time.Duration
+	lease       time.Duration
+	logger      *zap.Logger
+}
+
+func NewReconciler(
+	persistence PersistenceProvider,
+	device DeviceProvider,
+	monitorID string,
+	interval time.Duration,
+	lease time.Duration,
+	logger *zap.Logger,
+) *Reconciler {
+	return &Reconciler{
+		persistence: persistence,
+		device:      device,
+		monitorID:   monitorID,
+		interval:    interval,
+		lease:       lease,
+		logger:      logger,
+	}
+}
+
+// Run reconciles the desired config of every device leased by the monitor once per interval,
+// the lease is renewed on every reconciliation so that a single monitor reconciles a device.
+func (r *Reconciler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			configs, err := r.persistence.ListDeviceConfigs(ctx)
+			if err != nil {
+				r.logger.Error("failed to list device configs", zap.Error(err))
+				continue
+			}
+			for _, config := range configs {
+				owned, err := r.persistence.AcquireDeviceLease(
+					ctx, deref(config.DeviceID), r.monitorID, r.lease,
+				)
+				if err != nil {
+					r.logger.Error(
+						"failed to acquire device lease",
+						zap.String("device_id", deref(config.DeviceID)),
+						zap.Error(err),
+					)
+					continue
+				}
+				if !owned {
+					continue
+				}
+				if _, err := r.Reconcile(ctx, config); err != nil {
+					r.logger.Error(
+						"failed to reconcile device config",
+						zap.String("device_id", deref(config.DeviceID)),
+						zap.Error(err),
+					)
+				}
+			}
+		}
+	}
+}
+
+// Reconcile compares the diagnostics reported by a device with its desired config. A config
+// that has not been applied yet is applied, drift is reapplied or flagged according to the
+// drift policy of the config. Devices in a transitional or operator-driven status (e.g. a
+// firmware upgrade) are left untouched until they leave it. Unreachable devices are recorded
+// with an error status, only persistence failures are returned.
+func (r *Reconciler) Reconcile(ctx context.Context, config types.DeviceConfig) (types.DeviceConfig, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultReconcileTimeout)
+	defer cancel()
+	deviceID := deref(config.DeviceID)
+	desired := config.Desired()
+	client, err := r.client(ctx, deviceID)
+	if err != nil {
+		return r.update(ctx, deviceID, types.ConfigStatusError, nil, err.Error(), false)
+	}
+	defer client.Close() //nolint:errcheck
+	diag, err := client.GetDiagnostics(ctx)
+	if err != nil {
+		return r.update(ctx, deviceID, types.ConfigStatusError, nil, err.Error(), false)
+	}
+	if diag.DeviceStatus.IsTransitional() {
+		r.logger.Debug(
+			"device config reconciliation deferred",
+			zap.String("device_id", deviceID),
+			zap.String("device_status", diag.DeviceStatus.String()),
+		)
+		return config, nil
+	}
+	drift := desired.Drift(*diag)
+	applied := config.Applied != nil
+	switch {
+	case applied && len(drift) == 0:
+		return r.update(ctx, deviceID, types.ConfigStatusInSync, nil, "", false)
+	case applied && desired.DriftPolicy == types.DriftPolicyFlag:
+		r.logger.Warn(
+			"device config drift detected",
+			zap.String("device_id", deviceID),
+			zap.Strings("drift", drift),
+		)
+		return r.update(ctx, deviceID, types.ConfigStatusDrifted, drift, "", false)
+	}
+	if err := client.ApplyConfig(ctx, desired); err != nil {
+		return r.update(ctx, deviceID, types.ConfigStatusError, drift, err.Error(), false)
+	}
+	if !applied {
+		r.logger.Info("device config applied", zap.String("device_id", deviceID))
+		return r.update(ctx, deviceID, types.ConfigStatusInSync, nil, "", true)
+	}
+	r.logger.Warn(
+		"device config drift reapplied",
+		zap.String("device_id", deviceID),
+		zap.Strings("drift", drift),
+	)
+	return r.update(ctx, deviceID, types.ConfigStatusReapplied, drift, "", true)
+}
+
+func (r *Reconciler) client(ctx context.Context, deviceID string) (device.Client, error) {
+	result, err := r.persistence.GetDevice(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	config, err := r.device.ControlConfig(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+	return r.device.CreateClient(config)
+}
+
+// update persists the outcome, it outlives the reconciliation timeout of the device
+func (r *Reconciler) update(
+	ctx context.Context,
+	deviceID string,
+	status types.ConfigStatus,
+	drift []string,
+	message string,
+	applied bool,
+) (types.DeviceConfig, error) {
+	return r.persistence.UpdateDeviceConfigStatus(
+		context.WithoutCancel(ctx),
+		deviceID,
+		status,
+		drift,
+		message,
+		applied,
+	)
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
@@ -0,0 +1,257 @@
+package reconciler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"go.uber.org/zap"
+)
+
+// fleet emulates the persistence shared by the monitors and a device reporting a status,
+// applied configs are counted per monitor.
+type fleet struct {
+	mu       sync.Mutex
+	status   types.DeviceStatus
+	configs  []types.DeviceConfig
+	statuses []types.ConfigStatus
+	leases   map[string]string
+	applied  map[string]int
+}
+
+func (f *fleet) GetDevice(ctx context.Context, deviceID string) (types.Device, error) {
+	return types.Device{Identifier: &deviceID}, nil
+}
+
+func (f *fleet) ListDeviceConfigs(ctx context.Context) ([]types.DeviceConfig, error) {
+	return f.configs, nil
+}
+
+func (f *fleet) UpdateDeviceConfigStatus(
+	ctx context.Context,
+	deviceID string,
+	status types.ConfigStatus,
+	drift []string,
+	message string,
+	applied bool,
+) (types.DeviceConfig, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statuses = append(f.statuses, status)
+	return types.DeviceConfig{DeviceID: &deviceID}, nil
+}
+
+func (f *fleet) AcquireDeviceLease(
+	ctx context.Context,
+	deviceID string,
+	monitorID string,
+	ttl time.Duration,
+) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if holder, ok := f.leases[deviceID]; ok && holder != monitorID {
+		return false, nil
+	}
+	f.leases[deviceID] = monitorID
+	return true, nil
+}
+
+func (f *fleet) ControlConfig(ctx context.Context, dev types.Device) (device.Config, error) {
+	return device.Config{Identifier: deref(dev.Identifier)}, nil
+}
+
+func (f *fleet) CreateClient(config device.Config) (device.Client, error) {
+	return &client{fleet: f}, nil
+}
+
+type monitor struct {
+	*fleet
+	id string
+}
+
+func (m *monitor) CreateClient(config device.Config) (device.Client, error) {
+	return &client{fleet: m.fleet, monitorID: m.id}, nil
+}
+
+type client struct {
+	device.Client
+	fleet     *fleet
+	monitorID string
+}
+
+func (c *client) GetDiagnostics(ctx context.Context) (*types.DeviceDiagnostics, error) {
+	c.fleet.mu.Lock()
+	defer c.fleet.mu.Unlock()
+	return &types.DeviceDiagnostics{DeviceStatus: c.fleet.status}, nil
+}
+
+func (c *client) ApplyConfig(ctx context.Context, config types.DesiredConfig) error {
+	c.fleet.mu.Lock()
+	defer c.fleet.mu.Unlock()
+	c.fleet.applied[c.monitorID]++
+	return nil
+}
+
+func (c *client) Close() error {
+	return nil
+}
+
+func newConfig(status types.DeviceStatus, applied bool) types.DeviceConfig {
+	var (
+		deviceID = "device-001"
+		desired  = string(status)
+		policy   = string(types.DriftPolicyReapply)
+		now      = time.Now()
+	)
+	config := types.DeviceConfig{DeviceID: &deviceID, DeviceStatus: &desired, DriftPolicy: &policy}
+	if applied {
+		config.Applied = &now
+	}
+	return config
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	tests := []struct {
+		name     string
+		reported types.DeviceStatus
+		config   types.DeviceConfig
+		status   types.ConfigStatus
+		applied  int
+	}{
+		{
+			name:     "should apply pending config",
+			reported: types.DeviceStatusDegraded,
+			config:   newConfig(types.DeviceStatusHealthy, false),
+			status:   types.ConfigStatusInSync,
+			applied:  1,
+		},
+		{
+			name:     "should report config in sync",
+			reported: types.DeviceStatusHealthy,
+			config:   newConfig(types.DeviceStatusHealthy, true),
+			status:   types.ConfigStatusInSync,
+		},
+		{
+			name:     "should reapply drifted config",
+			reported: types.DeviceStatusDegraded,
+			config:   newConfig(types.DeviceStatusHealthy, true),
+			status:   types.ConfigStatusReapplied,
+			applied:  1,
+		},
+		{
+			name:     "should defer reconciliation of booting device",
+			reported: types.DeviceStatusBooting,
+			config:   newConfig(types.DeviceStatusHealthy, true),
+		},
+		{
+			name:     "should defer reconciliation of device in maintenance",
+			reported: types.DeviceStatusMaintenance,
+			config:   newConfig(types.DeviceStatusHealthy, true),
+		},
+		{
+			name:     "should defer pending config of device in maintenance",
+			reported: types.DeviceStatusMaintenance,
+			config:   newConfig(types.DeviceStatusHealthy, false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fleet{status: tt.reported, applied: make(map[string]int)}
+			r := NewReconciler(f, f, "monitor-001", time.Second, time.Minute, zap.NewNop())
+			if _, err := r.Reconcile(context.Background(), tt.config); err != nil {
+				t.Fatal(err)
+			}
+			if f.applied[""] != tt.applied {
+				t.Errorf("expected %d applied configs, got %d", tt.applied, f.applied[""])
+			}
+			switch {
+			case tt.status == "" && len(f.statuses) > 0:
+				t.Errorf("expected no status update, got %v", f.statuses)
+			case tt.status != "" && (len(f.statuses) != 1 || f.statuses[0] != tt.status):
+				t.Errorf("expected status %s, got %v", tt.status, f.statuses)
+			}
+		})
+	}
+}
+
+func TestReconciler_Run(t *testing.T) {
+	f := &fleet{
+		status:  types.DeviceStatusDegraded,
+		configs: []types.DeviceConfig{newConfig(types.DeviceStatusHealthy, true)},
+		leases:  make(map[string]string),
+		applied: make(map[string]int),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, id := range []string{"monitor-001", "monitor-002"} {
+		m := &monitor{fleet: f, id: id}
+		r := NewReconciler(m, m, id, 10*time.Millisecond, time.Minute, zap.NewNop())
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.Run(ctx) // nolint:errcheck
+		}()
+	}
+	wg.Wait()
+
+	holder := f.leases["device-001"]
+	if holder == "" {
+		t.Fatal("expected device to be leased")
+	}
+	if f.applied[holder] == 0 {
+		t.Errorf("expected lease holder %s to reapply drift", holder)
+	}
+	for id, applied := range f.applied {
+		if id != holder && applied > 0 {
+			t.Errorf(
+				"expected %s without lease to leave device untouched, got %d applied configs",
+				id,
+				applied,
+			)
+		}
+	}
+}
+
+func TestDesiredConfig_Drift(t *testing.T) {
+	desired := types.DesiredConfig{
+		DeviceStatus: types.DeviceStatusHealthy,
+		Labels:       map[string]string{"site": "hq"},
+	}
+	tests := []struct {
+		name     string
+		reported types.DeviceStatus
+		labels   map[string]string
+		drift    int
+	}{
+		{name: "should report no drift", reported: types.DeviceStatusHealthy, labels: desired.Labels},
+		{
+			name:     "should report status drift",
+			reported: types.DeviceStatusDegraded,
+			labels:   desired.Labels,
+			drift:    1,
+		},
+		{name: "should report label drift", reported: types.DeviceStatusHealthy, drift: 1},
+		{
+			name:     "should ignore status of booting device",
+			reported: types.DeviceStatusBooting,
+			labels:   desired.Labels,
+		},
+		{
+			name:     "should ignore status of device in maintenance",
+			reported: types.DeviceStatusMaintenance,
+			labels:   desired.Labels,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drift := desired.Drift(types.DeviceDiagnostics{DeviceStatus: tt.reported, Labels: tt.labels})
+			if len(drift) != tt.drift {
+				t.Errorf("expected %d drifted fields, got %v", tt.drift, drift)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/export"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExportHandler serves ExportDiagnostics as a file download (the generated gateway handler
+// would delimit the chunks of the stream). Query parameters: device_ids (repeated), from and
+// to (RFC 3339) and format (csv, ndjson or parquet).
+func ExportHandler(mux *runtime.ServeMux, client monitorv1.MonitorClient) runtime.HandlerFunc {
+	marshaler := &runtime.JSONPb{}
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		req, err := exportRequest(r)
+		if err != nil {
+			runtime.HTTPError(
+				r.Context(),
+				mux,
+				marshaler,
+				w,
+				r,
+				status.Error(codes.InvalidArgument, err.Error()),
+			)
+			return
+		}
+		stream, err := client.ExportDiagnostics(r.Context(), req)
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+			return
+		}
+		// Await the first chunk so that a failing export still responds with an error status
+		res, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+			return
+		}
+		contentType, extension := export.ContentType(types.ExportFormatFromProto(req.GetFormat()))
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set(
+			"Content-Disposition",
+			fmt.Sprintf(`attachment; filename="diagnostics-%s.%s"`, time.Now().UTC().Format("20060102T150405Z"), extension),
+		)
+		for err == nil {
+			if _, err := w.Write(res.GetData()); err != nil {
+				return
+			}
+			res, err = stream.Recv()
+		}
+		// Headers are sent, a failure past the first chunk can only abort the download
+		if !errors.Is(err, io.EOF) {
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+func exportRequest(r *http.Request) (*monitorv1.ExportDiagnosticsRequest, error) {
+	query := r.URL.Query()
+	req := &monitorv1.ExportDiagnosticsRequest{DeviceIds: query["device_ids"]}
+	if value := query.Get("format"); value != "" {
+		format, ok := monitorv1.ExportFormat_value["EXPORT_FORMAT_"+strings.ToUpper(value)]
+		if !ok {
+			format, ok = monitorv1.ExportFormat_value[value]
+		}
+		if !ok {
+			return nil, fmt.Errorf("invalid format '%s' in request", value)
+		}
+		req.Format = monitorv1.ExportFormat(format)
+	}
+	for name, field := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		if value := query.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s in request: %w", name, err)
+			}
+			*field = timestamppb.New(parsed)
+		}
+	}
+	return req, nil
+}
@@ -1,17 +1,25 @@
 package server
 
 import (
+	"bufio"
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"slices"
 	"time"
 
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/database/exceptions"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/inventory"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/service"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
 	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -19,7 +27,17 @@ import (
 var _ monitorv1.MonitorServer = (*Server)(nil)
 
 const (
-	DefaultContextTimeout time.Duration = 3 * time.Second
+	DefaultContextTimeout    time.Duration = 3 * time.Second
+	DefaultConfigTimeout     time.Duration = 10 * time.Second
+	DefaultReportTimeout     time.Duration = 30 * time.Second
+	DefaultImportTimeout     time.Duration = 60 * time.Second
+	DefaultExportChunkSize                 = 64 * 1024
+	DefaultHistoryWindow     time.Duration = time.Hour
+	DefaultHistoryLimit                    = 100
+	DefaultForecastHistory   time.Duration = 14 * 24 * time.Hour
+	DefaultForecastHorizon   time.Duration = 30 * 24 * time.Hour
+	DefaultForecastTop                     = 10
+	DefaultForecastThreshold               = 95
 )
 
 var (
@@ -28,10 +46,66 @@ var (
 
 type Provider interface {
 	RegisterDevice(ctx context.Context, reg types.DeviceRegistration) (types.Device, error)
+	ImportDevices(
+		ctx context.Context,
+		entries []types.InventoryEntry,
+		prune bool,
+	) (types.ImportReport, error)
 	ListDevices(ctx context.Context) ([]types.Device, error)
 	UpdateDevice(ctx context.Context, device string, status types.DeviceStatus) error
+	DeleteDevice(ctx context.Context, device string) error
+	RebootDevice(
+		ctx context.Context,
+		device string,
+		duration time.Duration,
+		timeout time.Duration,
+	) (types.Diagnostics, time.Duration, error)
+	SetDeviceConfig(
+		ctx context.Context,
+		device string,
+		desired types.DesiredConfig,
+	) (types.DeviceConfig, error)
+	GetDeviceConfig(ctx context.Context, device string) (types.DeviceConfig, error)
+	DeleteDeviceConfig(ctx context.Context, device string) error
+	ListStatusTransitions(
+		ctx context.Context,
+		device string,
+		query types.DiagnosticsQuery,
+	) ([]types.StatusTransition, error)
+	ListDeviceEvents(ctx context.Context, query types.DeviceEventQuery) ([]types.DeviceEvent, error)
+	StreamDeviceEvents(ctx context.Context, query types.DeviceEventQuery) (<-chan types.DeviceEvent, error)
+	ListAnomalies(ctx context.Context, query types.AnomalyQuery) ([]types.Anomaly, error)
+	StreamAnomalies(ctx context.Context, device string) (<-chan types.Anomaly, error)
+	GetAnomalySettings(ctx context.Context, device string) (types.AnomalySettings, error)
+	SetAnomalySensitivity(
+		ctx context.Context,
+		device string,
+		sensitivity types.Sensitivity,
+	) (types.AnomalySettings, error)
+	GetVersionInventory(ctx context.Context, query types.VersionQuery) (types.VersionInventory, error)
+	SetCompliancePolicy(ctx context.Context, policy types.CompliancePolicy) (types.CompliancePolicy, error)
+	ListCompliancePolicies(ctx context.Context) ([]types.CompliancePolicy, error)
+	DeleteCompliancePolicy(ctx context.Context, name string) error
+	ListVersionChanges(ctx context.Context, query types.VersionChangeQuery) ([]types.VersionChange, error)
+	StreamVersionChanges(
+		ctx context.Context,
+		device string,
+		component types.VersionComponent,
+	) (<-chan types.VersionChange, error)
+	GetForecast(ctx context.Context, query types.ForecastQuery) ([]types.Forecast, error)
 	GetDiagnostics(ctx context.Context, device string) (types.Diagnostics, error)
 	StreamDiagnostics(ctx context.Context, device string) <-chan types.Diagnostics
+	ExportDiagnostics(ctx context.Context, query types.ExportQuery, w io.Writer) error
+	ListDiagnostics(
+		ctx context.Context,
+		device string,
+		query types.DiagnosticsQuery,
+	) ([]types.Diagnostics, error)
+	GetAvailabilityReport(ctx context.Context, query types.ReportQuery) (types.AvailabilityReport, error)
+	CreateCampaign(ctx context.Context, spec types.CampaignSpec) (types.Campaign, error)
+	ListCampaigns(ctx context.Context) ([]types.Campaign, error)
+	GetCampaign(ctx context.Context, campaignID string) (types.Campaign, error)
+	CancelCampaign(ctx context.Context, campaignID string) error
 }
 
 type Server struct {
@@ -62,23 +136,63 @@ func (s *Server) RegisterDevice(
 	if err := req.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	protocol := types.Protocol(req.GetProtocol().String())
-	dev, err := s.provider.RegisterDevice(ctx, types.DeviceRegistration{
-		Protocol:    protocol,
-		Alias:       req.GetAlias(),
-		Host:        req.GetHost(),
-		Port:        req.GetPort(),
-		GatewayPort: req.GetPortGateway(),
-	})
+	dev, err := s.provider.RegisterDevice(ctx, registration(req))
 	if err != nil {
 		if errors.Is(err, device.ErrorNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, device.ErrorUnsupportedProtocol) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &monitorv1.RegisterDeviceResponse{Device: s.device(dev)}, nil
 }
 
+// ImportDevices registers the devices of a YAML or CSV inventory, entries are validated as
+// registration requests and reported individually.
+func (s *Server) ImportDevices(
+	ctx context.Context,
+	req *monitorv1.ImportDevicesRequest,
+) (*monitorv1.ImportDevicesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultImportTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	parsed, err := inventory.Parse(types.InventoryFormatFromProto(req.GetFormat()), req.GetData())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	entries := make([]types.InventoryEntry, len(parsed))
+	for i, entry := range parsed {
+		entries[i] = types.InventoryEntry{
+			Line:     entry.Line,
+			DeviceID: entry.Request.GetDeviceId(),
+			Error:    entry.Error,
+		}
+		if entry.Error == nil {
+			entries[i].Registration = registration(entry.Request)
+		}
+	}
+	report, err := s.provider.ImportDevices(ctx, entries, req.GetPrune())
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	results := make([]*monitorv1.ImportResult, len(report.Results))
+	for i, result := range report.Results {
+		results[i] = &monitorv1.ImportResult{
+			Line:     int32(result.Line), // nolint:gosec
+			DeviceId: result.DeviceID,
+			Status:   result.Status.Proto(),
+		}
+		if result.Error != nil {
+			results[i].Error = result.Error.Error()
+		}
+	}
+	return &monitorv1.ImportDevicesResponse{Results: results, Pruned: report.Pruned}, nil
+}
+
 func (s *Server) ListDevices(ctx context.Context, _ *emptypb.Empty) (*monitorv1.ListDevicesResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
 	defer cancel()
@@ -102,14 +216,442 @@ func (s *Server) UpdateDevice(
 	if err := req.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	status := types.DeviceStatusFromString(req.GetDeviceStatus().String())
-	err := s.provider.UpdateDevice(ctx, req.GetDeviceId(), status)
+	deviceStatus := types.DeviceStatusFromString(req.GetDeviceStatus().String())
+	err := s.provider.UpdateDevice(ctx, req.GetDeviceId(), deviceStatus)
 	if err != nil {
+		if errors.Is(err, service.ErrorInvalidTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, device.ErrorUnsupportedProtocol) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
 		return nil, s.databaseError(err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Server) DeleteDevice(
+	ctx context.Context,
+	req *monitorv1.DeleteDeviceRequest,
+) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.provider.DeleteDevice(ctx, req.GetDeviceId()); err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RebootDevice(
+	ctx context.Context,
+	req *monitorv1.RebootDeviceRequest,
+) (*monitorv1.RebootDeviceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	diag, downtime, err := s.provider.RebootDevice(
+		ctx,
+		req.GetDeviceId(),
+		req.GetDuration().AsDuration(),
+		req.GetTimeout().AsDuration(),
+	)
+	if err != nil {
+		if errors.Is(err, service.ErrorRebootTimeout) {
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
+		if errors.Is(err, device.ErrorUnsupportedProtocol) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.RebootDeviceResponse{
+		Downtime:    durationpb.New(downtime),
+		Diagnostics: s.sample(diag),
+	}, nil
+}
+
+func (s *Server) SetDeviceConfig(
+	ctx context.Context,
+	req *monitorv1.SetDeviceConfigRequest,
+) (*monitorv1.DeviceConfigResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultConfigTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	config := req.GetConfig()
+	desired := types.DesiredConfig{
+		StreamInterval: config.GetStreamInterval().AsDuration(),
+		Labels:         config.GetLabels(),
+		Config:         config.GetConfig(),
+		DriftPolicy:    types.DriftPolicyFromString(config.GetDriftPolicy().String()),
+	}
+	if config.GetDeviceStatus() != monitorv1.DeviceStatus_DEVICE_STATUS_UNSPECIFIED {
+		desired.DeviceStatus = types.DeviceStatusFromString(config.GetDeviceStatus().String())
+	}
+	result, err := s.provider.SetDeviceConfig(ctx, req.GetDeviceId(), desired)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.DeviceConfigResponse{Config: s.deviceConfig(result)}, nil
+}
+
+func (s *Server) GetDeviceConfig(
+	ctx context.Context,
+	req *monitorv1.GetDeviceConfigRequest,
+) (*monitorv1.DeviceConfigResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.provider.GetDeviceConfig(ctx, req.GetDeviceId())
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.DeviceConfigResponse{Config: s.deviceConfig(result)}, nil
+}
+
+func (s *Server) DeleteDeviceConfig(
+	ctx context.Context,
+	req *monitorv1.DeleteDeviceConfigRequest,
+) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.provider.DeleteDeviceConfig(ctx, req.GetDeviceId()); err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListStatusTransitions(
+	ctx context.Context,
+	req *monitorv1.ListStatusTransitionsRequest,
+) (*monitorv1.ListStatusTransitionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	query := historyQuery(req.GetFrom(), req.GetTo(), req.GetLimit())
+	result, err := s.provider.ListStatusTransitions(ctx, req.GetDeviceId(), query)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	transitions := make([]*monitorv1.StatusTransition, len(result))
+	for i, transition := range result {
+		transitions[i] = statusTransition(transition)
+	}
+	return &monitorv1.ListStatusTransitionsResponse{Transitions: transitions}, nil
+}
+
+// ListDeviceEvents returns the timeline of a device (or of all devices), newest first
+func (s *Server) ListDeviceEvents(
+	ctx context.Context,
+	req *monitorv1.ListDeviceEventsRequest,
+) (*monitorv1.ListDeviceEventsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	history := historyQuery(req.GetFrom(), req.GetTo(), req.GetLimit())
+	query := eventQuery(req.GetDeviceId(), req.GetTypes(), req.GetSeverity())
+	query.From, query.To, query.Limit = history.From, history.To, history.Limit
+	result, err := s.provider.ListDeviceEvents(ctx, query)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	events := make([]*monitorv1.DeviceEvent, len(result))
+	for i, event := range result {
+		events[i] = deviceEvent(event)
+	}
+	return &monitorv1.ListDeviceEventsResponse{Events: events}, nil
+}
+
+func (s *Server) StreamDeviceEvents(
+	req *monitorv1.StreamDeviceEventsRequest,
+	stream monitorv1.Monitor_StreamDeviceEventsServer,
+) error {
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	query := eventQuery(req.GetDeviceId(), req.GetTypes(), req.GetSeverity())
+	ch, err := s.provider.StreamDeviceEvents(stream.Context(), query)
+	if err != nil {
+		return s.databaseError(err)
+	}
+	for event := range ch {
+		if err := stream.Send(deviceEvent(event)); err != nil {
+			s.logger.Error(ErrorSendStream.Error(), zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+func (s *Server) ListAnomalies(
+	ctx context.Context,
+	req *monitorv1.ListAnomaliesRequest,
+) (*monitorv1.ListAnomaliesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	history := historyQuery(req.GetFrom(), req.GetTo(), req.GetLimit())
+	result, err := s.provider.ListAnomalies(ctx, types.AnomalyQuery{
+		DeviceID: req.GetDeviceId(),
+		From:     history.From,
+		To:       history.To,
+		Limit:    history.Limit,
+	})
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	anomalies := make([]*monitorv1.Anomaly, len(result))
+	for i, a := range result {
+		anomalies[i] = anomaly(a)
+	}
+	return &monitorv1.ListAnomaliesResponse{Anomalies: anomalies}, nil
+}
+
+func (s *Server) StreamAnomalies(
+	req *monitorv1.StreamAnomaliesRequest,
+	stream monitorv1.Monitor_StreamAnomaliesServer,
+) error {
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	ch, err := s.provider.StreamAnomalies(stream.Context(), req.GetDeviceId())
+	if err != nil {
+		return s.databaseError(err)
+	}
+	for a := range ch {
+		if err := stream.Send(anomaly(a)); err != nil {
+			s.logger.Error(ErrorSendStream.Error(), zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+func (s *Server) SetAnomalySensitivity(
+	ctx context.Context,
+	req *monitorv1.SetAnomalySensitivityRequest,
+) (*monitorv1.AnomalySettingsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sensitivity := types.SensitivityFromProto(req.GetSensitivity())
+	result, err := s.provider.SetAnomalySensitivity(ctx, req.GetDeviceId(), sensitivity)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.AnomalySettingsResponse{Settings: anomalySettings(result)}, nil
+}
+
+func (s *Server) GetAnomalySettings(
+	ctx context.Context,
+	req *monitorv1.GetAnomalySettingsRequest,
+) (*monitorv1.AnomalySettingsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.provider.GetAnomalySettings(ctx, req.GetDeviceId())
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.AnomalySettingsResponse{Settings: anomalySettings(result)}, nil
+}
+
+// GetVersionInventory aggregates the versions deployed across the fleet, optionally grouped by
+// model or architecture and restricted to the devices running a version below the given one.
+func (s *Server) GetVersionInventory(
+	ctx context.Context,
+	req *monitorv1.VersionInventoryRequest,
+) (*monitorv1.VersionInventoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultReportTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.provider.GetVersionInventory(ctx, types.VersionQuery{
+		GroupBy:   types.VersionGroupingFromProto(req.GetGroupBy()),
+		Component: types.VersionComponentFromProto(req.GetComponent()),
+		Below:     req.GetBelow(),
+	})
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return versionInventory(result), nil
+}
+
+func (s *Server) SetCompliancePolicy(
+	ctx context.Context,
+	req *monitorv1.SetCompliancePolicyRequest,
+) (*monitorv1.CompliancePolicyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	policy := req.GetPolicy()
+	component := types.VersionComponentFromProto(policy.GetComponent()).String()
+	result, err := s.provider.SetCompliancePolicy(ctx, types.CompliancePolicy{
+		Name:       &policy.Name,
+		Labels:     policy.GetLabels(),
+		Component:  &component,
+		MinVersion: &policy.MinVersion,
+		Allow:      policy.GetAllow(),
+		Deny:       policy.GetDeny(),
+	})
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.CompliancePolicyResponse{Policy: compliancePolicy(result)}, nil
+}
+
+func (s *Server) ListCompliancePolicies(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*monitorv1.ListCompliancePoliciesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	result, err := s.provider.ListCompliancePolicies(ctx)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	policies := make([]*monitorv1.CompliancePolicy, len(result))
+	for i, policy := range result {
+		policies[i] = compliancePolicy(policy)
+	}
+	return &monitorv1.ListCompliancePoliciesResponse{Policies: policies}, nil
+}
+
+func (s *Server) DeleteCompliancePolicy(
+	ctx context.Context,
+	req *monitorv1.DeleteCompliancePolicyRequest,
+) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.provider.DeleteCompliancePolicy(ctx, req.GetName()); err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListVersionChanges(
+	ctx context.Context,
+	req *monitorv1.ListVersionChangesRequest,
+) (*monitorv1.ListVersionChangesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	history := historyQuery(req.GetFrom(), req.GetTo(), req.GetLimit())
+	result, err := s.provider.ListVersionChanges(ctx, types.VersionChangeQuery{
+		DeviceID:  req.GetDeviceId(),
+		Component: types.VersionComponentFromProto(req.GetComponent()),
+		From:      history.From,
+		To:        history.To,
+		Limit:     history.Limit,
+	})
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	changes := make([]*monitorv1.VersionChange, len(result))
+	for i, change := range result {
+		changes[i] = versionChange(change)
+	}
+	return &monitorv1.ListVersionChangesResponse{Changes: changes}, nil
+}
+
+func (s *Server) StreamVersionChanges(
+	req *monitorv1.StreamVersionChangesRequest,
+	stream monitorv1.Monitor_StreamVersionChangesServer,
+) error {
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	component := types.VersionComponentFromProto(req.GetComponent())
+	ch, err := s.provider.StreamVersionChanges(stream.Context(), req.GetDeviceId(), component)
+	if err != nil {
+		return s.databaseError(err)
+	}
+	for change := range ch {
+		if err := stream.Send(versionChange(change)); err != nil {
+			s.logger.Error(ErrorSendStream.Error(), zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+// GetForecast forecasts a metric of a device or, without a device, of the devices at most at
+// risk of reaching the threshold. The metric defaults to memory and the threshold to 95.
+func (s *Server) GetForecast(
+	ctx context.Context,
+	req *monitorv1.GetForecastRequest,
+) (*monitorv1.GetForecastResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultReportTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	query := types.ForecastQuery{
+		DeviceID:  req.GetDeviceId(),
+		Metric:    types.MetricMemory,
+		Model:     types.ForecastModelFromProto(req.GetModel()),
+		Threshold: DefaultForecastThreshold,
+		History:   DefaultForecastHistory,
+		Horizon:   DefaultForecastHorizon,
+		Top:       DefaultForecastTop,
+	}
+	if req.GetMetric() != monitorv1.Metric_METRIC_UNSPECIFIED {
+		query.Metric = types.MetricFromProto(req.GetMetric())
+	}
+	if req.GetThreshold() > 0 {
+		query.Threshold = req.GetThreshold()
+	}
+	if req.History != nil {
+		query.History = req.GetHistory().AsDuration()
+	}
+	if req.Horizon != nil {
+		query.Horizon = req.GetHorizon().AsDuration()
+	}
+	if req.GetTop() > 0 {
+		query.Top = int(req.GetTop())
+	}
+	result, err := s.provider.GetForecast(ctx, query)
+	if err != nil {
+		if errors.Is(err, service.ErrorInsufficientData) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, s.databaseError(err)
+	}
+	now := time.Now()
+	forecasts := make([]*monitorv1.Forecast, len(result))
+	for i, f := range result {
+		forecasts[i] = deviceForecast(f, now)
+	}
+	return &monitorv1.GetForecastResponse{Forecasts: forecasts}, nil
+}
+
 func (s *Server) GetDiagnostics(
 	ctx context.Context,
 	req *monitorv1.DiagnosticsRequest,
@@ -144,24 +686,221 @@ func (s *Server) StreamDiagnostics(
 	return nil
 }
 
+func (s *Server) ListDiagnostics(
+	ctx context.Context,
+	req *monitorv1.ListDiagnosticsRequest,
+) (*monitorv1.ListDiagnosticsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	query := historyQuery(req.GetFrom(), req.GetTo(), req.GetLimit())
+	result, err := s.provider.ListDiagnostics(ctx, req.GetDeviceId(), query)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	diagnostics := make([]*monitorv1.Diagnostics, len(result))
+	for i, diag := range result {
+		diagnostics[i] = s.sample(diag)
+	}
+	return &monitorv1.ListDiagnosticsResponse{Diagnostics: diagnostics}, nil
+}
+
+// ExportDiagnostics streams the export in messages of up to DefaultExportChunkSize bytes,
+// an unset time range defaults to the history window before now.
+func (s *Server) ExportDiagnostics(
+	req *monitorv1.ExportDiagnosticsRequest,
+	stream monitorv1.Monitor_ExportDiagnosticsServer,
+) error {
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	history := historyQuery(req.GetFrom(), req.GetTo(), 0)
+	query := types.ExportQuery{
+		DeviceIDs: req.GetDeviceIds(),
+		From:      history.From,
+		To:        history.To,
+		Format:    types.ExportFormatFromProto(req.GetFormat()),
+	}
+	writer := bufio.NewWriterSize(exportWriter{stream: stream}, DefaultExportChunkSize)
+	if err := s.provider.ExportDiagnostics(stream.Context(), query, writer); err != nil {
+		if errors.Is(err, ErrorSendStream) {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		return s.databaseError(err)
+	}
+	if err := writer.Flush(); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return nil
+}
+
+// GetAvailabilityReport defaults to the current calendar month (UTC)
+func (s *Server) GetAvailabilityReport(
+	ctx context.Context,
+	req *monitorv1.AvailabilityReportRequest,
+) (*monitorv1.AvailabilityReportResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultReportTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	query := types.ReportQuery{
+		To:                 time.Now(),
+		DeviceIDs:          req.GetDeviceIds(),
+		GroupBy:            req.GetGroupBy(),
+		ExcludeMaintenance: req.GetExcludeMaintenance(),
+	}
+	if req.To != nil {
+		query.To = req.GetTo().AsTime()
+	}
+	year, month, _ := query.To.UTC().Date()
+	query.From = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if req.From != nil {
+		query.From = req.GetFrom().AsTime()
+	}
+	if !query.From.Before(query.To) {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"invalid time range in request (from must be before to)",
+		)
+	}
+	report, err := s.provider.GetAvailabilityReport(ctx, query)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	devices := make([]*monitorv1.DeviceAvailability, len(report.Devices))
+	for i, dev := range report.Devices {
+		devices[i] = &monitorv1.DeviceAvailability{
+			DeviceId:     dev.DeviceID,
+			Labels:       dev.Labels,
+			Availability: availability(dev.Availability),
+		}
+	}
+	groups := make([]*monitorv1.GroupAvailability, len(report.Groups))
+	for i, group := range report.Groups {
+		groups[i] = &monitorv1.GroupAvailability{
+			Value:        group.Value,
+			DeviceIds:    group.DeviceIDs,
+			Availability: availability(group.Availability),
+		}
+	}
+	return &monitorv1.AvailabilityReportResponse{
+		From:               timestamppb.New(report.Query.From),
+		To:                 timestamppb.New(report.Query.To),
+		GroupBy:            report.Query.GroupBy,
+		ExcludeMaintenance: report.Query.ExcludeMaintenance,
+		Fleet:              availability(report.Fleet),
+		Devices:            devices,
+		Groups:             groups,
+	}, nil
+}
+
+func (s *Server) CreateCampaign(
+	ctx context.Context,
+	req *monitorv1.CreateCampaignRequest,
+) (*monitorv1.CreateCampaignResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	selector := req.GetSelector()
+	result, err := s.provider.CreateCampaign(ctx, types.CampaignSpec{
+		TargetVersion: req.GetTargetVersion(),
+		Selector: types.CampaignSelector{
+			DeviceIDs:    selector.GetDeviceIds(),
+			Hardware:     selector.GetHardwareVersion(),
+			Firmware:     selector.GetFirmwareVersion(),
+			Architecture: selector.GetArchitecture(),
+			OS:           selector.GetOs(),
+		},
+		WaveSize:       int(req.GetWaveSize()),
+		MaxUnavailable: int(req.GetMaxUnavailable()),
+		WaveTimeout:    req.GetWaveTimeout().AsDuration(),
+		FailurePolicy:  types.FailurePolicyFromString(req.GetFailurePolicy().String()),
+	})
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.CreateCampaignResponse{Campaign: s.campaign(result)}, nil
+}
+
+func (s *Server) ListCampaigns(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*monitorv1.ListCampaignsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	result, err := s.provider.ListCampaigns(ctx)
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	campaigns := make([]*monitorv1.Campaign, len(result))
+	for i, campaign := range result {
+		campaigns[i] = s.campaign(campaign)
+	}
+	return &monitorv1.ListCampaignsResponse{Campaigns: campaigns}, nil
+}
+
+func (s *Server) GetCampaign(
+	ctx context.Context,
+	req *monitorv1.GetCampaignRequest,
+) (*monitorv1.GetCampaignResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, err := s.provider.GetCampaign(ctx, req.GetCampaignId())
+	if err != nil {
+		return nil, s.databaseError(err)
+	}
+	return &monitorv1.GetCampaignResponse{Campaign: s.campaign(result)}, nil
+}
+
+func (s *Server) CancelCampaign(
+	ctx context.Context,
+	req *monitorv1.CancelCampaignRequest,
+) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.provider.CancelCampaign(ctx, req.GetCampaignId()); err != nil {
+		if errors.Is(err, service.ErrorCampaignNotRunning) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, s.databaseError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Server) device(device types.Device) *monitorv1.Device {
+	signing := types.SigningAlgorithmFromString(deref(device.SigningAlgorithm))
 	return &monitorv1.Device{
-		Id:                 deref(device.ID),
-		DeviceId:           deref(device.Identifier),
-		Alias:              deref(device.Alias),
-		Host:               deref(device.Host),
-		Port:               deref(device.Port),
-		PortGateway:        deref(device.GatewayPort),
-		Architecture:       deref(device.Architecture),
-		Os:                 deref(device.OS),
-		SupportedProtocols: types.ProtocolFromStrings(deref(device.SupportedProtocols)),
-		CreatedAt:          timestamp(device.Created),
-		UpdatedAt:          timestamp(device.Updated),
+		Id:                   deref(device.ID),
+		DeviceId:             deref(device.Identifier),
+		Alias:                deref(device.Alias),
+		Host:                 deref(device.Host),
+		Port:                 deref(device.Port),
+		PortGateway:          deref(device.GatewayPort),
+		Architecture:         deref(device.Architecture),
+		Os:                   deref(device.OS),
+		SupportedProtocols:   types.ProtocolFromStrings(deref(device.SupportedProtocols)),
+		CreatedAt:            timestamp(device.Created),
+		UpdatedAt:            timestamp(device.Updated),
+		SigningAlgorithm:     signing.Proto(),
+		ComplianceStatus:     device.Compliance.Status.Proto(),
+		ComplianceViolations: device.Compliance.Violations,
+		Driver:               deref(device.Driver),
 	}
 }
 
 func (s *Server) diagnostics(diag types.Diagnostics) *monitorv1.DiagnosticsResponse {
-	status := types.DeviceStatusFromString(deref(diag.DeviceStatus))
+	signing := types.SigningAlgorithmFromString(deref(diag.SigningAlgorithm))
 	return &monitorv1.DiagnosticsResponse{
 		Device: &monitorv1.Device{
 			Id:                 deref(diag.ID),
@@ -175,19 +914,358 @@ func (s *Server) diagnostics(diag types.Diagnostics) *monitorv1.DiagnosticsRespo
 			SupportedProtocols: types.ProtocolFromStrings(deref(diag.SupportedProtocols)),
 			CreatedAt:          timestamp(diag.Created),
 			UpdatedAt:          timestamp(diag.Updated),
+			SigningAlgorithm:   signing.Proto(),
 		},
-		Diagnostics: &monitorv1.Diagnostics{
-			HardwareVersion: deref(diag.Hardware),
-			SoftwareVersion: deref(diag.Software),
-			FirmwareVersion: deref(diag.Firmware),
-			CpuUsage:        deref(diag.CPU),
-			MemoryUsage:     deref(diag.Memory),
-			DeviceStatus:    status.Proto(),
-			Checksum:        deref(diag.Checksum),
+		Diagnostics: s.sample(diag),
+		UpdatedAt:   timestamp(diag.LastUpdated),
+	}
+
+}
+
+func (s *Server) sample(diag types.Diagnostics) *monitorv1.Diagnostics {
+	status := types.DeviceStatusFromString(deref(diag.DeviceStatus))
+	verification := types.VerificationStatusFromString(deref(diag.Verification))
+	interfaces := make([]*monitorv1.NetworkInterface, len(diag.Interfaces))
+	for i, iface := range diag.Interfaces {
+		interfaces[i] = networkInterface(iface)
+	}
+	return &monitorv1.Diagnostics{
+		HardwareVersion:    deref(diag.Hardware),
+		SoftwareVersion:    deref(diag.Software),
+		FirmwareVersion:    deref(diag.Firmware),
+		CpuUsage:           deref(diag.CPU),
+		MemoryUsage:        deref(diag.Memory),
+		DeviceStatus:       status.Proto(),
+		Checksum:           deref(diag.Checksum),
+		VerificationStatus: verification.Proto(),
+		UptimeSeconds:      uint64(max(deref(diag.UptimeSeconds), 0)),
+		LoadAverage_1M:     deref(diag.LoadAverage1m),
+		LoadAverage_5M:     deref(diag.LoadAverage5m),
+		LoadAverage_15M:    deref(diag.LoadAverage15m),
+		TemperatureCelsius: deref(diag.Temperature),
+		DiskUsedBytes:      uint64(max(deref(diag.DiskUsed), 0)),
+		DiskTotalBytes:     uint64(max(deref(diag.DiskTotal), 0)),
+		ProcessCount:       uint32(max(deref(diag.Processes), 0)),
+		Interfaces:         interfaces,
+		Timestamp:          timestamp(diag.LastUpdated),
+	}
+}
+
+func (s *Server) deviceConfig(config types.DeviceConfig) *monitorv1.DeviceConfig {
+	desired := config.Desired()
+	status := types.ConfigStatusFromString(deref(config.Status))
+	result := &monitorv1.DeviceConfig{
+		DeviceId: deref(config.DeviceID),
+		Desired: &monitorv1.DesiredConfig{
+			DeviceStatus: desired.DeviceStatus.Proto(),
+			Labels:       desired.Labels,
+			Config:       desired.Config,
+			DriftPolicy:  desired.DriftPolicy.Proto(),
+		},
+		Status:       status.Proto(),
+		Drift:        config.Drift,
+		Error:        deref(config.Error),
+		ReapplyCount: deref(config.Reapplied),
+		AppliedAt:    timestamp(config.Applied),
+		ReconciledAt: timestamp(config.Reconciled),
+		CreatedAt:    timestamp(config.Created),
+		UpdatedAt:    timestamp(config.Updated),
+	}
+	if desired.StreamInterval > 0 {
+		result.Desired.StreamInterval = durationpb.New(desired.StreamInterval)
+	}
+	return result
+}
+
+func (s *Server) campaign(campaign types.Campaign) *monitorv1.Campaign {
+	status := types.CampaignStatusFromString(deref(campaign.Status))
+	policy := types.FailurePolicyFromString(deref(campaign.FailurePolicy))
+	selector := deref(campaign.Selector)
+	devices := make([]*monitorv1.CampaignDevice, len(campaign.Devices))
+	for i, dev := range campaign.Devices {
+		status := types.CampaignDeviceStatusFromString(deref(dev.Status))
+		devices[i] = &monitorv1.CampaignDevice{
+			DeviceId:        deref(dev.DeviceID),
+			Wave:            deref(dev.Wave),
+			Status:          status.Proto(),
+			PreviousVersion: deref(dev.PreviousVersion),
+			Error:           deref(dev.Error),
+			UpdatedAt:       timestamp(dev.Updated),
+		}
+	}
+	return &monitorv1.Campaign{
+		Id:            deref(campaign.ID),
+		TargetVersion: deref(campaign.TargetVersion),
+		Selector: &monitorv1.DeviceSelector{
+			DeviceIds:       selector.DeviceIDs,
+			HardwareVersion: selector.Hardware,
+			FirmwareVersion: selector.Firmware,
+			Architecture:    selector.Architecture,
+			Os:              selector.OS,
+		},
+		WaveSize:       deref(campaign.WaveSize),
+		MaxUnavailable: deref(campaign.MaxUnavailable),
+		WaveTimeout:    durationpb.New(time.Duration(deref(campaign.WaveTimeout)) * time.Millisecond),
+		FailurePolicy:  policy.Proto(),
+		Status:         status.Proto(),
+		CurrentWave:    deref(campaign.CurrentWave),
+		TotalWaves:     deref(campaign.TotalWaves),
+		Error:          deref(campaign.Error),
+		Devices:        devices,
+		CreatedAt:      timestamp(campaign.Created),
+		UpdatedAt:      timestamp(campaign.Updated),
+		CompletedAt:    timestamp(campaign.Completed),
+	}
+}
+
+func statusTransition(transition types.StatusTransition) *monitorv1.StatusTransition {
+	from := types.DeviceStatusFromString(deref(transition.FromStatus))
+	to := types.DeviceStatusFromString(deref(transition.ToStatus))
+	cause := types.TransitionCauseFromString(deref(transition.Cause))
+	return &monitorv1.StatusTransition{
+		DeviceId:       deref(transition.DeviceID),
+		FromStatus:     from.Proto(),
+		ToStatus:       to.Proto(),
+		Cause:          cause.Proto(),
+		Duration:       durationpb.New(time.Duration(deref(transition.Duration)) * time.Millisecond),
+		TransitionedAt: timestamp(transition.Transitioned),
+	}
+}
+
+func eventQuery(
+	deviceID string,
+	kinds []monitorv1.EventType,
+	severity monitorv1.EventSeverity,
+) types.DeviceEventQuery {
+	query := types.DeviceEventQuery{
+		DeviceID: deviceID,
+		Types:    make([]types.EventType, len(kinds)),
+		Severity: types.EventSeverityFromProto(severity),
+	}
+	for i, kind := range kinds {
+		query.Types[i] = types.EventTypeFromProto(kind)
+	}
+	return query
+}
+
+func deviceEvent(result types.DeviceEvent) *monitorv1.DeviceEvent {
+	kind := types.EventTypeFromString(deref(result.Type))
+	severity := types.EventSeverityFromString(deref(result.Severity))
+	return &monitorv1.DeviceEvent{
+		Id:         deref(result.ID),
+		DeviceId:   deref(result.DeviceID),
+		Type:       kind.Proto(),
+		Severity:   severity.Proto(),
+		Message:    deref(result.Message),
+		Details:    result.Details,
+		OccurredAt: timestamp(result.Occurred),
+	}
+}
+
+func anomaly(result types.Anomaly) *monitorv1.Anomaly {
+	metric := types.MetricFromString(deref(result.Metric))
+	kind := types.AnomalyKindFromString(deref(result.Kind))
+	return &monitorv1.Anomaly{
+		Id:         deref(result.ID),
+		DeviceId:   deref(result.DeviceID),
+		Metric:     metric.Proto(),
+		Kind:       kind.Proto(),
+		Value:      deref(result.Value),
+		Baseline:   deref(result.Baseline),
+		Deviation:  deref(result.Deviation),
+		Score:      deref(result.Score),
+		DetectedAt: timestamp(result.Detected),
+	}
+}
+
+func anomalySettings(result types.AnomalySettings) *monitorv1.AnomalySettings {
+	sensitivity := types.SensitivityFromString(deref(result.Sensitivity))
+	return &monitorv1.AnomalySettings{
+		DeviceId:    deref(result.DeviceID),
+		Sensitivity: sensitivity.Proto(),
+		Threshold:   sensitivity.Threshold(),
+		UpdatedAt:   timestamp(result.Updated),
+	}
+}
+
+func versionInventory(result types.VersionInventory) *monitorv1.VersionInventoryResponse {
+	response := &monitorv1.VersionInventoryResponse{
+		GroupBy: result.GroupBy.Proto(),
+		Groups:  make([]*monitorv1.VersionGroup, len(result.Groups)),
+		Devices: make([]*monitorv1.DeviceVersions, len(result.Devices)),
+	}
+	for i, group := range result.Groups {
+		versions := make([]*monitorv1.VersionCount, len(group.Versions))
+		for j, count := range group.Versions {
+			versions[j] = &monitorv1.VersionCount{
+				Component: count.Component.Proto(),
+				Version:   count.Version,
+				Devices:   int32(len(count.DeviceIDs)),
+				DeviceIds: count.DeviceIDs,
+			}
+		}
+		response.Groups[i] = &monitorv1.VersionGroup{
+			Value:    group.Value,
+			Devices:  int32(group.Devices),
+			Versions: versions,
+		}
+	}
+	for i, device := range result.Devices {
+		response.Devices[i] = &monitorv1.DeviceVersions{
+			DeviceId:         device.DeviceID,
+			Architecture:     device.Architecture,
+			HardwareVersion:  device.Versions.Hardware,
+			SoftwareVersion:  device.Versions.Software,
+			FirmwareVersion:  device.Versions.Firmware,
+			ComplianceStatus: device.Compliance.Proto(),
+		}
+	}
+	return response
+}
+
+func versionChange(result types.VersionChange) *monitorv1.VersionChange {
+	component := types.VersionComponentFromString(deref(result.Component))
+	return &monitorv1.VersionChange{
+		Id:              deref(result.ID),
+		DeviceId:        deref(result.DeviceID),
+		Component:       component.Proto(),
+		PreviousVersion: deref(result.Previous),
+		CurrentVersion:  deref(result.Current),
+		ChangedAt:       timestamp(result.Changed),
+	}
+}
+
+func compliancePolicy(result types.CompliancePolicy) *monitorv1.CompliancePolicy {
+	component := types.VersionComponentFromString(deref(result.Component))
+	return &monitorv1.CompliancePolicy{
+		Name:       deref(result.Name),
+		Labels:     result.Labels,
+		Component:  component.Proto(),
+		MinVersion: deref(result.MinVersion),
+		Allow:      result.Allow,
+		Deny:       result.Deny,
+		CreatedAt:  timestamp(result.Created),
+		UpdatedAt:  timestamp(result.Updated),
+	}
+}
+
+func deviceForecast(result types.Forecast, now time.Time) *monitorv1.Forecast {
+	points := make([]*monitorv1.ForecastPoint, len(result.Points))
+	for i, point := range result.Points {
+		points[i] = &monitorv1.ForecastPoint{
+			Timestamp: timestamppb.New(point.Time),
+			Value:     point.Value,
+			Lower:     point.Lower,
+			Upper:     point.Upper,
+		}
+	}
+	forecast := &monitorv1.Forecast{
+		DeviceId:    result.DeviceID,
+		Metric:      result.Metric.Proto(),
+		Model:       result.Model.Proto(),
+		Current:     result.Current,
+		TrendPerDay: result.Trend * 24,
+		Threshold:   result.Threshold,
+		ThresholdAt: timestamp(result.ThresholdAt),
+		Samples:     int32(result.Samples),
+		Points:      points,
+	}
+	if result.ThresholdAt != nil {
+		forecast.TimeToThreshold = durationpb.New(max(result.ThresholdAt.Sub(now), 0))
+	}
+	return forecast
+}
+
+func availability(result types.Availability) *monitorv1.Availability {
+	statuses := make([]*monitorv1.StatusTime, 0, len(result.Statuses))
+	for status, duration := range result.Statuses {
+		if duration > 0 {
+			statuses = append(statuses, &monitorv1.StatusTime{
+				Status:   status.Proto(),
+				Duration: durationpb.New(duration),
+				Percent:  result.Percent(status),
+			})
+		}
+	}
+	slices.SortFunc(statuses, func(a, b *monitorv1.StatusTime) int {
+		return cmp.Compare(a.Status, b.Status)
+	})
+	return &monitorv1.Availability{
+		Period:       durationpb.New(result.Period()),
+		Availability: result.Available(),
+		Statuses:     statuses,
+		Failures:     int32(result.Failures), // nolint:gosec
+		Mttr:         durationpb.New(result.MTTR()),
+		Mtbf:         durationpb.New(result.MTBF()),
+	}
+}
+
+func networkInterface(iface types.Interface) *monitorv1.NetworkInterface {
+	state := types.LinkStateFromString(deref(iface.LinkState))
+	counters, rates := iface.Counters(), iface.Rates()
+	return &monitorv1.NetworkInterface{
+		Name:               deref(iface.Name),
+		LinkState:          state.Proto(),
+		RxBytes:            counters.RxBytes,
+		TxBytes:            counters.TxBytes,
+		RxPackets:          counters.RxPackets,
+		TxPackets:          counters.TxPackets,
+		RxErrors:           counters.RxErrors,
+		TxErrors:           counters.TxErrors,
+		RxBytesPerSecond:   rates.RxBytes,
+		TxBytesPerSecond:   rates.TxBytes,
+		RxPacketsPerSecond: rates.RxPackets,
+		TxPacketsPerSecond: rates.TxPackets,
+		RxErrorsPerSecond:  rates.RxErrors,
+		TxErrorsPerSecond:  rates.TxErrors,
+	}
+}
+
+func registration(req *monitorv1.RegisterDeviceRequest) types.DeviceRegistration {
+	return types.DeviceRegistration{
+		Identifier:  req.GetDeviceId(),
+		Protocol:    types.Protocol(req.GetProtocol().String()),
+		Alias:       req.GetAlias(),
+		Host:        req.GetHost(),
+		Port:        req.GetPort(),
+		GatewayPort: req.GetPortGateway(),
+		Signing: types.DeviceSigning{
+			Algorithm: types.SigningAlgorithmFromString(req.GetSigningAlgorithm().String()),
+			Key:       req.GetSigningKey(),
 		},
-		UpdatedAt: timestamp(diag.LastUpdated),
+		Snmp:   types.SnmpCredentialsFromProto(req.GetSnmp()),
+		Driver: req.GetDriver(),
 	}
+}
 
+// historyQuery defaults an unset range to the history window before now
+func historyQuery(from, to *timestamppb.Timestamp, limit int32) types.DiagnosticsQuery {
+	query := types.DiagnosticsQuery{To: time.Now(), Limit: DefaultHistoryLimit}
+	if to != nil {
+		query.To = to.AsTime()
+	}
+	query.From = query.To.Add(-DefaultHistoryWindow)
+	if from != nil {
+		query.From = from.AsTime()
+	}
+	if limit > 0 {
+		query.Limit = int(limit)
+	}
+	return query
+}
+
+// exportWriter sends writes as messages of the export stream, split into chunks
+type exportWriter struct {
+	stream monitorv1.Monitor_ExportDiagnosticsServer
+}
+
+func (w exportWriter) Write(p []byte) (int, error) {
+	for chunk := range slices.Chunk(p, DefaultExportChunkSize) {
+		if err := w.stream.Send(&monitorv1.ExportDiagnosticsResponse{Data: chunk}); err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrorSendStream, err)
+		}
+	}
+	return len(p), nil
 }
 
 func (s *Server) databaseError(err error) error {
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"github.com/emil-j-olsson/ubiquiti/device/gateway"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Most samples replayed to a resuming client, the oldest samples of a longer gap are dropped
+	DefaultReplayLimit = 1000
+)
+
+const streamDiagnosticsPattern = "/v1/diagnostics/{device_id}/stream"
+
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// StreamMiddleware serves StreamDiagnostics as Server-Sent Events (Accept: text/event-stream)
+// or over WebSocket (Upgrade: websocket), other requests are passed on to the generated handler
+// that serves the stream as chunked JSON. Events are identified by the timestamp of their
+// sample, a client resuming after an event (Last-Event-ID header or last_event_id parameter)
+// is first sent the samples stored since, up to DefaultReplayLimit samples.
+func StreamMiddleware(client monitorv1.MonitorClient) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			pattern, _ := runtime.HTTPPathPattern(r.Context())
+			if pattern != streamDiagnosticsPattern || !gateway.Requested(r) {
+				next(w, r, params)
+				return
+			}
+			last, err := gateway.LastEventID(r)
+			if err != nil {
+				streamError(w, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+			stream, err := openDiagnosticsStream(ctx, client, params["device_id"], last)
+			if err != nil {
+				streamError(w, err)
+				return
+			}
+			gateway.Serve(ctx, cancel, w, r, "diagnostics", gateway.Events(ctx, stream.next))
+		}
+	}
+}
+
+// diagnosticsStream relays the samples a client has not received yet, the monitor stream
+// repeats the latest sample of a device until the device reports again.
+type diagnosticsStream struct {
+	stream  monitorv1.Monitor_StreamDiagnosticsClient
+	pending []*monitorv1.DiagnosticsResponse
+	last    time.Time
+}
+
+func openDiagnosticsStream(
+	ctx context.Context,
+	client monitorv1.MonitorClient,
+	deviceID string,
+	last time.Time,
+) (*diagnosticsStream, error) {
+	stream, err := client.StreamDiagnostics(ctx, &monitorv1.DiagnosticsRequest{DeviceId: deviceID})
+	if err != nil {
+		return nil, err
+	}
+	// Await the first sample so that a failing stream still responds with an error status
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	result := &diagnosticsStream{stream: stream, last: last}
+	current := first.GetDiagnostics().GetTimestamp()
+	if !last.IsZero() && (current == nil || current.AsTime().After(last)) {
+		samples, err := replayDiagnostics(ctx, client, deviceID, last, current)
+		if err != nil {
+			return nil, err
+		}
+		for _, sample := range samples {
+			result.pending = append(result.pending, &monitorv1.DiagnosticsResponse{
+				Device:      first.GetDevice(),
+				Diagnostics: sample,
+				UpdatedAt:   sample.GetTimestamp(),
+			})
+		}
+	}
+	result.pending = append(result.pending, first)
+	return result, nil
+}
+
+// replayDiagnostics returns the samples stored after the last event and before the current
+// sample oldest first, samples are listed newest first a page at a time.
+func replayDiagnostics(
+	ctx context.Context,
+	client monitorv1.MonitorClient,
+	deviceID string,
+	last time.Time,
+	current *timestamppb.Timestamp,
+) ([]*monitorv1.Diagnostics, error) {
+	var samples []*monitorv1.Diagnostics
+	to := current
+	for len(samples) < DefaultReplayLimit {
+		limit := min(DefaultHistoryLimit, DefaultReplayLimit-len(samples))
+		res, err := client.ListDiagnostics(ctx, &monitorv1.ListDiagnosticsRequest{
+			DeviceId: deviceID,
+			From:     timestamppb.New(last),
+			To:       to,
+			Limit:    int32(limit),
+		})
+		if err != nil {
+			return nil, err
+		}
+		page := res.GetDiagnostics()
+		samples = append(samples, page...)
+		if len(page) < limit {
+			break
+		}
+		to = page[len(page)-1].GetTimestamp()
+	}
+	slices.Reverse(samples)
+	return samples, nil
+}
+
+// next returns the next sample that is newer than the last sample sent
+func (s *diagnosticsStream) next() (string, proto.Message, error) {
+	for {
+		var res *monitorv1.DiagnosticsResponse
+		if len(s.pending) > 0 {
+			res, s.pending = s.pending[0], s.pending[1:]
+		} else {
+			var err error
+			if res, err = s.stream.Recv(); err != nil {
+				return "", nil, err
+			}
+		}
+		timestamp := res.GetDiagnostics().GetTimestamp()
+		if timestamp == nil {
+			return "", res, nil
+		}
+		if timestamp.AsTime().After(s.last) {
+			s.last = timestamp.AsTime()
+			return gateway.EventID(timestamp), res, nil
+		}
+	}
+}
+
+func streamError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	data, _ := marshalOptions.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	_, _ = w.Write(data)
+}
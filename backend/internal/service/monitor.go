@@ -1,13 +1,25 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/availability"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/compliance"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/export"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/forecast"
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/version"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 type PersistenceProvider interface {
@@ -18,16 +30,122 @@ type PersistenceProvider interface {
 	) (types.Device, error)
 	GetDevice(ctx context.Context, device string) (types.Device, error)
 	ListDevices(ctx context.Context) ([]types.Device, error)
+	DeleteDevice(ctx context.Context, device string) error
 	GetDiagnostics(ctx context.Context, device string) (types.Diagnostics, error)
+	ListDiagnostics(
+		ctx context.Context,
+		device string,
+		query types.DiagnosticsQuery,
+	) ([]types.Diagnostics, error)
+	ListSnapshots(ctx context.Context) ([]types.Diagnostics, error)
+	CreateCampaign(ctx context.Context, campaign types.Campaign) (types.Campaign, error)
+	GetCampaign(ctx context.Context, campaignID string) (types.Campaign, error)
+	ListCampaigns(ctx context.Context) ([]types.Campaign, error)
+	UpdateCampaign(
+		ctx context.Context,
+		campaignID string,
+		status types.CampaignStatus,
+		wave int,
+		message string,
+	) error
+	SaveDeviceConfig(
+		ctx context.Context,
+		device string,
+		desired types.DesiredConfig,
+	) (types.DeviceConfig, error)
+	GetDeviceConfig(ctx context.Context, device string) (types.DeviceConfig, error)
+	DeleteDeviceConfig(ctx context.Context, device string) error
+	RecordStatusTransition(
+		ctx context.Context,
+		device string,
+		status types.DeviceStatus,
+		cause types.TransitionCause,
+	) error
+	ListStatusTransitions(
+		ctx context.Context,
+		device string,
+		query types.DiagnosticsQuery,
+	) ([]types.StatusTransition, error)
+	ListStatusHistories(
+		ctx context.Context,
+		from time.Time,
+		to time.Time,
+		devices []string,
+	) ([]types.StatusHistory, error)
+	ListDeviceConfigs(ctx context.Context) ([]types.DeviceConfig, error)
+	SaveCompliancePolicy(ctx context.Context, policy types.CompliancePolicy) (types.CompliancePolicy, error)
+	ListCompliancePolicies(ctx context.Context) ([]types.CompliancePolicy, error)
+	DeleteCompliancePolicy(ctx context.Context, name string) error
+	ListHourlyAggregates(
+		ctx context.Context,
+		device string,
+		from time.Time,
+		to time.Time,
+	) ([]types.HourlyAggregate, error)
+	ListAnomalies(ctx context.Context, query types.AnomalyQuery) ([]types.Anomaly, error)
+	ListRecordedAnomalies(ctx context.Context, device string, after time.Time) ([]types.Anomaly, error)
+	SaveDeviceEvent(ctx context.Context, event types.EventRecord) error
+	ListDeviceEvents(ctx context.Context, query types.DeviceEventQuery) ([]types.DeviceEvent, error)
+	ListRecordedDeviceEvents(
+		ctx context.Context,
+		device string,
+		after time.Time,
+	) ([]types.DeviceEvent, error)
+	ListVersionChanges(ctx context.Context, query types.VersionChangeQuery) ([]types.VersionChange, error)
+	ListRecordedVersionChanges(
+		ctx context.Context,
+		device string,
+		component types.VersionComponent,
+		after time.Time,
+	) ([]types.VersionChange, error)
+	GetAnomalySettings(ctx context.Context, device string) (types.AnomalySettings, error)
+	SaveAnomalySettings(
+		ctx context.Context,
+		device string,
+		sensitivity types.Sensitivity,
+	) (types.AnomalySettings, error)
+	ExportDiagnostics(
+		ctx context.Context,
+		query types.ExportQuery,
+		fn func([]types.Diagnostics) error,
+	) error
 }
 
 type DeviceProvider interface {
 	CreateClient(config device.Config) (device.Client, error)
+	ControlConfig(ctx context.Context, device types.Device) (device.Config, error)
+	Lookup(name string) (device.Driver, error)
+}
+
+type CampaignRunner interface {
+	Start(ctx context.Context, campaign types.Campaign) error
+	Cancel(campaignID string) bool
+}
+
+type ConfigReconciler interface {
+	Reconcile(ctx context.Context, config types.DeviceConfig) (types.DeviceConfig, error)
 }
 
+const (
+	DefaultImportConcurrency               = 8
+	DefaultProbeTimeout      time.Duration = 5 * time.Second
+	// Anomalies, version changes and events are recorded at the start of the transaction of
+	// their sample, a stream re-reads this period to pick up records that were committed late
+	DefaultStreamOverlap time.Duration = 5 * time.Second
+)
+
+var (
+	ErrorCampaignNotRunning = errors.New("campaign is not running")
+	ErrorInvalidTransition  = errors.New("invalid device status transition")
+	ErrorInsufficientData   = errors.New("insufficient diagnostics history to forecast")
+	ErrorRebootTimeout      = errors.New("timeout waiting for device to report healthy after reboot")
+)
+
 type MonitorService struct {
 	persistence PersistenceProvider
 	device      DeviceProvider
+	campaigns   CampaignRunner
+	reconciler  ConfigReconciler
 	config      types.Config
 	logger      *zap.Logger
 }
@@ -35,12 +153,16 @@ type MonitorService struct {
 func NewMonitorService(
 	persistence PersistenceProvider,
 	device DeviceProvider,
+	campaigns CampaignRunner,
+	reconciler ConfigReconciler,
 	config types.Config,
 	logger *zap.Logger,
 ) *MonitorService {
 	return &MonitorService{
 		persistence: persistence,
 		device:      device,
+		campaigns:   campaigns,
+		reconciler:  reconciler,
 		config:      config,
 		logger:      logger,
 	}
@@ -50,58 +172,225 @@ func (s *MonitorService) RegisterDevice(
 	ctx context.Context,
 	reg types.DeviceRegistration,
 ) (types.Device, error) {
-	port := reg.Port
-	if reg.Protocol.IsHttp() {
-		port = reg.GatewayPort
-	}
-	client, err := s.device.CreateClient(device.Config{
-		Protocol: reg.Protocol,
-		Host:     reg.Host,
-		Port:     port,
-	})
+	reg = named(reg)
+	health, err := s.probe(ctx, reg)
 	if err != nil {
 		return types.Device{}, err
 	}
-	health, err := client.GetHealth(ctx)
+	return s.register(ctx, *health, reg)
+}
+
+// ImportDevices registers the devices of an inventory. The devices are probed concurrently
+// and a device is only registered again if its registration or reported health changed. With
+// prune, registered devices missing from the inventory are deleted unless an entry failed,
+// since a failed entry may refer to a registered device.
+func (s *MonitorService) ImportDevices(
+	ctx context.Context,
+	entries []types.InventoryEntry,
+	prune bool,
+) (types.ImportReport, error) {
+	devices, err := s.persistence.ListDevices(ctx)
 	if err != nil {
-		return types.Device{}, err
+		return types.ImportReport{}, err
+	}
+	registered := make(map[string]types.Device, len(devices))
+	for _, dev := range devices {
+		registered[deref(dev.Identifier)] = dev
+	}
+	healths := make([]*types.DeviceHealthStatus, len(entries))
+	errs := make([]error, len(entries))
+	for i := range entries {
+		entries[i].Registration = named(entries[i].Registration)
 	}
-	device, err := s.persistence.RegisterDevice(ctx, *health, reg)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(DefaultImportConcurrency)
+	for i, entry := range entries {
+		if entry.Error != nil {
+			continue
+		}
+		g.Go(func() error {
+			probeCtx, cancel := context.WithTimeout(gctx, DefaultProbeTimeout)
+			defer cancel()
+			healths[i], errs[i] = s.probe(probeCtx, entry.Registration)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var (
+		report = types.ImportReport{Results: make([]types.ImportResult, len(entries))}
+		listed = make(map[string]int, len(entries))
+		failed bool
+	)
+	for i, entry := range entries {
+		result := types.ImportResult{Line: entry.Line, DeviceID: entry.DeviceID}
+		err := cmp.Or(entry.Error, errs[i])
+		if err == nil {
+			identifier := healths[i].Identifier
+			switch line, ok := listed[identifier]; {
+			case entry.DeviceID != "" && entry.DeviceID != identifier:
+				err = fmt.Errorf("device reports identifier '%s'", identifier)
+			case ok:
+				err = fmt.Errorf("duplicate device '%s' (line %d)", identifier, line)
+			default:
+				listed[identifier] = entry.Line
+				result.DeviceID = identifier
+				result.Status, err = s.importDevice(ctx, registered, *healths[i], entry.Registration)
+			}
+		}
+		if err != nil {
+			result.Status, result.Error = types.ImportStatusFailed, err
+			failed = true
+		}
+		report.Results[i] = result
+	}
+	if !prune || failed {
+		return report, nil
+	}
+	report.Pruned = true
+	for _, deviceID := range slices.Sorted(maps.Keys(registered)) {
+		if _, ok := listed[deviceID]; ok {
+			continue
+		}
+		result := types.ImportResult{DeviceID: deviceID, Status: types.ImportStatusPruned}
+		if err := s.persistence.DeleteDevice(ctx, deviceID); err != nil {
+			result.Status, result.Error = types.ImportStatusFailed, err
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+func (s *MonitorService) importDevice(
+	ctx context.Context,
+	registered map[string]types.Device,
+	health types.DeviceHealthStatus,
+	reg types.DeviceRegistration,
+) (types.ImportStatus, error) {
+	dev, exists := registered[health.Identifier]
+	if exists && unchanged(dev, health, reg) {
+		return types.ImportStatusUnchanged, nil
+	}
+	if _, err := s.register(ctx, health, reg); err != nil {
+		return types.ImportStatusFailed, err
+	}
+	if exists {
+		return types.ImportStatusUpdated, nil
+	}
+	return types.ImportStatusCreated, nil
+}
+
+// register stores a device and adds its registration to the timeline of the device
+func (s *MonitorService) register(
+	ctx context.Context,
+	health types.DeviceHealthStatus,
+	reg types.DeviceRegistration,
+) (types.Device, error) {
+	device, err := s.persistence.RegisterDevice(ctx, health, reg)
 	if err != nil {
 		return types.Device{}, err
 	}
+	message := "device registered"
+	if !deref(device.Created).Equal(deref(device.Updated)) {
+		message = "device registration updated"
+	}
+	err = s.persistence.SaveDeviceEvent(ctx, types.EventRecord{
+		DeviceID: health.Identifier,
+		Type:     types.EventTypeRegistered,
+		Severity: types.EventSeverityInfo,
+		Message:  message,
+		Details: map[string]string{
+			"host":         reg.Host,
+			"architecture": health.Architecture,
+			"os":           health.OS,
+		},
+		Occurred: time.Now(),
+	})
+	if err != nil {
+		s.logger.Warn(
+			"failed to record device event",
+			zap.String("device_id", health.Identifier),
+			zap.Error(err),
+		)
+	}
 	return device, nil
 }
 
+// named sets the driver of a registration, devices registered with a built-in protocol are
+// served by the driver named after the protocol
+func named(reg types.DeviceRegistration) types.DeviceRegistration {
+	reg.Driver = cmp.Or(reg.Driver, reg.Protocol.Driver())
+	return reg
+}
+
+// probe requests the health of a device through the driver of its registration
+func (s *MonitorService) probe(
+	ctx context.Context,
+	reg types.DeviceRegistration,
+) (*types.DeviceHealthStatus, error) {
+	driver, err := s.device.Lookup(reg.Driver)
+	if err != nil {
+		return nil, err
+	}
+	port := reg.Port
+	if driver.Gateway {
+		port = reg.GatewayPort
+	}
+	client, err := driver.New(device.Config{
+		Protocol:   driver.Protocol,
+		Driver:     driver.Name,
+		Identifier: reg.Identifier,
+		Host:       reg.Host,
+		Port:       port,
+		Signing:    reg.Signing,
+		Snmp:       reg.Snmp,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close() // nolint:errcheck
+	return client.GetHealth(ctx)
+}
+
+// ListDevices returns the registered devices together with their compliance with the
+// compliance policies matching their labels.
 func (s *MonitorService) ListDevices(ctx context.Context) ([]types.Device, error) {
-	return s.persistence.ListDevices(ctx)
+	devices, err := s.persistence.ListDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.persistence.ListSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.evaluate(ctx, snapshots)
+	if err != nil {
+		return nil, err
+	}
+	for i := range devices {
+		devices[i].Compliance = results[deref(devices[i].Identifier)]
+	}
+	return devices, nil
 }
 
+// UpdateDevice changes the status of a device if the transition from its persisted status is
+// allowed and records the transition as caused by the operator.
 func (s *MonitorService) UpdateDevice(ctx context.Context, deviceID string, status types.DeviceStatus) error {
 	result, err := s.persistence.GetDevice(ctx, deviceID)
 	if err != nil {
 		return err
 	}
-	supported := *result.SupportedProtocols
-	if len(supported) == 0 {
-		return errors.New("device has no supported protocols")
-	}
-	protocol := types.Protocol(supported[0])
-	for _, p := range supported {
-		if proto := types.Protocol(p); proto.IsGrpc() {
-			protocol = proto
-			break
+	if diag, err := s.persistence.GetDiagnostics(ctx, deviceID); err == nil && diag.DeviceStatus != nil {
+		current := types.DeviceStatusFromString(*diag.DeviceStatus)
+		if !current.CanTransition(status) {
+			return fmt.Errorf("%w: %s to %s", ErrorInvalidTransition, current, status)
 		}
 	}
-	port := result.Port
-	if protocol.IsHttp() {
-		port = result.GatewayPort
+	config, err := s.device.ControlConfig(ctx, result)
+	if err != nil {
+		return err
 	}
-	client, err := s.device.CreateClient(device.Config{
-		Protocol: protocol,
-		Host:     *result.Host,
-		Port:     *port,
-	})
+	client, err := s.device.CreateClient(config)
 	if err != nil {
 		return err
 	}
@@ -109,13 +398,138 @@ func (s *MonitorService) UpdateDevice(ctx context.Context, deviceID string, stat
 	if err != nil {
 		return err
 	}
+	err = s.persistence.RecordStatusTransition(ctx, deviceID, status, types.TransitionCauseOperator)
+	if err != nil {
+		s.logger.Error("failed to record status transition", zap.String("device", deviceID), zap.Error(err))
+	}
 	return nil
 }
 
+// RebootDevice reboots a device and waits until the monitor receives diagnostics reporting
+// the device healthy again, the downtime is measured until that sample. A zero duration or
+// timeout falls back to the device and monitor defaults.
+func (s *MonitorService) RebootDevice(
+	ctx context.Context,
+	deviceID string,
+	duration time.Duration,
+	timeout time.Duration,
+) (types.Diagnostics, time.Duration, error) {
+	result, err := s.persistence.GetDevice(ctx, deviceID)
+	if err != nil {
+		return types.Diagnostics{}, 0, err
+	}
+	config, err := s.device.ControlConfig(ctx, result)
+	if err != nil {
+		return types.Diagnostics{}, 0, err
+	}
+	client, err := s.device.CreateClient(config)
+	if err != nil {
+		return types.Diagnostics{}, 0, err
+	}
+	defer client.Close() //nolint:errcheck
+	if timeout <= 0 {
+		timeout = s.config.RebootTimeout
+	}
+	ctx, cancel := context.WithTimeoutCause(ctx, timeout, ErrorRebootTimeout)
+	defer cancel()
+	started := time.Now()
+	if err := client.Reboot(ctx, duration); err != nil {
+		return types.Diagnostics{}, 0, err
+	}
+	// The device reports BOOTING before responding, later samples are healthy once booted
+	rebooted := time.Now()
+	ticker := time.NewTicker(s.config.StreamInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return types.Diagnostics{}, 0, context.Cause(ctx)
+		case <-ticker.C:
+		}
+		diag, err := s.persistence.GetDiagnostics(ctx, deviceID)
+		if err != nil || diag.LastUpdated == nil || !diag.LastUpdated.After(rebooted) {
+			continue
+		}
+		if diag.DeviceStatus != nil &&
+			types.DeviceStatusFromString(*diag.DeviceStatus) == types.DeviceStatusHealthy {
+			return diag, diag.LastUpdated.Sub(started), nil
+		}
+	}
+}
+
+// SetDeviceConfig stores the desired config of a device and applies it right away, a device
+// that cannot be reached is reconciled on the next interval.
+func (s *MonitorService) SetDeviceConfig(
+	ctx context.Context,
+	deviceID string,
+	desired types.DesiredConfig,
+) (types.DeviceConfig, error) {
+	if _, err := s.persistence.GetDevice(ctx, deviceID); err != nil {
+		return types.DeviceConfig{}, err
+	}
+	if desired.DriftPolicy == "" {
+		desired.DriftPolicy = types.DriftPolicyReapply
+	}
+	config, err := s.persistence.SaveDeviceConfig(ctx, deviceID, desired)
+	if err != nil {
+		return types.DeviceConfig{}, err
+	}
+	return s.reconciler.Reconcile(ctx, config)
+}
+
+// DeleteDevice unregisters a device, the history of the device is removed with it
+func (s *MonitorService) DeleteDevice(ctx context.Context, deviceID string) error {
+	return s.persistence.DeleteDevice(ctx, deviceID)
+}
+
+func (s *MonitorService) GetDeviceConfig(ctx context.Context, deviceID string) (types.DeviceConfig, error) {
+	return s.persistence.GetDeviceConfig(ctx, deviceID)
+}
+
+// DeleteDeviceConfig stops the reconciliation of a device, the applied config is kept
+func (s *MonitorService) DeleteDeviceConfig(ctx context.Context, deviceID string) error {
+	return s.persistence.DeleteDeviceConfig(ctx, deviceID)
+}
+
 func (s *MonitorService) GetDiagnostics(ctx context.Context, deviceID string) (types.Diagnostics, error) {
 	return s.persistence.GetDiagnostics(ctx, deviceID)
 }
 
+func (s *MonitorService) ListDiagnostics(
+	ctx context.Context,
+	deviceID string,
+	query types.DiagnosticsQuery,
+) ([]types.Diagnostics, error) {
+	if _, err := s.persistence.GetDevice(ctx, deviceID); err != nil {
+		return nil, err
+	}
+	return s.persistence.ListDiagnostics(ctx, deviceID, query)
+}
+
+func (s *MonitorService) ListStatusTransitions(
+	ctx context.Context,
+	deviceID string,
+	query types.DiagnosticsQuery,
+) ([]types.StatusTransition, error) {
+	if _, err := s.persistence.GetDevice(ctx, deviceID); err != nil {
+		return nil, err
+	}
+	return s.persistence.ListStatusTransitions(ctx, deviceID, query)
+}
+
+// ExportDiagnostics writes the samples matching the query to w in the requested format,
+// chunk by chunk as they are read from the database.
+func (s *MonitorService) ExportDiagnostics(ctx context.Context, query types.ExportQuery, w io.Writer) error {
+	writer, err := export.NewWriter(query.Format, w)
+	if err != nil {
+		return err
+	}
+	if err := s.persistence.ExportDiagnostics(ctx, query, writer.Write); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
 func (s *MonitorService) StreamDiagnostics(ctx context.Context, deviceID string) <-chan types.Diagnostics {
 	ch := make(chan types.Diagnostics)
 	interval := s.config.StreamInterval
@@ -143,3 +557,586 @@ func (s *MonitorService) StreamDiagnostics(ctx context.Context, deviceID string)
 	}()
 	return ch
 }
+
+func (s *MonitorService) ListAnomalies(
+	ctx context.Context,
+	query types.AnomalyQuery,
+) ([]types.Anomaly, error) {
+	if query.DeviceID != "" {
+		if _, err := s.persistence.GetDevice(ctx, query.DeviceID); err != nil {
+			return nil, err
+		}
+	}
+	return s.persistence.ListAnomalies(ctx, query)
+}
+
+// StreamAnomalies polls for the anomalies recorded after the stream is opened, of a device or
+// of all devices if none is given.
+func (s *MonitorService) StreamAnomalies(ctx context.Context, deviceID string) (<-chan types.Anomaly, error) {
+	if deviceID != "" {
+		if _, err := s.persistence.GetDevice(ctx, deviceID); err != nil {
+			return nil, err
+		}
+	}
+	return stream(
+		ctx,
+		s,
+		deviceID,
+		s.persistence.ListRecordedAnomalies,
+		func(a types.Anomaly) (string, time.Time) {
+			return deref(a.ID), deref(a.Recorded)
+		},
+	), nil
+}
+
+func (s *MonitorService) GetAnomalySettings(
+	ctx context.Context,
+	deviceID string,
+) (types.AnomalySettings, error) {
+	return s.persistence.GetAnomalySettings(ctx, deviceID)
+}
+
+func (s *MonitorService) SetAnomalySensitivity(
+	ctx context.Context,
+	deviceID string,
+	sensitivity types.Sensitivity,
+) (types.AnomalySettings, error) {
+	return s.persistence.SaveAnomalySettings(ctx, deviceID, sensitivity)
+}
+
+// GetAvailabilityReport computes the availability of the devices over a period (capped at
+// the current time) per device, for the whole fleet and, if grouped by a label, per label
+// value. Labels are taken from the desired device configuration, devices without the label
+// are grouped under an empty value.
+func (s *MonitorService) GetAvailabilityReport(
+	ctx context.Context,
+	query types.ReportQuery,
+) (types.AvailabilityReport, error) {
+	if now := time.Now(); query.To.After(now) {
+		query.To = now
+	}
+	histories, err := s.persistence.ListStatusHistories(ctx, query.From, query.To, query.DeviceIDs)
+	if err != nil {
+		return types.AvailabilityReport{}, err
+	}
+	configs, err := s.persistence.ListDeviceConfigs(ctx)
+	if err != nil {
+		return types.AvailabilityReport{}, err
+	}
+	labels := make(map[string]map[string]string, len(configs))
+	for _, config := range configs {
+		labels[deref(config.DeviceID)] = config.Labels
+	}
+	report := types.AvailabilityReport{
+		Query:   query,
+		Devices: make([]types.DeviceAvailability, len(histories)),
+	}
+	groups := make(map[string]*types.GroupAvailability)
+	for i, history := range histories {
+		deviceID := deref(history.DeviceID)
+		result := availability.Compute(history, query.To, query.ExcludeMaintenance)
+		report.Devices[i] = types.DeviceAvailability{
+			DeviceID:     deviceID,
+			Labels:       labels[deviceID],
+			Availability: result,
+		}
+		report.Fleet.Add(result)
+		if query.GroupBy == "" {
+			continue
+		}
+		value := labels[deviceID][query.GroupBy]
+		group, ok := groups[value]
+		if !ok {
+			group = &types.GroupAvailability{Value: value}
+			groups[value] = group
+		}
+		group.DeviceIDs = append(group.DeviceIDs, deviceID)
+		group.Availability.Add(result)
+	}
+	for _, value := range slices.Sorted(maps.Keys(groups)) {
+		report.Groups = append(report.Groups, *groups[value])
+	}
+	return report, nil
+}
+
+// GetVersionInventory aggregates the latest reported versions of the devices for the whole
+// fleet or per model (hardware version) or architecture. Only the versions of the requested
+// component are counted (all components if unset). If a version is given, only the devices
+// running a version of the component (firmware if unset) below it are included.
+func (s *MonitorService) GetVersionInventory(
+	ctx context.Context,
+	query types.VersionQuery,
+) (types.VersionInventory, error) {
+	snapshots, err := s.persistence.ListSnapshots(ctx)
+	if err != nil {
+		return types.VersionInventory{}, err
+	}
+	results, err := s.evaluate(ctx, snapshots)
+	if err != nil {
+		return types.VersionInventory{}, err
+	}
+	components := []types.VersionComponent{
+		types.VersionComponentHardware,
+		types.VersionComponentSoftware,
+		types.VersionComponentFirmware,
+	}
+	if query.Component != "" {
+		components = []types.VersionComponent{query.Component}
+	}
+	below := cmp.Or(query.Component, types.VersionComponentFirmware)
+	inventory := types.VersionInventory{GroupBy: query.GroupBy}
+	groups := make(map[string]map[types.VersionComponent]map[string][]string)
+	members := make(map[string]int)
+	for _, snapshot := range snapshots {
+		versions := reported(snapshot)
+		if versions == nil {
+			continue
+		}
+		current := versions.Version(below)
+		if query.Below != "" && (current == "" || version.Compare(current, query.Below) >= 0) {
+			continue
+		}
+		deviceID := deref(snapshot.Identifier)
+		inventory.Devices = append(inventory.Devices, types.DeviceInventory{
+			DeviceID:     deviceID,
+			Architecture: deref(snapshot.Architecture),
+			Versions:     *versions,
+			Compliance:   results[deviceID].Status,
+		})
+		var value string
+		switch query.GroupBy {
+		case types.VersionGroupingModel:
+			value = versions.Hardware
+		case types.VersionGroupingArchitecture:
+			value = deref(snapshot.Architecture)
+		}
+		if _, ok := groups[value]; !ok {
+			groups[value] = make(map[types.VersionComponent]map[string][]string)
+		}
+		members[value]++
+		for _, component := range components {
+			if versions.Version(component) == "" {
+				continue
+			}
+			if _, ok := groups[value][component]; !ok {
+				groups[value][component] = make(map[string][]string)
+			}
+			groups[value][component][versions.Version(component)] = append(
+				groups[value][component][versions.Version(component)],
+				deviceID,
+			)
+		}
+	}
+	for _, value := range slices.Sorted(maps.Keys(groups)) {
+		group := types.VersionGroup{Value: value, Devices: members[value]}
+		for _, component := range components {
+			deployed := groups[value][component]
+			for _, v := range slices.SortedFunc(maps.Keys(deployed), version.Compare) {
+				group.Versions = append(group.Versions, types.VersionCount{
+					Component: component,
+					Version:   v,
+					DeviceIDs: deployed[v],
+				})
+			}
+		}
+		inventory.Groups = append(inventory.Groups, group)
+	}
+	return inventory, nil
+}
+
+func (s *MonitorService) SetCompliancePolicy(
+	ctx context.Context,
+	policy types.CompliancePolicy,
+) (types.CompliancePolicy, error) {
+	return s.persistence.SaveCompliancePolicy(ctx, policy)
+}
+
+func (s *MonitorService) ListCompliancePolicies(ctx context.Context) ([]types.CompliancePolicy, error) {
+	return s.persistence.ListCompliancePolicies(ctx)
+}
+
+func (s *MonitorService) DeleteCompliancePolicy(ctx context.Context, name string) error {
+	return s.persistence.DeleteCompliancePolicy(ctx, name)
+}
+
+// evaluate checks the latest reported versions of the devices against the compliance policies
+// matching the labels of their desired configuration.
+func (s *MonitorService) evaluate(
+	ctx context.Context,
+	snapshots []types.Diagnostics,
+) (map[string]types.Compliance, error) {
+	policies, err := s.persistence.ListCompliancePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := s.persistence.ListDeviceConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]map[string]string, len(configs))
+	for _, config := range configs {
+		labels[deref(config.DeviceID)] = config.Labels
+	}
+	result := make(map[string]types.Compliance, len(snapshots))
+	for _, snapshot := range snapshots {
+		deviceID := deref(snapshot.Identifier)
+		result[deviceID] = compliance.Evaluate(policies, labels[deviceID], reported(snapshot))
+	}
+	return result, nil
+}
+
+func (s *MonitorService) ListDeviceEvents(
+	ctx context.Context,
+	query types.DeviceEventQuery,
+) ([]types.DeviceEvent, error) {
+	if query.DeviceID != "" {
+		if _, err := s.persistence.GetDevice(ctx, query.DeviceID); err != nil {
+			return nil, err
+		}
+	}
+	return s.persistence.ListDeviceEvents(ctx, query)
+}
+
+// StreamDeviceEvents polls for the events recorded after the stream is opened that match the
+// device, types and minimum severity of the query (the time range and limit are ignored).
+func (s *MonitorService) StreamDeviceEvents(
+	ctx context.Context,
+	query types.DeviceEventQuery,
+) (<-chan types.DeviceEvent, error) {
+	if query.DeviceID != "" {
+		if _, err := s.persistence.GetDevice(ctx, query.DeviceID); err != nil {
+			return nil, err
+		}
+	}
+	list := func(ctx context.Context, device string, after time.Time) ([]types.DeviceEvent, error) {
+		events, err := s.persistence.ListRecordedDeviceEvents(ctx, device, after)
+		if err != nil {
+			return nil, err
+		}
+		return slices.DeleteFunc(events, func(e types.DeviceEvent) bool {
+			return !query.Matches(e)
+		}), nil
+	}
+	return stream(ctx, s, query.DeviceID, list, func(e types.DeviceEvent) (string, time.Time) {
+		return deref(e.ID), deref(e.Recorded)
+	}), nil
+}
+
+func (s *MonitorService) ListVersionChanges(
+	ctx context.Context,
+	query types.VersionChangeQuery,
+) ([]types.VersionChange, error) {
+	if query.DeviceID != "" {
+		if _, err := s.persistence.GetDevice(ctx, query.DeviceID); err != nil {
+			return nil, err
+		}
+	}
+	return s.persistence.ListVersionChanges(ctx, query)
+}
+
+// StreamVersionChanges polls for the version changes recorded after the stream is opened, of
+// a device (all devices if none is given) and of a component (all components if unset).
+func (s *MonitorService) StreamVersionChanges(
+	ctx context.Context,
+	deviceID string,
+	component types.VersionComponent,
+) (<-chan types.VersionChange, error) {
+	if deviceID != "" {
+		if _, err := s.persistence.GetDevice(ctx, deviceID); err != nil {
+			return nil, err
+		}
+	}
+	list := func(ctx context.Context, device string, after time.Time) ([]types.VersionChange, error) {
+		return s.persistence.ListRecordedVersionChanges(ctx, device, component, after)
+	}
+	return stream(ctx, s, deviceID, list, func(c types.VersionChange) (string, time.Time) {
+		return deref(c.ID), deref(c.Recorded)
+	}), nil
+}
+
+// GetForecast fits the trend of a metric over the hourly aggregates of the history of a
+// device or, without a device, of every device with enough history, ranked by how soon they
+// reach the threshold. Holt-Winters falls back to a linear fit for histories shorter than two
+// seasons.
+func (s *MonitorService) GetForecast(
+	ctx context.Context,
+	query types.ForecastQuery,
+) ([]types.Forecast, error) {
+	if query.DeviceID != "" {
+		if _, err := s.persistence.GetDevice(ctx, query.DeviceID); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	aggregates, err := s.persistence.ListHourlyAggregates(ctx, query.DeviceID, now.Add(-query.History), now)
+	if err != nil {
+		return nil, err
+	}
+	series := make(map[string][]forecast.Point)
+	samples := make(map[string]int)
+	for _, aggregate := range aggregates {
+		value, ok := aggregate.Value(query.Metric)
+		if !ok {
+			continue
+		}
+		deviceID := deref(aggregate.DeviceID)
+		series[deviceID] = append(series[deviceID], forecast.Point{Time: deref(aggregate.Hour), Value: value})
+		samples[deviceID] += int(deref(aggregate.Samples))
+	}
+	forecasts := make([]types.Forecast, 0, len(series))
+	for _, deviceID := range slices.Sorted(maps.Keys(series)) {
+		points := series[deviceID]
+		fit, err := forecast.Linear(points, query.Horizon)
+		if query.Model == types.ForecastModelHoltWinters {
+			if seasonal, err := forecast.HoltWinters(points, query.Horizon); err == nil {
+				fit = seasonal
+			}
+		}
+		if err != nil {
+			continue
+		}
+		latest := points[len(points)-1].Time
+		forecasts = append(forecasts, types.Forecast{
+			DeviceID:    deviceID,
+			Metric:      query.Metric,
+			Model:       fit.Model,
+			Current:     fit.Current,
+			Trend:       fit.Trend,
+			Threshold:   query.Threshold,
+			ThresholdAt: forecast.Crossing(fit, latest, query.Threshold),
+			Samples:     samples[deviceID],
+			Points:      fit.Projections,
+		})
+	}
+	if query.DeviceID != "" {
+		if len(forecasts) == 0 {
+			return nil, ErrorInsufficientData
+		}
+		return forecasts, nil
+	}
+	slices.SortStableFunc(forecasts, func(a, b types.Forecast) int {
+		switch {
+		case a.ThresholdAt != nil && b.ThresholdAt != nil:
+			return a.ThresholdAt.Compare(*b.ThresholdAt)
+		case a.ThresholdAt != nil:
+			return -1
+		case b.ThresholdAt != nil:
+			return 1
+		}
+		return cmp.Compare(projected(b), projected(a))
+	})
+	return forecasts[:min(len(forecasts), query.Top)], nil
+}
+
+// CreateCampaign targets the devices matching the selector that do not run the target
+// version yet and splits them into waves ordered by device identifier. A campaign without
+// any targeted devices is completed right away. Unset rollout settings default to the
+// campaign configuration of the monitor.
+func (s *MonitorService) CreateCampaign(
+	ctx context.Context,
+	spec types.CampaignSpec,
+) (types.Campaign, error) {
+	if spec.WaveSize <= 0 {
+		spec.WaveSize = max(s.config.Campaign.WaveSize, 1)
+	}
+	if spec.MaxUnavailable <= 0 {
+		spec.MaxUnavailable = max(s.config.Campaign.MaxUnavailable, 1)
+	}
+	if spec.WaveTimeout <= 0 {
+		spec.WaveTimeout = s.config.Campaign.WaveTimeout
+	}
+	if spec.FailurePolicy == "" {
+		spec.FailurePolicy = types.FailurePolicyHalt
+	}
+	snapshots, err := s.persistence.ListSnapshots(ctx)
+	if err != nil {
+		return types.Campaign{}, err
+	}
+	snapshots = slices.DeleteFunc(snapshots, func(diag types.Diagnostics) bool {
+		return diag.Identifier == nil || diag.Firmware == nil ||
+			*diag.Firmware == spec.TargetVersion || !spec.Selector.Matches(diag)
+	})
+	slices.SortFunc(snapshots, func(a, b types.Diagnostics) int {
+		return strings.Compare(*a.Identifier, *b.Identifier)
+	})
+	pending := string(types.CampaignDeviceStatusPending)
+	devices := make([]types.CampaignDevice, len(snapshots))
+	for i, diag := range snapshots {
+		wave := int32(i/spec.WaveSize + 1) // nolint:gosec
+		devices[i] = types.CampaignDevice{
+			DeviceID:        diag.Identifier,
+			Wave:            &wave,
+			Status:          &pending,
+			PreviousVersion: diag.Firmware,
+		}
+	}
+	status := types.CampaignStatusPending
+	if len(devices) == 0 {
+		status = types.CampaignStatusCompleted
+	}
+	var (
+		waves          = int32((len(devices) + spec.WaveSize - 1) / spec.WaveSize) // nolint:gosec
+		waveSize       = int32(spec.WaveSize)                                      // nolint:gosec
+		maxUnavailable = int32(spec.MaxUnavailable)                                // nolint:gosec
+		waveTimeout    = spec.WaveTimeout.Milliseconds()
+		policy         = string(spec.FailurePolicy)
+		statusValue    = string(status)
+	)
+	campaign, err := s.persistence.CreateCampaign(ctx, types.Campaign{
+		MonitorID:      &s.config.Identifier,
+		TargetVersion:  &spec.TargetVersion,
+		Selector:       &spec.Selector,
+		WaveSize:       &waveSize,
+		MaxUnavailable: &maxUnavailable,
+		WaveTimeout:    &waveTimeout,
+		FailurePolicy:  &policy,
+		Status:         &statusValue,
+		TotalWaves:     &waves,
+		Devices:        devices,
+	})
+	if err != nil {
+		return types.Campaign{}, err
+	}
+	if status == types.CampaignStatusPending {
+		if err := s.campaigns.Start(ctx, campaign); err != nil {
+			// The campaign is halted so it is not left pending without being executed
+			message := fmt.Sprintf("failed to start campaign: %s", err)
+			if err := s.persistence.UpdateCampaign(
+				context.WithoutCancel(ctx), deref(campaign.ID), types.CampaignStatusHalted, 0, message,
+			); err != nil {
+				s.logger.Error(
+					"failed to halt campaign",
+					zap.String("campaign_id", deref(campaign.ID)),
+					zap.Error(err),
+				)
+			}
+			return types.Campaign{}, err
+		}
+	}
+	return campaign, nil
+}
+
+func (s *MonitorService) GetCampaign(ctx context.Context, campaignID string) (types.Campaign, error) {
+	return s.persistence.GetCampaign(ctx, campaignID)
+}
+
+func (s *MonitorService) ListCampaigns(ctx context.Context) ([]types.Campaign, error) {
+	return s.persistence.ListCampaigns(ctx)
+}
+
+// CancelCampaign stops a campaign executed by this monitor, upgrades already requested
+// from devices are not reverted.
+func (s *MonitorService) CancelCampaign(ctx context.Context, campaignID string) error {
+	if _, err := s.persistence.GetCampaign(ctx, campaignID); err != nil {
+		return err
+	}
+	if !s.campaigns.Cancel(campaignID) {
+		return ErrorCampaignNotRunning
+	}
+	return nil
+}
+
+// stream polls for the records recorded after the stream is opened, of a device or of all
+// devices if none is given. Every poll re-reads the overlap period to pick up records that were
+// committed late, records that have already been sent are skipped.
+func stream[T any](
+	ctx context.Context,
+	s *MonitorService,
+	deviceID string,
+	list func(ctx context.Context, device string, after time.Time) ([]T, error),
+	key func(T) (string, time.Time),
+) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(s.config.StreamInterval)
+		defer ticker.Stop()
+		opened := time.Now()
+		cursor := opened
+		sent := make(map[string]time.Time)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				records, err := list(ctx, deviceID, cursor.Add(-DefaultStreamOverlap))
+				if err != nil {
+					s.logger.Error(
+						"failed to list records for streaming",
+						zap.String("device", deviceID),
+						zap.Error(err),
+					)
+					continue
+				}
+				for _, record := range records {
+					id, recorded := key(record)
+					if _, ok := sent[id]; ok || recorded.Before(opened) {
+						continue
+					}
+					sent[id] = recorded
+					if recorded.After(cursor) {
+						cursor = recorded
+					}
+					select {
+					case ch <- record:
+					case <-ctx.Done():
+						return
+					}
+				}
+				for id, recorded := range sent {
+					if recorded.Before(cursor.Add(-DefaultStreamOverlap)) {
+						delete(sent, id)
+					}
+				}
+			}
+		}
+	}()
+	return ch
+}
+
+// unchanged reports whether registering the device again would not change it
+func unchanged(dev types.Device, health types.DeviceHealthStatus, reg types.DeviceRegistration) bool {
+	protocols := make([]string, len(health.SupportedProtocols))
+	for i, protocol := range health.SupportedProtocols {
+		protocols[i] = protocol.String()
+	}
+	return deref(dev.Alias) == reg.Alias &&
+		deref(dev.Host) == reg.Host &&
+		deref(dev.Port) == reg.Port &&
+		deref(dev.GatewayPort) == reg.GatewayPort &&
+		dev.Signing() == reg.Signing &&
+		dev.Snmp() == reg.Snmp &&
+		deref(dev.Driver) == reg.Driver &&
+		deref(dev.Architecture) == health.Architecture &&
+		deref(dev.OS) == health.OS &&
+		slices.Equal(deref(dev.SupportedProtocols), protocols)
+}
+
+// reported returns the latest versions reported by a device (the snapshot carries the versions
+// of the latest sample that reported any), nil if the device has not reported versions
+func reported(snapshot types.Diagnostics) *types.DeviceVersions {
+	if deref(snapshot.Hardware) == "" && deref(snapshot.Software) == "" && deref(snapshot.Firmware) == "" {
+		return nil
+	}
+	return &types.DeviceVersions{
+		Hardware: deref(snapshot.Hardware),
+		Software: deref(snapshot.Software),
+		Firmware: deref(snapshot.Firmware),
+	}
+}
+
+// projected returns the value of a forecast at the end of its horizon
+func projected(f types.Forecast) float64 {
+	if len(f.Points) == 0 {
+		return f.Current
+	}
+	return f.Points[len(f.Points)-1].Value
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+	"go.uber.org/zap"
+)
+
+// persistence serves snapshots and compliance policies, other methods are not used by the tests
+type persistence struct {
+	PersistenceProvider
+	snapshots []types.Diagnostics
+	policies  []types.CompliancePolicy
+}
+
+func (p *persistence) ListDevices(context.Context) ([]types.Device, error) {
+	devices := make([]types.Device, len(p.snapshots))
+	for i, snapshot := range p.snapshots {
+		devices[i] = types.Device{Identifier: snapshot.Identifier}
+	}
+	return devices, nil
+}
+
+func (p *persistence) ListSnapshots(context.Context) ([]types.Diagnostics, error) {
+	return p.snapshots, nil
+}
+
+func (p *persistence) ListCompliancePolicies(context.Context) ([]types.CompliancePolicy, error) {
+	return p.policies, nil
+}
+
+func (p *persistence) ListDeviceConfigs(context.Context) ([]types.DeviceConfig, error) {
+	return nil, nil
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+// snapshot returns the snapshot of a device with versions, nil versions are not reported
+func snapshot(deviceID string, versions *types.DeviceVersions) types.Diagnostics {
+	result := types.Diagnostics{Identifier: &deviceID, Architecture: ptr("arm64")}
+	if versions != nil {
+		result.Hardware = &versions.Hardware
+		result.Software = &versions.Software
+		result.Firmware = &versions.Firmware
+	}
+	return result
+}
+
+func TestReported(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot types.Diagnostics
+		expected *types.DeviceVersions
+	}{
+		{name: "should return nil without diagnostics", snapshot: snapshot("router", nil)},
+		{
+			name:     "should return nil without reported versions (e.g. offline sample)",
+			snapshot: snapshot("router", &types.DeviceVersions{}),
+		},
+		{
+			name:     "should return partially reported versions",
+			snapshot: snapshot("router", &types.DeviceVersions{Firmware: "FW:1.0.0"}),
+			expected: &types.DeviceVersions{Firmware: "FW:1.0.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := reported(tt.snapshot)
+			if (result == nil) != (tt.expected == nil) || (result != nil && *result != *tt.expected) {
+				t.Errorf("expected versions %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMonitorService_Compliance(t *testing.T) {
+	p := &persistence{
+		snapshots: []types.Diagnostics{
+			snapshot(
+				"outdated",
+				&types.DeviceVersions{Hardware: "HW:1", Software: "SW:1", Firmware: "FW:1.0.0"},
+			),
+			snapshot(
+				"current",
+				&types.DeviceVersions{Hardware: "HW:1", Software: "SW:1", Firmware: "FW:2.0.0"},
+			),
+			// An offline sample of a device that never reported versions
+			snapshot("offline", &types.DeviceVersions{}),
+			snapshot("registered", nil),
+		},
+		policies: []types.CompliancePolicy{{
+			Name:       ptr("minimum-firmware"),
+			Component:  ptr(types.VersionComponentFirmware.String()),
+			MinVersion: ptr("FW:2.0.0"),
+		}},
+	}
+	s := NewMonitorService(p, nil, nil, nil, types.Config{}, zap.NewNop())
+	expected := map[string]types.ComplianceStatus{
+		"outdated":   types.ComplianceStatusNonCompliant,
+		"current":    types.ComplianceStatusCompliant,
+		"offline":    types.ComplianceStatusUnknown,
+		"registered": types.ComplianceStatusUnknown,
+	}
+
+	t.Run("should evaluate compliance of devices", func(t *testing.T) {
+		devices, err := s.ListDevices(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, device := range devices {
+			if status := device.Compliance.Status; status != expected[*device.Identifier] {
+				t.Errorf(
+					"expected %s of %s, got %s",
+					expected[*device.Identifier],
+					*device.Identifier,
+					status,
+				)
+			}
+		}
+	})
+	t.Run("should list devices that reported versions in inventory", func(t *testing.T) {
+		inventory, err := s.GetVersionInventory(context.Background(), types.VersionQuery{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(inventory.Devices) != 2 {
+			t.Fatalf("expected 2 devices, got %+v", inventory.Devices)
+		}
+		for _, device := range inventory.Devices {
+			if device.Compliance != expected[device.DeviceID] {
+				t.Errorf(
+					"expected %s of %s, got %s",
+					expected[device.DeviceID],
+					device.DeviceID,
+					device.Compliance,
+				)
+			}
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package signature
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+var (
+	ErrorInvalidKey = errors.New("invalid signing key")
+)
+
+type Verifier struct {
+	algorithm types.SigningAlgorithm
+	secret    []byte
+	public    ed25519.PublicKey
+}
+
+// NewVerifier creates a verifier from a base64 encoded key registered for the device,
+// the key is either a shared secret (hmac-sha256) or a public key (ed25519). Devices
+// without a registered key yield a verifier that reports samples as unsigned.
+func NewVerifier(signing types.DeviceSigning) (*Verifier, error) {
+	verifier := &Verifier{algorithm: signing.Algorithm}
+	if signing.Algorithm == "" {
+		return verifier, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(signing.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode key (%s): %w", ErrorInvalidKey, signing.Algorithm, err)
+	}
+	switch signing.Algorithm {
+	case types.SigningAlgorithmHmacSha256:
+		if len(key) == 0 {
+			return nil, fmt.Errorf("%w: empty secret (%s)", ErrorInvalidKey, signing.Algorithm)
+		}
+		verifier.secret = key
+	case types.SigningAlgorithmEd25519:
+		if len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf(
+				"%w: unexpected key size %d (%s)",
+				ErrorInvalidKey,
+				len(key),
+				signing.Algorithm,
+			)
+		}
+		verifier.public = ed25519.PublicKey(key)
+	default:
+		return nil, fmt.Errorf("%w: unsupported algorithm %s", ErrorInvalidKey, signing.Algorithm)
+	}
+	return verifier, nil
+}
+
+func (v *Verifier) Verify(data []byte, signature string) types.VerificationStatus {
+	if v.algorithm == "" {
+		return types.VerificationStatusUnsigned
+	}
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(decoded) == 0 {
+		return types.VerificationStatusInvalid
+	}
+	switch v.algorithm {
+	case types.SigningAlgorithmHmacSha256:
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write(data)
+		if hmac.Equal(mac.Sum(nil), decoded) {
+			return types.VerificationStatusAuthentic
+		}
+	case types.SigningAlgorithmEd25519:
+		if ed25519.Verify(v.public, data, decoded) {
+			return types.VerificationStatusAuthentic
+		}
+	}
+	return types.VerificationStatusInvalid
+}
@@ -0,0 +1,56 @@
+package throughput
+
+import (
+	"math"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+// Delta returns the increase of a cumulative counter of a width in bits between two samples.
+// A decreasing counter is a wrap when the previous value was in the upper half of the range of
+// the counter and a reset (device reboot, driver reload) otherwise, where the current value is
+// the increase since the reset. Decreasing counters of unknown width are always resets.
+func Delta(previous, current uint64, bits uint32) uint64 {
+	if current >= previous {
+		return current - previous
+	}
+	var limit uint64
+	switch bits {
+	case 32:
+		limit = math.MaxUint32
+	case 64:
+		limit = math.MaxUint64
+	default:
+		return current
+	}
+	if previous <= limit && previous > limit/2 {
+		return current + (limit - previous) + 1
+	}
+	return current
+}
+
+// Rates returns the per-second rates of the counters over the elapsed time between two
+// samples of counters of a width in bits, non-positive intervals (clock skew, duplicate
+// samples) yield zero rates.
+func Rates(
+	previous, current types.InterfaceCounters,
+	bits uint32,
+	elapsed time.Duration,
+) types.InterfaceRates {
+	if elapsed <= 0 {
+		return types.InterfaceRates{}
+	}
+	seconds := elapsed.Seconds()
+	rate := func(previous, current uint64) float64 {
+		return float64(Delta(previous, current, bits)) / seconds
+	}
+	return types.InterfaceRates{
+		RxBytes:   rate(previous.RxBytes, current.RxBytes),
+		TxBytes:   rate(previous.TxBytes, current.TxBytes),
+		RxPackets: rate(previous.RxPackets, current.RxPackets),
+		TxPackets: rate(previous.TxPackets, current.TxPackets),
+		RxErrors:  rate(previous.RxErrors, current.RxErrors),
+		TxErrors:  rate(previous.TxErrors, current.TxErrors),
+	}
+}
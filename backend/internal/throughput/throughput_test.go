@@ -0,0 +1,118 @@
+package throughput
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous uint64
+		current  uint64
+		bits     uint32
+		expected uint64
+	}{
+		{name: "should return increase", previous: 100, current: 250, bits: 64, expected: 150},
+		{name: "should return increase of unknown width", previous: 100, current: 250, expected: 150},
+		{
+			name:     "should return zero for unchanged counter",
+			previous: 100,
+			current:  100,
+			bits:     64,
+			expected: 0,
+		},
+		{
+			name:     "should return increase across 32-bit wrap",
+			previous: math.MaxUint32 - 9,
+			current:  5,
+			bits:     32,
+			expected: 15,
+		},
+		{
+			name:     "should return increase across 32-bit wrap to zero",
+			previous: math.MaxUint32,
+			current:  0,
+			bits:     32,
+			expected: 1,
+		},
+		{
+			name:     "should return increase across 64-bit wrap",
+			previous: math.MaxUint64 - 9,
+			current:  5,
+			bits:     64,
+			expected: 15,
+		},
+		{
+			name:     "should return increase across 64-bit wrap to zero",
+			previous: math.MaxUint64,
+			current:  0,
+			bits:     64,
+			expected: 1,
+		},
+		{name: "should return current value after reset to zero", previous: 1000, current: 0, bits: 64},
+		{
+			name:     "should return current value after reset",
+			previous: 1000,
+			current:  40,
+			bits:     64,
+			expected: 40,
+		},
+		{
+			name:     "should return current value after reset of 32-bit counter in lower half",
+			previous: math.MaxUint32 / 2,
+			current:  40,
+			bits:     32,
+			expected: 40,
+		},
+		{
+			name:     "should return current value after reset of 64-bit counter below 2^32",
+			previous: math.MaxUint32 - 9,
+			current:  40,
+			bits:     64,
+			expected: 40,
+		},
+		{
+			name:     "should return current value after reset of 32-bit counter beyond 32-bit range",
+			previous: math.MaxUint32 + 1000,
+			current:  40,
+			bits:     32,
+			expected: 40,
+		},
+		{
+			name:     "should return current value after decrease of counter of unknown width",
+			previous: math.MaxUint32 - 9,
+			current:  40,
+			expected: 40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if delta := Delta(tt.previous, tt.current, tt.bits); delta != tt.expected {
+				t.Errorf("expected delta %d, got %d", tt.expected, delta)
+			}
+		})
+	}
+}
+
+func TestRates(t *testing.T) {
+	previous := types.InterfaceCounters{RxBytes: 1000, TxBytes: math.MaxUint32 - 99, RxErrors: 7}
+	current := types.InterfaceCounters{RxBytes: 3000, TxBytes: 100, RxErrors: 0}
+	expected := types.InterfaceRates{RxBytes: 1000, TxBytes: 100, RxErrors: 0}
+	if rates := Rates(previous, current, 32, 2*time.Second); rates != expected {
+		t.Errorf("expected rates %+v, got %+v", expected, rates)
+	}
+	// The wrap of a 32-bit counter is a reset of a 64-bit counter
+	expected.TxBytes = 50
+	if rates := Rates(previous, current, 64, 2*time.Second); rates != expected {
+		t.Errorf("expected rates %+v, got %+v", expected, rates)
+	}
+	for _, elapsed := range []time.Duration{0, -time.Second} {
+		if rates := Rates(previous, current, 32, elapsed); rates != (types.InterfaceRates{}) {
+			t.Errorf("expected zero rates for elapsed %s, got %+v", elapsed, rates)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"fmt"
+	"maps"
 	"runtime"
+	"slices"
+	"strings"
 	"time"
 
 	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
@@ -25,8 +29,34 @@ type Config struct {
 	GatewayHost        string        `envconfig:"GATEWAY_HOST"         default:"localhost"`
 	Identifier         string        `envconfig:"IDENTIFIER"           default:"monitor-001"`
 	StreamInterval     time.Duration `envconfig:"STREAM_INTERVAL"      default:"500ms"`
+	RebootTimeout      time.Duration `envconfig:"REBOOT_TIMEOUT"       default:"1m"`
+	ReconcileInterval  time.Duration `envconfig:"RECONCILE_INTERVAL"   default:"30s"`
+	LeaseTTL           time.Duration `envconfig:"LEASE_TTL"            default:"1m"`
 	ChecksumBinaryPath string        `envconfig:"CHECKSUM_BINARY_PATH" default:"/usr/local/bin/checksum"`
+	ChecksumAlgorithm  string        `envconfig:"CHECKSUM_ALGORITHM"   default:"sha256"`
 	Persistence        Persistence   `envconfig:"PERSISTENCE"`
+	Campaign           Campaigns     `envconfig:"CAMPAIGN"`
+	MQTT               MQTT          `envconfig:"MQTT"`
+	// Out-of-process device drivers as name=endpoint (e.g. vendor=localhost:9190)
+	DriverPlugins []string `envconfig:"DRIVER_PLUGINS"`
+}
+
+// MQTT configures the broker devices publish their telemetry to, the monitor embeds a broker
+// listening on the port if it is set. The monitor authenticates with the username and password,
+// devices allowed to connect to the embedded broker are listed as id:password.
+type MQTT struct {
+	Broker   string   `envconfig:"BROKER"   default:"tcp://localhost:1883"`
+	Port     int      `envconfig:"PORT"     default:"0"`
+	Username string   `envconfig:"USERNAME" default:"monitor"`
+	Password string   `envconfig:"PASSWORD"`
+	Devices  []string `envconfig:"DEVICES"`
+}
+
+type Campaigns struct {
+	PollInterval   time.Duration `envconfig:"POLL_INTERVAL"   default:"1s"`
+	WaveSize       int           `envconfig:"WAVE_SIZE"       default:"5"`
+	MaxUnavailable int           `envconfig:"MAX_UNAVAILABLE" default:"1"`
+	WaveTimeout    time.Duration `envconfig:"WAVE_TIMEOUT"    default:"2m"`
 }
 
 type Persistence struct {
@@ -40,39 +70,398 @@ type Postgres struct {
 }
 
 type Device struct {
-	ID                 *string    `db:"id"`
-	Identifier         *string    `db:"device_id"`
-	Alias              *string    `db:"alias"`
-	Host               *string    `db:"host"`
-	Port               *int64     `db:"port"`
-	GatewayPort        *int64     `db:"port_gateway"`
-	Architecture       *string    `db:"architecture"`
-	OS                 *string    `db:"os"`
-	SupportedProtocols *[]string  `db:"supported_protocols"`
-	Created            *time.Time `db:"created_at"`
-	Updated            *time.Time `db:"updated_at"`
+	ID                 *string          `db:"id"`
+	Identifier         *string          `db:"device_id"`
+	Alias              *string          `db:"alias"`
+	Host               *string          `db:"host"`
+	Port               *int64           `db:"port"`
+	GatewayPort        *int64           `db:"port_gateway"`
+	Architecture       *string          `db:"architecture"`
+	OS                 *string          `db:"os"`
+	SupportedProtocols *[]string        `db:"supported_protocols"`
+	SigningAlgorithm   *string          `db:"signing_algorithm"`
+	SigningKey         *string          `db:"signing_key"`
+	SnmpCredentials    *SnmpCredentials `db:"snmp_credentials"`
+	Driver             *string          `db:"driver"`
+	Created            *time.Time       `db:"created_at"`
+	Updated            *time.Time       `db:"updated_at"`
+	Compliance         Compliance       `db:"-"`
+}
+
+func (d *Device) Signing() DeviceSigning {
+	if d.SigningAlgorithm == nil || d.SigningKey == nil {
+		return DeviceSigning{}
+	}
+	return DeviceSigning{
+		Algorithm: SigningAlgorithm(*d.SigningAlgorithm),
+		Key:       *d.SigningKey,
+	}
+}
+
+func (d *Device) Snmp() SnmpCredentials {
+	if d.SnmpCredentials == nil {
+		return SnmpCredentials{}
+	}
+	return *d.SnmpCredentials
 }
 
 type Diagnostics struct {
-	ID                 *string    `db:"id"`
-	Identifier         *string    `db:"device_id"`
-	Alias              *string    `db:"alias"`
-	Host               *string    `db:"host"`
-	Port               *int64     `db:"port"`
-	GatewayPort        *int64     `db:"port_gateway"`
-	Architecture       *string    `db:"architecture"`
-	OS                 *string    `db:"os"`
-	SupportedProtocols *[]string  `db:"supported_protocols"`
-	Hardware           *string    `db:"hardware_version"`
-	Software           *string    `db:"software_version"`
-	Firmware           *string    `db:"firmware_version"`
-	CPU                *float64   `db:"cpu_usage"`
-	Memory             *float64   `db:"memory_usage"`
-	DeviceStatus       *string    `db:"device_status"`
-	Checksum           *string    `db:"checksum"`
-	LastUpdated        *time.Time `db:"last_updated"`
-	Created            *time.Time `db:"created_at"`
-	Updated            *time.Time `db:"updated_at"`
+	ID                 *string     `db:"id"`
+	Identifier         *string     `db:"device_id"`
+	Alias              *string     `db:"alias"`
+	Host               *string     `db:"host"`
+	Port               *int64      `db:"port"`
+	GatewayPort        *int64      `db:"port_gateway"`
+	Architecture       *string     `db:"architecture"`
+	OS                 *string     `db:"os"`
+	SupportedProtocols *[]string   `db:"supported_protocols"`
+	SigningAlgorithm   *string     `db:"signing_algorithm"`
+	Hardware           *string     `db:"hardware_version"`
+	Software           *string     `db:"software_version"`
+	Firmware           *string     `db:"firmware_version"`
+	CPU                *float64    `db:"cpu_usage"`
+	Memory             *float64    `db:"memory_usage"`
+	DeviceStatus       *string     `db:"device_status"`
+	Checksum           *string     `db:"checksum"`
+	Verification       *string     `db:"verification_status"`
+	UptimeSeconds      *int64      `db:"uptime_seconds"`
+	LoadAverage1m      *float64    `db:"load_average_1m"`
+	LoadAverage5m      *float64    `db:"load_average_5m"`
+	LoadAverage15m     *float64    `db:"load_average_15m"`
+	Temperature        *float64    `db:"temperature_celsius"`
+	DiskUsed           *int64      `db:"disk_used_bytes"`
+	DiskTotal          *int64      `db:"disk_total_bytes"`
+	Processes          *int32      `db:"process_count"`
+	DiagnosticsID      *string     `db:"diagnostics_id"`
+	Interfaces         []Interface `db:"-"`
+	LastUpdated        *time.Time  `db:"last_updated"`
+	Created            *time.Time  `db:"created_at"`
+	Updated            *time.Time  `db:"updated_at"`
+}
+
+type Campaign struct {
+	ID             *string           `db:"id"`
+	MonitorID      *string           `db:"monitor_id"`
+	TargetVersion  *string           `db:"target_version"`
+	Selector       *CampaignSelector `db:"selector"`
+	WaveSize       *int32            `db:"wave_size"`
+	MaxUnavailable *int32            `db:"max_unavailable"`
+	WaveTimeout    *int64            `db:"wave_timeout_ms"`
+	FailurePolicy  *string           `db:"failure_policy"`
+	Status         *string           `db:"status"`
+	CurrentWave    *int32            `db:"current_wave"`
+	TotalWaves     *int32            `db:"total_waves"`
+	Error          *string           `db:"error"`
+	Devices        []CampaignDevice  `db:"-"`
+	Created        *time.Time        `db:"created_at"`
+	Updated        *time.Time        `db:"updated_at"`
+	Completed      *time.Time        `db:"completed_at"`
+}
+
+type StatusTransition struct {
+	ID           *string    `db:"id"`
+	DeviceID     *string    `db:"device_id"`
+	FromStatus   *string    `db:"from_status"`
+	ToStatus     *string    `db:"to_status"`
+	Cause        *string    `db:"cause"`
+	Duration     *int64     `db:"duration_ms"`
+	Transitioned *time.Time `db:"transitioned_at"`
+}
+
+// StatusHistory is the status of a device at the start of a report period (or its first
+// sample within the period) followed by the transitions within the period.
+type StatusHistory struct {
+	DeviceID    *string            `db:"device_id"`
+	Status      *string            `db:"device_status"`
+	Since       *time.Time         `db:"since"`
+	Transitions []StatusTransition `db:"-"`
+}
+
+type Anomaly struct {
+	ID        *string    `db:"id"`
+	DeviceID  *string    `db:"device_id"`
+	Metric    *string    `db:"metric"`
+	Kind      *string    `db:"kind"`
+	Value     *float64   `db:"value"`
+	Baseline  *float64   `db:"baseline"`
+	Deviation *float64   `db:"deviation"`
+	Score     *float64   `db:"score"`
+	Detected  *time.Time `db:"detected_at"`
+	Recorded  *time.Time `db:"recorded_at"`
+}
+
+// DeviceEvent is an entry of the timeline of a device
+type DeviceEvent struct {
+	ID       *string           `db:"id"`
+	DeviceID *string           `db:"device_id"`
+	Type     *string           `db:"type"`
+	Severity *string           `db:"severity"`
+	Message  *string           `db:"message"`
+	Details  map[string]string `db:"details"`
+	Occurred *time.Time        `db:"occurred_at"`
+	Recorded *time.Time        `db:"recorded_at"`
+}
+
+// VersionChange is a change of the version of a component between two diagnostics samples
+type VersionChange struct {
+	ID        *string    `db:"id"`
+	DeviceID  *string    `db:"device_id"`
+	Component *string    `db:"component"`
+	Previous  *string    `db:"previous_version"`
+	Current   *string    `db:"current_version"`
+	Changed   *time.Time `db:"changed_at"`
+	Recorded  *time.Time `db:"recorded_at"`
+}
+
+// HourlyAggregate is the average of the metrics of the samples of a device within an hour,
+// disk usage is the percentage of the disk size.
+type HourlyAggregate struct {
+	DeviceID    *string    `db:"device_id"`
+	Hour        *time.Time `db:"hour"`
+	CPU         *float64   `db:"cpu_usage"`
+	Memory      *float64   `db:"memory_usage"`
+	Disk        *float64   `db:"disk_usage"`
+	Temperature *float64   `db:"temperature_celsius"`
+	Samples     *int64     `db:"samples"`
+}
+
+// Value returns the average of a metric, metrics without a value within the hour are not set
+func (h *HourlyAggregate) Value(metric Metric) (float64, bool) {
+	var value *float64
+	switch metric {
+	case MetricCpu:
+		value = h.CPU
+	case MetricMemory:
+		value = h.Memory
+	case MetricDisk:
+		value = h.Disk
+	case MetricTemperature:
+		value = h.Temperature
+	}
+	return deref(value), value != nil
+}
+
+// CompliancePolicy applies to the devices whose labels contain all labels of the policy (all
+// devices if it has none). A compliant version is at least the minimum version, matches
+// the allow list if not empty and does not match the deny list.
+type CompliancePolicy struct {
+	Name       *string           `db:"name"`
+	Labels     map[string]string `db:"labels"`
+	Component  *string           `db:"component"`
+	MinVersion *string           `db:"min_version"`
+	Allow      []string          `db:"allow"`
+	Deny       []string          `db:"deny"`
+	Created    *time.Time        `db:"created_at"`
+	Updated    *time.Time        `db:"updated_at"`
+}
+
+func (p *CompliancePolicy) Applies(labels map[string]string) bool {
+	for key, value := range p.Labels {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+type Compliance struct {
+	Status     ComplianceStatus
+	Violations []string
+}
+
+// MetricBaseline is selected as json together with the diagnostics insert
+type MetricBaseline struct {
+	DeviceID    *string    `db:"device_id"    json:"device_id"`
+	Metric      *string    `db:"metric"       json:"metric"`
+	Mean        *float64   `db:"mean"         json:"mean"`
+	Variance    *float64   `db:"variance"     json:"variance"`
+	FastMean    *float64   `db:"fast_mean"    json:"fast_mean"`
+	Samples     *int64     `db:"samples"      json:"samples"`
+	SpikeActive *bool      `db:"spike_active" json:"spike_active"`
+	DriftActive *bool      `db:"drift_active" json:"drift_active"`
+	Observed    *time.Time `db:"observed_at"  json:"observed_at"`
+}
+
+type AnomalySettings struct {
+	DeviceID    *string    `db:"device_id"`
+	Sensitivity *string    `db:"sensitivity"`
+	Updated     *time.Time `db:"updated_at"`
+}
+
+type DeviceConfig struct {
+	DeviceID       *string           `db:"device_id"`
+	DeviceStatus   *string           `db:"device_status"`
+	StreamInterval *int64            `db:"stream_interval_ms"`
+	Labels         map[string]string `db:"labels"`
+	Config         map[string]string `db:"config"`
+	DriftPolicy    *string           `db:"drift_policy"`
+	Status         *string           `db:"status"`
+	Drift          []string          `db:"drift"`
+	Error          *string           `db:"error"`
+	Reapplied      *int32            `db:"reapply_count"`
+	Applied        *time.Time        `db:"applied_at"`
+	Reconciled     *time.Time        `db:"reconciled_at"`
+	Created        *time.Time        `db:"created_at"`
+	Updated        *time.Time        `db:"updated_at"`
+}
+
+func (c *DeviceConfig) Desired() DesiredConfig {
+	desired := DesiredConfig{
+		Labels:      c.Labels,
+		Config:      c.Config,
+		DriftPolicy: DriftPolicyFromString(deref(c.DriftPolicy)),
+	}
+	if c.DeviceStatus != nil {
+		desired.DeviceStatus = DeviceStatusFromString(*c.DeviceStatus)
+	}
+	if c.StreamInterval != nil {
+		desired.StreamInterval = time.Duration(*c.StreamInterval) * time.Millisecond
+	}
+	return desired
+}
+
+type CampaignDevice struct {
+	CampaignID      *string    `db:"campaign_id"`
+	DeviceID        *string    `db:"device_id"`
+	Wave            *int32     `db:"wave"`
+	Status          *string    `db:"status"`
+	PreviousVersion *string    `db:"previous_version"`
+	Error           *string    `db:"error"`
+	Updated         *time.Time `db:"updated_at"`
+}
+
+// CampaignSelector targets devices by identifier and by the fields of their latest
+// diagnostics, empty fields match every device.
+type CampaignSelector struct {
+	DeviceIDs    []string `json:"device_ids,omitempty"`
+	Hardware     string   `json:"hardware_version,omitempty"`
+	Firmware     string   `json:"firmware_version,omitempty"`
+	Architecture string   `json:"architecture,omitempty"`
+	OS           string   `json:"os,omitempty"`
+}
+
+func (s *CampaignSelector) Matches(diag Diagnostics) bool {
+	matches := func(expected string, actual *string) bool {
+		return expected == "" || (actual != nil && *actual == expected)
+	}
+	if len(s.DeviceIDs) > 0 && (diag.Identifier == nil || !slices.Contains(s.DeviceIDs, *diag.Identifier)) {
+		return false
+	}
+	return matches(s.Hardware, diag.Hardware) &&
+		matches(s.Firmware, diag.Firmware) &&
+		matches(s.Architecture, diag.Architecture) &&
+		matches(s.OS, diag.OS)
+}
+
+type ExportQuery struct {
+	DeviceIDs []string
+	From      time.Time
+	To        time.Time
+	Format    ExportFormat
+}
+
+type ReportQuery struct {
+	From               time.Time
+	To                 time.Time
+	DeviceIDs          []string
+	GroupBy            string
+	ExcludeMaintenance bool
+}
+
+type AvailabilityReport struct {
+	Query   ReportQuery
+	Fleet   Availability
+	Devices []DeviceAvailability
+	Groups  []GroupAvailability
+}
+
+type DeviceAvailability struct {
+	DeviceID     string
+	Labels       map[string]string
+	Availability Availability
+}
+
+type GroupAvailability struct {
+	Value        string
+	DeviceIDs    []string
+	Availability Availability
+}
+
+// Availability is the time spent in each status over a period, failures count the
+// transitions from an available (healthy, degraded) or other status into an unavailable
+// (error, offline) status.
+type Availability struct {
+	Statuses map[DeviceStatus]time.Duration
+	Failures int
+}
+
+func (a *Availability) Add(other Availability) {
+	if a.Statuses == nil {
+		a.Statuses = make(map[DeviceStatus]time.Duration, len(other.Statuses))
+	}
+	for status, duration := range other.Statuses {
+		a.Statuses[status] += duration
+	}
+	a.Failures += other.Failures
+}
+
+// Period returns the time covered by the availability (without excluded statuses)
+func (a *Availability) Period() time.Duration {
+	var period time.Duration
+	for _, duration := range a.Statuses {
+		period += duration
+	}
+	return period
+}
+
+func (a *Availability) Uptime() time.Duration {
+	return a.Statuses[DeviceStatusHealthy] + a.Statuses[DeviceStatusDegraded]
+}
+
+func (a *Availability) Downtime() time.Duration {
+	return a.Statuses[DeviceStatusError] + a.Statuses[DeviceStatusOffline]
+}
+
+// Percent returns the share of the period spent in a status (0-100)
+func (a *Availability) Percent(status DeviceStatus) float64 {
+	return percent(a.Statuses[status], a.Period())
+}
+
+// Available returns the share of the period the device was healthy or degraded (0-100)
+func (a *Availability) Available() float64 {
+	return percent(a.Uptime(), a.Period())
+}
+
+// MTTR returns the mean time to repair, zero without failures
+func (a *Availability) MTTR() time.Duration {
+	if a.Failures == 0 {
+		return 0
+	}
+	return a.Downtime() / time.Duration(a.Failures)
+}
+
+// MTBF returns the mean time between failures, zero without failures
+func (a *Availability) MTBF() time.Duration {
+	if a.Failures == 0 {
+		return 0
+	}
+	return a.Uptime() / time.Duration(a.Failures)
+}
+
+func percent(part, total time.Duration) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
+
+type CampaignSpec struct {
+	TargetVersion  string
+	Selector       CampaignSelector
+	WaveSize       int
+	MaxUnavailable int
+	WaveTimeout    time.Duration
+	FailurePolicy  FailurePolicy
 }
 
 type DeviceHealthStatus struct {
@@ -84,13 +473,219 @@ type DeviceHealthStatus struct {
 }
 
 type DeviceDiagnostics struct {
-	Identifier     string
-	DeviceVersions DeviceVersions
-	CPU            float64
-	Memory         float64
+	Identifier       string
+	DeviceVersions   DeviceVersions
+	CPU              float64
+	Memory           float64
+	DeviceStatus     DeviceStatus
+	Checksum         string
+	ChecksumMismatch bool
+	Verification     VerificationStatus
+	Uptime           time.Duration
+	LoadAverage      LoadAverage
+	Temperature      float64
+	DiskUsed         uint64
+	DiskTotal        uint64
+	Processes        uint32
+	Interfaces       []DeviceInterface
+	StreamInterval   time.Duration
+	Labels           map[string]string
+	Config           map[string]string
+	Timestamp        time.Time
+}
+
+// EventRecord is an event of a device to be added to its timeline
+type EventRecord struct {
+	DeviceID string
+	Type     EventType
+	Severity EventSeverity
+	Message  string
+	Details  map[string]string
+	Occurred time.Time
+}
+
+// DesiredConfig is the configuration a device is reconciled towards, an empty status or a
+// zero stream interval is not enforced.
+type DesiredConfig struct {
 	DeviceStatus   DeviceStatus
-	Checksum       string
-	Timestamp      time.Time
+	StreamInterval time.Duration
+	Labels         map[string]string
+	Config         map[string]string
+	DriftPolicy    DriftPolicy
+}
+
+// Drift describes the fields of the reported diagnostics that differ from the desired
+// config. The status is not compared while the device is in a transitional status.
+func (c *DesiredConfig) Drift(diag DeviceDiagnostics) []string {
+	var drift []string
+	if c.DeviceStatus != "" && !diag.DeviceStatus.IsTransitional() &&
+		diag.DeviceStatus != c.DeviceStatus {
+		drift = append(
+			drift,
+			fmt.Sprintf("device_status: %s (desired %s)", diag.DeviceStatus, c.DeviceStatus),
+		)
+	}
+	if c.StreamInterval > 0 && diag.StreamInterval != c.StreamInterval {
+		drift = append(
+			drift,
+			fmt.Sprintf("stream_interval: %s (desired %s)", diag.StreamInterval, c.StreamInterval),
+		)
+	}
+	drift = append(drift, mapDrift("labels", diag.Labels, c.Labels)...)
+	drift = append(drift, mapDrift("config", diag.Config, c.Config)...)
+	return drift
+}
+
+type DeviceFirmwareUpgrade struct {
+	TargetVersion   string
+	PreviousVersion string
+	Phase           UpgradePhase
+	Error           string
+}
+
+type LoadAverage struct {
+	One     float64
+	Five    float64
+	Fifteen float64
+}
+
+type Interface struct {
+	ID            *string    `db:"id"`
+	DiagnosticsID *string    `db:"diagnostics_id"`
+	DeviceID      *string    `db:"device_id"`
+	Name          *string    `db:"name"`
+	LinkState     *string    `db:"link_state"`
+	RxBytes       *int64     `db:"rx_bytes"`
+	TxBytes       *int64     `db:"tx_bytes"`
+	RxPackets     *int64     `db:"rx_packets"`
+	TxPackets     *int64     `db:"tx_packets"`
+	RxErrors      *int64     `db:"rx_errors"`
+	TxErrors      *int64     `db:"tx_errors"`
+	RxBytesRate   *float64   `db:"rx_bytes_rate"`
+	TxBytesRate   *float64   `db:"tx_bytes_rate"`
+	RxPacketsRate *float64   `db:"rx_packets_rate"`
+	TxPacketsRate *float64   `db:"tx_packets_rate"`
+	RxErrorsRate  *float64   `db:"rx_errors_rate"`
+	TxErrorsRate  *float64   `db:"tx_errors_rate"`
+	Timestamp     *time.Time `db:"timestamp"`
+}
+
+func (i *Interface) Counters() InterfaceCounters {
+	return InterfaceCounters{
+		RxBytes:   counter(i.RxBytes),
+		TxBytes:   counter(i.TxBytes),
+		RxPackets: counter(i.RxPackets),
+		TxPackets: counter(i.TxPackets),
+		RxErrors:  counter(i.RxErrors),
+		TxErrors:  counter(i.TxErrors),
+	}
+}
+
+func (i *Interface) Rates() InterfaceRates {
+	return InterfaceRates{
+		RxBytes:   rate(i.RxBytesRate),
+		TxBytes:   rate(i.TxBytesRate),
+		RxPackets: rate(i.RxPacketsRate),
+		TxPackets: rate(i.TxPacketsRate),
+		RxErrors:  rate(i.RxErrorsRate),
+		TxErrors:  rate(i.TxErrorsRate),
+	}
+}
+
+type DeviceInterface struct {
+	Name        string
+	LinkState   LinkState
+	Counters    InterfaceCounters
+	CounterBits uint32
+}
+
+type InterfaceCounters struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	RxErrors  uint64
+	TxErrors  uint64
+}
+
+type InterfaceRates struct {
+	RxBytes   float64
+	TxBytes   float64
+	RxPackets float64
+	TxPackets float64
+	RxErrors  float64
+	TxErrors  float64
+}
+
+type DiagnosticsQuery struct {
+	From  time.Time
+	To    time.Time
+	Limit int
+}
+
+type AnomalyQuery struct {
+	DeviceID string
+	From     time.Time
+	To       time.Time
+	Limit    int
+}
+
+// DeviceEventQuery filters the events of a device (all devices if empty) by type (all types
+// if empty) and by minimum severity (all severities if empty).
+type DeviceEventQuery struct {
+	DeviceID string
+	Types    []EventType
+	Severity EventSeverity
+	From     time.Time
+	To       time.Time
+	Limit    int
+}
+
+func (q *DeviceEventQuery) Matches(event DeviceEvent) bool {
+	if len(q.Types) > 0 && !slices.Contains(q.Types, EventTypeFromString(deref(event.Type))) {
+		return false
+	}
+	severity := EventSeverityFromString(deref(event.Severity))
+	return severity.Level() >= q.Severity.Level()
+}
+
+type VersionChangeQuery struct {
+	DeviceID  string
+	Component VersionComponent
+	From      time.Time
+	To        time.Time
+	Limit     int
+}
+
+type ForecastQuery struct {
+	DeviceID  string
+	Metric    Metric
+	Model     ForecastModel
+	Threshold float64
+	History   time.Duration
+	Horizon   time.Duration
+	Top       int
+}
+
+// Forecast is the projection of a metric of a device in hourly steps, the trend is the change
+// per hour and the threshold is not reached within the horizon if its time is not set.
+type Forecast struct {
+	DeviceID    string
+	Metric      Metric
+	Model       ForecastModel
+	Current     float64
+	Trend       float64
+	Threshold   float64
+	ThresholdAt *time.Time
+	Samples     int
+	Points      []ForecastPoint
+}
+
+type ForecastPoint struct {
+	Time  time.Time
+	Value float64
+	Lower float64
+	Upper float64
 }
 
 type DeviceVersions struct {
@@ -99,12 +694,96 @@ type DeviceVersions struct {
 	Firmware string
 }
 
+func (v *DeviceVersions) Version(component VersionComponent) string {
+	switch component {
+	case VersionComponentHardware:
+		return v.Hardware
+	case VersionComponentSoftware:
+		return v.Software
+	default:
+		return v.Firmware
+	}
+}
+
+type VersionQuery struct {
+	GroupBy   VersionGrouping
+	Component VersionComponent
+	Below     string
+}
+
+type VersionInventory struct {
+	GroupBy VersionGrouping
+	Groups  []VersionGroup
+	Devices []DeviceInventory
+}
+
+// VersionGroup counts the versions of each component deployed to the devices of a group
+type VersionGroup struct {
+	Value    string
+	Devices  int
+	Versions []VersionCount
+}
+
+type VersionCount struct {
+	Component VersionComponent
+	Version   string
+	DeviceIDs []string
+}
+
+type DeviceInventory struct {
+	DeviceID     string
+	Architecture string
+	Versions     DeviceVersions
+	Compliance   ComplianceStatus
+}
+
 type DeviceRegistration struct {
+	Identifier  string
 	Protocol    Protocol
 	Alias       string
 	Host        string
 	Port        int64
 	GatewayPort int64
+	Signing     DeviceSigning
+	Snmp        SnmpCredentials
+	Driver      string
+}
+
+type DeviceSigning struct {
+	Algorithm SigningAlgorithm
+	Key       string
+}
+
+// SnmpCredentials are persisted as a JSON document of the device (snmp_credentials)
+type SnmpCredentials struct {
+	Version        SnmpVersion      `json:"version"`
+	Community      string           `json:"community,omitempty"`
+	Username       string           `json:"username,omitempty"`
+	AuthProtocol   SnmpAuthProtocol `json:"auth_protocol,omitempty"`
+	AuthPassphrase string           `json:"auth_passphrase,omitempty"`
+	PrivProtocol   SnmpPrivProtocol `json:"priv_protocol,omitempty"`
+	PrivPassphrase string           `json:"priv_passphrase,omitempty"`
+}
+
+// InventoryEntry is a device of an imported inventory, entries failing validation carry the
+// error and are reported as failed without being probed.
+type InventoryEntry struct {
+	Line         int
+	DeviceID     string
+	Registration DeviceRegistration
+	Error        error
+}
+
+type ImportResult struct {
+	Line     int
+	DeviceID string
+	Status   ImportStatus
+	Error    error
+}
+
+type ImportReport struct {
+	Results []ImportResult
+	Pruned  bool
 }
 
 type Event struct {
@@ -193,57 +872,959 @@ func DeviceStatusFromString(value string) DeviceStatus {
 	return parsed
 }
 
+// IsTransitional reports whether the device is in a transitional (booting) or operator-driven
+// (maintenance, e.g. a firmware upgrade) status that is not reconciled
+func (d *DeviceStatus) IsTransitional() bool {
+	return *d == DeviceStatusBooting || *d == DeviceStatusMaintenance
+}
+
+// IsAvailable reports whether the device serves traffic in this status
+func (d *DeviceStatus) IsAvailable() bool {
+	return *d == DeviceStatusHealthy || *d == DeviceStatusDegraded
+}
+
+// IsUnavailable reports whether the device has failed in this status, maintenance and
+// booting are neither available nor failures.
+func (d *DeviceStatus) IsUnavailable() bool {
+	return *d == DeviceStatusError || *d == DeviceStatusOffline
+}
+
+// CanTransition reports whether a device may move from this status to the given status
+// (devicev1.DeviceStatus), remaining in the same status is always allowed. Every status may
+// move to offline and an offline device may come back in any status.
+func (d *DeviceStatus) CanTransition(to DeviceStatus) bool {
+	if *d == to || *d == DeviceStatusOffline || to == DeviceStatusOffline {
+		return true
+	}
+	return d.DeviceProto().CanTransition(to.DeviceProto())
+}
+
 /*
 ENUM(
 
-	http = PROTOCOL_HTTP
-	http-stream = PROTOCOL_HTTP_STREAM
-	grpc = PROTOCOL_GRPC
-	grpc-stream = PROTOCOL_GRPC_STREAM
+	csv = EXPORT_FORMAT_CSV
+	ndjson = EXPORT_FORMAT_NDJSON
+	parquet = EXPORT_FORMAT_PARQUET
 
 )
 */
-type Protocol string
+type ExportFormat string
 
-func (p *Protocol) Proto() monitorv1.Protocol {
-	switch *p {
-	case ProtocolHttp:
-		return monitorv1.Protocol_PROTOCOL_HTTP
-	case ProtocolHttpStream:
-		return monitorv1.Protocol_PROTOCOL_HTTP_STREAM
-	case ProtocolGrpc:
-		return monitorv1.Protocol_PROTOCOL_GRPC
-	case ProtocolGrpcStream:
-		return monitorv1.Protocol_PROTOCOL_GRPC_STREAM
+func ExportFormatFromProto(format monitorv1.ExportFormat) ExportFormat {
+	switch format {
+	case monitorv1.ExportFormat_EXPORT_FORMAT_NDJSON:
+		return ExportFormatNdjson
+	case monitorv1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return ExportFormatParquet
 	default:
-		return monitorv1.Protocol_PROTOCOL_UNSPECIFIED
+		return ExportFormatCsv
+	}
+}
+
+/*
+ENUM(
+
+	yaml = INVENTORY_FORMAT_YAML
+	csv = INVENTORY_FORMAT_CSV
+
+)
+*/
+type InventoryFormat string
+
+func InventoryFormatFromProto(format monitorv1.InventoryFormat) InventoryFormat {
+	switch format {
+	case monitorv1.InventoryFormat_INVENTORY_FORMAT_CSV:
+		return InventoryFormatCsv
+	default:
+		return InventoryFormatYaml
 	}
 }
 
-func (p *Protocol) IsGrpc() bool {
-	return *p == ProtocolGrpc || *p == ProtocolGrpcStream
+/*
+ENUM(
+
+	created = IMPORT_STATUS_CREATED
+	updated = IMPORT_STATUS_UPDATED
+	unchanged = IMPORT_STATUS_UNCHANGED
+	failed = IMPORT_STATUS_FAILED
+	pruned = IMPORT_STATUS_PRUNED
+
+)
+*/
+type ImportStatus string
+
+func (i *ImportStatus) Proto() monitorv1.ImportStatus {
+	switch *i {
+	case ImportStatusCreated:
+		return monitorv1.ImportStatus_IMPORT_STATUS_CREATED
+	case ImportStatusUpdated:
+		return monitorv1.ImportStatus_IMPORT_STATUS_UPDATED
+	case ImportStatusUnchanged:
+		return monitorv1.ImportStatus_IMPORT_STATUS_UNCHANGED
+	case ImportStatusFailed:
+		return monitorv1.ImportStatus_IMPORT_STATUS_FAILED
+	case ImportStatusPruned:
+		return monitorv1.ImportStatus_IMPORT_STATUS_PRUNED
+	default:
+		return monitorv1.ImportStatus_IMPORT_STATUS_UNSPECIFIED
+	}
 }
 
-func (p *Protocol) IsHttp() bool {
-	return *p == ProtocolHttp || *p == ProtocolHttpStream
+/*
+ENUM(
+
+	cpu = METRIC_CPU
+	memory = METRIC_MEMORY
+	temperature = METRIC_TEMPERATURE
+	load = METRIC_LOAD
+	disk = METRIC_DISK
+	processes = METRIC_PROCESSES
+
+)
+*/
+type Metric string
+
+func (m *Metric) Proto() monitorv1.Metric {
+	switch *m {
+	case MetricCpu:
+		return monitorv1.Metric_METRIC_CPU
+	case MetricMemory:
+		return monitorv1.Metric_METRIC_MEMORY
+	case MetricTemperature:
+		return monitorv1.Metric_METRIC_TEMPERATURE
+	case MetricLoad:
+		return monitorv1.Metric_METRIC_LOAD
+	case MetricDisk:
+		return monitorv1.Metric_METRIC_DISK
+	case MetricProcesses:
+		return monitorv1.Metric_METRIC_PROCESSES
+	default:
+		return monitorv1.Metric_METRIC_UNSPECIFIED
+	}
 }
 
-func ProtocolFromStrings(values []string) []monitorv1.Protocol {
-	result := make([]monitorv1.Protocol, 0, len(values))
-	for _, value := range values {
-		if p, err := ParseProtocol(value); err == nil {
-			result = append(result, p.Proto())
-		}
+func MetricFromProto(metric monitorv1.Metric) Metric {
+	parsed, err := ParseMetric(metric.String())
+	if err != nil {
+		return Metric("")
 	}
-	return result
+	return parsed
 }
 
-func ProtocolFromDevice(values []devicev1.Protocol) []Protocol {
-	result := make([]Protocol, 0, len(values))
-	for _, value := range values {
-		if p, err := ParseProtocol(value.String()); err == nil {
-			result = append(result, p)
-		}
+func MetricFromString(value string) Metric {
+	parsed, err := ParseMetric(value)
+	if err != nil {
+		return Metric("")
 	}
-	return result
+	return parsed
+}
+
+/*
+ENUM(
+
+	linear = FORECAST_MODEL_LINEAR
+	holt-winters = FORECAST_MODEL_HOLT_WINTERS
+
+)
+*/
+type ForecastModel string
+
+func (f *ForecastModel) Proto() monitorv1.ForecastModel {
+	switch *f {
+	case ForecastModelLinear:
+		return monitorv1.ForecastModel_FORECAST_MODEL_LINEAR
+	case ForecastModelHoltWinters:
+		return monitorv1.ForecastModel_FORECAST_MODEL_HOLT_WINTERS
+	default:
+		return monitorv1.ForecastModel_FORECAST_MODEL_UNSPECIFIED
+	}
+}
+
+func ForecastModelFromProto(model monitorv1.ForecastModel) ForecastModel {
+	switch model {
+	case monitorv1.ForecastModel_FORECAST_MODEL_HOLT_WINTERS:
+		return ForecastModelHoltWinters
+	default:
+		return ForecastModelLinear
+	}
+}
+
+/*
+ENUM(
+
+	registered = EVENT_TYPE_REGISTERED
+	status-changed = EVENT_TYPE_STATUS_CHANGED
+	offline = EVENT_TYPE_OFFLINE
+	checksum-mismatch = EVENT_TYPE_CHECKSUM_MISMATCH
+	version-changed = EVENT_TYPE_VERSION_CHANGED
+	worker-started = EVENT_TYPE_WORKER_STARTED
+	worker-restarted = EVENT_TYPE_WORKER_RESTARTED
+	worker-stopped = EVENT_TYPE_WORKER_STOPPED
+
+)
+*/
+type EventType string
+
+func (e *EventType) Proto() monitorv1.EventType {
+	switch *e {
+	case EventTypeRegistered:
+		return monitorv1.EventType_EVENT_TYPE_REGISTERED
+	case EventTypeStatusChanged:
+		return monitorv1.EventType_EVENT_TYPE_STATUS_CHANGED
+	case EventTypeOffline:
+		return monitorv1.EventType_EVENT_TYPE_OFFLINE
+	case EventTypeChecksumMismatch:
+		return monitorv1.EventType_EVENT_TYPE_CHECKSUM_MISMATCH
+	case EventTypeVersionChanged:
+		return monitorv1.EventType_EVENT_TYPE_VERSION_CHANGED
+	case EventTypeWorkerStarted:
+		return monitorv1.EventType_EVENT_TYPE_WORKER_STARTED
+	case EventTypeWorkerRestarted:
+		return monitorv1.EventType_EVENT_TYPE_WORKER_RESTARTED
+	case EventTypeWorkerStopped:
+		return monitorv1.EventType_EVENT_TYPE_WORKER_STOPPED
+	default:
+		return monitorv1.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func EventTypeFromProto(kind monitorv1.EventType) EventType {
+	parsed, err := ParseEventType(kind.String())
+	if err != nil {
+		return EventType("")
+	}
+	return parsed
+}
+
+func EventTypeFromString(value string) EventType {
+	parsed, err := ParseEventType(value)
+	if err != nil {
+		return EventType("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	info = EVENT_SEVERITY_INFO
+	warning = EVENT_SEVERITY_WARNING
+	error = EVENT_SEVERITY_ERROR
+
+)
+*/
+type EventSeverity string
+
+func (e *EventSeverity) Proto() monitorv1.EventSeverity {
+	switch *e {
+	case EventSeverityInfo:
+		return monitorv1.EventSeverity_EVENT_SEVERITY_INFO
+	case EventSeverityWarning:
+		return monitorv1.EventSeverity_EVENT_SEVERITY_WARNING
+	case EventSeverityError:
+		return monitorv1.EventSeverity_EVENT_SEVERITY_ERROR
+	default:
+		return monitorv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED
+	}
+}
+
+// Level orders severities from info to error, an unset severity is below info
+func (e EventSeverity) Level() int {
+	return int(e.Proto())
+}
+
+func EventSeverityFromProto(severity monitorv1.EventSeverity) EventSeverity {
+	parsed, err := ParseEventSeverity(severity.String())
+	if err != nil {
+		return EventSeverity("")
+	}
+	return parsed
+}
+
+func EventSeverityFromString(value string) EventSeverity {
+	parsed, err := ParseEventSeverity(value)
+	if err != nil {
+		return EventSeverity("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	hardware = VERSION_COMPONENT_HARDWARE
+	software = VERSION_COMPONENT_SOFTWARE
+	firmware = VERSION_COMPONENT_FIRMWARE
+
+)
+*/
+type VersionComponent string
+
+func (v *VersionComponent) Proto() monitorv1.VersionComponent {
+	switch *v {
+	case VersionComponentHardware:
+		return monitorv1.VersionComponent_VERSION_COMPONENT_HARDWARE
+	case VersionComponentSoftware:
+		return monitorv1.VersionComponent_VERSION_COMPONENT_SOFTWARE
+	case VersionComponentFirmware:
+		return monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE
+	default:
+		return monitorv1.VersionComponent_VERSION_COMPONENT_UNSPECIFIED
+	}
+}
+
+// Label returns the lowercase name of a component (e.g. firmware)
+func (v VersionComponent) Label() string {
+	switch v {
+	case VersionComponentHardware:
+		return "hardware"
+	case VersionComponentSoftware:
+		return "software"
+	default:
+		return "firmware"
+	}
+}
+
+func VersionComponentFromProto(component monitorv1.VersionComponent) VersionComponent {
+	parsed, err := ParseVersionComponent(component.String())
+	if err != nil {
+		return VersionComponent("")
+	}
+	return parsed
+}
+
+func VersionComponentFromString(value string) VersionComponent {
+	parsed, err := ParseVersionComponent(value)
+	if err != nil {
+		return VersionComponent("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	model = VERSION_GROUPING_MODEL
+	architecture = VERSION_GROUPING_ARCHITECTURE
+
+)
+*/
+type VersionGrouping string
+
+func (v *VersionGrouping) Proto() monitorv1.VersionGrouping {
+	switch *v {
+	case VersionGroupingModel:
+		return monitorv1.VersionGrouping_VERSION_GROUPING_MODEL
+	case VersionGroupingArchitecture:
+		return monitorv1.VersionGrouping_VERSION_GROUPING_ARCHITECTURE
+	default:
+		return monitorv1.VersionGrouping_VERSION_GROUPING_UNSPECIFIED
+	}
+}
+
+func VersionGroupingFromProto(grouping monitorv1.VersionGrouping) VersionGrouping {
+	parsed, err := ParseVersionGrouping(grouping.String())
+	if err != nil {
+		return VersionGrouping("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	compliant = COMPLIANCE_STATUS_COMPLIANT
+	non-compliant = COMPLIANCE_STATUS_NON_COMPLIANT
+	unknown = COMPLIANCE_STATUS_UNKNOWN
+
+)
+*/
+type ComplianceStatus string
+
+func (c *ComplianceStatus) Proto() monitorv1.ComplianceStatus {
+	switch *c {
+	case ComplianceStatusCompliant:
+		return monitorv1.ComplianceStatus_COMPLIANCE_STATUS_COMPLIANT
+	case ComplianceStatusNonCompliant:
+		return monitorv1.ComplianceStatus_COMPLIANCE_STATUS_NON_COMPLIANT
+	case ComplianceStatusUnknown:
+		return monitorv1.ComplianceStatus_COMPLIANCE_STATUS_UNKNOWN
+	default:
+		return monitorv1.ComplianceStatus_COMPLIANCE_STATUS_UNSPECIFIED
+	}
+}
+
+/*
+ENUM(
+
+	spike = ANOMALY_KIND_SPIKE
+	drift = ANOMALY_KIND_DRIFT
+
+)
+*/
+type AnomalyKind string
+
+func (a *AnomalyKind) Proto() monitorv1.AnomalyKind {
+	switch *a {
+	case AnomalyKindSpike:
+		return monitorv1.AnomalyKind_ANOMALY_KIND_SPIKE
+	case AnomalyKindDrift:
+		return monitorv1.AnomalyKind_ANOMALY_KIND_DRIFT
+	default:
+		return monitorv1.AnomalyKind_ANOMALY_KIND_UNSPECIFIED
+	}
+}
+
+func AnomalyKindFromString(value string) AnomalyKind {
+	parsed, err := ParseAnomalyKind(value)
+	if err != nil {
+		return AnomalyKind("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	disabled = SENSITIVITY_DISABLED
+	low = SENSITIVITY_LOW
+	medium = SENSITIVITY_MEDIUM
+	high = SENSITIVITY_HIGH
+
+)
+*/
+type Sensitivity string
+
+func (s *Sensitivity) Proto() monitorv1.Sensitivity {
+	switch *s {
+	case SensitivityDisabled:
+		return monitorv1.Sensitivity_SENSITIVITY_DISABLED
+	case SensitivityLow:
+		return monitorv1.Sensitivity_SENSITIVITY_LOW
+	case SensitivityMedium:
+		return monitorv1.Sensitivity_SENSITIVITY_MEDIUM
+	case SensitivityHigh:
+		return monitorv1.Sensitivity_SENSITIVITY_HIGH
+	default:
+		return monitorv1.Sensitivity_SENSITIVITY_UNSPECIFIED
+	}
+}
+
+// Threshold is the deviation from the baseline (in standard deviations) at which a metric is
+// anomalous, a disabled sensitivity has no threshold.
+func (s *Sensitivity) Threshold() float64 {
+	switch *s {
+	case SensitivityLow:
+		return 5
+	case SensitivityHigh:
+		return 3
+	case SensitivityDisabled:
+		return 0
+	default:
+		return 4
+	}
+}
+
+func SensitivityFromString(value string) Sensitivity {
+	parsed, err := ParseSensitivity(value)
+	if err != nil {
+		return SensitivityMedium
+	}
+	return parsed
+}
+
+func SensitivityFromProto(sensitivity monitorv1.Sensitivity) Sensitivity {
+	switch sensitivity {
+	case monitorv1.Sensitivity_SENSITIVITY_DISABLED:
+		return SensitivityDisabled
+	case monitorv1.Sensitivity_SENSITIVITY_LOW:
+		return SensitivityLow
+	case monitorv1.Sensitivity_SENSITIVITY_HIGH:
+		return SensitivityHigh
+	default:
+		return SensitivityMedium
+	}
+}
+
+/*
+ENUM(
+
+	device = TRANSITION_CAUSE_DEVICE
+	operator = TRANSITION_CAUSE_OPERATOR
+	offline = TRANSITION_CAUSE_OFFLINE
+
+)
+*/
+type TransitionCause string
+
+func (t *TransitionCause) Proto() monitorv1.TransitionCause {
+	switch *t {
+	case TransitionCauseDevice:
+		return monitorv1.TransitionCause_TRANSITION_CAUSE_DEVICE
+	case TransitionCauseOperator:
+		return monitorv1.TransitionCause_TRANSITION_CAUSE_OPERATOR
+	case TransitionCauseOffline:
+		return monitorv1.TransitionCause_TRANSITION_CAUSE_OFFLINE
+	default:
+		return monitorv1.TransitionCause_TRANSITION_CAUSE_UNSPECIFIED
+	}
+}
+
+func TransitionCauseFromString(value string) TransitionCause {
+	parsed, err := ParseTransitionCause(value)
+	if err != nil {
+		return TransitionCause("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	http = PROTOCOL_HTTP
+	http-stream = PROTOCOL_HTTP_STREAM
+	grpc = PROTOCOL_GRPC
+	grpc-stream = PROTOCOL_GRPC_STREAM
+	mqtt = PROTOCOL_MQTT
+	snmp = PROTOCOL_SNMP
+	plugin = PROTOCOL_PLUGIN
+
+)
+*/
+type Protocol string
+
+// Driver returns the name of the built-in driver of a protocol (e.g. grpc-stream)
+func (p Protocol) Driver() string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(p.String(), "PROTOCOL_")), "_", "-")
+}
+
+func (p *Protocol) Proto() monitorv1.Protocol {
+	switch *p {
+	case ProtocolHttp:
+		return monitorv1.Protocol_PROTOCOL_HTTP
+	case ProtocolHttpStream:
+		return monitorv1.Protocol_PROTOCOL_HTTP_STREAM
+	case ProtocolGrpc:
+		return monitorv1.Protocol_PROTOCOL_GRPC
+	case ProtocolGrpcStream:
+		return monitorv1.Protocol_PROTOCOL_GRPC_STREAM
+	case ProtocolMqtt:
+		return monitorv1.Protocol_PROTOCOL_MQTT
+	case ProtocolSnmp:
+		return monitorv1.Protocol_PROTOCOL_SNMP
+	case ProtocolPlugin:
+		return monitorv1.Protocol_PROTOCOL_PLUGIN
+	default:
+		return monitorv1.Protocol_PROTOCOL_UNSPECIFIED
+	}
+}
+
+/*
+ENUM(
+
+	hmac-sha256 = SIGNING_ALGORITHM_HMAC_SHA256
+	ed25519 = SIGNING_ALGORITHM_ED25519
+
+)
+*/
+type SigningAlgorithm string
+
+func (a *SigningAlgorithm) Proto() monitorv1.SigningAlgorithm {
+	switch *a {
+	case SigningAlgorithmHmacSha256:
+		return monitorv1.SigningAlgorithm_SIGNING_ALGORITHM_HMAC_SHA256
+	case SigningAlgorithmEd25519:
+		return monitorv1.SigningAlgorithm_SIGNING_ALGORITHM_ED25519
+	default:
+		return monitorv1.SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED
+	}
+}
+
+func SigningAlgorithmFromString(value string) SigningAlgorithm {
+	parsed, err := ParseSigningAlgorithm(value)
+	if err != nil {
+		return SigningAlgorithm("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	v2c = SNMP_VERSION_V2C
+	v3 = SNMP_VERSION_V3
+
+)
+*/
+type SnmpVersion string
+
+/*
+ENUM(
+
+	md5 = SNMP_AUTH_PROTOCOL_MD5
+	sha = SNMP_AUTH_PROTOCOL_SHA
+	sha256 = SNMP_AUTH_PROTOCOL_SHA256
+	sha512 = SNMP_AUTH_PROTOCOL_SHA512
+
+)
+*/
+type SnmpAuthProtocol string
+
+/*
+ENUM(
+
+	des = SNMP_PRIV_PROTOCOL_DES
+	aes = SNMP_PRIV_PROTOCOL_AES
+	aes256 = SNMP_PRIV_PROTOCOL_AES256
+
+)
+*/
+type SnmpPrivProtocol string
+
+// SnmpCredentialsFromProto converts the credentials of a registration, unset enums are empty
+func SnmpCredentialsFromProto(credentials *monitorv1.SnmpCredentials) SnmpCredentials {
+	if credentials == nil {
+		return SnmpCredentials{}
+	}
+	var result SnmpCredentials
+	if value, err := ParseSnmpVersion(credentials.GetVersion().String()); err == nil {
+		result.Version = value
+	}
+	if value, err := ParseSnmpAuthProtocol(credentials.GetAuthProtocol().String()); err == nil {
+		result.AuthProtocol = value
+	}
+	if value, err := ParseSnmpPrivProtocol(credentials.GetPrivProtocol().String()); err == nil {
+		result.PrivProtocol = value
+	}
+	result.Community = credentials.GetCommunity()
+	result.Username = credentials.GetUsername()
+	result.AuthPassphrase = credentials.GetAuthPassphrase()
+	result.PrivPassphrase = credentials.GetPrivPassphrase()
+	return result
+}
+
+/*
+ENUM(
+
+	unsigned = VERIFICATION_STATUS_UNSIGNED
+	authentic = VERIFICATION_STATUS_AUTHENTIC
+	invalid = VERIFICATION_STATUS_INVALID
+
+)
+*/
+type VerificationStatus string
+
+func (v *VerificationStatus) Proto() monitorv1.VerificationStatus {
+	switch *v {
+	case VerificationStatusUnsigned:
+		return monitorv1.VerificationStatus_VERIFICATION_STATUS_UNSIGNED
+	case VerificationStatusAuthentic:
+		return monitorv1.VerificationStatus_VERIFICATION_STATUS_AUTHENTIC
+	case VerificationStatusInvalid:
+		return monitorv1.VerificationStatus_VERIFICATION_STATUS_INVALID
+	default:
+		return monitorv1.VerificationStatus_VERIFICATION_STATUS_UNSPECIFIED
+	}
+}
+
+func VerificationStatusFromString(value string) VerificationStatus {
+	parsed, err := ParseVerificationStatus(value)
+	if err != nil {
+		return VerificationStatus("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	up = LINK_STATE_UP
+	down = LINK_STATE_DOWN
+
+)
+*/
+type LinkState string
+
+func (l *LinkState) Proto() monitorv1.LinkState {
+	switch *l {
+	case LinkStateUp:
+		return monitorv1.LinkState_LINK_STATE_UP
+	case LinkStateDown:
+		return monitorv1.LinkState_LINK_STATE_DOWN
+	default:
+		return monitorv1.LinkState_LINK_STATE_UNSPECIFIED
+	}
+}
+
+func LinkStateFromString(value string) LinkState {
+	parsed, err := ParseLinkState(value)
+	if err != nil {
+		return LinkState("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	pending = CAMPAIGN_STATUS_PENDING
+	running = CAMPAIGN_STATUS_RUNNING
+	completed = CAMPAIGN_STATUS_COMPLETED
+	halted = CAMPAIGN_STATUS_HALTED
+	rolled-back = CAMPAIGN_STATUS_ROLLED_BACK
+	cancelled = CAMPAIGN_STATUS_CANCELLED
+
+)
+*/
+type CampaignStatus string
+
+func (c *CampaignStatus) Proto() monitorv1.CampaignStatus {
+	switch *c {
+	case CampaignStatusPending:
+		return monitorv1.CampaignStatus_CAMPAIGN_STATUS_PENDING
+	case CampaignStatusRunning:
+		return monitorv1.CampaignStatus_CAMPAIGN_STATUS_RUNNING
+	case CampaignStatusCompleted:
+		return monitorv1.CampaignStatus_CAMPAIGN_STATUS_COMPLETED
+	case CampaignStatusHalted:
+		return monitorv1.CampaignStatus_CAMPAIGN_STATUS_HALTED
+	case CampaignStatusRolledBack:
+		return monitorv1.CampaignStatus_CAMPAIGN_STATUS_ROLLED_BACK
+	case CampaignStatusCancelled:
+		return monitorv1.CampaignStatus_CAMPAIGN_STATUS_CANCELLED
+	default:
+		return monitorv1.CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED
+	}
+}
+
+// IsTerminal reports whether the campaign has finished (successfully or not)
+func (c *CampaignStatus) IsTerminal() bool {
+	return *c != CampaignStatusPending && *c != CampaignStatusRunning
+}
+
+func CampaignStatusFromString(value string) CampaignStatus {
+	parsed, err := ParseCampaignStatus(value)
+	if err != nil {
+		return CampaignStatus("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	pending = CAMPAIGN_DEVICE_STATUS_PENDING
+	upgrading = CAMPAIGN_DEVICE_STATUS_UPGRADING
+	upgraded = CAMPAIGN_DEVICE_STATUS_UPGRADED
+	failed = CAMPAIGN_DEVICE_STATUS_FAILED
+	rolled-back = CAMPAIGN_DEVICE_STATUS_ROLLED_BACK
+	skipped = CAMPAIGN_DEVICE_STATUS_SKIPPED
+
+)
+*/
+type CampaignDeviceStatus string
+
+func (c *CampaignDeviceStatus) Proto() monitorv1.CampaignDeviceStatus {
+	switch *c {
+	case CampaignDeviceStatusPending:
+		return monitorv1.CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_PENDING
+	case CampaignDeviceStatusUpgrading:
+		return monitorv1.CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_UPGRADING
+	case CampaignDeviceStatusUpgraded:
+		return monitorv1.CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_UPGRADED
+	case CampaignDeviceStatusFailed:
+		return monitorv1.CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_FAILED
+	case CampaignDeviceStatusRolledBack:
+		return monitorv1.CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_ROLLED_BACK
+	case CampaignDeviceStatusSkipped:
+		return monitorv1.CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_SKIPPED
+	default:
+		return monitorv1.CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_UNSPECIFIED
+	}
+}
+
+func CampaignDeviceStatusFromString(value string) CampaignDeviceStatus {
+	parsed, err := ParseCampaignDeviceStatus(value)
+	if err != nil {
+		return CampaignDeviceStatus("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	halt = FAILURE_POLICY_HALT
+	rollback = FAILURE_POLICY_ROLLBACK
+
+)
+*/
+type FailurePolicy string
+
+func (f *FailurePolicy) Proto() monitorv1.FailurePolicy {
+	switch *f {
+	case FailurePolicyHalt:
+		return monitorv1.FailurePolicy_FAILURE_POLICY_HALT
+	case FailurePolicyRollback:
+		return monitorv1.FailurePolicy_FAILURE_POLICY_ROLLBACK
+	default:
+		return monitorv1.FailurePolicy_FAILURE_POLICY_UNSPECIFIED
+	}
+}
+
+func FailurePolicyFromString(value string) FailurePolicy {
+	parsed, err := ParseFailurePolicy(value)
+	if err != nil {
+		return FailurePolicy("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	downloading = UPGRADE_PHASE_DOWNLOADING
+	installing = UPGRADE_PHASE_INSTALLING
+	booting = UPGRADE_PHASE_BOOTING
+	completed = UPGRADE_PHASE_COMPLETED
+	failed = UPGRADE_PHASE_FAILED
+
+)
+*/
+type UpgradePhase string
+
+func UpgradePhaseFromString(value string) UpgradePhase {
+	parsed, err := ParseUpgradePhase(value)
+	if err != nil {
+		return UpgradePhase("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	pending = CONFIG_STATUS_PENDING
+	in-sync = CONFIG_STATUS_IN_SYNC
+	reapplied = CONFIG_STATUS_REAPPLIED
+	drifted = CONFIG_STATUS_DRIFTED
+	error = CONFIG_STATUS_ERROR
+
+)
+*/
+type ConfigStatus string
+
+func (c *ConfigStatus) Proto() monitorv1.ConfigStatus {
+	switch *c {
+	case ConfigStatusPending:
+		return monitorv1.ConfigStatus_CONFIG_STATUS_PENDING
+	case ConfigStatusInSync:
+		return monitorv1.ConfigStatus_CONFIG_STATUS_IN_SYNC
+	case ConfigStatusReapplied:
+		return monitorv1.ConfigStatus_CONFIG_STATUS_REAPPLIED
+	case ConfigStatusDrifted:
+		return monitorv1.ConfigStatus_CONFIG_STATUS_DRIFTED
+	case ConfigStatusError:
+		return monitorv1.ConfigStatus_CONFIG_STATUS_ERROR
+	default:
+		return monitorv1.ConfigStatus_CONFIG_STATUS_UNSPECIFIED
+	}
+}
+
+func ConfigStatusFromString(value string) ConfigStatus {
+	parsed, err := ParseConfigStatus(value)
+	if err != nil {
+		return ConfigStatus("")
+	}
+	return parsed
+}
+
+/*
+ENUM(
+
+	reapply = DRIFT_POLICY_REAPPLY
+	flag = DRIFT_POLICY_FLAG
+
+)
+*/
+type DriftPolicy string
+
+func (d *DriftPolicy) Proto() monitorv1.DriftPolicy {
+	switch *d {
+	case DriftPolicyReapply:
+		return monitorv1.DriftPolicy_DRIFT_POLICY_REAPPLY
+	case DriftPolicyFlag:
+		return monitorv1.DriftPolicy_DRIFT_POLICY_FLAG
+	default:
+		return monitorv1.DriftPolicy_DRIFT_POLICY_UNSPECIFIED
+	}
+}
+
+func DriftPolicyFromString(value string) DriftPolicy {
+	parsed, err := ParseDriftPolicy(value)
+	if err != nil {
+		return DriftPolicy("")
+	}
+	return parsed
+}
+
+func ProtocolFromStrings(values []string) []monitorv1.Protocol {
+	result := make([]monitorv1.Protocol, 0, len(values))
+	for _, value := range values {
+		if p, err := ParseProtocol(value); err == nil {
+			result = append(result, p.Proto())
+		}
+	}
+	return result
+}
+
+func ProtocolFromDevice(values []devicev1.Protocol) []Protocol {
+	result := make([]Protocol, 0, len(values))
+	for _, value := range values {
+		if p, err := ParseProtocol(value.String()); err == nil {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func mapDrift(name string, reported, desired map[string]string) []string {
+	var drift []string
+	for _, key := range slices.Sorted(maps.Keys(desired)) {
+		value, ok := reported[key]
+		switch {
+		case !ok:
+			drift = append(drift, fmt.Sprintf("%s.%s: missing (desired %q)", name, key, desired[key]))
+		case value != desired[key]:
+			drift = append(drift, fmt.Sprintf("%s.%s: %q (desired %q)", name, key, value, desired[key]))
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(reported)) {
+		if _, ok := desired[key]; !ok {
+			drift = append(drift, fmt.Sprintf("%s.%s: unexpected", name, key))
+		}
+	}
+	return drift
+}
+
+func deref[T any](ptr *T) T {
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
+}
+
+func counter(value *int64) uint64 {
+	if value == nil || *value < 0 {
+		return 0
+	}
+	return uint64(*value)
+}
+
+func rate(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
 }
@@ -11,6 +11,212 @@ import (
 	"fmt"
 )
 
+const (
+	// AnomalyKindSpike is a AnomalyKind of type spike.
+	AnomalyKindSpike AnomalyKind = "ANOMALY_KIND_SPIKE"
+	// AnomalyKindDrift is a AnomalyKind of type drift.
+	AnomalyKindDrift AnomalyKind = "ANOMALY_KIND_DRIFT"
+)
+
+var ErrInvalidAnomalyKind = errors.New("not a valid AnomalyKind")
+
+// String implements the Stringer interface.
+func (x AnomalyKind) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AnomalyKind) IsValid() bool {
+	_, err := ParseAnomalyKind(string(x))
+	return err == nil
+}
+
+var _AnomalyKindValue = map[string]AnomalyKind{
+	"ANOMALY_KIND_SPIKE": AnomalyKindSpike,
+	"ANOMALY_KIND_DRIFT": AnomalyKindDrift,
+}
+
+// ParseAnomalyKind attempts to convert a string to a AnomalyKind.
+func ParseAnomalyKind(name string) (AnomalyKind, error) {
+	if x, ok := _AnomalyKindValue[name]; ok {
+		return x, nil
+	}
+	return AnomalyKind(""), fmt.Errorf("%s is %w", name, ErrInvalidAnomalyKind)
+}
+
+const (
+	// CampaignDeviceStatusPending is a CampaignDeviceStatus of type pending.
+	CampaignDeviceStatusPending CampaignDeviceStatus = "CAMPAIGN_DEVICE_STATUS_PENDING"
+	// CampaignDeviceStatusUpgrading is a CampaignDeviceStatus of type upgrading.
+	CampaignDeviceStatusUpgrading CampaignDeviceStatus = "CAMPAIGN_DEVICE_STATUS_UPGRADING"
+	// CampaignDeviceStatusUpgraded is a CampaignDeviceStatus of type upgraded.
+	CampaignDeviceStatusUpgraded CampaignDeviceStatus = "CAMPAIGN_DEVICE_STATUS_UPGRADED"
+	// CampaignDeviceStatusFailed is a CampaignDeviceStatus of type failed.
+	CampaignDeviceStatusFailed CampaignDeviceStatus = "CAMPAIGN_DEVICE_STATUS_FAILED"
+	// CampaignDeviceStatusRolledBack is a CampaignDeviceStatus of type rolled-back.
+	CampaignDeviceStatusRolledBack CampaignDeviceStatus = "CAMPAIGN_DEVICE_STATUS_ROLLED_BACK"
+	// CampaignDeviceStatusSkipped is a CampaignDeviceStatus of type skipped.
+	CampaignDeviceStatusSkipped CampaignDeviceStatus = "CAMPAIGN_DEVICE_STATUS_SKIPPED"
+)
+
+var ErrInvalidCampaignDeviceStatus = errors.New("not a valid CampaignDeviceStatus")
+
+// String implements the Stringer interface.
+func (x CampaignDeviceStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x CampaignDeviceStatus) IsValid() bool {
+	_, err := ParseCampaignDeviceStatus(string(x))
+	return err == nil
+}
+
+var _CampaignDeviceStatusValue = map[string]CampaignDeviceStatus{
+	"CAMPAIGN_DEVICE_STATUS_PENDING":     CampaignDeviceStatusPending,
+	"CAMPAIGN_DEVICE_STATUS_UPGRADING":   CampaignDeviceStatusUpgrading,
+	"CAMPAIGN_DEVICE_STATUS_UPGRADED":    CampaignDeviceStatusUpgraded,
+	"CAMPAIGN_DEVICE_STATUS_FAILED":      CampaignDeviceStatusFailed,
+	"CAMPAIGN_DEVICE_STATUS_ROLLED_BACK": CampaignDeviceStatusRolledBack,
+	"CAMPAIGN_DEVICE_STATUS_SKIPPED":     CampaignDeviceStatusSkipped,
+}
+
+// ParseCampaignDeviceStatus attempts to convert a string to a CampaignDeviceStatus.
+func ParseCampaignDeviceStatus(name string) (CampaignDeviceStatus, error) {
+	if x, ok := _CampaignDeviceStatusValue[name]; ok {
+		return x, nil
+	}
+	return CampaignDeviceStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidCampaignDeviceStatus)
+}
+
+const (
+	// CampaignStatusPending is a CampaignStatus of type pending.
+	CampaignStatusPending CampaignStatus = "CAMPAIGN_STATUS_PENDING"
+	// CampaignStatusRunning is a CampaignStatus of type running.
+	CampaignStatusRunning CampaignStatus = "CAMPAIGN_STATUS_RUNNING"
+	// CampaignStatusCompleted is a CampaignStatus of type completed.
+	CampaignStatusCompleted CampaignStatus = "CAMPAIGN_STATUS_COMPLETED"
+	// CampaignStatusHalted is a CampaignStatus of type halted.
+	CampaignStatusHalted CampaignStatus = "CAMPAIGN_STATUS_HALTED"
+	// CampaignStatusRolledBack is a CampaignStatus of type rolled-back.
+	CampaignStatusRolledBack CampaignStatus = "CAMPAIGN_STATUS_ROLLED_BACK"
+	// CampaignStatusCancelled is a CampaignStatus of type cancelled.
+	CampaignStatusCancelled CampaignStatus = "CAMPAIGN_STATUS_CANCELLED"
+)
+
+var ErrInvalidCampaignStatus = errors.New("not a valid CampaignStatus")
+
+// String implements the Stringer interface.
+func (x CampaignStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x CampaignStatus) IsValid() bool {
+	_, err := ParseCampaignStatus(string(x))
+	return err == nil
+}
+
+var _CampaignStatusValue = map[string]CampaignStatus{
+	"CAMPAIGN_STATUS_PENDING":     CampaignStatusPending,
+	"CAMPAIGN_STATUS_RUNNING":     CampaignStatusRunning,
+	"CAMPAIGN_STATUS_COMPLETED":   CampaignStatusCompleted,
+	"CAMPAIGN_STATUS_HALTED":      CampaignStatusHalted,
+	"CAMPAIGN_STATUS_ROLLED_BACK": CampaignStatusRolledBack,
+	"CAMPAIGN_STATUS_CANCELLED":   CampaignStatusCancelled,
+}
+
+// ParseCampaignStatus attempts to convert a string to a CampaignStatus.
+func ParseCampaignStatus(name string) (CampaignStatus, error) {
+	if x, ok := _CampaignStatusValue[name]; ok {
+		return x, nil
+	}
+	return CampaignStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidCampaignStatus)
+}
+
+const (
+	// ComplianceStatusCompliant is a ComplianceStatus of type compliant.
+	ComplianceStatusCompliant ComplianceStatus = "COMPLIANCE_STATUS_COMPLIANT"
+	// ComplianceStatusNonCompliant is a ComplianceStatus of type non-compliant.
+	ComplianceStatusNonCompliant ComplianceStatus = "COMPLIANCE_STATUS_NON_COMPLIANT"
+	// ComplianceStatusUnknown is a ComplianceStatus of type unknown.
+	ComplianceStatusUnknown ComplianceStatus = "COMPLIANCE_STATUS_UNKNOWN"
+)
+
+var ErrInvalidComplianceStatus = errors.New("not a valid ComplianceStatus")
+
+// String implements the Stringer interface.
+func (x ComplianceStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ComplianceStatus) IsValid() bool {
+	_, err := ParseComplianceStatus(string(x))
+	return err == nil
+}
+
+var _ComplianceStatusValue = map[string]ComplianceStatus{
+	"COMPLIANCE_STATUS_COMPLIANT":     ComplianceStatusCompliant,
+	"COMPLIANCE_STATUS_NON_COMPLIANT": ComplianceStatusNonCompliant,
+	"COMPLIANCE_STATUS_UNKNOWN":       ComplianceStatusUnknown,
+}
+
+// ParseComplianceStatus attempts to convert a string to a ComplianceStatus.
+func ParseComplianceStatus(name string) (ComplianceStatus, error) {
+	if x, ok := _ComplianceStatusValue[name]; ok {
+		return x, nil
+	}
+	return ComplianceStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidComplianceStatus)
+}
+
+const (
+	// ConfigStatusPending is a ConfigStatus of type pending.
+	ConfigStatusPending ConfigStatus = "CONFIG_STATUS_PENDING"
+	// ConfigStatusInSync is a ConfigStatus of type in-sync.
+	ConfigStatusInSync ConfigStatus = "CONFIG_STATUS_IN_SYNC"
+	// ConfigStatusReapplied is a ConfigStatus of type reapplied.
+	ConfigStatusReapplied ConfigStatus = "CONFIG_STATUS_REAPPLIED"
+	// ConfigStatusDrifted is a ConfigStatus of type drifted.
+	ConfigStatusDrifted ConfigStatus = "CONFIG_STATUS_DRIFTED"
+	// ConfigStatusError is a ConfigStatus of type error.
+	ConfigStatusError ConfigStatus = "CONFIG_STATUS_ERROR"
+)
+
+var ErrInvalidConfigStatus = errors.New("not a valid ConfigStatus")
+
+// String implements the Stringer interface.
+func (x ConfigStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ConfigStatus) IsValid() bool {
+	_, err := ParseConfigStatus(string(x))
+	return err == nil
+}
+
+var _ConfigStatusValue = map[string]ConfigStatus{
+	"CONFIG_STATUS_PENDING":   ConfigStatusPending,
+	"CONFIG_STATUS_IN_SYNC":   ConfigStatusInSync,
+	"CONFIG_STATUS_REAPPLIED": ConfigStatusReapplied,
+	"CONFIG_STATUS_DRIFTED":   ConfigStatusDrifted,
+	"CONFIG_STATUS_ERROR":     ConfigStatusError,
+}
+
+// ParseConfigStatus attempts to convert a string to a ConfigStatus.
+func ParseConfigStatus(name string) (ConfigStatus, error) {
+	if x, ok := _ConfigStatusValue[name]; ok {
+		return x, nil
+	}
+	return ConfigStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidConfigStatus)
+}
+
 const (
 	// DatabasePostgres is a Database of type postgres.
 	DatabasePostgres Database = "postgres"
@@ -119,6 +325,40 @@ func ParseDeviceStatus(name string) (DeviceStatus, error) {
 	return DeviceStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidDeviceStatus)
 }
 
+const (
+	// DriftPolicyReapply is a DriftPolicy of type reapply.
+	DriftPolicyReapply DriftPolicy = "DRIFT_POLICY_REAPPLY"
+	// DriftPolicyFlag is a DriftPolicy of type flag.
+	DriftPolicyFlag DriftPolicy = "DRIFT_POLICY_FLAG"
+)
+
+var ErrInvalidDriftPolicy = errors.New("not a valid DriftPolicy")
+
+// String implements the Stringer interface.
+func (x DriftPolicy) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DriftPolicy) IsValid() bool {
+	_, err := ParseDriftPolicy(string(x))
+	return err == nil
+}
+
+var _DriftPolicyValue = map[string]DriftPolicy{
+	"DRIFT_POLICY_REAPPLY": DriftPolicyReapply,
+	"DRIFT_POLICY_FLAG":    DriftPolicyFlag,
+}
+
+// ParseDriftPolicy attempts to convert a string to a DriftPolicy.
+func ParseDriftPolicy(name string) (DriftPolicy, error) {
+	if x, ok := _DriftPolicyValue[name]; ok {
+		return x, nil
+	}
+	return DriftPolicy(""), fmt.Errorf("%s is %w", name, ErrInvalidDriftPolicy)
+}
+
 const (
 	// EnvironmentTest is a Environment of type test.
 	EnvironmentTest Environment = "test"
@@ -159,6 +399,357 @@ func ParseEnvironment(name string) (Environment, error) {
 	return Environment(""), fmt.Errorf("%s is %w", name, ErrInvalidEnvironment)
 }
 
+const (
+	// EventSeverityInfo is a EventSeverity of type info.
+	EventSeverityInfo EventSeverity = "EVENT_SEVERITY_INFO"
+	// EventSeverityWarning is a EventSeverity of type warning.
+	EventSeverityWarning EventSeverity = "EVENT_SEVERITY_WARNING"
+	// EventSeverityError is a EventSeverity of type error.
+	EventSeverityError EventSeverity = "EVENT_SEVERITY_ERROR"
+)
+
+var ErrInvalidEventSeverity = errors.New("not a valid EventSeverity")
+
+// String implements the Stringer interface.
+func (x EventSeverity) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EventSeverity) IsValid() bool {
+	_, err := ParseEventSeverity(string(x))
+	return err == nil
+}
+
+var _EventSeverityValue = map[string]EventSeverity{
+	"EVENT_SEVERITY_INFO":    EventSeverityInfo,
+	"EVENT_SEVERITY_WARNING": EventSeverityWarning,
+	"EVENT_SEVERITY_ERROR":   EventSeverityError,
+}
+
+// ParseEventSeverity attempts to convert a string to a EventSeverity.
+func ParseEventSeverity(name string) (EventSeverity, error) {
+	if x, ok := _EventSeverityValue[name]; ok {
+		return x, nil
+	}
+	return EventSeverity(""), fmt.Errorf("%s is %w", name, ErrInvalidEventSeverity)
+}
+
+const (
+	// EventTypeRegistered is a EventType of type registered.
+	EventTypeRegistered EventType = "EVENT_TYPE_REGISTERED"
+	// EventTypeStatusChanged is a EventType of type status-changed.
+	EventTypeStatusChanged EventType = "EVENT_TYPE_STATUS_CHANGED"
+	// EventTypeOffline is a EventType of type offline.
+	EventTypeOffline EventType = "EVENT_TYPE_OFFLINE"
+	// EventTypeChecksumMismatch is a EventType of type checksum-mismatch.
+	EventTypeChecksumMismatch EventType = "EVENT_TYPE_CHECKSUM_MISMATCH"
+	// EventTypeVersionChanged is a EventType of type version-changed.
+	EventTypeVersionChanged EventType = "EVENT_TYPE_VERSION_CHANGED"
+	// EventTypeWorkerStarted is a EventType of type worker-started.
+	EventTypeWorkerStarted EventType = "EVENT_TYPE_WORKER_STARTED"
+	// EventTypeWorkerRestarted is a EventType of type worker-restarted.
+	EventTypeWorkerRestarted EventType = "EVENT_TYPE_WORKER_RESTARTED"
+	// EventTypeWorkerStopped is a EventType of type worker-stopped.
+	EventTypeWorkerStopped EventType = "EVENT_TYPE_WORKER_STOPPED"
+)
+
+var ErrInvalidEventType = errors.New("not a valid EventType")
+
+// String implements the Stringer interface.
+func (x EventType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EventType) IsValid() bool {
+	_, err := ParseEventType(string(x))
+	return err == nil
+}
+
+var _EventTypeValue = map[string]EventType{
+	"EVENT_TYPE_REGISTERED":        EventTypeRegistered,
+	"EVENT_TYPE_STATUS_CHANGED":    EventTypeStatusChanged,
+	"EVENT_TYPE_OFFLINE":           EventTypeOffline,
+	"EVENT_TYPE_CHECKSUM_MISMATCH": EventTypeChecksumMismatch,
+	"EVENT_TYPE_VERSION_CHANGED":   EventTypeVersionChanged,
+	"EVENT_TYPE_WORKER_STARTED":    EventTypeWorkerStarted,
+	"EVENT_TYPE_WORKER_RESTARTED":  EventTypeWorkerRestarted,
+	"EVENT_TYPE_WORKER_STOPPED":    EventTypeWorkerStopped,
+}
+
+// ParseEventType attempts to convert a string to a EventType.
+func ParseEventType(name string) (EventType, error) {
+	if x, ok := _EventTypeValue[name]; ok {
+		return x, nil
+	}
+	return EventType(""), fmt.Errorf("%s is %w", name, ErrInvalidEventType)
+}
+
+const (
+	// ExportFormatCsv is a ExportFormat of type csv.
+	ExportFormatCsv ExportFormat = "EXPORT_FORMAT_CSV"
+	// ExportFormatNdjson is a ExportFormat of type ndjson.
+	ExportFormatNdjson ExportFormat = "EXPORT_FORMAT_NDJSON"
+	// ExportFormatParquet is a ExportFormat of type parquet.
+	ExportFormatParquet ExportFormat = "EXPORT_FORMAT_PARQUET"
+)
+
+var ErrInvalidExportFormat = errors.New("not a valid ExportFormat")
+
+// String implements the Stringer interface.
+func (x ExportFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ExportFormat) IsValid() bool {
+	_, err := ParseExportFormat(string(x))
+	return err == nil
+}
+
+var _ExportFormatValue = map[string]ExportFormat{
+	"EXPORT_FORMAT_CSV":     ExportFormatCsv,
+	"EXPORT_FORMAT_NDJSON":  ExportFormatNdjson,
+	"EXPORT_FORMAT_PARQUET": ExportFormatParquet,
+}
+
+// ParseExportFormat attempts to convert a string to a ExportFormat.
+func ParseExportFormat(name string) (ExportFormat, error) {
+	if x, ok := _ExportFormatValue[name]; ok {
+		return x, nil
+	}
+	return ExportFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidExportFormat)
+}
+
+const (
+	// FailurePolicyHalt is a FailurePolicy of type halt.
+	FailurePolicyHalt FailurePolicy = "FAILURE_POLICY_HALT"
+	// FailurePolicyRollback is a FailurePolicy of type rollback.
+	FailurePolicyRollback FailurePolicy = "FAILURE_POLICY_ROLLBACK"
+)
+
+var ErrInvalidFailurePolicy = errors.New("not a valid FailurePolicy")
+
+// String implements the Stringer interface.
+func (x FailurePolicy) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x FailurePolicy) IsValid() bool {
+	_, err := ParseFailurePolicy(string(x))
+	return err == nil
+}
+
+var _FailurePolicyValue = map[string]FailurePolicy{
+	"FAILURE_POLICY_HALT":     FailurePolicyHalt,
+	"FAILURE_POLICY_ROLLBACK": FailurePolicyRollback,
+}
+
+// ParseFailurePolicy attempts to convert a string to a FailurePolicy.
+func ParseFailurePolicy(name string) (FailurePolicy, error) {
+	if x, ok := _FailurePolicyValue[name]; ok {
+		return x, nil
+	}
+	return FailurePolicy(""), fmt.Errorf("%s is %w", name, ErrInvalidFailurePolicy)
+}
+
+const (
+	// ForecastModelLinear is a ForecastModel of type linear.
+	ForecastModelLinear ForecastModel = "FORECAST_MODEL_LINEAR"
+	// ForecastModelHoltWinters is a ForecastModel of type holt-winters.
+	ForecastModelHoltWinters ForecastModel = "FORECAST_MODEL_HOLT_WINTERS"
+)
+
+var ErrInvalidForecastModel = errors.New("not a valid ForecastModel")
+
+// String implements the Stringer interface.
+func (x ForecastModel) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ForecastModel) IsValid() bool {
+	_, err := ParseForecastModel(string(x))
+	return err == nil
+}
+
+var _ForecastModelValue = map[string]ForecastModel{
+	"FORECAST_MODEL_LINEAR":       ForecastModelLinear,
+	"FORECAST_MODEL_HOLT_WINTERS": ForecastModelHoltWinters,
+}
+
+// ParseForecastModel attempts to convert a string to a ForecastModel.
+func ParseForecastModel(name string) (ForecastModel, error) {
+	if x, ok := _ForecastModelValue[name]; ok {
+		return x, nil
+	}
+	return ForecastModel(""), fmt.Errorf("%s is %w", name, ErrInvalidForecastModel)
+}
+
+const (
+	// ImportStatusCreated is a ImportStatus of type created.
+	ImportStatusCreated ImportStatus = "IMPORT_STATUS_CREATED"
+	// ImportStatusUpdated is a ImportStatus of type updated.
+	ImportStatusUpdated ImportStatus = "IMPORT_STATUS_UPDATED"
+	// ImportStatusUnchanged is a ImportStatus of type unchanged.
+	ImportStatusUnchanged ImportStatus = "IMPORT_STATUS_UNCHANGED"
+	// ImportStatusFailed is a ImportStatus of type failed.
+	ImportStatusFailed ImportStatus = "IMPORT_STATUS_FAILED"
+	// ImportStatusPruned is a ImportStatus of type pruned.
+	ImportStatusPruned ImportStatus = "IMPORT_STATUS_PRUNED"
+)
+
+var ErrInvalidImportStatus = errors.New("not a valid ImportStatus")
+
+// String implements the Stringer interface.
+func (x ImportStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ImportStatus) IsValid() bool {
+	_, err := ParseImportStatus(string(x))
+	return err == nil
+}
+
+var _ImportStatusValue = map[string]ImportStatus{
+	"IMPORT_STATUS_CREATED":   ImportStatusCreated,
+	"IMPORT_STATUS_UPDATED":   ImportStatusUpdated,
+	"IMPORT_STATUS_UNCHANGED": ImportStatusUnchanged,
+	"IMPORT_STATUS_FAILED":    ImportStatusFailed,
+	"IMPORT_STATUS_PRUNED":    ImportStatusPruned,
+}
+
+// ParseImportStatus attempts to convert a string to a ImportStatus.
+func ParseImportStatus(name string) (ImportStatus, error) {
+	if x, ok := _ImportStatusValue[name]; ok {
+		return x, nil
+	}
+	return ImportStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidImportStatus)
+}
+
+const (
+	// InventoryFormatYaml is a InventoryFormat of type yaml.
+	InventoryFormatYaml InventoryFormat = "INVENTORY_FORMAT_YAML"
+	// InventoryFormatCsv is a InventoryFormat of type csv.
+	InventoryFormatCsv InventoryFormat = "INVENTORY_FORMAT_CSV"
+)
+
+var ErrInvalidInventoryFormat = errors.New("not a valid InventoryFormat")
+
+// String implements the Stringer interface.
+func (x InventoryFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x InventoryFormat) IsValid() bool {
+	_, err := ParseInventoryFormat(string(x))
+	return err == nil
+}
+
+var _InventoryFormatValue = map[string]InventoryFormat{
+	"INVENTORY_FORMAT_YAML": InventoryFormatYaml,
+	"INVENTORY_FORMAT_CSV":  InventoryFormatCsv,
+}
+
+// ParseInventoryFormat attempts to convert a string to a InventoryFormat.
+func ParseInventoryFormat(name string) (InventoryFormat, error) {
+	if x, ok := _InventoryFormatValue[name]; ok {
+		return x, nil
+	}
+	return InventoryFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidInventoryFormat)
+}
+
+const (
+	// LinkStateUp is a LinkState of type up.
+	LinkStateUp LinkState = "LINK_STATE_UP"
+	// LinkStateDown is a LinkState of type down.
+	LinkStateDown LinkState = "LINK_STATE_DOWN"
+)
+
+var ErrInvalidLinkState = errors.New("not a valid LinkState")
+
+// String implements the Stringer interface.
+func (x LinkState) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x LinkState) IsValid() bool {
+	_, err := ParseLinkState(string(x))
+	return err == nil
+}
+
+var _LinkStateValue = map[string]LinkState{
+	"LINK_STATE_UP":   LinkStateUp,
+	"LINK_STATE_DOWN": LinkStateDown,
+}
+
+// ParseLinkState attempts to convert a string to a LinkState.
+func ParseLinkState(name string) (LinkState, error) {
+	if x, ok := _LinkStateValue[name]; ok {
+		return x, nil
+	}
+	return LinkState(""), fmt.Errorf("%s is %w", name, ErrInvalidLinkState)
+}
+
+const (
+	// MetricCpu is a Metric of type cpu.
+	MetricCpu Metric = "METRIC_CPU"
+	// MetricMemory is a Metric of type memory.
+	MetricMemory Metric = "METRIC_MEMORY"
+	// MetricTemperature is a Metric of type temperature.
+	MetricTemperature Metric = "METRIC_TEMPERATURE"
+	// MetricLoad is a Metric of type load.
+	MetricLoad Metric = "METRIC_LOAD"
+	// MetricDisk is a Metric of type disk.
+	MetricDisk Metric = "METRIC_DISK"
+	// MetricProcesses is a Metric of type processes.
+	MetricProcesses Metric = "METRIC_PROCESSES"
+)
+
+var ErrInvalidMetric = errors.New("not a valid Metric")
+
+// String implements the Stringer interface.
+func (x Metric) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Metric) IsValid() bool {
+	_, err := ParseMetric(string(x))
+	return err == nil
+}
+
+var _MetricValue = map[string]Metric{
+	"METRIC_CPU":         MetricCpu,
+	"METRIC_MEMORY":      MetricMemory,
+	"METRIC_TEMPERATURE": MetricTemperature,
+	"METRIC_LOAD":        MetricLoad,
+	"METRIC_DISK":        MetricDisk,
+	"METRIC_PROCESSES":   MetricProcesses,
+}
+
+// ParseMetric attempts to convert a string to a Metric.
+func ParseMetric(name string) (Metric, error) {
+	if x, ok := _MetricValue[name]; ok {
+		return x, nil
+	}
+	return Metric(""), fmt.Errorf("%s is %w", name, ErrInvalidMetric)
+}
+
 const (
 	// PostgresConnectionProxy is a PostgresConnection of type proxy.
 	PostgresConnectionProxy PostgresConnection = "proxy"
@@ -199,6 +790,12 @@ const (
 	ProtocolGrpc Protocol = "PROTOCOL_GRPC"
 	// ProtocolGrpcStream is a Protocol of type grpc-stream.
 	ProtocolGrpcStream Protocol = "PROTOCOL_GRPC_STREAM"
+	// ProtocolMqtt is a Protocol of type mqtt.
+	ProtocolMqtt Protocol = "PROTOCOL_MQTT"
+	// ProtocolSnmp is a Protocol of type snmp.
+	ProtocolSnmp Protocol = "PROTOCOL_SNMP"
+	// ProtocolPlugin is a Protocol of type plugin.
+	ProtocolPlugin Protocol = "PROTOCOL_PLUGIN"
 )
 
 var ErrInvalidProtocol = errors.New("not a valid Protocol")
@@ -220,6 +817,9 @@ var _ProtocolValue = map[string]Protocol{
 	"PROTOCOL_HTTP_STREAM": ProtocolHttpStream,
 	"PROTOCOL_GRPC":        ProtocolGrpc,
 	"PROTOCOL_GRPC_STREAM": ProtocolGrpcStream,
+	"PROTOCOL_MQTT":        ProtocolMqtt,
+	"PROTOCOL_SNMP":        ProtocolSnmp,
+	"PROTOCOL_PLUGIN":      ProtocolPlugin,
 }
 
 // ParseProtocol attempts to convert a string to a Protocol.
@@ -229,3 +829,376 @@ func ParseProtocol(name string) (Protocol, error) {
 	}
 	return Protocol(""), fmt.Errorf("%s is %w", name, ErrInvalidProtocol)
 }
+
+const (
+	// SensitivityDisabled is a Sensitivity of type disabled.
+	SensitivityDisabled Sensitivity = "SENSITIVITY_DISABLED"
+	// SensitivityLow is a Sensitivity of type low.
+	SensitivityLow Sensitivity = "SENSITIVITY_LOW"
+	// SensitivityMedium is a Sensitivity of type medium.
+	SensitivityMedium Sensitivity = "SENSITIVITY_MEDIUM"
+	// SensitivityHigh is a Sensitivity of type high.
+	SensitivityHigh Sensitivity = "SENSITIVITY_HIGH"
+)
+
+var ErrInvalidSensitivity = errors.New("not a valid Sensitivity")
+
+// String implements the Stringer interface.
+func (x Sensitivity) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Sensitivity) IsValid() bool {
+	_, err := ParseSensitivity(string(x))
+	return err == nil
+}
+
+var _SensitivityValue = map[string]Sensitivity{
+	"SENSITIVITY_DISABLED": SensitivityDisabled,
+	"SENSITIVITY_LOW":      SensitivityLow,
+	"SENSITIVITY_MEDIUM":   SensitivityMedium,
+	"SENSITIVITY_HIGH":     SensitivityHigh,
+}
+
+// ParseSensitivity attempts to convert a string to a Sensitivity.
+func ParseSensitivity(name string) (Sensitivity, error) {
+	if x, ok := _SensitivityValue[name]; ok {
+		return x, nil
+	}
+	return Sensitivity(""), fmt.Errorf("%s is %w", name, ErrInvalidSensitivity)
+}
+
+const (
+	// SigningAlgorithmHmacSha256 is a SigningAlgorithm of type hmac-sha256.
+	SigningAlgorithmHmacSha256 SigningAlgorithm = "SIGNING_ALGORITHM_HMAC_SHA256"
+	// SigningAlgorithmEd25519 is a SigningAlgorithm of type ed25519.
+	SigningAlgorithmEd25519 SigningAlgorithm = "SIGNING_ALGORITHM_ED25519"
+)
+
+var ErrInvalidSigningAlgorithm = errors.New("not a valid SigningAlgorithm")
+
+// String implements the Stringer interface.
+func (x SigningAlgorithm) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SigningAlgorithm) IsValid() bool {
+	_, err := ParseSigningAlgorithm(string(x))
+	return err == nil
+}
+
+var _SigningAlgorithmValue = map[string]SigningAlgorithm{
+	"SIGNING_ALGORITHM_HMAC_SHA256": SigningAlgorithmHmacSha256,
+	"SIGNING_ALGORITHM_ED25519":     SigningAlgorithmEd25519,
+}
+
+// ParseSigningAlgorithm attempts to convert a string to a SigningAlgorithm.
+func ParseSigningAlgorithm(name string) (SigningAlgorithm, error) {
+	if x, ok := _SigningAlgorithmValue[name]; ok {
+		return x, nil
+	}
+	return SigningAlgorithm(""), fmt.Errorf("%s is %w", name, ErrInvalidSigningAlgorithm)
+}
+
+const (
+	// SnmpAuthProtocolMd5 is a SnmpAuthProtocol of type md5.
+	SnmpAuthProtocolMd5 SnmpAuthProtocol = "SNMP_AUTH_PROTOCOL_MD5"
+	// SnmpAuthProtocolSha is a SnmpAuthProtocol of type sha.
+	SnmpAuthProtocolSha SnmpAuthProtocol = "SNMP_AUTH_PROTOCOL_SHA"
+	// SnmpAuthProtocolSha256 is a SnmpAuthProtocol of type sha256.
+	SnmpAuthProtocolSha256 SnmpAuthProtocol = "SNMP_AUTH_PROTOCOL_SHA256"
+	// SnmpAuthProtocolSha512 is a SnmpAuthProtocol of type sha512.
+	SnmpAuthProtocolSha512 SnmpAuthProtocol = "SNMP_AUTH_PROTOCOL_SHA512"
+)
+
+var ErrInvalidSnmpAuthProtocol = errors.New("not a valid SnmpAuthProtocol")
+
+// String implements the Stringer interface.
+func (x SnmpAuthProtocol) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SnmpAuthProtocol) IsValid() bool {
+	_, err := ParseSnmpAuthProtocol(string(x))
+	return err == nil
+}
+
+var _SnmpAuthProtocolValue = map[string]SnmpAuthProtocol{
+	"SNMP_AUTH_PROTOCOL_MD5":    SnmpAuthProtocolMd5,
+	"SNMP_AUTH_PROTOCOL_SHA":    SnmpAuthProtocolSha,
+	"SNMP_AUTH_PROTOCOL_SHA256": SnmpAuthProtocolSha256,
+	"SNMP_AUTH_PROTOCOL_SHA512": SnmpAuthProtocolSha512,
+}
+
+// ParseSnmpAuthProtocol attempts to convert a string to a SnmpAuthProtocol.
+func ParseSnmpAuthProtocol(name string) (SnmpAuthProtocol, error) {
+	if x, ok := _SnmpAuthProtocolValue[name]; ok {
+		return x, nil
+	}
+	return SnmpAuthProtocol(""), fmt.Errorf("%s is %w", name, ErrInvalidSnmpAuthProtocol)
+}
+
+const (
+	// SnmpPrivProtocolDes is a SnmpPrivProtocol of type des.
+	SnmpPrivProtocolDes SnmpPrivProtocol = "SNMP_PRIV_PROTOCOL_DES"
+	// SnmpPrivProtocolAes is a SnmpPrivProtocol of type aes.
+	SnmpPrivProtocolAes SnmpPrivProtocol = "SNMP_PRIV_PROTOCOL_AES"
+	// SnmpPrivProtocolAes256 is a SnmpPrivProtocol of type aes256.
+	SnmpPrivProtocolAes256 SnmpPrivProtocol = "SNMP_PRIV_PROTOCOL_AES256"
+)
+
+var ErrInvalidSnmpPrivProtocol = errors.New("not a valid SnmpPrivProtocol")
+
+// String implements the Stringer interface.
+func (x SnmpPrivProtocol) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SnmpPrivProtocol) IsValid() bool {
+	_, err := ParseSnmpPrivProtocol(string(x))
+	return err == nil
+}
+
+var _SnmpPrivProtocolValue = map[string]SnmpPrivProtocol{
+	"SNMP_PRIV_PROTOCOL_DES":    SnmpPrivProtocolDes,
+	"SNMP_PRIV_PROTOCOL_AES":    SnmpPrivProtocolAes,
+	"SNMP_PRIV_PROTOCOL_AES256": SnmpPrivProtocolAes256,
+}
+
+// ParseSnmpPrivProtocol attempts to convert a string to a SnmpPrivProtocol.
+func ParseSnmpPrivProtocol(name string) (SnmpPrivProtocol, error) {
+	if x, ok := _SnmpPrivProtocolValue[name]; ok {
+		return x, nil
+	}
+	return SnmpPrivProtocol(""), fmt.Errorf("%s is %w", name, ErrInvalidSnmpPrivProtocol)
+}
+
+const (
+	// SnmpVersionV2c is a SnmpVersion of type v2c.
+	SnmpVersionV2c SnmpVersion = "SNMP_VERSION_V2C"
+	// SnmpVersionV3 is a SnmpVersion of type v3.
+	SnmpVersionV3 SnmpVersion = "SNMP_VERSION_V3"
+)
+
+var ErrInvalidSnmpVersion = errors.New("not a valid SnmpVersion")
+
+// String implements the Stringer interface.
+func (x SnmpVersion) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SnmpVersion) IsValid() bool {
+	_, err := ParseSnmpVersion(string(x))
+	return err == nil
+}
+
+var _SnmpVersionValue = map[string]SnmpVersion{
+	"SNMP_VERSION_V2C": SnmpVersionV2c,
+	"SNMP_VERSION_V3":  SnmpVersionV3,
+}
+
+// ParseSnmpVersion attempts to convert a string to a SnmpVersion.
+func ParseSnmpVersion(name string) (SnmpVersion, error) {
+	if x, ok := _SnmpVersionValue[name]; ok {
+		return x, nil
+	}
+	return SnmpVersion(""), fmt.Errorf("%s is %w", name, ErrInvalidSnmpVersion)
+}
+
+const (
+	// TransitionCauseDevice is a TransitionCause of type device.
+	TransitionCauseDevice TransitionCause = "TRANSITION_CAUSE_DEVICE"
+	// TransitionCauseOperator is a TransitionCause of type operator.
+	TransitionCauseOperator TransitionCause = "TRANSITION_CAUSE_OPERATOR"
+	// TransitionCauseOffline is a TransitionCause of type offline.
+	TransitionCauseOffline TransitionCause = "TRANSITION_CAUSE_OFFLINE"
+)
+
+var ErrInvalidTransitionCause = errors.New("not a valid TransitionCause")
+
+// String implements the Stringer interface.
+func (x TransitionCause) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TransitionCause) IsValid() bool {
+	_, err := ParseTransitionCause(string(x))
+	return err == nil
+}
+
+var _TransitionCauseValue = map[string]TransitionCause{
+	"TRANSITION_CAUSE_DEVICE":   TransitionCauseDevice,
+	"TRANSITION_CAUSE_OPERATOR": TransitionCauseOperator,
+	"TRANSITION_CAUSE_OFFLINE":  TransitionCauseOffline,
+}
+
+// ParseTransitionCause attempts to convert a string to a TransitionCause.
+func ParseTransitionCause(name string) (TransitionCause, error) {
+	if x, ok := _TransitionCauseValue[name]; ok {
+		return x, nil
+	}
+	return TransitionCause(""), fmt.Errorf("%s is %w", name, ErrInvalidTransitionCause)
+}
+
+const (
+	// UpgradePhaseDownloading is a UpgradePhase of type downloading.
+	UpgradePhaseDownloading UpgradePhase = "UPGRADE_PHASE_DOWNLOADING"
+	// UpgradePhaseInstalling is a UpgradePhase of type installing.
+	UpgradePhaseInstalling UpgradePhase = "UPGRADE_PHASE_INSTALLING"
+	// UpgradePhaseBooting is a UpgradePhase of type booting.
+	UpgradePhaseBooting UpgradePhase = "UPGRADE_PHASE_BOOTING"
+	// UpgradePhaseCompleted is a UpgradePhase of type completed.
+	UpgradePhaseCompleted UpgradePhase = "UPGRADE_PHASE_COMPLETED"
+	// UpgradePhaseFailed is a UpgradePhase of type failed.
+	UpgradePhaseFailed UpgradePhase = "UPGRADE_PHASE_FAILED"
+)
+
+var ErrInvalidUpgradePhase = errors.New("not a valid UpgradePhase")
+
+// String implements the Stringer interface.
+func (x UpgradePhase) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x UpgradePhase) IsValid() bool {
+	_, err := ParseUpgradePhase(string(x))
+	return err == nil
+}
+
+var _UpgradePhaseValue = map[string]UpgradePhase{
+	"UPGRADE_PHASE_DOWNLOADING": UpgradePhaseDownloading,
+	"UPGRADE_PHASE_INSTALLING":  UpgradePhaseInstalling,
+	"UPGRADE_PHASE_BOOTING":     UpgradePhaseBooting,
+	"UPGRADE_PHASE_COMPLETED":   UpgradePhaseCompleted,
+	"UPGRADE_PHASE_FAILED":      UpgradePhaseFailed,
+}
+
+// ParseUpgradePhase attempts to convert a string to a UpgradePhase.
+func ParseUpgradePhase(name string) (UpgradePhase, error) {
+	if x, ok := _UpgradePhaseValue[name]; ok {
+		return x, nil
+	}
+	return UpgradePhase(""), fmt.Errorf("%s is %w", name, ErrInvalidUpgradePhase)
+}
+
+const (
+	// VerificationStatusUnsigned is a VerificationStatus of type unsigned.
+	VerificationStatusUnsigned VerificationStatus = "VERIFICATION_STATUS_UNSIGNED"
+	// VerificationStatusAuthentic is a VerificationStatus of type authentic.
+	VerificationStatusAuthentic VerificationStatus = "VERIFICATION_STATUS_AUTHENTIC"
+	// VerificationStatusInvalid is a VerificationStatus of type invalid.
+	VerificationStatusInvalid VerificationStatus = "VERIFICATION_STATUS_INVALID"
+)
+
+var ErrInvalidVerificationStatus = errors.New("not a valid VerificationStatus")
+
+// String implements the Stringer interface.
+func (x VerificationStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x VerificationStatus) IsValid() bool {
+	_, err := ParseVerificationStatus(string(x))
+	return err == nil
+}
+
+var _VerificationStatusValue = map[string]VerificationStatus{
+	"VERIFICATION_STATUS_UNSIGNED":  VerificationStatusUnsigned,
+	"VERIFICATION_STATUS_AUTHENTIC": VerificationStatusAuthentic,
+	"VERIFICATION_STATUS_INVALID":   VerificationStatusInvalid,
+}
+
+// ParseVerificationStatus attempts to convert a string to a VerificationStatus.
+func ParseVerificationStatus(name string) (VerificationStatus, error) {
+	if x, ok := _VerificationStatusValue[name]; ok {
+		return x, nil
+	}
+	return VerificationStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidVerificationStatus)
+}
+
+const (
+	// VersionComponentHardware is a VersionComponent of type hardware.
+	VersionComponentHardware VersionComponent = "VERSION_COMPONENT_HARDWARE"
+	// VersionComponentSoftware is a VersionComponent of type software.
+	VersionComponentSoftware VersionComponent = "VERSION_COMPONENT_SOFTWARE"
+	// VersionComponentFirmware is a VersionComponent of type firmware.
+	VersionComponentFirmware VersionComponent = "VERSION_COMPONENT_FIRMWARE"
+)
+
+var ErrInvalidVersionComponent = errors.New("not a valid VersionComponent")
+
+// String implements the Stringer interface.
+func (x VersionComponent) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x VersionComponent) IsValid() bool {
+	_, err := ParseVersionComponent(string(x))
+	return err == nil
+}
+
+var _VersionComponentValue = map[string]VersionComponent{
+	"VERSION_COMPONENT_HARDWARE": VersionComponentHardware,
+	"VERSION_COMPONENT_SOFTWARE": VersionComponentSoftware,
+	"VERSION_COMPONENT_FIRMWARE": VersionComponentFirmware,
+}
+
+// ParseVersionComponent attempts to convert a string to a VersionComponent.
+func ParseVersionComponent(name string) (VersionComponent, error) {
+	if x, ok := _VersionComponentValue[name]; ok {
+		return x, nil
+	}
+	return VersionComponent(""), fmt.Errorf("%s is %w", name, ErrInvalidVersionComponent)
+}
+
+const (
+	// VersionGroupingModel is a VersionGrouping of type model.
+	VersionGroupingModel VersionGrouping = "VERSION_GROUPING_MODEL"
+	// VersionGroupingArchitecture is a VersionGrouping of type architecture.
+	VersionGroupingArchitecture VersionGrouping = "VERSION_GROUPING_ARCHITECTURE"
+)
+
+var ErrInvalidVersionGrouping = errors.New("not a valid VersionGrouping")
+
+// String implements the Stringer interface.
+func (x VersionGrouping) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x VersionGrouping) IsValid() bool {
+	_, err := ParseVersionGrouping(string(x))
+	return err == nil
+}
+
+var _VersionGroupingValue = map[string]VersionGrouping{
+	"VERSION_GROUPING_MODEL":        VersionGroupingModel,
+	"VERSION_GROUPING_ARCHITECTURE": VersionGroupingArchitecture,
+}
+
+// ParseVersionGrouping attempts to convert a string to a VersionGrouping.
+func ParseVersionGrouping(name string) (VersionGrouping, error) {
+	if x, ok := _VersionGroupingValue[name]; ok {
+		return x, nil
+	}
+	return VersionGrouping(""), fmt.Errorf("%s is %w", name, ErrInvalidVersionGrouping)
+}
@@ -0,0 +1,94 @@
+package version
+
+import (
+	"cmp"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+var release = regexp.MustCompile(`\d+(\.\d+)*`)
+
+// Release returns the numeric release of a version, which is its first run of dot separated
+// numbers (e.g. 4.3.20.11298 of FW:4.3.20.11298 or 3.22.2 of SW:alpine:3.22.2:arm64).
+func Release(version string) string {
+	return release.FindString(version)
+}
+
+// Compare orders versions by their numeric release, missing trailing numbers count as zero.
+// Versions without a release are ordered as strings after those with a release.
+func Compare(a, b string) int {
+	ra, rb := Release(a), Release(b)
+	switch {
+	case ra == "" && rb == "":
+		return strings.Compare(a, b)
+	case ra == "":
+		return 1
+	case rb == "":
+		return -1
+	}
+	na, nb := numbers(ra), numbers(rb)
+	for i := range max(len(na), len(nb)) {
+		if c := cmp.Compare(at(na, i), at(nb, i)); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Matches reports whether a version or its release matches an exact version or a pattern
+// (e.g. FW:4.3.* or 4.3.*)
+func Matches(pattern, version string) bool {
+	return slices.ContainsFunc([]string{version, Release(version)}, func(candidate string) bool {
+		if candidate == pattern {
+			return true
+		}
+		matched, err := path.Match(pattern, candidate)
+		return err == nil && matched
+	})
+}
+
+// Change is a version of a component that differs from the version of the previous sample
+type Change struct {
+	Component types.VersionComponent
+	Previous  string
+	Current   string
+}
+
+// Changes returns the components whose version differs between two samples, components that
+// are not reported by either sample (e.g. by offline samples) are not compared.
+func Changes(previous, current types.DeviceVersions) []Change {
+	var result []Change
+	for _, component := range []types.VersionComponent{
+		types.VersionComponentHardware,
+		types.VersionComponentSoftware,
+		types.VersionComponentFirmware,
+	} {
+		before, after := previous.Version(component), current.Version(component)
+		if before == "" || after == "" || before == after {
+			continue
+		}
+		result = append(result, Change{Component: component, Previous: before, Current: after})
+	}
+	return result
+}
+
+func numbers(release string) []int {
+	parts := strings.Split(release, ".")
+	result := make([]int, len(parts))
+	for i, part := range parts {
+		result[i], _ = strconv.Atoi(part)
+	}
+	return result
+}
+
+func at(values []int, i int) int {
+	if i < len(values) {
+		return values[i]
+	}
+	return 0
+}
@@ -0,0 +1,154 @@
+package version
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/emil-j-olsson/ubiquiti/backend/internal/types"
+)
+
+func TestChanges(t *testing.T) {
+	reported := types.DeviceVersions{
+		Hardware: "HW:2.9.3",
+		Software: "SW:ubuntu:22.04:amd64",
+		Firmware: "FW:5.10.2.11230",
+	}
+	tests := []struct {
+		name     string
+		previous types.DeviceVersions
+		current  types.DeviceVersions
+		expected []Change
+	}{
+		{
+			name:     "should report no changes of equal versions",
+			previous: reported,
+			current:  reported,
+		},
+		{
+			name:     "should report firmware change",
+			previous: reported,
+			current: types.DeviceVersions{
+				Hardware: reported.Hardware,
+				Software: reported.Software,
+				Firmware: "FW:5.11.0.11599",
+			},
+			expected: []Change{
+				{
+					Component: types.VersionComponentFirmware,
+					Previous:  "FW:5.10.2.11230",
+					Current:   "FW:5.11.0.11599",
+				},
+			},
+		},
+		{
+			name:     "should report changes in component order",
+			previous: reported,
+			current: types.DeviceVersions{
+				Hardware: "HW:3.0.0",
+				Software: "SW:ubuntu:24.04:amd64",
+				Firmware: "FW:5.9.0.10000",
+			},
+			expected: []Change{
+				{Component: types.VersionComponentHardware, Previous: "HW:2.9.3", Current: "HW:3.0.0"},
+				{
+					Component: types.VersionComponentSoftware,
+					Previous:  "SW:ubuntu:22.04:amd64",
+					Current:   "SW:ubuntu:24.04:amd64",
+				},
+				{
+					Component: types.VersionComponentFirmware,
+					Previous:  "FW:5.10.2.11230",
+					Current:   "FW:5.9.0.10000",
+				},
+			},
+		},
+		{
+			name:     "should skip components missing from current sample",
+			previous: reported,
+			current:  types.DeviceVersions{Firmware: "FW:5.11.0.11599"},
+			expected: []Change{
+				{
+					Component: types.VersionComponentFirmware,
+					Previous:  "FW:5.10.2.11230",
+					Current:   "FW:5.11.0.11599",
+				},
+			},
+		},
+		{
+			name:    "should skip components missing from previous sample",
+			current: reported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if changes := Changes(tt.previous, tt.current); !slices.Equal(changes, tt.expected) {
+				t.Errorf("expected changes %v, got %v", tt.expected, changes)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected int
+	}{
+		{name: "should order by numeric release", a: "FW:4.3.20.11298", b: "FW:5.11.0.11599", expected: -1},
+		{name: "should compare numbers not strings", a: "FW:5.10.0", b: "FW:5.9.0", expected: 1},
+		{name: "should count missing numbers as zero", a: "4.3", b: "FW:4.3.0.0", expected: 0},
+		{name: "should order release before none", a: "FW:1.0", b: "FW:beta", expected: -1},
+		{name: "should order versions without release as strings", a: "beta", b: "alpha", expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Compare(tt.a, tt.b); result != tt.expected {
+				t.Errorf("expected %d comparing %s to %s, got %d", tt.expected, tt.a, tt.b, result)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		version  string
+		expected bool
+	}{
+		{
+			name:     "should match exact version",
+			pattern:  "FW:4.3.20.11298",
+			version:  "FW:4.3.20.11298",
+			expected: true,
+		},
+		{
+			name:     "should match exact release",
+			pattern:  "4.3.20.11298",
+			version:  "FW:4.3.20.11298",
+			expected: true,
+		},
+		{
+			name:     "should match version pattern",
+			pattern:  "FW:4.3.*",
+			version:  "FW:4.3.20.11298",
+			expected: true,
+		},
+		{name: "should match release pattern", pattern: "4.3.*", version: "FW:4.3.20.11298", expected: true},
+		{name: "should not match other release", pattern: "4.4.*", version: "FW:4.3.20.11298"},
+		{name: "should not match invalid pattern", pattern: "[4.3", version: "FW:4.3.20.11298"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Matches(tt.pattern, tt.version); result != tt.expected {
+				t.Errorf(
+					"expected %t matching %s against %s, got %t",
+					tt.expected,
+					tt.version,
+					tt.pattern,
+					result,
+				)
+			}
+		})
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,20 +15,17 @@ import (
 
 var _ = []Worker{(*WorkerPoll)(nil), (*WorkerStream)(nil)}
 
-var (
-	// Protocol hierarchy preference for workers (desc)
-	WorkerProtocolHierarchy = []types.Protocol{
-		types.ProtocolGrpcStream,
-		types.ProtocolHttpStream,
-		types.ProtocolGrpc,
-		types.ProtocolHttp,
-	}
-)
-
 type PersistenceProvider interface {
 	GetDevice(ctx context.Context, deviceID string) (types.Device, error)
 	ListDevices(ctx context.Context) ([]types.Device, error)
 	SaveDiagnostics(ctx context.Context, diag types.DeviceDiagnostics) error
+	SaveDeviceEvent(ctx context.Context, event types.EventRecord) error
+	AcquireDeviceLease(
+		ctx context.Context,
+		deviceID string,
+		monitorID string,
+		ttl time.Duration,
+	) (bool, error)
 }
 
 type PersistenceNotifier interface {
@@ -36,7 +33,7 @@ type PersistenceNotifier interface {
 }
 
 type DeviceProvider interface {
-	CreateClient(config device.Config) (device.Client, error)
+	Resolve(ctx context.Context, device types.Device) []device.Driver
 }
 
 type EventPayload struct {
@@ -58,14 +55,16 @@ func NewOrchestrator(
 	persistence PersistenceProvider,
 	notifier PersistenceNotifier,
 	device DeviceProvider,
+	monitorID string,
 	interval time.Duration,
+	lease time.Duration,
 	logger *zap.Logger,
 ) *orchestrator {
 	return &orchestrator{
 		persistence: persistence,
 		notifier:    notifier,
 		device:      device,
-		pool:        NewPool(persistence, device, interval, logger),
+		pool:        NewPool(persistence, device, monitorID, interval, lease, logger),
 		interval:    interval,
 		logger:      logger,
 	}
@@ -126,6 +125,7 @@ type Worker interface {
 type pool struct {
 	persistence PersistenceProvider
 	device      DeviceProvider
+	timeline    *timeline
 	interval    time.Duration
 	workers     map[string]context.CancelFunc
 	mu          sync.RWMutex
@@ -135,13 +135,16 @@ type pool struct {
 func NewPool(
 	persistence PersistenceProvider,
 	device DeviceProvider,
+	monitorID string,
 	interval time.Duration,
+	lease time.Duration,
 	logger *zap.Logger,
 ) *pool {
 	return &pool{
 		workers:     make(map[string]context.CancelFunc),
 		persistence: persistence,
 		device:      device,
+		timeline:    newTimeline(persistence, monitorID, lease, logger),
 		interval:    interval,
 		logger:      logger,
 	}
@@ -158,29 +161,52 @@ func (p *pool) NewWorker(ctx context.Context, deviceID string) error {
 	if err != nil {
 		return err
 	}
-	protocol := p.protocol(*device.SupportedProtocols)
-	if protocol == "" {
+	driver, streaming := p.driver(ctx, device)
+	if driver.Name == "" {
 		return fmt.Errorf("no supported protocol found for device %s", deviceID)
 	}
 	wctx, cancel := context.WithCancel(ctx)
 	p.workers[deviceID] = cancel
 
 	var worker Worker
-	switch protocol {
-	case types.ProtocolGrpc:
-		worker = NewWorkerPoll(device, types.ProtocolGrpc, p.persistence, p.device, p.interval, p.logger)
-	case types.ProtocolGrpcStream:
-		worker = NewWorkerStream(device, types.ProtocolGrpcStream, p.persistence, p.device, p.logger)
-	case types.ProtocolHttp:
-		worker = NewWorkerPoll(device, types.ProtocolHttp, p.persistence, p.device, p.interval, p.logger)
-	case types.ProtocolHttpStream:
-		worker = NewWorkerStream(device, types.ProtocolHttpStream, p.persistence, p.device, p.logger)
+	if streaming {
+		worker = NewWorkerStream(device, driver, p.persistence, p.timeline, p.logger)
+	} else {
+		worker = NewWorkerPoll(device, driver, p.persistence, p.timeline, p.interval, p.logger)
 	}
+	p.timeline.record(ctx, types.EventRecord{
+		DeviceID: deviceID,
+		Type:     types.EventTypeWorkerStarted,
+		Severity: types.EventSeverityInfo,
+		Message:  fmt.Sprintf("worker started with protocol %s", driver.Protocol),
+		Details: map[string]string{
+			"protocol":            driver.Protocol.String(),
+			"driver":              driver.Name,
+			"supported_protocols": strings.Join(*device.SupportedProtocols, ","),
+		},
+		Occurred: time.Now(),
+	})
 	go func() {
 		defer p.delete(deviceID)
-		if err := worker.Run(wctx); err != nil {
-			p.logger.Error("worker encountered an error", zap.String("device_id", deviceID), zap.Error(err))
+		err := worker.Run(wctx)
+		if err == nil {
+			return
 		}
+		p.logger.Error("worker encountered an error", zap.String("device_id", deviceID), zap.Error(err))
+		if wctx.Err() != nil {
+			return
+		}
+		p.timeline.record(ctx, types.EventRecord{
+			DeviceID: deviceID,
+			Type:     types.EventTypeWorkerStopped,
+			Severity: types.EventSeverityError,
+			Message:  "worker stopped",
+			Details: map[string]string{
+				"protocol": driver.Protocol.String(),
+				"error":    err.Error(),
+			},
+			Occurred: time.Now(),
+		})
 	}()
 	return nil
 }
@@ -195,15 +221,18 @@ func (p *pool) StopWorker(deviceID string) {
 	}
 }
 
-func (p *pool) protocol(protocols []string) types.Protocol {
-	for _, preferred := range WorkerProtocolHierarchy {
-		if slices.ContainsFunc(protocols, func(proto string) bool {
-			return types.Protocol(proto) == preferred
-		}) {
-			return preferred
+// driver selects the driver of a worker, the preferred driver supporting streaming is
+// selected over the preferred driver supporting polling.
+func (p *pool) driver(ctx context.Context, dev types.Device) (device.Driver, bool) {
+	drivers := p.device.Resolve(ctx, dev)
+	for _, streaming := range []bool{true, false} {
+		for _, driver := range drivers {
+			if streaming && driver.Capabilities.Streaming || !streaming && driver.Capabilities.Polling {
+				return driver, streaming
+			}
 		}
 	}
-	return ""
+	return device.Driver{}, false
 }
 
 func (p *pool) delete(deviceID string) {
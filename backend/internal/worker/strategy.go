@@ -44,7 +44,8 @@ type PollFunc func(ctx context.Context) error
 
 type StreamFunc func(ctx context.Context, messageCh chan<- struct{}) error
 
-type ErrorFunc func(ctx context.Context) error
+// ErrorFunc handles the last error once all attempts have failed
+type ErrorFunc func(ctx context.Context, err error) error
 
 // Polling Strategy
 type PollingStrategy struct {
@@ -103,7 +104,7 @@ func (s *PollingStrategy) poll(ctx context.Context) error {
 		}
 		return nil
 	}
-	if err := s.errorFunc(ctx); err != nil {
+	if err := s.errorFunc(ctx, lastErr); err != nil {
 		return err
 	}
 	return fmt.Errorf("poll failed after %d attempts: %w", s.config.MaxRetries+1, lastErr)
@@ -157,7 +158,7 @@ func (s *StreamingStrategy) Run(ctx context.Context) error {
 		}
 		lastErr = err
 	}
-	if err := s.errorFunc(ctx); err != nil {
+	if err := s.errorFunc(ctx, lastErr); err != nil {
 		s.logger.Error("failed to handle stream error", zap.Error(err))
 	}
 	return fmt.Errorf("stream failed after %d attempts: %w", s.config.MaxRetries+1, lastErr)
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/emil-j-olsson/ubiquiti/backend/internal/device"
@@ -13,43 +14,35 @@ import (
 // Worker (Polling Strategy)
 type WorkerPoll struct {
 	device      types.Device
-	protocol    types.Protocol
+	driver      device.Driver
 	persistence PersistenceProvider
-	provider    DeviceProvider
+	timeline    *timeline
 	interval    time.Duration
 	logger      *zap.Logger
 }
 
 func NewWorkerPoll(
 	device types.Device,
-	protocol types.Protocol,
+	driver device.Driver,
 	persistence PersistenceProvider,
-	provider DeviceProvider,
+	timeline *timeline,
 	interval time.Duration,
 	logger *zap.Logger,
 ) *WorkerPoll {
 	return &WorkerPoll{
 		device:      device,
-		protocol:    protocol,
+		driver:      driver,
 		persistence: persistence,
-		provider:    provider,
+		timeline:    timeline,
 		interval:    interval,
 		logger:      logger,
 	}
 }
 
 func (w *WorkerPoll) Run(ctx context.Context) error {
-	port := *w.device.Port
-	if w.protocol.IsHttp() {
-		port = *w.device.GatewayPort
-	}
-	client, err := w.provider.CreateClient(device.Config{
-		Protocol: w.protocol,
-		Host:     *w.device.Host,
-		Port:     port,
-	})
+	client, err := w.driver.New(w.driver.Config(w.device))
 	if err != nil {
-		return fmt.Errorf("failed to create client (%s): %w", w.protocol.String(), err)
+		return fmt.Errorf("failed to create client (%s): %w", w.driver.Name, err)
 	}
 	defer client.Close() //nolint:errcheck
 	deviceID := *w.device.Identifier
@@ -61,12 +54,8 @@ func (w *WorkerPoll) Run(ctx context.Context) error {
 		}
 		return w.persistence.SaveDiagnostics(ctx, *diagnostics)
 	}
-	failure := func(ctx context.Context) error {
-		return w.persistence.SaveDiagnostics(ctx, types.DeviceDiagnostics{
-			Identifier:   deviceID,
-			DeviceStatus: types.DeviceStatusOffline,
-			Timestamp:    time.Now(),
-		})
+	failure := func(ctx context.Context, err error) error {
+		return offline(ctx, w.persistence, w.timeline, deviceID, w.driver.Protocol, err, config.MaxRetries+1)
 	}
 	return NewPollingStrategy(config, job, failure, w.logger).Run(ctx)
 }
@@ -74,43 +63,49 @@ func (w *WorkerPoll) Run(ctx context.Context) error {
 // Worker (Streaming Strategy)
 type WorkerStream struct {
 	device      types.Device
-	protocol    types.Protocol
+	driver      device.Driver
 	persistence PersistenceProvider
-	provider    DeviceProvider
+	timeline    *timeline
 	logger      *zap.Logger
 }
 
 func NewWorkerStream(
 	device types.Device,
-	protocol types.Protocol,
+	driver device.Driver,
 	persistence PersistenceProvider,
-	provider DeviceProvider,
+	timeline *timeline,
 	logger *zap.Logger,
 ) *WorkerStream {
 	return &WorkerStream{
 		device:      device,
-		protocol:    protocol,
+		driver:      driver,
 		persistence: persistence,
-		provider:    provider,
+		timeline:    timeline,
 		logger:      logger,
 	}
 }
 
 func (w *WorkerStream) Run(ctx context.Context) error {
-	port := *w.device.Port
-	if w.protocol.IsHttp() {
-		port = *w.device.GatewayPort
-	}
 	deviceID := *w.device.Identifier
 	config := DefaultStreamingConfig()
+	var attempts int
 	streamFunc := func(ctx context.Context, messageCh chan<- struct{}) error {
-		client, err := w.provider.CreateClient(device.Config{
-			Protocol: w.protocol,
-			Host:     *w.device.Host,
-			Port:     port,
-		})
+		if attempts++; attempts > 1 {
+			w.timeline.record(ctx, types.EventRecord{
+				DeviceID: deviceID,
+				Type:     types.EventTypeWorkerRestarted,
+				Severity: types.EventSeverityWarning,
+				Message:  fmt.Sprintf("stream restarted (attempt %d)", attempts),
+				Details: map[string]string{
+					"protocol": w.driver.Protocol.String(),
+					"attempt":  strconv.Itoa(attempts),
+				},
+				Occurred: time.Now(),
+			})
+		}
+		client, err := w.driver.New(w.driver.Config(w.device))
 		if err != nil {
-			return fmt.Errorf("failed to create client (%s): %w", w.protocol.String(), err)
+			return fmt.Errorf("failed to create client (%s): %w", w.driver.Name, err)
 		}
 		defer client.Close() //nolint:errcheck
 		diagCh, errCh := client.StreamDiagnostics(ctx)
@@ -138,12 +133,90 @@ func (w *WorkerStream) Run(ctx context.Context) error {
 			}
 		}
 	}
-	errorFunc := func(ctx context.Context) error {
-		return w.persistence.SaveDiagnostics(ctx, types.DeviceDiagnostics{
-			Identifier:   deviceID,
-			DeviceStatus: types.DeviceStatusOffline,
-			Timestamp:    time.Now(),
-		})
+	errorFunc := func(ctx context.Context, err error) error {
+		return offline(ctx, w.persistence, w.timeline, deviceID, w.driver.Protocol, err, config.MaxRetries+1)
 	}
 	return NewStreamingStrategy(config, streamFunc, errorFunc, w.logger).Run(ctx)
 }
+
+// offline marks a device offline once all attempts to collect its diagnostics have failed
+func offline(
+	ctx context.Context,
+	persistence PersistenceProvider,
+	timeline *timeline,
+	deviceID string,
+	protocol types.Protocol,
+	cause error,
+	attempts int,
+) error {
+	details := map[string]string{
+		"protocol": protocol.String(),
+		"attempts": strconv.Itoa(attempts),
+	}
+	if cause != nil {
+		details["error"] = cause.Error()
+	}
+	now := time.Now()
+	timeline.record(ctx, types.EventRecord{
+		DeviceID: deviceID,
+		Type:     types.EventTypeOffline,
+		Severity: types.EventSeverityError,
+		Message:  fmt.Sprintf("device unreachable after %d attempts", attempts),
+		Details:  details,
+		Occurred: now,
+	})
+	return persistence.SaveDiagnostics(ctx, types.DeviceDiagnostics{
+		Identifier:   deviceID,
+		DeviceStatus: types.DeviceStatusOffline,
+		Timestamp:    now,
+	})
+}
+
+// timeline records the events of devices, every monitor runs a worker for every device so that
+// only the monitor holding the lease of a device records its events
+type timeline struct {
+	persistence PersistenceProvider
+	monitorID   string
+	lease       time.Duration
+	logger      *zap.Logger
+}
+
+func newTimeline(
+	persistence PersistenceProvider,
+	monitorID string,
+	lease time.Duration,
+	logger *zap.Logger,
+) *timeline {
+	return &timeline{
+		persistence: persistence,
+		monitorID:   monitorID,
+		lease:       lease,
+		logger:      logger,
+	}
+}
+
+// record adds an event to the timeline of a device, failures are only logged so that the
+// timeline never interrupts the collection of diagnostics
+func (t *timeline) record(ctx context.Context, event types.EventRecord) {
+	owned, err := t.persistence.AcquireDeviceLease(ctx, event.DeviceID, t.monitorID, t.lease)
+	if err != nil {
+		t.logger.Warn(
+			"failed to acquire device lease",
+			zap.String("device_id", event.DeviceID),
+			zap.String("type", event.Type.String()),
+			zap.Error(err),
+		)
+		return
+	}
+	if !owned {
+		return
+	}
+	if err := t.persistence.SaveDeviceEvent(ctx, event); err != nil {
+		t.logger.Warn(
+			"failed to record device event",
+			zap.String("device_id", event.DeviceID),
+			zap.String("type", event.Type.String()),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,763 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.4
+// source: proto/driver/v1/driver.proto
+
+package driverv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeviceStatus int32
+
+const (
+	DeviceStatus_DEVICE_STATUS_UNSPECIFIED DeviceStatus = 0
+	DeviceStatus_DEVICE_STATUS_HEALTHY     DeviceStatus = 1
+	DeviceStatus_DEVICE_STATUS_DEGRADED    DeviceStatus = 2
+	DeviceStatus_DEVICE_STATUS_ERROR       DeviceStatus = 3
+	DeviceStatus_DEVICE_STATUS_MAINTENANCE DeviceStatus = 4
+	DeviceStatus_DEVICE_STATUS_BOOTING     DeviceStatus = 5
+)
+
+// Enum value maps for DeviceStatus.
+var (
+	DeviceStatus_name = map[int32]string{
+		0: "DEVICE_STATUS_UNSPECIFIED",
+		1: "DEVICE_STATUS_HEALTHY",
+		2: "DEVICE_STATUS_DEGRADED",
+		3: "DEVICE_STATUS_ERROR",
+		4: "DEVICE_STATUS_MAINTENANCE",
+		5: "DEVICE_STATUS_BOOTING",
+	}
+	DeviceStatus_value = map[string]int32{
+		"DEVICE_STATUS_UNSPECIFIED": 0,
+		"DEVICE_STATUS_HEALTHY":     1,
+		"DEVICE_STATUS_DEGRADED":    2,
+		"DEVICE_STATUS_ERROR":       3,
+		"DEVICE_STATUS_MAINTENANCE": 4,
+		"DEVICE_STATUS_BOOTING":     5,
+	}
+)
+
+func (x DeviceStatus) Enum() *DeviceStatus {
+	p := new(DeviceStatus)
+	*p = x
+	return p
+}
+
+func (x DeviceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_driver_v1_driver_proto_enumTypes[0].Descriptor()
+}
+
+func (DeviceStatus) Type() protoreflect.EnumType {
+	return &file_proto_driver_v1_driver_proto_enumTypes[0]
+}
+
+func (x DeviceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceStatus.Descriptor instead.
+func (DeviceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{0}
+}
+
+type Capabilities struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Polling       bool                   `protobuf:"varint,1,opt,name=polling,proto3" json:"polling,omitempty"`
+	Streaming     bool                   `protobuf:"varint,2,opt,name=streaming,proto3" json:"streaming,omitempty"`
+	Update        bool                   `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Capabilities) GetPolling() bool {
+	if x != nil {
+		return x.Polling
+	}
+	return false
+}
+
+func (x *Capabilities) GetStreaming() bool {
+	if x != nil {
+		return x.Streaming
+	}
+	return false
+}
+
+func (x *Capabilities) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
+
+type DescribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeRequest) Reset() {
+	*x = DescribeRequest{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeRequest) ProtoMessage() {}
+
+func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeRequest.ProtoReflect.Descriptor instead.
+func (*DescribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{1}
+}
+
+type DescribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capabilities  *Capabilities          `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeResponse) Reset() {
+	*x = DescribeResponse{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeResponse) ProtoMessage() {}
+
+func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeResponse.ProtoReflect.Descriptor instead.
+func (*DescribeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DescribeResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DescribeResponse) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int64                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Device) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Device) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Device) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type DeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceRequest) Reset() {
+	*x = DeviceRequest{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRequest) ProtoMessage() {}
+
+func (x *DeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeviceRequest) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type HealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Architecture  string                 `protobuf:"bytes,1,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Os            string                 `protobuf:"bytes,2,opt,name=os,proto3" json:"os,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HealthResponse) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+type DiagnosticsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	HardwareVersion    string                 `protobuf:"bytes,2,opt,name=hardware_version,proto3" json:"hardware_version,omitempty"`
+	SoftwareVersion    string                 `protobuf:"bytes,3,opt,name=software_version,proto3" json:"software_version,omitempty"`
+	FirmwareVersion    string                 `protobuf:"bytes,4,opt,name=firmware_version,proto3" json:"firmware_version,omitempty"`
+	CpuUsage           float64                `protobuf:"fixed64,5,opt,name=cpu_usage,proto3" json:"cpu_usage,omitempty"`
+	MemoryUsage        float64                `protobuf:"fixed64,6,opt,name=memory_usage,proto3" json:"memory_usage,omitempty"`
+	DeviceStatus       DeviceStatus           `protobuf:"varint,7,opt,name=device_status,proto3,enum=driver.v1.DeviceStatus" json:"device_status,omitempty"`
+	UptimeSeconds      uint64                 `protobuf:"varint,8,opt,name=uptime_seconds,proto3" json:"uptime_seconds,omitempty"`
+	LoadAverage_1M     float64                `protobuf:"fixed64,9,opt,name=load_average_1m,proto3" json:"load_average_1m,omitempty"`
+	LoadAverage_5M     float64                `protobuf:"fixed64,10,opt,name=load_average_5m,proto3" json:"load_average_5m,omitempty"`
+	LoadAverage_15M    float64                `protobuf:"fixed64,11,opt,name=load_average_15m,proto3" json:"load_average_15m,omitempty"`
+	TemperatureCelsius float64                `protobuf:"fixed64,12,opt,name=temperature_celsius,proto3" json:"temperature_celsius,omitempty"`
+	DiskUsedBytes      uint64                 `protobuf:"varint,13,opt,name=disk_used_bytes,proto3" json:"disk_used_bytes,omitempty"`
+	DiskTotalBytes     uint64                 `protobuf:"varint,14,opt,name=disk_total_bytes,proto3" json:"disk_total_bytes,omitempty"`
+	ProcessCount       uint32                 `protobuf:"varint,15,opt,name=process_count,proto3" json:"process_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DiagnosticsResponse) Reset() {
+	*x = DiagnosticsResponse{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsResponse) ProtoMessage() {}
+
+func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiagnosticsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetHardwareVersion() string {
+	if x != nil {
+		return x.HardwareVersion
+	}
+	return ""
+}
+
+func (x *DiagnosticsResponse) GetSoftwareVersion() string {
+	if x != nil {
+		return x.SoftwareVersion
+	}
+	return ""
+}
+
+func (x *DiagnosticsResponse) GetFirmwareVersion() string {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return ""
+}
+
+func (x *DiagnosticsResponse) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetMemoryUsage() float64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDeviceStatus() DeviceStatus {
+	if x != nil {
+		return x.DeviceStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+func (x *DiagnosticsResponse) GetUptimeSeconds() uint64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetLoadAverage_1M() float64 {
+	if x != nil {
+		return x.LoadAverage_1M
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetLoadAverage_5M() float64 {
+	if x != nil {
+		return x.LoadAverage_5M
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetLoadAverage_15M() float64 {
+	if x != nil {
+		return x.LoadAverage_15M
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetTemperatureCelsius() float64 {
+	if x != nil {
+		return x.TemperatureCelsius
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDiskUsedBytes() uint64 {
+	if x != nil {
+		return x.DiskUsedBytes
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDiskTotalBytes() uint64 {
+	if x != nil {
+		return x.DiskTotalBytes
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetProcessCount() uint32 {
+	if x != nil {
+		return x.ProcessCount
+	}
+	return 0
+}
+
+type UpdateDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceStatus  DeviceStatus           `protobuf:"varint,2,opt,name=device_status,proto3,enum=driver.v1.DeviceStatus" json:"device_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeviceRequest) Reset() {
+	*x = UpdateDeviceRequest{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceRequest) ProtoMessage() {}
+
+func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDeviceRequest) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *UpdateDeviceRequest) GetDeviceStatus() DeviceStatus {
+	if x != nil {
+		return x.DeviceStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+type UpdateDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeviceResponse) Reset() {
+	*x = UpdateDeviceResponse{}
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceResponse) ProtoMessage() {}
+
+func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_driver_v1_driver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_driver_v1_driver_proto_rawDescGZIP(), []int{8}
+}
+
+var File_proto_driver_v1_driver_proto protoreflect.FileDescriptor
+
+const file_proto_driver_v1_driver_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/driver/v1/driver.proto\x12\tdriver.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\fCapabilities\x12\x18\n" +
+	"\apolling\x18\x01 \x01(\bR\apolling\x12\x1c\n" +
+	"\tstreaming\x18\x02 \x01(\bR\tstreaming\x12\x16\n" +
+	"\x06update\x18\x03 \x01(\bR\x06update\"\x11\n" +
+	"\x0fDescribeRequest\"c\n" +
+	"\x10DescribeResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\fcapabilities\x18\x02 \x01(\v2\x17.driver.v1.CapabilitiesR\fcapabilities\"N\n" +
+	"\x06Device\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x03R\x04port\":\n" +
+	"\rDeviceRequest\x12)\n" +
+	"\x06device\x18\x01 \x01(\v2\x11.driver.v1.DeviceR\x06device\"D\n" +
+	"\x0eHealthResponse\x12\"\n" +
+	"\farchitecture\x18\x01 \x01(\tR\farchitecture\x12\x0e\n" +
+	"\x02os\x18\x02 \x01(\tR\x02os\"\xaa\x05\n" +
+	"\x13DiagnosticsResponse\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12*\n" +
+	"\x10hardware_version\x18\x02 \x01(\tR\x10hardware_version\x12*\n" +
+	"\x10software_version\x18\x03 \x01(\tR\x10software_version\x12*\n" +
+	"\x10firmware_version\x18\x04 \x01(\tR\x10firmware_version\x12\x1c\n" +
+	"\tcpu_usage\x18\x05 \x01(\x01R\tcpu_usage\x12\"\n" +
+	"\fmemory_usage\x18\x06 \x01(\x01R\fmemory_usage\x12=\n" +
+	"\rdevice_status\x18\a \x01(\x0e2\x17.driver.v1.DeviceStatusR\rdevice_status\x12&\n" +
+	"\x0euptime_seconds\x18\b \x01(\x04R\x0euptime_seconds\x12(\n" +
+	"\x0fload_average_1m\x18\t \x01(\x01R\x0fload_average_1m\x12(\n" +
+	"\x0fload_average_5m\x18\n" +
+	" \x01(\x01R\x0fload_average_5m\x12*\n" +
+	"\x10load_average_15m\x18\v \x01(\x01R\x10load_average_15m\x120\n" +
+	"\x13temperature_celsius\x18\f \x01(\x01R\x13temperature_celsius\x12(\n" +
+	"\x0fdisk_used_bytes\x18\r \x01(\x04R\x0fdisk_used_bytes\x12*\n" +
+	"\x10disk_total_bytes\x18\x0e \x01(\x04R\x10disk_total_bytes\x12$\n" +
+	"\rprocess_count\x18\x0f \x01(\rR\rprocess_count\"\x7f\n" +
+	"\x13UpdateDeviceRequest\x12)\n" +
+	"\x06device\x18\x01 \x01(\v2\x11.driver.v1.DeviceR\x06device\x12=\n" +
+	"\rdevice_status\x18\x02 \x01(\x0e2\x17.driver.v1.DeviceStatusR\rdevice_status\"\x16\n" +
+	"\x14UpdateDeviceResponse*\xb7\x01\n" +
+	"\fDeviceStatus\x12\x1d\n" +
+	"\x19DEVICE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DEVICE_STATUS_HEALTHY\x10\x01\x12\x1a\n" +
+	"\x16DEVICE_STATUS_DEGRADED\x10\x02\x12\x17\n" +
+	"\x13DEVICE_STATUS_ERROR\x10\x03\x12\x1d\n" +
+	"\x19DEVICE_STATUS_MAINTENANCE\x10\x04\x12\x19\n" +
+	"\x15DEVICE_STATUS_BOOTING\x10\x052\xfd\x02\n" +
+	"\x06Driver\x12C\n" +
+	"\bDescribe\x12\x1a.driver.v1.DescribeRequest\x1a\x1b.driver.v1.DescribeResponse\x12@\n" +
+	"\tGetHealth\x12\x18.driver.v1.DeviceRequest\x1a\x19.driver.v1.HealthResponse\x12J\n" +
+	"\x0eGetDiagnostics\x12\x18.driver.v1.DeviceRequest\x1a\x1e.driver.v1.DiagnosticsResponse\x12O\n" +
+	"\x11StreamDiagnostics\x12\x18.driver.v1.DeviceRequest\x1a\x1e.driver.v1.DiagnosticsResponse0\x01\x12O\n" +
+	"\fUpdateDevice\x12\x1e.driver.v1.UpdateDeviceRequest\x1a\x1f.driver.v1.UpdateDeviceResponseBDZBgithub.com/emil-j-olsson/ubiquiti/backend/proto/driver/v1;driverv1b\x06proto3"
+
+var (
+	file_proto_driver_v1_driver_proto_rawDescOnce sync.Once
+	file_proto_driver_v1_driver_proto_rawDescData []byte
+)
+
+func file_proto_driver_v1_driver_proto_rawDescGZIP() []byte {
+	file_proto_driver_v1_driver_proto_rawDescOnce.Do(func() {
+		file_proto_driver_v1_driver_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_driver_v1_driver_proto_rawDesc), len(file_proto_driver_v1_driver_proto_rawDesc)))
+	})
+	return file_proto_driver_v1_driver_proto_rawDescData
+}
+
+var file_proto_driver_v1_driver_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_driver_v1_driver_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_driver_v1_driver_proto_goTypes = []any{
+	(DeviceStatus)(0),             // 0: driver.v1.DeviceStatus
+	(*Capabilities)(nil),          // 1: driver.v1.Capabilities
+	(*DescribeRequest)(nil),       // 2: driver.v1.DescribeRequest
+	(*DescribeResponse)(nil),      // 3: driver.v1.DescribeResponse
+	(*Device)(nil),                // 4: driver.v1.Device
+	(*DeviceRequest)(nil),         // 5: driver.v1.DeviceRequest
+	(*HealthResponse)(nil),        // 6: driver.v1.HealthResponse
+	(*DiagnosticsResponse)(nil),   // 7: driver.v1.DiagnosticsResponse
+	(*UpdateDeviceRequest)(nil),   // 8: driver.v1.UpdateDeviceRequest
+	(*UpdateDeviceResponse)(nil),  // 9: driver.v1.UpdateDeviceResponse
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_proto_driver_v1_driver_proto_depIdxs = []int32{
+	1,  // 0: driver.v1.DescribeResponse.capabilities:type_name -> driver.v1.Capabilities
+	4,  // 1: driver.v1.DeviceRequest.device:type_name -> driver.v1.Device
+	10, // 2: driver.v1.DiagnosticsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 3: driver.v1.DiagnosticsResponse.device_status:type_name -> driver.v1.DeviceStatus
+	4,  // 4: driver.v1.UpdateDeviceRequest.device:type_name -> driver.v1.Device
+	0,  // 5: driver.v1.UpdateDeviceRequest.device_status:type_name -> driver.v1.DeviceStatus
+	2,  // 6: driver.v1.Driver.Describe:input_type -> driver.v1.DescribeRequest
+	5,  // 7: driver.v1.Driver.GetHealth:input_type -> driver.v1.DeviceRequest
+	5,  // 8: driver.v1.Driver.GetDiagnostics:input_type -> driver.v1.DeviceRequest
+	5,  // 9: driver.v1.Driver.StreamDiagnostics:input_type -> driver.v1.DeviceRequest
+	8,  // 10: driver.v1.Driver.UpdateDevice:input_type -> driver.v1.UpdateDeviceRequest
+	3,  // 11: driver.v1.Driver.Describe:output_type -> driver.v1.DescribeResponse
+	6,  // 12: driver.v1.Driver.GetHealth:output_type -> driver.v1.HealthResponse
+	7,  // 13: driver.v1.Driver.GetDiagnostics:output_type -> driver.v1.DiagnosticsResponse
+	7,  // 14: driver.v1.Driver.StreamDiagnostics:output_type -> driver.v1.DiagnosticsResponse
+	9,  // 15: driver.v1.Driver.UpdateDevice:output_type -> driver.v1.UpdateDeviceResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_driver_v1_driver_proto_init() }
+func file_proto_driver_v1_driver_proto_init() {
+	if File_proto_driver_v1_driver_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_driver_v1_driver_proto_rawDesc), len(file_proto_driver_v1_driver_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_driver_v1_driver_proto_goTypes,
+		DependencyIndexes: file_proto_driver_v1_driver_proto_depIdxs,
+		EnumInfos:         file_proto_driver_v1_driver_proto_enumTypes,
+		MessageInfos:      file_proto_driver_v1_driver_proto_msgTypes,
+	}.Build()
+	File_proto_driver_v1_driver_proto = out.File
+	file_proto_driver_v1_driver_proto_goTypes = nil
+	file_proto_driver_v1_driver_proto_depIdxs = nil
+}
@@ -14,6 +14,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -33,6 +34,9 @@ const (
 	Protocol_PROTOCOL_HTTP_STREAM Protocol = 2
 	Protocol_PROTOCOL_GRPC        Protocol = 3
 	Protocol_PROTOCOL_GRPC_STREAM Protocol = 4
+	Protocol_PROTOCOL_MQTT        Protocol = 5
+	Protocol_PROTOCOL_SNMP        Protocol = 6
+	Protocol_PROTOCOL_PLUGIN      Protocol = 7
 )
 
 // Enum value maps for Protocol.
@@ -43,6 +47,9 @@ var (
 		2: "PROTOCOL_HTTP_STREAM",
 		3: "PROTOCOL_GRPC",
 		4: "PROTOCOL_GRPC_STREAM",
+		5: "PROTOCOL_MQTT",
+		6: "PROTOCOL_SNMP",
+		7: "PROTOCOL_PLUGIN",
 	}
 	Protocol_value = map[string]int32{
 		"PROTOCOL_UNSPECIFIED": 0,
@@ -50,6 +57,9 @@ var (
 		"PROTOCOL_HTTP_STREAM": 2,
 		"PROTOCOL_GRPC":        3,
 		"PROTOCOL_GRPC_STREAM": 4,
+		"PROTOCOL_MQTT":        5,
+		"PROTOCOL_SNMP":        6,
+		"PROTOCOL_PLUGIN":      7,
 	}
 )
 
@@ -141,38 +151,5838 @@ func (DeviceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{1}
 }
 
+type SigningAlgorithm int32
+
+const (
+	SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED SigningAlgorithm = 0
+	SigningAlgorithm_SIGNING_ALGORITHM_HMAC_SHA256 SigningAlgorithm = 1
+	SigningAlgorithm_SIGNING_ALGORITHM_ED25519     SigningAlgorithm = 2
+)
+
+// Enum value maps for SigningAlgorithm.
+var (
+	SigningAlgorithm_name = map[int32]string{
+		0: "SIGNING_ALGORITHM_UNSPECIFIED",
+		1: "SIGNING_ALGORITHM_HMAC_SHA256",
+		2: "SIGNING_ALGORITHM_ED25519",
+	}
+	SigningAlgorithm_value = map[string]int32{
+		"SIGNING_ALGORITHM_UNSPECIFIED": 0,
+		"SIGNING_ALGORITHM_HMAC_SHA256": 1,
+		"SIGNING_ALGORITHM_ED25519":     2,
+	}
+)
+
+func (x SigningAlgorithm) Enum() *SigningAlgorithm {
+	p := new(SigningAlgorithm)
+	*p = x
+	return p
+}
+
+func (x SigningAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[2].Descriptor()
+}
+
+func (SigningAlgorithm) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[2]
+}
+
+func (x SigningAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningAlgorithm.Descriptor instead.
+func (SigningAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{2}
+}
+
+type SnmpVersion int32
+
+const (
+	SnmpVersion_SNMP_VERSION_UNSPECIFIED SnmpVersion = 0
+	SnmpVersion_SNMP_VERSION_V2C         SnmpVersion = 1
+	SnmpVersion_SNMP_VERSION_V3          SnmpVersion = 2
+)
+
+// Enum value maps for SnmpVersion.
+var (
+	SnmpVersion_name = map[int32]string{
+		0: "SNMP_VERSION_UNSPECIFIED",
+		1: "SNMP_VERSION_V2C",
+		2: "SNMP_VERSION_V3",
+	}
+	SnmpVersion_value = map[string]int32{
+		"SNMP_VERSION_UNSPECIFIED": 0,
+		"SNMP_VERSION_V2C":         1,
+		"SNMP_VERSION_V3":          2,
+	}
+)
+
+func (x SnmpVersion) Enum() *SnmpVersion {
+	p := new(SnmpVersion)
+	*p = x
+	return p
+}
+
+func (x SnmpVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnmpVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[3].Descriptor()
+}
+
+func (SnmpVersion) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[3]
+}
+
+func (x SnmpVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnmpVersion.Descriptor instead.
+func (SnmpVersion) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{3}
+}
+
+type SnmpAuthProtocol int32
+
+const (
+	SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_UNSPECIFIED SnmpAuthProtocol = 0
+	SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_MD5         SnmpAuthProtocol = 1
+	SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_SHA         SnmpAuthProtocol = 2
+	SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_SHA256      SnmpAuthProtocol = 3
+	SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_SHA512      SnmpAuthProtocol = 4
+)
+
+// Enum value maps for SnmpAuthProtocol.
+var (
+	SnmpAuthProtocol_name = map[int32]string{
+		0: "SNMP_AUTH_PROTOCOL_UNSPECIFIED",
+		1: "SNMP_AUTH_PROTOCOL_MD5",
+		2: "SNMP_AUTH_PROTOCOL_SHA",
+		3: "SNMP_AUTH_PROTOCOL_SHA256",
+		4: "SNMP_AUTH_PROTOCOL_SHA512",
+	}
+	SnmpAuthProtocol_value = map[string]int32{
+		"SNMP_AUTH_PROTOCOL_UNSPECIFIED": 0,
+		"SNMP_AUTH_PROTOCOL_MD5":         1,
+		"SNMP_AUTH_PROTOCOL_SHA":         2,
+		"SNMP_AUTH_PROTOCOL_SHA256":      3,
+		"SNMP_AUTH_PROTOCOL_SHA512":      4,
+	}
+)
+
+func (x SnmpAuthProtocol) Enum() *SnmpAuthProtocol {
+	p := new(SnmpAuthProtocol)
+	*p = x
+	return p
+}
+
+func (x SnmpAuthProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnmpAuthProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[4].Descriptor()
+}
+
+func (SnmpAuthProtocol) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[4]
+}
+
+func (x SnmpAuthProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnmpAuthProtocol.Descriptor instead.
+func (SnmpAuthProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{4}
+}
+
+type SnmpPrivProtocol int32
+
+const (
+	SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_UNSPECIFIED SnmpPrivProtocol = 0
+	SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_DES         SnmpPrivProtocol = 1
+	SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_AES         SnmpPrivProtocol = 2
+	SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_AES256      SnmpPrivProtocol = 3
+)
+
+// Enum value maps for SnmpPrivProtocol.
+var (
+	SnmpPrivProtocol_name = map[int32]string{
+		0: "SNMP_PRIV_PROTOCOL_UNSPECIFIED",
+		1: "SNMP_PRIV_PROTOCOL_DES",
+		2: "SNMP_PRIV_PROTOCOL_AES",
+		3: "SNMP_PRIV_PROTOCOL_AES256",
+	}
+	SnmpPrivProtocol_value = map[string]int32{
+		"SNMP_PRIV_PROTOCOL_UNSPECIFIED": 0,
+		"SNMP_PRIV_PROTOCOL_DES":         1,
+		"SNMP_PRIV_PROTOCOL_AES":         2,
+		"SNMP_PRIV_PROTOCOL_AES256":      3,
+	}
+)
+
+func (x SnmpPrivProtocol) Enum() *SnmpPrivProtocol {
+	p := new(SnmpPrivProtocol)
+	*p = x
+	return p
+}
+
+func (x SnmpPrivProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnmpPrivProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[5].Descriptor()
+}
+
+func (SnmpPrivProtocol) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[5]
+}
+
+func (x SnmpPrivProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnmpPrivProtocol.Descriptor instead.
+func (SnmpPrivProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{5}
+}
+
+type VerificationStatus int32
+
+const (
+	VerificationStatus_VERIFICATION_STATUS_UNSPECIFIED VerificationStatus = 0
+	VerificationStatus_VERIFICATION_STATUS_UNSIGNED    VerificationStatus = 1
+	VerificationStatus_VERIFICATION_STATUS_AUTHENTIC   VerificationStatus = 2
+	VerificationStatus_VERIFICATION_STATUS_INVALID     VerificationStatus = 3
+)
+
+// Enum value maps for VerificationStatus.
+var (
+	VerificationStatus_name = map[int32]string{
+		0: "VERIFICATION_STATUS_UNSPECIFIED",
+		1: "VERIFICATION_STATUS_UNSIGNED",
+		2: "VERIFICATION_STATUS_AUTHENTIC",
+		3: "VERIFICATION_STATUS_INVALID",
+	}
+	VerificationStatus_value = map[string]int32{
+		"VERIFICATION_STATUS_UNSPECIFIED": 0,
+		"VERIFICATION_STATUS_UNSIGNED":    1,
+		"VERIFICATION_STATUS_AUTHENTIC":   2,
+		"VERIFICATION_STATUS_INVALID":     3,
+	}
+)
+
+func (x VerificationStatus) Enum() *VerificationStatus {
+	p := new(VerificationStatus)
+	*p = x
+	return p
+}
+
+func (x VerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[6].Descriptor()
+}
+
+func (VerificationStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[6]
+}
+
+func (x VerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerificationStatus.Descriptor instead.
+func (VerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{6}
+}
+
+type LinkState int32
+
+const (
+	LinkState_LINK_STATE_UNSPECIFIED LinkState = 0
+	LinkState_LINK_STATE_UP          LinkState = 1
+	LinkState_LINK_STATE_DOWN        LinkState = 2
+)
+
+// Enum value maps for LinkState.
+var (
+	LinkState_name = map[int32]string{
+		0: "LINK_STATE_UNSPECIFIED",
+		1: "LINK_STATE_UP",
+		2: "LINK_STATE_DOWN",
+	}
+	LinkState_value = map[string]int32{
+		"LINK_STATE_UNSPECIFIED": 0,
+		"LINK_STATE_UP":          1,
+		"LINK_STATE_DOWN":        2,
+	}
+)
+
+func (x LinkState) Enum() *LinkState {
+	p := new(LinkState)
+	*p = x
+	return p
+}
+
+func (x LinkState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[7].Descriptor()
+}
+
+func (LinkState) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[7]
+}
+
+func (x LinkState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkState.Descriptor instead.
+func (LinkState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{7}
+}
+
+type CampaignStatus int32
+
+const (
+	CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED CampaignStatus = 0
+	CampaignStatus_CAMPAIGN_STATUS_PENDING     CampaignStatus = 1
+	CampaignStatus_CAMPAIGN_STATUS_RUNNING     CampaignStatus = 2
+	CampaignStatus_CAMPAIGN_STATUS_COMPLETED   CampaignStatus = 3
+	CampaignStatus_CAMPAIGN_STATUS_HALTED      CampaignStatus = 4
+	CampaignStatus_CAMPAIGN_STATUS_ROLLED_BACK CampaignStatus = 5
+	CampaignStatus_CAMPAIGN_STATUS_CANCELLED   CampaignStatus = 6
+)
+
+// Enum value maps for CampaignStatus.
+var (
+	CampaignStatus_name = map[int32]string{
+		0: "CAMPAIGN_STATUS_UNSPECIFIED",
+		1: "CAMPAIGN_STATUS_PENDING",
+		2: "CAMPAIGN_STATUS_RUNNING",
+		3: "CAMPAIGN_STATUS_COMPLETED",
+		4: "CAMPAIGN_STATUS_HALTED",
+		5: "CAMPAIGN_STATUS_ROLLED_BACK",
+		6: "CAMPAIGN_STATUS_CANCELLED",
+	}
+	CampaignStatus_value = map[string]int32{
+		"CAMPAIGN_STATUS_UNSPECIFIED": 0,
+		"CAMPAIGN_STATUS_PENDING":     1,
+		"CAMPAIGN_STATUS_RUNNING":     2,
+		"CAMPAIGN_STATUS_COMPLETED":   3,
+		"CAMPAIGN_STATUS_HALTED":      4,
+		"CAMPAIGN_STATUS_ROLLED_BACK": 5,
+		"CAMPAIGN_STATUS_CANCELLED":   6,
+	}
+)
+
+func (x CampaignStatus) Enum() *CampaignStatus {
+	p := new(CampaignStatus)
+	*p = x
+	return p
+}
+
+func (x CampaignStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[8].Descriptor()
+}
+
+func (CampaignStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[8]
+}
+
+func (x CampaignStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignStatus.Descriptor instead.
+func (CampaignStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{8}
+}
+
+type CampaignDeviceStatus int32
+
+const (
+	CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_UNSPECIFIED CampaignDeviceStatus = 0
+	CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_PENDING     CampaignDeviceStatus = 1
+	CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_UPGRADING   CampaignDeviceStatus = 2
+	CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_UPGRADED    CampaignDeviceStatus = 3
+	CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_FAILED      CampaignDeviceStatus = 4
+	CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_ROLLED_BACK CampaignDeviceStatus = 5
+	CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_SKIPPED     CampaignDeviceStatus = 6
+)
+
+// Enum value maps for CampaignDeviceStatus.
+var (
+	CampaignDeviceStatus_name = map[int32]string{
+		0: "CAMPAIGN_DEVICE_STATUS_UNSPECIFIED",
+		1: "CAMPAIGN_DEVICE_STATUS_PENDING",
+		2: "CAMPAIGN_DEVICE_STATUS_UPGRADING",
+		3: "CAMPAIGN_DEVICE_STATUS_UPGRADED",
+		4: "CAMPAIGN_DEVICE_STATUS_FAILED",
+		5: "CAMPAIGN_DEVICE_STATUS_ROLLED_BACK",
+		6: "CAMPAIGN_DEVICE_STATUS_SKIPPED",
+	}
+	CampaignDeviceStatus_value = map[string]int32{
+		"CAMPAIGN_DEVICE_STATUS_UNSPECIFIED": 0,
+		"CAMPAIGN_DEVICE_STATUS_PENDING":     1,
+		"CAMPAIGN_DEVICE_STATUS_UPGRADING":   2,
+		"CAMPAIGN_DEVICE_STATUS_UPGRADED":    3,
+		"CAMPAIGN_DEVICE_STATUS_FAILED":      4,
+		"CAMPAIGN_DEVICE_STATUS_ROLLED_BACK": 5,
+		"CAMPAIGN_DEVICE_STATUS_SKIPPED":     6,
+	}
+)
+
+func (x CampaignDeviceStatus) Enum() *CampaignDeviceStatus {
+	p := new(CampaignDeviceStatus)
+	*p = x
+	return p
+}
+
+func (x CampaignDeviceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignDeviceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[9].Descriptor()
+}
+
+func (CampaignDeviceStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[9]
+}
+
+func (x CampaignDeviceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignDeviceStatus.Descriptor instead.
+func (CampaignDeviceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{9}
+}
+
+type FailurePolicy int32
+
+const (
+	FailurePolicy_FAILURE_POLICY_UNSPECIFIED FailurePolicy = 0
+	FailurePolicy_FAILURE_POLICY_HALT        FailurePolicy = 1
+	FailurePolicy_FAILURE_POLICY_ROLLBACK    FailurePolicy = 2
+)
+
+// Enum value maps for FailurePolicy.
+var (
+	FailurePolicy_name = map[int32]string{
+		0: "FAILURE_POLICY_UNSPECIFIED",
+		1: "FAILURE_POLICY_HALT",
+		2: "FAILURE_POLICY_ROLLBACK",
+	}
+	FailurePolicy_value = map[string]int32{
+		"FAILURE_POLICY_UNSPECIFIED": 0,
+		"FAILURE_POLICY_HALT":        1,
+		"FAILURE_POLICY_ROLLBACK":    2,
+	}
+)
+
+func (x FailurePolicy) Enum() *FailurePolicy {
+	p := new(FailurePolicy)
+	*p = x
+	return p
+}
+
+func (x FailurePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FailurePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[10].Descriptor()
+}
+
+func (FailurePolicy) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[10]
+}
+
+func (x FailurePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FailurePolicy.Descriptor instead.
+func (FailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{10}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_NDJSON      ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_PARQUET     ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_NDJSON",
+		3: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_NDJSON":      2,
+		"EXPORT_FORMAT_PARQUET":     3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[11].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[11]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{11}
+}
+
+type InventoryFormat int32
+
+const (
+	InventoryFormat_INVENTORY_FORMAT_UNSPECIFIED InventoryFormat = 0
+	InventoryFormat_INVENTORY_FORMAT_YAML        InventoryFormat = 1
+	InventoryFormat_INVENTORY_FORMAT_CSV         InventoryFormat = 2
+)
+
+// Enum value maps for InventoryFormat.
+var (
+	InventoryFormat_name = map[int32]string{
+		0: "INVENTORY_FORMAT_UNSPECIFIED",
+		1: "INVENTORY_FORMAT_YAML",
+		2: "INVENTORY_FORMAT_CSV",
+	}
+	InventoryFormat_value = map[string]int32{
+		"INVENTORY_FORMAT_UNSPECIFIED": 0,
+		"INVENTORY_FORMAT_YAML":        1,
+		"INVENTORY_FORMAT_CSV":         2,
+	}
+)
+
+func (x InventoryFormat) Enum() *InventoryFormat {
+	p := new(InventoryFormat)
+	*p = x
+	return p
+}
+
+func (x InventoryFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventoryFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[12].Descriptor()
+}
+
+func (InventoryFormat) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[12]
+}
+
+func (x InventoryFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventoryFormat.Descriptor instead.
+func (InventoryFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{12}
+}
+
+type ImportStatus int32
+
+const (
+	ImportStatus_IMPORT_STATUS_UNSPECIFIED ImportStatus = 0
+	ImportStatus_IMPORT_STATUS_CREATED     ImportStatus = 1
+	ImportStatus_IMPORT_STATUS_UPDATED     ImportStatus = 2
+	ImportStatus_IMPORT_STATUS_UNCHANGED   ImportStatus = 3
+	ImportStatus_IMPORT_STATUS_FAILED      ImportStatus = 4
+	ImportStatus_IMPORT_STATUS_PRUNED      ImportStatus = 5
+)
+
+// Enum value maps for ImportStatus.
+var (
+	ImportStatus_name = map[int32]string{
+		0: "IMPORT_STATUS_UNSPECIFIED",
+		1: "IMPORT_STATUS_CREATED",
+		2: "IMPORT_STATUS_UPDATED",
+		3: "IMPORT_STATUS_UNCHANGED",
+		4: "IMPORT_STATUS_FAILED",
+		5: "IMPORT_STATUS_PRUNED",
+	}
+	ImportStatus_value = map[string]int32{
+		"IMPORT_STATUS_UNSPECIFIED": 0,
+		"IMPORT_STATUS_CREATED":     1,
+		"IMPORT_STATUS_UPDATED":     2,
+		"IMPORT_STATUS_UNCHANGED":   3,
+		"IMPORT_STATUS_FAILED":      4,
+		"IMPORT_STATUS_PRUNED":      5,
+	}
+)
+
+func (x ImportStatus) Enum() *ImportStatus {
+	p := new(ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[13].Descriptor()
+}
+
+func (ImportStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[13]
+}
+
+func (x ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStatus.Descriptor instead.
+func (ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{13}
+}
+
+type TransitionCause int32
+
+const (
+	TransitionCause_TRANSITION_CAUSE_UNSPECIFIED TransitionCause = 0
+	TransitionCause_TRANSITION_CAUSE_DEVICE      TransitionCause = 1
+	TransitionCause_TRANSITION_CAUSE_OPERATOR    TransitionCause = 2
+	TransitionCause_TRANSITION_CAUSE_OFFLINE     TransitionCause = 3
+)
+
+// Enum value maps for TransitionCause.
+var (
+	TransitionCause_name = map[int32]string{
+		0: "TRANSITION_CAUSE_UNSPECIFIED",
+		1: "TRANSITION_CAUSE_DEVICE",
+		2: "TRANSITION_CAUSE_OPERATOR",
+		3: "TRANSITION_CAUSE_OFFLINE",
+	}
+	TransitionCause_value = map[string]int32{
+		"TRANSITION_CAUSE_UNSPECIFIED": 0,
+		"TRANSITION_CAUSE_DEVICE":      1,
+		"TRANSITION_CAUSE_OPERATOR":    2,
+		"TRANSITION_CAUSE_OFFLINE":     3,
+	}
+)
+
+func (x TransitionCause) Enum() *TransitionCause {
+	p := new(TransitionCause)
+	*p = x
+	return p
+}
+
+func (x TransitionCause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransitionCause) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[14].Descriptor()
+}
+
+func (TransitionCause) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[14]
+}
+
+func (x TransitionCause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransitionCause.Descriptor instead.
+func (TransitionCause) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{14}
+}
+
+type ConfigStatus int32
+
+const (
+	ConfigStatus_CONFIG_STATUS_UNSPECIFIED ConfigStatus = 0
+	ConfigStatus_CONFIG_STATUS_PENDING     ConfigStatus = 1
+	ConfigStatus_CONFIG_STATUS_IN_SYNC     ConfigStatus = 2
+	ConfigStatus_CONFIG_STATUS_REAPPLIED   ConfigStatus = 3
+	ConfigStatus_CONFIG_STATUS_DRIFTED     ConfigStatus = 4
+	ConfigStatus_CONFIG_STATUS_ERROR       ConfigStatus = 5
+)
+
+// Enum value maps for ConfigStatus.
+var (
+	ConfigStatus_name = map[int32]string{
+		0: "CONFIG_STATUS_UNSPECIFIED",
+		1: "CONFIG_STATUS_PENDING",
+		2: "CONFIG_STATUS_IN_SYNC",
+		3: "CONFIG_STATUS_REAPPLIED",
+		4: "CONFIG_STATUS_DRIFTED",
+		5: "CONFIG_STATUS_ERROR",
+	}
+	ConfigStatus_value = map[string]int32{
+		"CONFIG_STATUS_UNSPECIFIED": 0,
+		"CONFIG_STATUS_PENDING":     1,
+		"CONFIG_STATUS_IN_SYNC":     2,
+		"CONFIG_STATUS_REAPPLIED":   3,
+		"CONFIG_STATUS_DRIFTED":     4,
+		"CONFIG_STATUS_ERROR":       5,
+	}
+)
+
+func (x ConfigStatus) Enum() *ConfigStatus {
+	p := new(ConfigStatus)
+	*p = x
+	return p
+}
+
+func (x ConfigStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[15].Descriptor()
+}
+
+func (ConfigStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[15]
+}
+
+func (x ConfigStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigStatus.Descriptor instead.
+func (ConfigStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{15}
+}
+
+type DriftPolicy int32
+
+const (
+	DriftPolicy_DRIFT_POLICY_UNSPECIFIED DriftPolicy = 0
+	DriftPolicy_DRIFT_POLICY_REAPPLY     DriftPolicy = 1
+	DriftPolicy_DRIFT_POLICY_FLAG        DriftPolicy = 2
+)
+
+// Enum value maps for DriftPolicy.
+var (
+	DriftPolicy_name = map[int32]string{
+		0: "DRIFT_POLICY_UNSPECIFIED",
+		1: "DRIFT_POLICY_REAPPLY",
+		2: "DRIFT_POLICY_FLAG",
+	}
+	DriftPolicy_value = map[string]int32{
+		"DRIFT_POLICY_UNSPECIFIED": 0,
+		"DRIFT_POLICY_REAPPLY":     1,
+		"DRIFT_POLICY_FLAG":        2,
+	}
+)
+
+func (x DriftPolicy) Enum() *DriftPolicy {
+	p := new(DriftPolicy)
+	*p = x
+	return p
+}
+
+func (x DriftPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriftPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[16].Descriptor()
+}
+
+func (DriftPolicy) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[16]
+}
+
+func (x DriftPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriftPolicy.Descriptor instead.
+func (DriftPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{16}
+}
+
+type Metric int32
+
+const (
+	Metric_METRIC_UNSPECIFIED Metric = 0
+	Metric_METRIC_CPU         Metric = 1
+	Metric_METRIC_MEMORY      Metric = 2
+	Metric_METRIC_TEMPERATURE Metric = 3
+	Metric_METRIC_LOAD        Metric = 4
+	Metric_METRIC_DISK        Metric = 5
+	Metric_METRIC_PROCESSES   Metric = 6
+)
+
+// Enum value maps for Metric.
+var (
+	Metric_name = map[int32]string{
+		0: "METRIC_UNSPECIFIED",
+		1: "METRIC_CPU",
+		2: "METRIC_MEMORY",
+		3: "METRIC_TEMPERATURE",
+		4: "METRIC_LOAD",
+		5: "METRIC_DISK",
+		6: "METRIC_PROCESSES",
+	}
+	Metric_value = map[string]int32{
+		"METRIC_UNSPECIFIED": 0,
+		"METRIC_CPU":         1,
+		"METRIC_MEMORY":      2,
+		"METRIC_TEMPERATURE": 3,
+		"METRIC_LOAD":        4,
+		"METRIC_DISK":        5,
+		"METRIC_PROCESSES":   6,
+	}
+)
+
+func (x Metric) Enum() *Metric {
+	p := new(Metric)
+	*p = x
+	return p
+}
+
+func (x Metric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Metric) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[17].Descriptor()
+}
+
+func (Metric) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[17]
+}
+
+func (x Metric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Metric.Descriptor instead.
+func (Metric) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{17}
+}
+
+type AnomalyKind int32
+
+const (
+	AnomalyKind_ANOMALY_KIND_UNSPECIFIED AnomalyKind = 0
+	AnomalyKind_ANOMALY_KIND_SPIKE       AnomalyKind = 1
+	AnomalyKind_ANOMALY_KIND_DRIFT       AnomalyKind = 2
+)
+
+// Enum value maps for AnomalyKind.
+var (
+	AnomalyKind_name = map[int32]string{
+		0: "ANOMALY_KIND_UNSPECIFIED",
+		1: "ANOMALY_KIND_SPIKE",
+		2: "ANOMALY_KIND_DRIFT",
+	}
+	AnomalyKind_value = map[string]int32{
+		"ANOMALY_KIND_UNSPECIFIED": 0,
+		"ANOMALY_KIND_SPIKE":       1,
+		"ANOMALY_KIND_DRIFT":       2,
+	}
+)
+
+func (x AnomalyKind) Enum() *AnomalyKind {
+	p := new(AnomalyKind)
+	*p = x
+	return p
+}
+
+func (x AnomalyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[18].Descriptor()
+}
+
+func (AnomalyKind) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[18]
+}
+
+func (x AnomalyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalyKind.Descriptor instead.
+func (AnomalyKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{18}
+}
+
+type Sensitivity int32
+
+const (
+	Sensitivity_SENSITIVITY_UNSPECIFIED Sensitivity = 0
+	Sensitivity_SENSITIVITY_DISABLED    Sensitivity = 1
+	Sensitivity_SENSITIVITY_LOW         Sensitivity = 2
+	Sensitivity_SENSITIVITY_MEDIUM      Sensitivity = 3
+	Sensitivity_SENSITIVITY_HIGH        Sensitivity = 4
+)
+
+// Enum value maps for Sensitivity.
+var (
+	Sensitivity_name = map[int32]string{
+		0: "SENSITIVITY_UNSPECIFIED",
+		1: "SENSITIVITY_DISABLED",
+		2: "SENSITIVITY_LOW",
+		3: "SENSITIVITY_MEDIUM",
+		4: "SENSITIVITY_HIGH",
+	}
+	Sensitivity_value = map[string]int32{
+		"SENSITIVITY_UNSPECIFIED": 0,
+		"SENSITIVITY_DISABLED":    1,
+		"SENSITIVITY_LOW":         2,
+		"SENSITIVITY_MEDIUM":      3,
+		"SENSITIVITY_HIGH":        4,
+	}
+)
+
+func (x Sensitivity) Enum() *Sensitivity {
+	p := new(Sensitivity)
+	*p = x
+	return p
+}
+
+func (x Sensitivity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Sensitivity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[19].Descriptor()
+}
+
+func (Sensitivity) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[19]
+}
+
+func (x Sensitivity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Sensitivity.Descriptor instead.
+func (Sensitivity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{19}
+}
+
+type ForecastModel int32
+
+const (
+	ForecastModel_FORECAST_MODEL_UNSPECIFIED  ForecastModel = 0
+	ForecastModel_FORECAST_MODEL_LINEAR       ForecastModel = 1
+	ForecastModel_FORECAST_MODEL_HOLT_WINTERS ForecastModel = 2
+)
+
+// Enum value maps for ForecastModel.
+var (
+	ForecastModel_name = map[int32]string{
+		0: "FORECAST_MODEL_UNSPECIFIED",
+		1: "FORECAST_MODEL_LINEAR",
+		2: "FORECAST_MODEL_HOLT_WINTERS",
+	}
+	ForecastModel_value = map[string]int32{
+		"FORECAST_MODEL_UNSPECIFIED":  0,
+		"FORECAST_MODEL_LINEAR":       1,
+		"FORECAST_MODEL_HOLT_WINTERS": 2,
+	}
+)
+
+func (x ForecastModel) Enum() *ForecastModel {
+	p := new(ForecastModel)
+	*p = x
+	return p
+}
+
+func (x ForecastModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForecastModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[20].Descriptor()
+}
+
+func (ForecastModel) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[20]
+}
+
+func (x ForecastModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForecastModel.Descriptor instead.
+func (ForecastModel) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{20}
+}
+
+type VersionComponent int32
+
+const (
+	VersionComponent_VERSION_COMPONENT_UNSPECIFIED VersionComponent = 0
+	VersionComponent_VERSION_COMPONENT_HARDWARE    VersionComponent = 1
+	VersionComponent_VERSION_COMPONENT_SOFTWARE    VersionComponent = 2
+	VersionComponent_VERSION_COMPONENT_FIRMWARE    VersionComponent = 3
+)
+
+// Enum value maps for VersionComponent.
+var (
+	VersionComponent_name = map[int32]string{
+		0: "VERSION_COMPONENT_UNSPECIFIED",
+		1: "VERSION_COMPONENT_HARDWARE",
+		2: "VERSION_COMPONENT_SOFTWARE",
+		3: "VERSION_COMPONENT_FIRMWARE",
+	}
+	VersionComponent_value = map[string]int32{
+		"VERSION_COMPONENT_UNSPECIFIED": 0,
+		"VERSION_COMPONENT_HARDWARE":    1,
+		"VERSION_COMPONENT_SOFTWARE":    2,
+		"VERSION_COMPONENT_FIRMWARE":    3,
+	}
+)
+
+func (x VersionComponent) Enum() *VersionComponent {
+	p := new(VersionComponent)
+	*p = x
+	return p
+}
+
+func (x VersionComponent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VersionComponent) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[21].Descriptor()
+}
+
+func (VersionComponent) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[21]
+}
+
+func (x VersionComponent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VersionComponent.Descriptor instead.
+func (VersionComponent) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{21}
+}
+
+type VersionGrouping int32
+
+const (
+	VersionGrouping_VERSION_GROUPING_UNSPECIFIED  VersionGrouping = 0
+	VersionGrouping_VERSION_GROUPING_MODEL        VersionGrouping = 1
+	VersionGrouping_VERSION_GROUPING_ARCHITECTURE VersionGrouping = 2
+)
+
+// Enum value maps for VersionGrouping.
+var (
+	VersionGrouping_name = map[int32]string{
+		0: "VERSION_GROUPING_UNSPECIFIED",
+		1: "VERSION_GROUPING_MODEL",
+		2: "VERSION_GROUPING_ARCHITECTURE",
+	}
+	VersionGrouping_value = map[string]int32{
+		"VERSION_GROUPING_UNSPECIFIED":  0,
+		"VERSION_GROUPING_MODEL":        1,
+		"VERSION_GROUPING_ARCHITECTURE": 2,
+	}
+)
+
+func (x VersionGrouping) Enum() *VersionGrouping {
+	p := new(VersionGrouping)
+	*p = x
+	return p
+}
+
+func (x VersionGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VersionGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[22].Descriptor()
+}
+
+func (VersionGrouping) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[22]
+}
+
+func (x VersionGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VersionGrouping.Descriptor instead.
+func (VersionGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{22}
+}
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED       EventType = 0
+	EventType_EVENT_TYPE_REGISTERED        EventType = 1
+	EventType_EVENT_TYPE_STATUS_CHANGED    EventType = 2
+	EventType_EVENT_TYPE_OFFLINE           EventType = 3
+	EventType_EVENT_TYPE_CHECKSUM_MISMATCH EventType = 4
+	EventType_EVENT_TYPE_VERSION_CHANGED   EventType = 5
+	EventType_EVENT_TYPE_WORKER_STARTED    EventType = 6
+	EventType_EVENT_TYPE_WORKER_RESTARTED  EventType = 7
+	EventType_EVENT_TYPE_WORKER_STOPPED    EventType = 8
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_REGISTERED",
+		2: "EVENT_TYPE_STATUS_CHANGED",
+		3: "EVENT_TYPE_OFFLINE",
+		4: "EVENT_TYPE_CHECKSUM_MISMATCH",
+		5: "EVENT_TYPE_VERSION_CHANGED",
+		6: "EVENT_TYPE_WORKER_STARTED",
+		7: "EVENT_TYPE_WORKER_RESTARTED",
+		8: "EVENT_TYPE_WORKER_STOPPED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":       0,
+		"EVENT_TYPE_REGISTERED":        1,
+		"EVENT_TYPE_STATUS_CHANGED":    2,
+		"EVENT_TYPE_OFFLINE":           3,
+		"EVENT_TYPE_CHECKSUM_MISMATCH": 4,
+		"EVENT_TYPE_VERSION_CHANGED":   5,
+		"EVENT_TYPE_WORKER_STARTED":    6,
+		"EVENT_TYPE_WORKER_RESTARTED":  7,
+		"EVENT_TYPE_WORKER_STOPPED":    8,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[23].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[23]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{23}
+}
+
+type EventSeverity int32
+
+const (
+	EventSeverity_EVENT_SEVERITY_UNSPECIFIED EventSeverity = 0
+	EventSeverity_EVENT_SEVERITY_INFO        EventSeverity = 1
+	EventSeverity_EVENT_SEVERITY_WARNING     EventSeverity = 2
+	EventSeverity_EVENT_SEVERITY_ERROR       EventSeverity = 3
+)
+
+// Enum value maps for EventSeverity.
+var (
+	EventSeverity_name = map[int32]string{
+		0: "EVENT_SEVERITY_UNSPECIFIED",
+		1: "EVENT_SEVERITY_INFO",
+		2: "EVENT_SEVERITY_WARNING",
+		3: "EVENT_SEVERITY_ERROR",
+	}
+	EventSeverity_value = map[string]int32{
+		"EVENT_SEVERITY_UNSPECIFIED": 0,
+		"EVENT_SEVERITY_INFO":        1,
+		"EVENT_SEVERITY_WARNING":     2,
+		"EVENT_SEVERITY_ERROR":       3,
+	}
+)
+
+func (x EventSeverity) Enum() *EventSeverity {
+	p := new(EventSeverity)
+	*p = x
+	return p
+}
+
+func (x EventSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[24].Descriptor()
+}
+
+func (EventSeverity) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[24]
+}
+
+func (x EventSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventSeverity.Descriptor instead.
+func (EventSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{24}
+}
+
+type ComplianceStatus int32
+
+const (
+	ComplianceStatus_COMPLIANCE_STATUS_UNSPECIFIED   ComplianceStatus = 0
+	ComplianceStatus_COMPLIANCE_STATUS_COMPLIANT     ComplianceStatus = 1
+	ComplianceStatus_COMPLIANCE_STATUS_NON_COMPLIANT ComplianceStatus = 2
+	ComplianceStatus_COMPLIANCE_STATUS_UNKNOWN       ComplianceStatus = 3
+)
+
+// Enum value maps for ComplianceStatus.
+var (
+	ComplianceStatus_name = map[int32]string{
+		0: "COMPLIANCE_STATUS_UNSPECIFIED",
+		1: "COMPLIANCE_STATUS_COMPLIANT",
+		2: "COMPLIANCE_STATUS_NON_COMPLIANT",
+		3: "COMPLIANCE_STATUS_UNKNOWN",
+	}
+	ComplianceStatus_value = map[string]int32{
+		"COMPLIANCE_STATUS_UNSPECIFIED":   0,
+		"COMPLIANCE_STATUS_COMPLIANT":     1,
+		"COMPLIANCE_STATUS_NON_COMPLIANT": 2,
+		"COMPLIANCE_STATUS_UNKNOWN":       3,
+	}
+)
+
+func (x ComplianceStatus) Enum() *ComplianceStatus {
+	p := new(ComplianceStatus)
+	*p = x
+	return p
+}
+
+func (x ComplianceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComplianceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_monitor_v1_monitor_proto_enumTypes[25].Descriptor()
+}
+
+func (ComplianceStatus) Type() protoreflect.EnumType {
+	return &file_proto_monitor_v1_monitor_proto_enumTypes[25]
+}
+
+func (x ComplianceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComplianceStatus.Descriptor instead.
+func (ComplianceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{25}
+}
+
 type Device struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId             string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Alias                string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Host                 string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Port                 int64                  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	PortGateway          int64                  `protobuf:"varint,6,opt,name=port_gateway,proto3" json:"port_gateway,omitempty"`
+	Architecture         string                 `protobuf:"bytes,7,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Os                   string                 `protobuf:"bytes,8,opt,name=os,proto3" json:"os,omitempty"`
+	SupportedProtocols   []Protocol             `protobuf:"varint,9,rep,packed,name=supported_protocols,proto3,enum=monitor.v1.Protocol" json:"supported_protocols,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	SigningAlgorithm     SigningAlgorithm       `protobuf:"varint,12,opt,name=signing_algorithm,proto3,enum=monitor.v1.SigningAlgorithm" json:"signing_algorithm,omitempty"`
+	ComplianceStatus     ComplianceStatus       `protobuf:"varint,13,opt,name=compliance_status,proto3,enum=monitor.v1.ComplianceStatus" json:"compliance_status,omitempty"`
+	ComplianceViolations []string               `protobuf:"bytes,14,rep,name=compliance_violations,proto3" json:"compliance_violations,omitempty"`
+	Driver               string                 `protobuf:"bytes,15,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Device) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Device) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Device) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Device) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Device) GetPortGateway() int64 {
+	if x != nil {
+		return x.PortGateway
+	}
+	return 0
+}
+
+func (x *Device) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *Device) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *Device) GetSupportedProtocols() []Protocol {
+	if x != nil {
+		return x.SupportedProtocols
+	}
+	return nil
+}
+
+func (x *Device) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Device) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Device) GetSigningAlgorithm() SigningAlgorithm {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED
+}
+
+func (x *Device) GetComplianceStatus() ComplianceStatus {
+	if x != nil {
+		return x.ComplianceStatus
+	}
+	return ComplianceStatus_COMPLIANCE_STATUS_UNSPECIFIED
+}
+
+func (x *Device) GetComplianceViolations() []string {
+	if x != nil {
+		return x.ComplianceViolations
+	}
+	return nil
+}
+
+func (x *Device) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+type Diagnostics struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HardwareVersion    string                 `protobuf:"bytes,1,opt,name=hardware_version,proto3" json:"hardware_version,omitempty"`
+	SoftwareVersion    string                 `protobuf:"bytes,2,opt,name=software_version,proto3" json:"software_version,omitempty"`
+	FirmwareVersion    string                 `protobuf:"bytes,3,opt,name=firmware_version,proto3" json:"firmware_version,omitempty"`
+	CpuUsage           float64                `protobuf:"fixed64,4,opt,name=cpu_usage,proto3" json:"cpu_usage,omitempty"`
+	MemoryUsage        float64                `protobuf:"fixed64,5,opt,name=memory_usage,proto3" json:"memory_usage,omitempty"`
+	DeviceStatus       DeviceStatus           `protobuf:"varint,6,opt,name=device_status,proto3,enum=monitor.v1.DeviceStatus" json:"device_status,omitempty"`
+	Checksum           string                 `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	VerificationStatus VerificationStatus     `protobuf:"varint,8,opt,name=verification_status,proto3,enum=monitor.v1.VerificationStatus" json:"verification_status,omitempty"`
+	UptimeSeconds      uint64                 `protobuf:"varint,9,opt,name=uptime_seconds,proto3" json:"uptime_seconds,omitempty"`
+	LoadAverage_1M     float64                `protobuf:"fixed64,10,opt,name=load_average_1m,proto3" json:"load_average_1m,omitempty"`
+	LoadAverage_5M     float64                `protobuf:"fixed64,11,opt,name=load_average_5m,proto3" json:"load_average_5m,omitempty"`
+	LoadAverage_15M    float64                `protobuf:"fixed64,12,opt,name=load_average_15m,proto3" json:"load_average_15m,omitempty"`
+	TemperatureCelsius float64                `protobuf:"fixed64,13,opt,name=temperature_celsius,proto3" json:"temperature_celsius,omitempty"`
+	DiskUsedBytes      uint64                 `protobuf:"varint,14,opt,name=disk_used_bytes,proto3" json:"disk_used_bytes,omitempty"`
+	DiskTotalBytes     uint64                 `protobuf:"varint,15,opt,name=disk_total_bytes,proto3" json:"disk_total_bytes,omitempty"`
+	ProcessCount       uint32                 `protobuf:"varint,16,opt,name=process_count,proto3" json:"process_count,omitempty"`
+	Interfaces         []*NetworkInterface    `protobuf:"bytes,17,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Timestamp          *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Diagnostics) Reset() {
+	*x = Diagnostics{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostics) ProtoMessage() {}
+
+func (x *Diagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostics.ProtoReflect.Descriptor instead.
+func (*Diagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Diagnostics) GetHardwareVersion() string {
+	if x != nil {
+		return x.HardwareVersion
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetSoftwareVersion() string {
+	if x != nil {
+		return x.SoftwareVersion
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetFirmwareVersion() string {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetMemoryUsage() float64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetDeviceStatus() DeviceStatus {
+	if x != nil {
+		return x.DeviceStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+func (x *Diagnostics) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *Diagnostics) GetVerificationStatus() VerificationStatus {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return VerificationStatus_VERIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *Diagnostics) GetUptimeSeconds() uint64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetLoadAverage_1M() float64 {
+	if x != nil {
+		return x.LoadAverage_1M
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetLoadAverage_5M() float64 {
+	if x != nil {
+		return x.LoadAverage_5M
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetLoadAverage_15M() float64 {
+	if x != nil {
+		return x.LoadAverage_15M
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetTemperatureCelsius() float64 {
+	if x != nil {
+		return x.TemperatureCelsius
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetDiskUsedBytes() uint64 {
+	if x != nil {
+		return x.DiskUsedBytes
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetDiskTotalBytes() uint64 {
+	if x != nil {
+		return x.DiskTotalBytes
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetProcessCount() uint32 {
+	if x != nil {
+		return x.ProcessCount
+	}
+	return 0
+}
+
+func (x *Diagnostics) GetInterfaces() []*NetworkInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *Diagnostics) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type NetworkInterface struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LinkState          LinkState              `protobuf:"varint,2,opt,name=link_state,proto3,enum=monitor.v1.LinkState" json:"link_state,omitempty"`
+	RxBytes            uint64                 `protobuf:"varint,3,opt,name=rx_bytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes            uint64                 `protobuf:"varint,4,opt,name=tx_bytes,proto3" json:"tx_bytes,omitempty"`
+	RxPackets          uint64                 `protobuf:"varint,5,opt,name=rx_packets,proto3" json:"rx_packets,omitempty"`
+	TxPackets          uint64                 `protobuf:"varint,6,opt,name=tx_packets,proto3" json:"tx_packets,omitempty"`
+	RxErrors           uint64                 `protobuf:"varint,7,opt,name=rx_errors,proto3" json:"rx_errors,omitempty"`
+	TxErrors           uint64                 `protobuf:"varint,8,opt,name=tx_errors,proto3" json:"tx_errors,omitempty"`
+	RxBytesPerSecond   float64                `protobuf:"fixed64,9,opt,name=rx_bytes_per_second,proto3" json:"rx_bytes_per_second,omitempty"`
+	TxBytesPerSecond   float64                `protobuf:"fixed64,10,opt,name=tx_bytes_per_second,proto3" json:"tx_bytes_per_second,omitempty"`
+	RxPacketsPerSecond float64                `protobuf:"fixed64,11,opt,name=rx_packets_per_second,proto3" json:"rx_packets_per_second,omitempty"`
+	TxPacketsPerSecond float64                `protobuf:"fixed64,12,opt,name=tx_packets_per_second,proto3" json:"tx_packets_per_second,omitempty"`
+	RxErrorsPerSecond  float64                `protobuf:"fixed64,13,opt,name=rx_errors_per_second,proto3" json:"rx_errors_per_second,omitempty"`
+	TxErrorsPerSecond  float64                `protobuf:"fixed64,14,opt,name=tx_errors_per_second,proto3" json:"tx_errors_per_second,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NetworkInterface) Reset() {
+	*x = NetworkInterface{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterface) ProtoMessage() {}
+
+func (x *NetworkInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterface.ProtoReflect.Descriptor instead.
+func (*NetworkInterface) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NetworkInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetLinkState() LinkState {
+	if x != nil {
+		return x.LinkState
+	}
+	return LinkState_LINK_STATE_UNSPECIFIED
+}
+
+func (x *NetworkInterface) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxBytesPerSecond() float64 {
+	if x != nil {
+		return x.RxBytesPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxBytesPerSecond() float64 {
+	if x != nil {
+		return x.TxBytesPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxPacketsPerSecond() float64 {
+	if x != nil {
+		return x.RxPacketsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxPacketsPerSecond() float64 {
+	if x != nil {
+		return x.TxPacketsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxErrorsPerSecond() float64 {
+	if x != nil {
+		return x.RxErrorsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxErrorsPerSecond() float64 {
+	if x != nil {
+		return x.TxErrorsPerSecond
+	}
+	return 0
+}
+
+type RegisterDeviceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId         string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Alias            string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Host             string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port             int64                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	PortGateway      int64                  `protobuf:"varint,5,opt,name=port_gateway,proto3" json:"port_gateway,omitempty"`
+	Protocol         Protocol               `protobuf:"varint,6,opt,name=protocol,proto3,enum=monitor.v1.Protocol" json:"protocol,omitempty"`
+	SigningAlgorithm SigningAlgorithm       `protobuf:"varint,7,opt,name=signing_algorithm,proto3,enum=monitor.v1.SigningAlgorithm" json:"signing_algorithm,omitempty"`
+	SigningKey       string                 `protobuf:"bytes,8,opt,name=signing_key,proto3" json:"signing_key,omitempty"`
+	Snmp             *SnmpCredentials       `protobuf:"bytes,9,opt,name=snmp,proto3" json:"snmp,omitempty"`
+	// Name of the plugin driver of the device (required by PROTOCOL_PLUGIN)
+	Driver        string `protobuf:"bytes,10,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceRequest) Reset() {
+	*x = RegisterDeviceRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RegisterDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RegisterDeviceRequest) GetPortGateway() int64 {
+	if x != nil {
+		return x.PortGateway
+	}
+	return 0
+}
+
+func (x *RegisterDeviceRequest) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_PROTOCOL_UNSPECIFIED
+}
+
+func (x *RegisterDeviceRequest) GetSigningAlgorithm() SigningAlgorithm {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED
+}
+
+func (x *RegisterDeviceRequest) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetSnmp() *SnmpCredentials {
+	if x != nil {
+		return x.Snmp
+	}
+	return nil
+}
+
+func (x *RegisterDeviceRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+// Community (v2c) or user-based security (v3) of a device polled over SNMP, v3 without an
+// authentication protocol is noAuthNoPriv and a privacy protocol requires authentication.
+type SnmpCredentials struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Version        SnmpVersion            `protobuf:"varint,1,opt,name=version,proto3,enum=monitor.v1.SnmpVersion" json:"version,omitempty"`
+	Community      string                 `protobuf:"bytes,2,opt,name=community,proto3" json:"community,omitempty"`
+	Username       string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	AuthProtocol   SnmpAuthProtocol       `protobuf:"varint,4,opt,name=auth_protocol,proto3,enum=monitor.v1.SnmpAuthProtocol" json:"auth_protocol,omitempty"`
+	AuthPassphrase string                 `protobuf:"bytes,5,opt,name=auth_passphrase,proto3" json:"auth_passphrase,omitempty"`
+	PrivProtocol   SnmpPrivProtocol       `protobuf:"varint,6,opt,name=priv_protocol,proto3,enum=monitor.v1.SnmpPrivProtocol" json:"priv_protocol,omitempty"`
+	PrivPassphrase string                 `protobuf:"bytes,7,opt,name=priv_passphrase,proto3" json:"priv_passphrase,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SnmpCredentials) Reset() {
+	*x = SnmpCredentials{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnmpCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnmpCredentials) ProtoMessage() {}
+
+func (x *SnmpCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnmpCredentials.ProtoReflect.Descriptor instead.
+func (*SnmpCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SnmpCredentials) GetVersion() SnmpVersion {
+	if x != nil {
+		return x.Version
+	}
+	return SnmpVersion_SNMP_VERSION_UNSPECIFIED
+}
+
+func (x *SnmpCredentials) GetCommunity() string {
+	if x != nil {
+		return x.Community
+	}
+	return ""
+}
+
+func (x *SnmpCredentials) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SnmpCredentials) GetAuthProtocol() SnmpAuthProtocol {
+	if x != nil {
+		return x.AuthProtocol
+	}
+	return SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_UNSPECIFIED
+}
+
+func (x *SnmpCredentials) GetAuthPassphrase() string {
+	if x != nil {
+		return x.AuthPassphrase
+	}
+	return ""
+}
+
+func (x *SnmpCredentials) GetPrivProtocol() SnmpPrivProtocol {
+	if x != nil {
+		return x.PrivProtocol
+	}
+	return SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_UNSPECIFIED
+}
+
+func (x *SnmpCredentials) GetPrivPassphrase() string {
+	if x != nil {
+		return x.PrivPassphrase
+	}
+	return ""
+}
+
+type RegisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceResponse) Reset() {
+	*x = RegisterDeviceResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceResponse) ProtoMessage() {}
+
+func (x *RegisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterDeviceResponse) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type UpdateDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	DeviceStatus  DeviceStatus           `protobuf:"varint,2,opt,name=device_status,proto3,enum=monitor.v1.DeviceStatus" json:"device_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeviceRequest) Reset() {
+	*x = UpdateDeviceRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceRequest) ProtoMessage() {}
+
+func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *UpdateDeviceRequest) GetDeviceStatus() DeviceStatus {
+	if x != nil {
+		return x.DeviceStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+type DeleteDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeviceRequest) Reset() {
+	*x = DeleteDeviceRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeviceRequest) ProtoMessage() {}
+
+func (x *DeleteDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type ImportDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format        InventoryFormat        `protobuf:"varint,2,opt,name=format,proto3,enum=monitor.v1.InventoryFormat" json:"format,omitempty"`
+	Prune         bool                   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDevicesRequest) Reset() {
+	*x = ImportDevicesRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDevicesRequest) ProtoMessage() {}
+
+func (x *ImportDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ImportDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportDevicesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportDevicesRequest) GetFormat() InventoryFormat {
+	if x != nil {
+		return x.Format
+	}
+	return InventoryFormat_INVENTORY_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportDevicesRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type ImportResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Status        ImportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=monitor.v1.ImportStatus" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportResult) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ImportResult) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ImportResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Pruned        bool                   `protobuf:"varint,2,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDevicesResponse) Reset() {
+	*x = ImportDevicesResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDevicesResponse) ProtoMessage() {}
+
+func (x *ImportDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ImportDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportDevicesResponse) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportDevicesResponse) GetPruned() bool {
+	if x != nil {
+		return x.Pruned
+	}
+	return false
+}
+
+type RebootDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebootDeviceRequest) Reset() {
+	*x = RebootDeviceRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebootDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebootDeviceRequest) ProtoMessage() {}
+
+func (x *RebootDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RebootDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RebootDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RebootDeviceRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *RebootDeviceRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type RebootDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Downtime      *durationpb.Duration   `protobuf:"bytes,1,opt,name=downtime,proto3" json:"downtime,omitempty"`
+	Diagnostics   *Diagnostics           `protobuf:"bytes,2,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebootDeviceResponse) Reset() {
+	*x = RebootDeviceResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebootDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebootDeviceResponse) ProtoMessage() {}
+
+func (x *RebootDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RebootDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RebootDeviceResponse) GetDowntime() *durationpb.Duration {
+	if x != nil {
+		return x.Downtime
+	}
+	return nil
+}
+
+func (x *RebootDeviceResponse) GetDiagnostics() *Diagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type StatusTransition struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId       string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	FromStatus     DeviceStatus           `protobuf:"varint,2,opt,name=from_status,proto3,enum=monitor.v1.DeviceStatus" json:"from_status,omitempty"`
+	ToStatus       DeviceStatus           `protobuf:"varint,3,opt,name=to_status,proto3,enum=monitor.v1.DeviceStatus" json:"to_status,omitempty"`
+	Cause          TransitionCause        `protobuf:"varint,4,opt,name=cause,proto3,enum=monitor.v1.TransitionCause" json:"cause,omitempty"`
+	Duration       *durationpb.Duration   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	TransitionedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=transitioned_at,proto3" json:"transitioned_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransition) ProtoMessage() {}
+
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StatusTransition) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetFromStatus() DeviceStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+func (x *StatusTransition) GetToStatus() DeviceStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+func (x *StatusTransition) GetCause() TransitionCause {
+	if x != nil {
+		return x.Cause
+	}
+	return TransitionCause_TRANSITION_CAUSE_UNSPECIFIED
+}
+
+func (x *StatusTransition) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *StatusTransition) GetTransitionedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransitionedAt
+	}
+	return nil
+}
+
+type ListStatusTransitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusTransitionsRequest) Reset() {
+	*x = ListStatusTransitionsRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusTransitionsRequest) ProtoMessage() {}
+
+func (x *ListStatusTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStatusTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListStatusTransitionsRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ListStatusTransitionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListStatusTransitionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListStatusTransitionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListStatusTransitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transitions   []*StatusTransition    `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusTransitionsResponse) Reset() {
+	*x = ListStatusTransitionsResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusTransitionsResponse) ProtoMessage() {}
+
+func (x *ListStatusTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStatusTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListStatusTransitionsResponse) GetTransitions() []*StatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type Anomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Metric        Metric                 `protobuf:"varint,3,opt,name=metric,proto3,enum=monitor.v1.Metric" json:"metric,omitempty"`
+	Kind          AnomalyKind            `protobuf:"varint,4,opt,name=kind,proto3,enum=monitor.v1.AnomalyKind" json:"kind,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	Baseline      float64                `protobuf:"fixed64,6,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Deviation     float64                `protobuf:"fixed64,7,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Score         float64                `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=detected_at,proto3" json:"detected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Anomaly) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Anomaly) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Anomaly) GetMetric() Metric {
+	if x != nil {
+		return x.Metric
+	}
+	return Metric_METRIC_UNSPECIFIED
+}
+
+func (x *Anomaly) GetKind() AnomalyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AnomalyKind_ANOMALY_KIND_UNSPECIFIED
+}
+
+func (x *Anomaly) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Anomaly) GetBaseline() float64 {
+	if x != nil {
+		return x.Baseline
+	}
+	return 0
+}
+
+func (x *Anomaly) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *Anomaly) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Anomaly) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+type DeviceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Type          EventType              `protobuf:"varint,3,opt,name=type,proto3,enum=monitor.v1.EventType" json:"type,omitempty"`
+	Severity      EventSeverity          `protobuf:"varint,4,opt,name=severity,proto3,enum=monitor.v1.EventSeverity" json:"severity,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,6,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceEvent) Reset() {
+	*x = DeviceEvent{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceEvent) ProtoMessage() {}
+
+func (x *DeviceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceEvent.ProtoReflect.Descriptor instead.
+func (*DeviceEvent) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeviceEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *DeviceEvent) GetSeverity() EventSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return EventSeverity_EVENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *DeviceEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeviceEvent) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *DeviceEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ListDeviceEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Types         []EventType            `protobuf:"varint,2,rep,packed,name=types,proto3,enum=monitor.v1.EventType" json:"types,omitempty"`
+	Severity      EventSeverity          `protobuf:"varint,3,opt,name=severity,proto3,enum=monitor.v1.EventSeverity" json:"severity,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeviceEventsRequest) Reset() {
+	*x = ListDeviceEventsRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeviceEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeviceEventsRequest) ProtoMessage() {}
+
+func (x *ListDeviceEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeviceEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeviceEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDeviceEventsRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ListDeviceEventsRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListDeviceEventsRequest) GetSeverity() EventSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return EventSeverity_EVENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *ListDeviceEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListDeviceEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListDeviceEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeviceEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*DeviceEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeviceEventsResponse) Reset() {
+	*x = ListDeviceEventsResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeviceEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeviceEventsResponse) ProtoMessage() {}
+
+func (x *ListDeviceEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeviceEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeviceEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDeviceEventsResponse) GetEvents() []*DeviceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type StreamDeviceEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Types         []EventType            `protobuf:"varint,2,rep,packed,name=types,proto3,enum=monitor.v1.EventType" json:"types,omitempty"`
+	Severity      EventSeverity          `protobuf:"varint,3,opt,name=severity,proto3,enum=monitor.v1.EventSeverity" json:"severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDeviceEventsRequest) Reset() {
+	*x = StreamDeviceEventsRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDeviceEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDeviceEventsRequest) ProtoMessage() {}
+
+func (x *StreamDeviceEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDeviceEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamDeviceEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamDeviceEventsRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *StreamDeviceEventsRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *StreamDeviceEventsRequest) GetSeverity() EventSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return EventSeverity_EVENT_SEVERITY_UNSPECIFIED
+}
+
+type ListAnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnomaliesRequest) Reset() {
+	*x = ListAnomaliesRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnomaliesRequest) ProtoMessage() {}
+
+func (x *ListAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*ListAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAnomaliesRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ListAnomaliesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAnomaliesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAnomaliesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAnomaliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*Anomaly             `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnomaliesResponse) Reset() {
+	*x = ListAnomaliesResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnomaliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnomaliesResponse) ProtoMessage() {}
+
+func (x *ListAnomaliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnomaliesResponse.ProtoReflect.Descriptor instead.
+func (*ListAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListAnomaliesResponse) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type StreamAnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamAnomaliesRequest) Reset() {
+	*x = StreamAnomaliesRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAnomaliesRequest) ProtoMessage() {}
+
+func (x *StreamAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*StreamAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamAnomaliesRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type AnomalySettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Sensitivity   Sensitivity            `protobuf:"varint,2,opt,name=sensitivity,proto3,enum=monitor.v1.Sensitivity" json:"sensitivity,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalySettings) Reset() {
+	*x = AnomalySettings{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalySettings) ProtoMessage() {}
+
+func (x *AnomalySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalySettings.ProtoReflect.Descriptor instead.
+func (*AnomalySettings) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AnomalySettings) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *AnomalySettings) GetSensitivity() Sensitivity {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return Sensitivity_SENSITIVITY_UNSPECIFIED
+}
+
+func (x *AnomalySettings) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AnomalySettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetAnomalySensitivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Sensitivity   Sensitivity            `protobuf:"varint,2,opt,name=sensitivity,proto3,enum=monitor.v1.Sensitivity" json:"sensitivity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnomalySensitivityRequest) Reset() {
+	*x = SetAnomalySensitivityRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnomalySensitivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnomalySensitivityRequest) ProtoMessage() {}
+
+func (x *SetAnomalySensitivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnomalySensitivityRequest.ProtoReflect.Descriptor instead.
+func (*SetAnomalySensitivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetAnomalySensitivityRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SetAnomalySensitivityRequest) GetSensitivity() Sensitivity {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return Sensitivity_SENSITIVITY_UNSPECIFIED
+}
+
+type GetAnomalySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnomalySettingsRequest) Reset() {
+	*x = GetAnomalySettingsRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnomalySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnomalySettingsRequest) ProtoMessage() {}
+
+func (x *GetAnomalySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnomalySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnomalySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAnomalySettingsRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type AnomalySettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *AnomalySettings       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalySettingsResponse) Reset() {
+	*x = AnomalySettingsResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalySettingsResponse) ProtoMessage() {}
+
+func (x *AnomalySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalySettingsResponse.ProtoReflect.Descriptor instead.
+func (*AnomalySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AnomalySettingsResponse) GetSettings() *AnomalySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type VersionInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       VersionGrouping        `protobuf:"varint,1,opt,name=group_by,proto3,enum=monitor.v1.VersionGrouping" json:"group_by,omitempty"`
+	Component     VersionComponent       `protobuf:"varint,2,opt,name=component,proto3,enum=monitor.v1.VersionComponent" json:"component,omitempty"`
+	Below         string                 `protobuf:"bytes,3,opt,name=below,proto3" json:"below,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionInventoryRequest) Reset() {
+	*x = VersionInventoryRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionInventoryRequest) ProtoMessage() {}
+
+func (x *VersionInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionInventoryRequest.ProtoReflect.Descriptor instead.
+func (*VersionInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VersionInventoryRequest) GetGroupBy() VersionGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return VersionGrouping_VERSION_GROUPING_UNSPECIFIED
+}
+
+func (x *VersionInventoryRequest) GetComponent() VersionComponent {
+	if x != nil {
+		return x.Component
+	}
+	return VersionComponent_VERSION_COMPONENT_UNSPECIFIED
+}
+
+func (x *VersionInventoryRequest) GetBelow() string {
+	if x != nil {
+		return x.Below
+	}
+	return ""
+}
+
+type VersionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     VersionComponent       `protobuf:"varint,1,opt,name=component,proto3,enum=monitor.v1.VersionComponent" json:"component,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Devices       int32                  `protobuf:"varint,3,opt,name=devices,proto3" json:"devices,omitempty"`
+	DeviceIds     []string               `protobuf:"bytes,4,rep,name=device_ids,proto3" json:"device_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionCount) Reset() {
+	*x = VersionCount{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionCount) ProtoMessage() {}
+
+func (x *VersionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionCount.ProtoReflect.Descriptor instead.
+func (*VersionCount) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VersionCount) GetComponent() VersionComponent {
+	if x != nil {
+		return x.Component
+	}
+	return VersionComponent_VERSION_COMPONENT_UNSPECIFIED
+}
+
+func (x *VersionCount) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VersionCount) GetDevices() int32 {
+	if x != nil {
+		return x.Devices
+	}
+	return 0
+}
+
+func (x *VersionCount) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+type VersionGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Devices       int32                  `protobuf:"varint,2,opt,name=devices,proto3" json:"devices,omitempty"`
+	Versions      []*VersionCount        `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionGroup) Reset() {
+	*x = VersionGroup{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionGroup) ProtoMessage() {}
+
+func (x *VersionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionGroup.ProtoReflect.Descriptor instead.
+func (*VersionGroup) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VersionGroup) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VersionGroup) GetDevices() int32 {
+	if x != nil {
+		return x.Devices
+	}
+	return 0
+}
+
+func (x *VersionGroup) GetVersions() []*VersionCount {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type DeviceVersions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId         string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Architecture     string                 `protobuf:"bytes,2,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	HardwareVersion  string                 `protobuf:"bytes,3,opt,name=hardware_version,proto3" json:"hardware_version,omitempty"`
+	SoftwareVersion  string                 `protobuf:"bytes,4,opt,name=software_version,proto3" json:"software_version,omitempty"`
+	FirmwareVersion  string                 `protobuf:"bytes,5,opt,name=firmware_version,proto3" json:"firmware_version,omitempty"`
+	ComplianceStatus ComplianceStatus       `protobuf:"varint,6,opt,name=compliance_status,proto3,enum=monitor.v1.ComplianceStatus" json:"compliance_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeviceVersions) Reset() {
+	*x = DeviceVersions{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceVersions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceVersions) ProtoMessage() {}
+
+func (x *DeviceVersions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceVersions.ProtoReflect.Descriptor instead.
+func (*DeviceVersions) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeviceVersions) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceVersions) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *DeviceVersions) GetHardwareVersion() string {
+	if x != nil {
+		return x.HardwareVersion
+	}
+	return ""
+}
+
+func (x *DeviceVersions) GetSoftwareVersion() string {
+	if x != nil {
+		return x.SoftwareVersion
+	}
+	return ""
+}
+
+func (x *DeviceVersions) GetFirmwareVersion() string {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return ""
+}
+
+func (x *DeviceVersions) GetComplianceStatus() ComplianceStatus {
+	if x != nil {
+		return x.ComplianceStatus
+	}
+	return ComplianceStatus_COMPLIANCE_STATUS_UNSPECIFIED
+}
+
+type VersionInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       VersionGrouping        `protobuf:"varint,1,opt,name=group_by,proto3,enum=monitor.v1.VersionGrouping" json:"group_by,omitempty"`
+	Groups        []*VersionGroup        `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Devices       []*DeviceVersions      `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionInventoryResponse) Reset() {
+	*x = VersionInventoryResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionInventoryResponse) ProtoMessage() {}
+
+func (x *VersionInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionInventoryResponse.ProtoReflect.Descriptor instead.
+func (*VersionInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VersionInventoryResponse) GetGroupBy() VersionGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return VersionGrouping_VERSION_GROUPING_UNSPECIFIED
+}
+
+func (x *VersionInventoryResponse) GetGroups() []*VersionGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *VersionInventoryResponse) GetDevices() []*DeviceVersions {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type CompliancePolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Component     VersionComponent       `protobuf:"varint,3,opt,name=component,proto3,enum=monitor.v1.VersionComponent" json:"component,omitempty"`
+	MinVersion    string                 `protobuf:"bytes,4,opt,name=min_version,proto3" json:"min_version,omitempty"`
+	Allow         []string               `protobuf:"bytes,5,rep,name=allow,proto3" json:"allow,omitempty"`
+	Deny          []string               `protobuf:"bytes,6,rep,name=deny,proto3" json:"deny,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompliancePolicy) Reset() {
+	*x = CompliancePolicy{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompliancePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompliancePolicy) ProtoMessage() {}
+
+func (x *CompliancePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompliancePolicy.ProtoReflect.Descriptor instead.
+func (*CompliancePolicy) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CompliancePolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompliancePolicy) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CompliancePolicy) GetComponent() VersionComponent {
+	if x != nil {
+		return x.Component
+	}
+	return VersionComponent_VERSION_COMPONENT_UNSPECIFIED
+}
+
+func (x *CompliancePolicy) GetMinVersion() string {
+	if x != nil {
+		return x.MinVersion
+	}
+	return ""
+}
+
+func (x *CompliancePolicy) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+func (x *CompliancePolicy) GetDeny() []string {
+	if x != nil {
+		return x.Deny
+	}
+	return nil
+}
+
+func (x *CompliancePolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CompliancePolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetCompliancePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *CompliancePolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCompliancePolicyRequest) Reset() {
+	*x = SetCompliancePolicyRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCompliancePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCompliancePolicyRequest) ProtoMessage() {}
+
+func (x *SetCompliancePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCompliancePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetCompliancePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetCompliancePolicyRequest) GetPolicy() *CompliancePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type CompliancePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *CompliancePolicy      `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompliancePolicyResponse) Reset() {
+	*x = CompliancePolicyResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompliancePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompliancePolicyResponse) ProtoMessage() {}
+
+func (x *CompliancePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompliancePolicyResponse.ProtoReflect.Descriptor instead.
+func (*CompliancePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CompliancePolicyResponse) GetPolicy() *CompliancePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ListCompliancePoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*CompliancePolicy    `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompliancePoliciesResponse) Reset() {
+	*x = ListCompliancePoliciesResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompliancePoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompliancePoliciesResponse) ProtoMessage() {}
+
+func (x *ListCompliancePoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompliancePoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompliancePoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListCompliancePoliciesResponse) GetPolicies() []*CompliancePolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type DeleteCompliancePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompliancePolicyRequest) Reset() {
+	*x = DeleteCompliancePolicyRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompliancePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompliancePolicyRequest) ProtoMessage() {}
+
+func (x *DeleteCompliancePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompliancePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompliancePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteCompliancePolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type VersionChange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId        string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Component       VersionComponent       `protobuf:"varint,3,opt,name=component,proto3,enum=monitor.v1.VersionComponent" json:"component,omitempty"`
+	PreviousVersion string                 `protobuf:"bytes,4,opt,name=previous_version,proto3" json:"previous_version,omitempty"`
+	CurrentVersion  string                 `protobuf:"bytes,5,opt,name=current_version,proto3" json:"current_version,omitempty"`
+	ChangedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=changed_at,proto3" json:"changed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VersionChange) Reset() {
+	*x = VersionChange{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionChange) ProtoMessage() {}
+
+func (x *VersionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionChange.ProtoReflect.Descriptor instead.
+func (*VersionChange) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VersionChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VersionChange) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *VersionChange) GetComponent() VersionComponent {
+	if x != nil {
+		return x.Component
+	}
+	return VersionComponent_VERSION_COMPONENT_UNSPECIFIED
+}
+
+func (x *VersionChange) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+func (x *VersionChange) GetCurrentVersion() string {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return ""
+}
+
+func (x *VersionChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ListVersionChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Component     VersionComponent       `protobuf:"varint,2,opt,name=component,proto3,enum=monitor.v1.VersionComponent" json:"component,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVersionChangesRequest) Reset() {
+	*x = ListVersionChangesRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVersionChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVersionChangesRequest) ProtoMessage() {}
+
+func (x *ListVersionChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVersionChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListVersionChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListVersionChangesRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ListVersionChangesRequest) GetComponent() VersionComponent {
+	if x != nil {
+		return x.Component
+	}
+	return VersionComponent_VERSION_COMPONENT_UNSPECIFIED
+}
+
+func (x *ListVersionChangesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListVersionChangesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListVersionChangesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListVersionChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*VersionChange       `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVersionChangesResponse) Reset() {
+	*x = ListVersionChangesResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVersionChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVersionChangesResponse) ProtoMessage() {}
+
+func (x *ListVersionChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVersionChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListVersionChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListVersionChangesResponse) GetChanges() []*VersionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type StreamVersionChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Component     VersionComponent       `protobuf:"varint,2,opt,name=component,proto3,enum=monitor.v1.VersionComponent" json:"component,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamVersionChangesRequest) Reset() {
+	*x = StreamVersionChangesRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamVersionChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamVersionChangesRequest) ProtoMessage() {}
+
+func (x *StreamVersionChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamVersionChangesRequest.ProtoReflect.Descriptor instead.
+func (*StreamVersionChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StreamVersionChangesRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *StreamVersionChangesRequest) GetComponent() VersionComponent {
+	if x != nil {
+		return x.Component
+	}
+	return VersionComponent_VERSION_COMPONENT_UNSPECIFIED
+}
+
+type GetForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Metric        Metric                 `protobuf:"varint,2,opt,name=metric,proto3,enum=monitor.v1.Metric" json:"metric,omitempty"`
+	Model         ForecastModel          `protobuf:"varint,3,opt,name=model,proto3,enum=monitor.v1.ForecastModel" json:"model,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	History       *durationpb.Duration   `protobuf:"bytes,5,opt,name=history,proto3" json:"history,omitempty"`
+	Horizon       *durationpb.Duration   `protobuf:"bytes,6,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	Top           int32                  `protobuf:"varint,7,opt,name=top,proto3" json:"top,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastRequest) Reset() {
+	*x = GetForecastRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastRequest) ProtoMessage() {}
+
+func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetForecastRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetForecastRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *GetForecastRequest) GetMetric() Metric {
+	if x != nil {
+		return x.Metric
+	}
+	return Metric_METRIC_UNSPECIFIED
+}
+
+func (x *GetForecastRequest) GetModel() ForecastModel {
+	if x != nil {
+		return x.Model
+	}
+	return ForecastModel_FORECAST_MODEL_UNSPECIFIED
+}
+
+func (x *GetForecastRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetForecastRequest) GetHistory() *durationpb.Duration {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetForecastRequest) GetHorizon() *durationpb.Duration {
+	if x != nil {
+		return x.Horizon
+	}
+	return nil
+}
+
+func (x *GetForecastRequest) GetTop() int32 {
+	if x != nil {
+		return x.Top
+	}
+	return 0
+}
+
+type ForecastPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Lower         float64                `protobuf:"fixed64,3,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper         float64                `protobuf:"fixed64,4,opt,name=upper,proto3" json:"upper,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastPoint) Reset() {
+	*x = ForecastPoint{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastPoint) ProtoMessage() {}
+
+func (x *ForecastPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastPoint.ProtoReflect.Descriptor instead.
+func (*ForecastPoint) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ForecastPoint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ForecastPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ForecastPoint) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ForecastPoint) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+type Forecast struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId        string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Metric          Metric                 `protobuf:"varint,2,opt,name=metric,proto3,enum=monitor.v1.Metric" json:"metric,omitempty"`
+	Model           ForecastModel          `protobuf:"varint,3,opt,name=model,proto3,enum=monitor.v1.ForecastModel" json:"model,omitempty"`
+	Current         float64                `protobuf:"fixed64,4,opt,name=current,proto3" json:"current,omitempty"`
+	TrendPerDay     float64                `protobuf:"fixed64,5,opt,name=trend_per_day,proto3" json:"trend_per_day,omitempty"`
+	Threshold       float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ThresholdAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=threshold_at,proto3" json:"threshold_at,omitempty"`
+	TimeToThreshold *durationpb.Duration   `protobuf:"bytes,8,opt,name=time_to_threshold,proto3" json:"time_to_threshold,omitempty"`
+	Samples         int32                  `protobuf:"varint,9,opt,name=samples,proto3" json:"samples,omitempty"`
+	Points          []*ForecastPoint       `protobuf:"bytes,10,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Forecast) Reset() {
+	*x = Forecast{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Forecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Forecast) ProtoMessage() {}
+
+func (x *Forecast) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Forecast.ProtoReflect.Descriptor instead.
+func (*Forecast) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Forecast) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Forecast) GetMetric() Metric {
+	if x != nil {
+		return x.Metric
+	}
+	return Metric_METRIC_UNSPECIFIED
+}
+
+func (x *Forecast) GetModel() ForecastModel {
+	if x != nil {
+		return x.Model
+	}
+	return ForecastModel_FORECAST_MODEL_UNSPECIFIED
+}
+
+func (x *Forecast) GetCurrent() float64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *Forecast) GetTrendPerDay() float64 {
+	if x != nil {
+		return x.TrendPerDay
+	}
+	return 0
+}
+
+func (x *Forecast) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Forecast) GetThresholdAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ThresholdAt
+	}
+	return nil
+}
+
+func (x *Forecast) GetTimeToThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToThreshold
+	}
+	return nil
+}
+
+func (x *Forecast) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *Forecast) GetPoints() []*ForecastPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forecasts     []*Forecast            `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastResponse) Reset() {
+	*x = GetForecastResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastResponse) ProtoMessage() {}
+
+func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetForecastResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetForecastResponse) GetForecasts() []*Forecast {
+	if x != nil {
+		return x.Forecasts
+	}
+	return nil
+}
+
+type DesiredConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeviceStatus   DeviceStatus           `protobuf:"varint,1,opt,name=device_status,proto3,enum=monitor.v1.DeviceStatus" json:"device_status,omitempty"`
+	StreamInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=stream_interval,proto3" json:"stream_interval,omitempty"`
+	Labels         map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Config         map[string]string      `protobuf:"bytes,4,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DriftPolicy    DriftPolicy            `protobuf:"varint,5,opt,name=drift_policy,proto3,enum=monitor.v1.DriftPolicy" json:"drift_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DesiredConfig) Reset() {
+	*x = DesiredConfig{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DesiredConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DesiredConfig) ProtoMessage() {}
+
+func (x *DesiredConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DesiredConfig.ProtoReflect.Descriptor instead.
+func (*DesiredConfig) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DesiredConfig) GetDeviceStatus() DeviceStatus {
+	if x != nil {
+		return x.DeviceStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+func (x *DesiredConfig) GetStreamInterval() *durationpb.Duration {
+	if x != nil {
+		return x.StreamInterval
+	}
+	return nil
+}
+
+func (x *DesiredConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DesiredConfig) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DesiredConfig) GetDriftPolicy() DriftPolicy {
+	if x != nil {
+		return x.DriftPolicy
+	}
+	return DriftPolicy_DRIFT_POLICY_UNSPECIFIED
+}
+
+type DeviceConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Desired       *DesiredConfig         `protobuf:"bytes,2,opt,name=desired,proto3" json:"desired,omitempty"`
+	Status        ConfigStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=monitor.v1.ConfigStatus" json:"status,omitempty"`
+	Drift         []string               `protobuf:"bytes,4,rep,name=drift,proto3" json:"drift,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	ReapplyCount  int32                  `protobuf:"varint,6,opt,name=reapply_count,proto3" json:"reapply_count,omitempty"`
+	AppliedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=applied_at,proto3" json:"applied_at,omitempty"`
+	ReconciledAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reconciled_at,proto3" json:"reconciled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceConfig) Reset() {
+	*x = DeviceConfig{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceConfig) ProtoMessage() {}
+
+func (x *DeviceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceConfig.ProtoReflect.Descriptor instead.
+func (*DeviceConfig) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeviceConfig) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceConfig) GetDesired() *DesiredConfig {
+	if x != nil {
+		return x.Desired
+	}
+	return nil
+}
+
+func (x *DeviceConfig) GetStatus() ConfigStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ConfigStatus_CONFIG_STATUS_UNSPECIFIED
+}
+
+func (x *DeviceConfig) GetDrift() []string {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *DeviceConfig) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeviceConfig) GetReapplyCount() int32 {
+	if x != nil {
+		return x.ReapplyCount
+	}
+	return 0
+}
+
+func (x *DeviceConfig) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+func (x *DeviceConfig) GetReconciledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReconciledAt
+	}
+	return nil
+}
+
+func (x *DeviceConfig) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeviceConfig) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetDeviceConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Config        *DesiredConfig         `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeviceConfigRequest) Reset() {
+	*x = SetDeviceConfigRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeviceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeviceConfigRequest) ProtoMessage() {}
+
+func (x *SetDeviceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeviceConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetDeviceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetDeviceConfigRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SetDeviceConfigRequest) GetConfig() *DesiredConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetDeviceConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceConfigRequest) Reset() {
+	*x = GetDeviceConfigRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceConfigRequest) ProtoMessage() {}
+
+func (x *GetDeviceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetDeviceConfigRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type DeleteDeviceConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeviceConfigRequest) Reset() {
+	*x = DeleteDeviceConfigRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeviceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeviceConfigRequest) ProtoMessage() {}
+
+func (x *DeleteDeviceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeviceConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteDeviceConfigRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type DeviceConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *DeviceConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceConfigResponse) Reset() {
+	*x = DeviceConfigResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceConfigResponse) ProtoMessage() {}
+
+func (x *DeviceConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeviceConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeviceConfigResponse) GetConfig() *DeviceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosticsRequest) Reset() {
+	*x = DiagnosticsRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsRequest) ProtoMessage() {}
+
+func (x *DiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*DiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DiagnosticsRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type DiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Diagnostics   *Diagnostics           `protobuf:"bytes,2,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosticsResponse) Reset() {
+	*x = DiagnosticsResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsResponse) ProtoMessage() {}
+
+func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DiagnosticsResponse) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetDiagnostics() *Diagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiagnosticsRequest) Reset() {
+	*x = ListDiagnosticsRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiagnosticsRequest) ProtoMessage() {}
+
+func (x *ListDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListDiagnosticsRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ListDiagnosticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListDiagnosticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListDiagnosticsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diagnostics   []*Diagnostics         `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiagnosticsResponse) Reset() {
+	*x = ListDiagnosticsResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiagnosticsResponse) ProtoMessage() {}
+
+func (x *ListDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListDiagnosticsResponse) GetDiagnostics() []*Diagnostics {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type ExportDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceIds     []string               `protobuf:"bytes,1,rep,name=device_ids,proto3" json:"device_ids,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=monitor.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDiagnosticsRequest) Reset() {
+	*x = ExportDiagnosticsRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiagnosticsRequest) ProtoMessage() {}
+
+func (x *ExportDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*ExportDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportDiagnosticsRequest) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *ExportDiagnosticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportDiagnosticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportDiagnosticsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type ExportDiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDiagnosticsResponse) Reset() {
+	*x = ExportDiagnosticsResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiagnosticsResponse) ProtoMessage() {}
+
+func (x *ExportDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*ExportDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExportDiagnosticsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AvailabilityReportRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	From               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                 *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	DeviceIds          []string               `protobuf:"bytes,3,rep,name=device_ids,proto3" json:"device_ids,omitempty"`
+	GroupBy            string                 `protobuf:"bytes,4,opt,name=group_by,proto3" json:"group_by,omitempty"`
+	ExcludeMaintenance bool                   `protobuf:"varint,5,opt,name=exclude_maintenance,proto3" json:"exclude_maintenance,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AvailabilityReportRequest) Reset() {
+	*x = AvailabilityReportRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityReportRequest) ProtoMessage() {}
+
+func (x *AvailabilityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityReportRequest.ProtoReflect.Descriptor instead.
+func (*AvailabilityReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AvailabilityReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AvailabilityReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AvailabilityReportRequest) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *AvailabilityReportRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *AvailabilityReportRequest) GetExcludeMaintenance() bool {
+	if x != nil {
+		return x.ExcludeMaintenance
+	}
+	return false
+}
+
+type StatusTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        DeviceStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=monitor.v1.DeviceStatus" json:"status,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Percent       float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusTime) Reset() {
+	*x = StatusTime{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTime) ProtoMessage() {}
+
+func (x *StatusTime) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTime.ProtoReflect.Descriptor instead.
+func (*StatusTime) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StatusTime) GetStatus() DeviceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+func (x *StatusTime) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *StatusTime) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type Availability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *durationpb.Duration   `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Availability  float64                `protobuf:"fixed64,2,opt,name=availability,proto3" json:"availability,omitempty"`
+	Statuses      []*StatusTime          `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Failures      int32                  `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	Mttr          *durationpb.Duration   `protobuf:"bytes,5,opt,name=mttr,proto3" json:"mttr,omitempty"`
+	Mtbf          *durationpb.Duration   `protobuf:"bytes,6,opt,name=mtbf,proto3" json:"mtbf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Availability) Reset() {
+	*x = Availability{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Availability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Availability) ProtoMessage() {}
+
+func (x *Availability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Availability.ProtoReflect.Descriptor instead.
+func (*Availability) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Availability) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *Availability) GetAvailability() float64 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *Availability) GetStatuses() []*StatusTime {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *Availability) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Availability) GetMttr() *durationpb.Duration {
+	if x != nil {
+		return x.Mttr
+	}
+	return nil
+}
+
+func (x *Availability) GetMtbf() *durationpb.Duration {
+	if x != nil {
+		return x.Mtbf
+	}
+	return nil
+}
+
+type DeviceAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Availability  *Availability          `protobuf:"bytes,3,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceAvailability) Reset() {
+	*x = DeviceAvailability{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceAvailability) ProtoMessage() {}
+
+func (x *DeviceAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceAvailability.ProtoReflect.Descriptor instead.
+func (*DeviceAvailability) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeviceAvailability) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceAvailability) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DeviceAvailability) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+type GroupAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	DeviceIds     []string               `protobuf:"bytes,2,rep,name=device_ids,proto3" json:"device_ids,omitempty"`
+	Availability  *Availability          `protobuf:"bytes,3,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAvailability) Reset() {
+	*x = GroupAvailability{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAvailability) ProtoMessage() {}
+
+func (x *GroupAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAvailability.ProtoReflect.Descriptor instead.
+func (*GroupAvailability) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GroupAvailability) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GroupAvailability) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *GroupAvailability) GetAvailability() *Availability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+type AvailabilityReportResponse struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DeviceId           string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
-	Alias              string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
-	Host               string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
-	Port               int64                  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
-	PortGateway        int64                  `protobuf:"varint,6,opt,name=port_gateway,proto3" json:"port_gateway,omitempty"`
-	Architecture       string                 `protobuf:"bytes,7,opt,name=architecture,proto3" json:"architecture,omitempty"`
-	Os                 string                 `protobuf:"bytes,8,opt,name=os,proto3" json:"os,omitempty"`
-	SupportedProtocols []Protocol             `protobuf:"varint,9,rep,packed,name=supported_protocols,proto3,enum=monitor.v1.Protocol" json:"supported_protocols,omitempty"`
-	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	From               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                 *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupBy            string                 `protobuf:"bytes,3,opt,name=group_by,proto3" json:"group_by,omitempty"`
+	ExcludeMaintenance bool                   `protobuf:"varint,4,opt,name=exclude_maintenance,proto3" json:"exclude_maintenance,omitempty"`
+	Fleet              *Availability          `protobuf:"bytes,5,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	Devices            []*DeviceAvailability  `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices,omitempty"`
+	Groups             []*GroupAvailability   `protobuf:"bytes,7,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Device) Reset() {
-	*x = Device{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[0]
+func (x *AvailabilityReportResponse) Reset() {
+	*x = AvailabilityReportResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityReportResponse) ProtoMessage() {}
+
+func (x *AvailabilityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityReportResponse.ProtoReflect.Descriptor instead.
+func (*AvailabilityReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AvailabilityReportResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AvailabilityReportResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AvailabilityReportResponse) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *AvailabilityReportResponse) GetExcludeMaintenance() bool {
+	if x != nil {
+		return x.ExcludeMaintenance
+	}
+	return false
+}
+
+func (x *AvailabilityReportResponse) GetFleet() *Availability {
+	if x != nil {
+		return x.Fleet
+	}
+	return nil
+}
+
+func (x *AvailabilityReportResponse) GetDevices() []*DeviceAvailability {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *AvailabilityReportResponse) GetGroups() []*GroupAvailability {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type DeviceSelector struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DeviceIds       []string               `protobuf:"bytes,1,rep,name=device_ids,proto3" json:"device_ids,omitempty"`
+	HardwareVersion string                 `protobuf:"bytes,2,opt,name=hardware_version,proto3" json:"hardware_version,omitempty"`
+	FirmwareVersion string                 `protobuf:"bytes,3,opt,name=firmware_version,proto3" json:"firmware_version,omitempty"`
+	Architecture    string                 `protobuf:"bytes,4,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Os              string                 `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeviceSelector) Reset() {
+	*x = DeviceSelector{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSelector) ProtoMessage() {}
+
+func (x *DeviceSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSelector.ProtoReflect.Descriptor instead.
+func (*DeviceSelector) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeviceSelector) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *DeviceSelector) GetHardwareVersion() string {
+	if x != nil {
+		return x.HardwareVersion
+	}
+	return ""
+}
+
+func (x *DeviceSelector) GetFirmwareVersion() string {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return ""
+}
+
+func (x *DeviceSelector) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *DeviceSelector) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+type Campaign struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetVersion  string                 `protobuf:"bytes,2,opt,name=target_version,proto3" json:"target_version,omitempty"`
+	Selector       *DeviceSelector        `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	WaveSize       int32                  `protobuf:"varint,4,opt,name=wave_size,proto3" json:"wave_size,omitempty"`
+	MaxUnavailable int32                  `protobuf:"varint,5,opt,name=max_unavailable,proto3" json:"max_unavailable,omitempty"`
+	WaveTimeout    *durationpb.Duration   `protobuf:"bytes,6,opt,name=wave_timeout,proto3" json:"wave_timeout,omitempty"`
+	FailurePolicy  FailurePolicy          `protobuf:"varint,7,opt,name=failure_policy,proto3,enum=monitor.v1.FailurePolicy" json:"failure_policy,omitempty"`
+	Status         CampaignStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=monitor.v1.CampaignStatus" json:"status,omitempty"`
+	CurrentWave    int32                  `protobuf:"varint,9,opt,name=current_wave,proto3" json:"current_wave,omitempty"`
+	TotalWaves     int32                  `protobuf:"varint,10,opt,name=total_waves,proto3" json:"total_waves,omitempty"`
+	Error          string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	Devices        []*CampaignDevice      `protobuf:"bytes,12,rep,name=devices,proto3" json:"devices,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=completed_at,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Device) String() string {
+func (x *Campaign) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Device) ProtoMessage() {}
+func (*Campaign) ProtoMessage() {}
 
-func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[0]
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -183,116 +5993,143 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Device.ProtoReflect.Descriptor instead.
-func (*Device) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Device) GetId() string {
+func (x *Campaign) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Device) GetDeviceId() string {
+func (x *Campaign) GetTargetVersion() string {
 	if x != nil {
-		return x.DeviceId
+		return x.TargetVersion
 	}
 	return ""
 }
 
-func (x *Device) GetAlias() string {
+func (x *Campaign) GetSelector() *DeviceSelector {
 	if x != nil {
-		return x.Alias
+		return x.Selector
 	}
-	return ""
+	return nil
 }
 
-func (x *Device) GetHost() string {
+func (x *Campaign) GetWaveSize() int32 {
 	if x != nil {
-		return x.Host
+		return x.WaveSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Device) GetPort() int64 {
+func (x *Campaign) GetMaxUnavailable() int32 {
 	if x != nil {
-		return x.Port
+		return x.MaxUnavailable
 	}
 	return 0
 }
 
-func (x *Device) GetPortGateway() int64 {
+func (x *Campaign) GetWaveTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.PortGateway
+		return x.WaveTimeout
+	}
+	return nil
+}
+
+func (x *Campaign) GetFailurePolicy() FailurePolicy {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return FailurePolicy_FAILURE_POLICY_UNSPECIFIED
+}
+
+func (x *Campaign) GetStatus() CampaignStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED
+}
+
+func (x *Campaign) GetCurrentWave() int32 {
+	if x != nil {
+		return x.CurrentWave
 	}
 	return 0
 }
 
-func (x *Device) GetArchitecture() string {
+func (x *Campaign) GetTotalWaves() int32 {
 	if x != nil {
-		return x.Architecture
+		return x.TotalWaves
 	}
-	return ""
+	return 0
 }
 
-func (x *Device) GetOs() string {
+func (x *Campaign) GetError() string {
 	if x != nil {
-		return x.Os
+		return x.Error
 	}
 	return ""
 }
 
-func (x *Device) GetSupportedProtocols() []Protocol {
+func (x *Campaign) GetDevices() []*CampaignDevice {
 	if x != nil {
-		return x.SupportedProtocols
+		return x.Devices
 	}
 	return nil
 }
 
-func (x *Device) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Campaign) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Device) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Campaign) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-type Diagnostics struct {
+func (x *Campaign) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type CampaignDevice struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	HardwareVersion string                 `protobuf:"bytes,1,opt,name=hardware_version,proto3" json:"hardware_version,omitempty"`
-	SoftwareVersion string                 `protobuf:"bytes,2,opt,name=software_version,proto3" json:"software_version,omitempty"`
-	FirmwareVersion string                 `protobuf:"bytes,3,opt,name=firmware_version,proto3" json:"firmware_version,omitempty"`
-	CpuUsage        float64                `protobuf:"fixed64,4,opt,name=cpu_usage,proto3" json:"cpu_usage,omitempty"`
-	MemoryUsage     float64                `protobuf:"fixed64,5,opt,name=memory_usage,proto3" json:"memory_usage,omitempty"`
-	DeviceStatus    DeviceStatus           `protobuf:"varint,6,opt,name=device_status,proto3,enum=monitor.v1.DeviceStatus" json:"device_status,omitempty"`
-	Checksum        string                 `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	DeviceId        string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Wave            int32                  `protobuf:"varint,2,opt,name=wave,proto3" json:"wave,omitempty"`
+	Status          CampaignDeviceStatus   `protobuf:"varint,3,opt,name=status,proto3,enum=monitor.v1.CampaignDeviceStatus" json:"status,omitempty"`
+	PreviousVersion string                 `protobuf:"bytes,4,opt,name=previous_version,proto3" json:"previous_version,omitempty"`
+	Error           string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Diagnostics) Reset() {
-	*x = Diagnostics{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[1]
+func (x *CampaignDevice) Reset() {
+	*x = CampaignDevice{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Diagnostics) String() string {
+func (x *CampaignDevice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Diagnostics) ProtoMessage() {}
+func (*CampaignDevice) ProtoMessage() {}
 
-func (x *Diagnostics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[1]
+func (x *CampaignDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,87 +6140,80 @@ func (x *Diagnostics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Diagnostics.ProtoReflect.Descriptor instead.
-func (*Diagnostics) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *Diagnostics) GetHardwareVersion() string {
-	if x != nil {
-		return x.HardwareVersion
-	}
-	return ""
+// Deprecated: Use CampaignDevice.ProtoReflect.Descriptor instead.
+func (*CampaignDevice) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Diagnostics) GetSoftwareVersion() string {
+func (x *CampaignDevice) GetDeviceId() string {
 	if x != nil {
-		return x.SoftwareVersion
+		return x.DeviceId
 	}
 	return ""
 }
 
-func (x *Diagnostics) GetFirmwareVersion() string {
+func (x *CampaignDevice) GetWave() int32 {
 	if x != nil {
-		return x.FirmwareVersion
+		return x.Wave
 	}
-	return ""
+	return 0
 }
 
-func (x *Diagnostics) GetCpuUsage() float64 {
+func (x *CampaignDevice) GetStatus() CampaignDeviceStatus {
 	if x != nil {
-		return x.CpuUsage
+		return x.Status
 	}
-	return 0
+	return CampaignDeviceStatus_CAMPAIGN_DEVICE_STATUS_UNSPECIFIED
 }
 
-func (x *Diagnostics) GetMemoryUsage() float64 {
+func (x *CampaignDevice) GetPreviousVersion() string {
 	if x != nil {
-		return x.MemoryUsage
+		return x.PreviousVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *Diagnostics) GetDeviceStatus() DeviceStatus {
+func (x *CampaignDevice) GetError() string {
 	if x != nil {
-		return x.DeviceStatus
+		return x.Error
 	}
-	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *Diagnostics) GetChecksum() string {
+func (x *CampaignDevice) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Checksum
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type RegisterDeviceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
-	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int64                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
-	PortGateway   int64                  `protobuf:"varint,5,opt,name=port_gateway,proto3" json:"port_gateway,omitempty"`
-	Protocol      Protocol               `protobuf:"varint,6,opt,name=protocol,proto3,enum=monitor.v1.Protocol" json:"protocol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateCampaignRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TargetVersion  string                 `protobuf:"bytes,1,opt,name=target_version,proto3" json:"target_version,omitempty"`
+	Selector       *DeviceSelector        `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	WaveSize       int32                  `protobuf:"varint,3,opt,name=wave_size,proto3" json:"wave_size,omitempty"`
+	MaxUnavailable int32                  `protobuf:"varint,4,opt,name=max_unavailable,proto3" json:"max_unavailable,omitempty"`
+	WaveTimeout    *durationpb.Duration   `protobuf:"bytes,5,opt,name=wave_timeout,proto3" json:"wave_timeout,omitempty"`
+	FailurePolicy  FailurePolicy          `protobuf:"varint,6,opt,name=failure_policy,proto3,enum=monitor.v1.FailurePolicy" json:"failure_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RegisterDeviceRequest) Reset() {
-	*x = RegisterDeviceRequest{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[2]
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterDeviceRequest) String() string {
+func (x *CreateCampaignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterDeviceRequest) ProtoMessage() {}
+func (*CreateCampaignRequest) ProtoMessage() {}
 
-func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[2]
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,75 +6224,75 @@ func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
-func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *RegisterDeviceRequest) GetDeviceId() string {
+func (x *CreateCampaignRequest) GetTargetVersion() string {
 	if x != nil {
-		return x.DeviceId
+		return x.TargetVersion
 	}
 	return ""
 }
 
-func (x *RegisterDeviceRequest) GetAlias() string {
+func (x *CreateCampaignRequest) GetSelector() *DeviceSelector {
 	if x != nil {
-		return x.Alias
+		return x.Selector
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterDeviceRequest) GetHost() string {
+func (x *CreateCampaignRequest) GetWaveSize() int32 {
 	if x != nil {
-		return x.Host
+		return x.WaveSize
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterDeviceRequest) GetPort() int64 {
+func (x *CreateCampaignRequest) GetMaxUnavailable() int32 {
 	if x != nil {
-		return x.Port
+		return x.MaxUnavailable
 	}
 	return 0
 }
 
-func (x *RegisterDeviceRequest) GetPortGateway() int64 {
+func (x *CreateCampaignRequest) GetWaveTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.PortGateway
+		return x.WaveTimeout
 	}
-	return 0
+	return nil
 }
 
-func (x *RegisterDeviceRequest) GetProtocol() Protocol {
+func (x *CreateCampaignRequest) GetFailurePolicy() FailurePolicy {
 	if x != nil {
-		return x.Protocol
+		return x.FailurePolicy
 	}
-	return Protocol_PROTOCOL_UNSPECIFIED
+	return FailurePolicy_FAILURE_POLICY_UNSPECIFIED
 }
 
-type RegisterDeviceResponse struct {
+type CreateCampaignResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterDeviceResponse) Reset() {
-	*x = RegisterDeviceResponse{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[3]
+func (x *CreateCampaignResponse) Reset() {
+	*x = CreateCampaignResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterDeviceResponse) String() string {
+func (x *CreateCampaignResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterDeviceResponse) ProtoMessage() {}
+func (*CreateCampaignResponse) ProtoMessage() {}
 
-func (x *RegisterDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[3]
+func (x *CreateCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,40 +6303,40 @@ func (x *RegisterDeviceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterDeviceResponse.ProtoReflect.Descriptor instead.
-func (*RegisterDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *RegisterDeviceResponse) GetDevice() *Device {
+func (x *CreateCampaignResponse) GetCampaign() *Campaign {
 	if x != nil {
-		return x.Device
+		return x.Campaign
 	}
 	return nil
 }
 
-type ListDevicesResponse struct {
+type ListCampaignsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devices       []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	Campaigns     []*Campaign            `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDevicesResponse) Reset() {
-	*x = ListDevicesResponse{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[4]
+func (x *ListCampaignsResponse) Reset() {
+	*x = ListCampaignsResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDevicesResponse) String() string {
+func (x *ListCampaignsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDevicesResponse) ProtoMessage() {}
+func (*ListCampaignsResponse) ProtoMessage() {}
 
-func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[4]
+func (x *ListCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,41 +6347,40 @@ func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
-func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListDevicesResponse) GetDevices() []*Device {
+func (x *ListCampaignsResponse) GetCampaigns() []*Campaign {
 	if x != nil {
-		return x.Devices
+		return x.Campaigns
 	}
 	return nil
 }
 
-type UpdateDeviceRequest struct {
+type GetCampaignRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
-	DeviceStatus  DeviceStatus           `protobuf:"varint,2,opt,name=device_status,proto3,enum=monitor.v1.DeviceStatus" json:"device_status,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,proto3" json:"campaign_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDeviceRequest) Reset() {
-	*x = UpdateDeviceRequest{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[5]
+func (x *GetCampaignRequest) Reset() {
+	*x = GetCampaignRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDeviceRequest) String() string {
+func (x *GetCampaignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeviceRequest) ProtoMessage() {}
+func (*GetCampaignRequest) ProtoMessage() {}
 
-func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[5]
+func (x *GetCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,47 +6391,40 @@ func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeviceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *UpdateDeviceRequest) GetDeviceId() string {
+func (x *GetCampaignRequest) GetCampaignId() string {
 	if x != nil {
-		return x.DeviceId
+		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *UpdateDeviceRequest) GetDeviceStatus() DeviceStatus {
-	if x != nil {
-		return x.DeviceStatus
-	}
-	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
-}
-
-type DiagnosticsRequest struct {
+type GetCampaignResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiagnosticsRequest) Reset() {
-	*x = DiagnosticsRequest{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[6]
+func (x *GetCampaignResponse) Reset() {
+	*x = GetCampaignResponse{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiagnosticsRequest) String() string {
+func (x *GetCampaignResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiagnosticsRequest) ProtoMessage() {}
+func (*GetCampaignResponse) ProtoMessage() {}
 
-func (x *DiagnosticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[6]
+func (x *GetCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,42 +6435,40 @@ func (x *DiagnosticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiagnosticsRequest.ProtoReflect.Descriptor instead.
-func (*DiagnosticsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetCampaignResponse.ProtoReflect.Descriptor instead.
+func (*GetCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *DiagnosticsRequest) GetDeviceId() string {
+func (x *GetCampaignResponse) GetCampaign() *Campaign {
 	if x != nil {
-		return x.DeviceId
+		return x.Campaign
 	}
-	return ""
+	return nil
 }
 
-type DiagnosticsResponse struct {
+type CancelCampaignRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
-	Diagnostics   *Diagnostics           `protobuf:"bytes,2,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,proto3" json:"campaign_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiagnosticsResponse) Reset() {
-	*x = DiagnosticsResponse{}
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[7]
+func (x *CancelCampaignRequest) Reset() {
+	*x = CancelCampaignRequest{}
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiagnosticsResponse) String() string {
+func (x *CancelCampaignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiagnosticsResponse) ProtoMessage() {}
+func (*CancelCampaignRequest) ProtoMessage() {}
 
-func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[7]
+func (x *CancelCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_monitor_v1_monitor_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,30 +6479,16 @@ func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiagnosticsResponse.ProtoReflect.Descriptor instead.
-func (*DiagnosticsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *DiagnosticsResponse) GetDevice() *Device {
-	if x != nil {
-		return x.Device
-	}
-	return nil
-}
-
-func (x *DiagnosticsResponse) GetDiagnostics() *Diagnostics {
-	if x != nil {
-		return x.Diagnostics
-	}
-	return nil
+// Deprecated: Use CancelCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CancelCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_monitor_v1_monitor_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DiagnosticsResponse) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CancelCampaignRequest) GetCampaignId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
 var File_proto_monitor_v1_monitor_proto protoreflect.FileDescriptor
@@ -690,7 +6496,7 @@ var File_proto_monitor_v1_monitor_proto protoreflect.FileDescriptor
 const file_proto_monitor_v1_monitor_proto_rawDesc = "" +
 	"\n" +
 	"\x1eproto/monitor/v1/monitor.proto\x12\n" +
-	"monitor.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x8c\x03\n" +
+	"monitor.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf2\x04\n" +
 	"\x06Device\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tdevice_id\x18\x02 \x01(\tR\tdevice_id\x12\x14\n" +
@@ -707,7 +6513,11 @@ const file_proto_monitor_v1_monitor_proto_rawDesc = "" +
 	"created_at\x12:\n" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updated_at\"\xaf\x02\n" +
+	"updated_at\x12J\n" +
+	"\x11signing_algorithm\x18\f \x01(\x0e2\x1c.monitor.v1.SigningAlgorithmR\x11signing_algorithm\x12J\n" +
+	"\x11compliance_status\x18\r \x01(\x0e2\x1c.monitor.v1.ComplianceStatusR\x11compliance_status\x124\n" +
+	"\x15compliance_violations\x18\x0e \x03(\tR\x15compliance_violations\x12\x16\n" +
+	"\x06driver\x18\x0f \x01(\tR\x06driver\"\xcf\x06\n" +
 	"\vDiagnostics\x12*\n" +
 	"\x10hardware_version\x18\x01 \x01(\tR\x10hardware_version\x12*\n" +
 	"\x10software_version\x18\x02 \x01(\tR\x10software_version\x12*\n" +
@@ -715,21 +6525,298 @@ const file_proto_monitor_v1_monitor_proto_rawDesc = "" +
 	"\tcpu_usage\x18\x04 \x01(\x01R\tcpu_usage\x12\"\n" +
 	"\fmemory_usage\x18\x05 \x01(\x01R\fmemory_usage\x12>\n" +
 	"\rdevice_status\x18\x06 \x01(\x0e2\x18.monitor.v1.DeviceStatusR\rdevice_status\x12\x1a\n" +
-	"\bchecksum\x18\a \x01(\tR\bchecksum\"\xc9\x01\n" +
+	"\bchecksum\x18\a \x01(\tR\bchecksum\x12P\n" +
+	"\x13verification_status\x18\b \x01(\x0e2\x1e.monitor.v1.VerificationStatusR\x13verification_status\x12&\n" +
+	"\x0euptime_seconds\x18\t \x01(\x04R\x0euptime_seconds\x12(\n" +
+	"\x0fload_average_1m\x18\n" +
+	" \x01(\x01R\x0fload_average_1m\x12(\n" +
+	"\x0fload_average_5m\x18\v \x01(\x01R\x0fload_average_5m\x12*\n" +
+	"\x10load_average_15m\x18\f \x01(\x01R\x10load_average_15m\x120\n" +
+	"\x13temperature_celsius\x18\r \x01(\x01R\x13temperature_celsius\x12(\n" +
+	"\x0fdisk_used_bytes\x18\x0e \x01(\x04R\x0fdisk_used_bytes\x12*\n" +
+	"\x10disk_total_bytes\x18\x0f \x01(\x04R\x10disk_total_bytes\x12$\n" +
+	"\rprocess_count\x18\x10 \x01(\rR\rprocess_count\x12<\n" +
+	"\n" +
+	"interfaces\x18\x11 \x03(\v2\x1c.monitor.v1.NetworkInterfaceR\n" +
+	"interfaces\x128\n" +
+	"\ttimestamp\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xc9\x04\n" +
+	"\x10NetworkInterface\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\n" +
+	"link_state\x18\x02 \x01(\x0e2\x15.monitor.v1.LinkStateR\n" +
+	"link_state\x12\x1a\n" +
+	"\brx_bytes\x18\x03 \x01(\x04R\brx_bytes\x12\x1a\n" +
+	"\btx_bytes\x18\x04 \x01(\x04R\btx_bytes\x12\x1e\n" +
+	"\n" +
+	"rx_packets\x18\x05 \x01(\x04R\n" +
+	"rx_packets\x12\x1e\n" +
+	"\n" +
+	"tx_packets\x18\x06 \x01(\x04R\n" +
+	"tx_packets\x12\x1c\n" +
+	"\trx_errors\x18\a \x01(\x04R\trx_errors\x12\x1c\n" +
+	"\ttx_errors\x18\b \x01(\x04R\ttx_errors\x120\n" +
+	"\x13rx_bytes_per_second\x18\t \x01(\x01R\x13rx_bytes_per_second\x120\n" +
+	"\x13tx_bytes_per_second\x18\n" +
+	" \x01(\x01R\x13tx_bytes_per_second\x124\n" +
+	"\x15rx_packets_per_second\x18\v \x01(\x01R\x15rx_packets_per_second\x124\n" +
+	"\x15tx_packets_per_second\x18\f \x01(\x01R\x15tx_packets_per_second\x122\n" +
+	"\x14rx_errors_per_second\x18\r \x01(\x01R\x14rx_errors_per_second\x122\n" +
+	"\x14tx_errors_per_second\x18\x0e \x01(\x01R\x14tx_errors_per_second\"\x80\x03\n" +
 	"\x15RegisterDeviceRequest\x12\x1c\n" +
 	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12\x14\n" +
 	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x12\n" +
 	"\x04host\x18\x03 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x04 \x01(\x03R\x04port\x12\"\n" +
 	"\fport_gateway\x18\x05 \x01(\x03R\fport_gateway\x120\n" +
-	"\bprotocol\x18\x06 \x01(\x0e2\x14.monitor.v1.ProtocolR\bprotocol\"D\n" +
+	"\bprotocol\x18\x06 \x01(\x0e2\x14.monitor.v1.ProtocolR\bprotocol\x12J\n" +
+	"\x11signing_algorithm\x18\a \x01(\x0e2\x1c.monitor.v1.SigningAlgorithmR\x11signing_algorithm\x12 \n" +
+	"\vsigning_key\x18\b \x01(\tR\vsigning_key\x12/\n" +
+	"\x04snmp\x18\t \x01(\v2\x1b.monitor.v1.SnmpCredentialsR\x04snmp\x12\x16\n" +
+	"\x06driver\x18\n" +
+	" \x01(\tR\x06driver\"\xda\x02\n" +
+	"\x0fSnmpCredentials\x121\n" +
+	"\aversion\x18\x01 \x01(\x0e2\x17.monitor.v1.SnmpVersionR\aversion\x12\x1c\n" +
+	"\tcommunity\x18\x02 \x01(\tR\tcommunity\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12B\n" +
+	"\rauth_protocol\x18\x04 \x01(\x0e2\x1c.monitor.v1.SnmpAuthProtocolR\rauth_protocol\x12(\n" +
+	"\x0fauth_passphrase\x18\x05 \x01(\tR\x0fauth_passphrase\x12B\n" +
+	"\rpriv_protocol\x18\x06 \x01(\x0e2\x1c.monitor.v1.SnmpPrivProtocolR\rpriv_protocol\x12(\n" +
+	"\x0fpriv_passphrase\x18\a \x01(\tR\x0fpriv_passphrase\"D\n" +
 	"\x16RegisterDeviceResponse\x12*\n" +
 	"\x06device\x18\x01 \x01(\v2\x12.monitor.v1.DeviceR\x06device\"C\n" +
 	"\x13ListDevicesResponse\x12,\n" +
 	"\adevices\x18\x01 \x03(\v2\x12.monitor.v1.DeviceR\adevices\"s\n" +
 	"\x13UpdateDeviceRequest\x12\x1c\n" +
 	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12>\n" +
-	"\rdevice_status\x18\x02 \x01(\x0e2\x18.monitor.v1.DeviceStatusR\rdevice_status\"2\n" +
+	"\rdevice_status\x18\x02 \x01(\x0e2\x18.monitor.v1.DeviceStatusR\rdevice_status\"3\n" +
+	"\x13DeleteDeviceRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\"u\n" +
+	"\x14ImportDevicesRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x123\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1b.monitor.v1.InventoryFormatR\x06format\x12\x14\n" +
+	"\x05prune\x18\x03 \x01(\bR\x05prune\"\x88\x01\n" +
+	"\fImportResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1c\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\tdevice_id\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.monitor.v1.ImportStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"c\n" +
+	"\x15ImportDevicesResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.monitor.v1.ImportResultR\aresults\x12\x16\n" +
+	"\x06pruned\x18\x02 \x01(\bR\x06pruned\"\x9f\x01\n" +
+	"\x13RebootDeviceRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x88\x01\n" +
+	"\x14RebootDeviceResponse\x125\n" +
+	"\bdowntime\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bdowntime\x129\n" +
+	"\vdiagnostics\x18\x02 \x01(\v2\x17.monitor.v1.DiagnosticsR\vdiagnostics\"\xd4\x02\n" +
+	"\x10StatusTransition\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12:\n" +
+	"\vfrom_status\x18\x02 \x01(\x0e2\x18.monitor.v1.DeviceStatusR\vfrom_status\x126\n" +
+	"\tto_status\x18\x03 \x01(\x0e2\x18.monitor.v1.DeviceStatusR\tto_status\x121\n" +
+	"\x05cause\x18\x04 \x01(\x0e2\x1b.monitor.v1.TransitionCauseR\x05cause\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12D\n" +
+	"\x0ftransitioned_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0ftransitioned_at\"\xae\x01\n" +
+	"\x1cListStatusTransitionsRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"_\n" +
+	"\x1dListStatusTransitionsResponse\x12>\n" +
+	"\vtransitions\x18\x01 \x03(\v2\x1c.monitor.v1.StatusTransitionR\vtransitions\"\xb4\x02\n" +
+	"\aAnomaly\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\tdevice_id\x12*\n" +
+	"\x06metric\x18\x03 \x01(\x0e2\x12.monitor.v1.MetricR\x06metric\x12+\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x17.monitor.v1.AnomalyKindR\x04kind\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bbaseline\x18\x06 \x01(\x01R\bbaseline\x12\x1c\n" +
+	"\tdeviation\x18\a \x01(\x01R\tdeviation\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05score\x12<\n" +
+	"\vdetected_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vdetected_at\"\xf1\x02\n" +
+	"\vDeviceEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\tdevice_id\x12)\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x15.monitor.v1.EventTypeR\x04type\x125\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x19.monitor.v1.EventSeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12>\n" +
+	"\adetails\x18\x06 \x03(\v2$.monitor.v1.DeviceEvent.DetailsEntryR\adetails\x12<\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\voccurred_at\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8d\x02\n" +
+	"\x17ListDeviceEventsRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12+\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x15.monitor.v1.EventTypeR\x05types\x125\n" +
+	"\bseverity\x18\x03 \x01(\x0e2\x19.monitor.v1.EventSeverityR\bseverity\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"K\n" +
+	"\x18ListDeviceEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.monitor.v1.DeviceEventR\x06events\"\x9d\x01\n" +
+	"\x19StreamDeviceEventsRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12+\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x15.monitor.v1.EventTypeR\x05types\x125\n" +
+	"\bseverity\x18\x03 \x01(\x0e2\x19.monitor.v1.EventSeverityR\bseverity\"\xa6\x01\n" +
+	"\x14ListAnomaliesRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"J\n" +
+	"\x15ListAnomaliesResponse\x121\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x13.monitor.v1.AnomalyR\tanomalies\"6\n" +
+	"\x16StreamAnomaliesRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\"\xc4\x01\n" +
+	"\x0fAnomalySettings\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x129\n" +
+	"\vsensitivity\x18\x02 \x01(\x0e2\x17.monitor.v1.SensitivityR\vsensitivity\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x01R\tthreshold\x12:\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"w\n" +
+	"\x1cSetAnomalySensitivityRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x129\n" +
+	"\vsensitivity\x18\x02 \x01(\x0e2\x17.monitor.v1.SensitivityR\vsensitivity\"9\n" +
+	"\x19GetAnomalySettingsRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\"R\n" +
+	"\x17AnomalySettingsResponse\x127\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.monitor.v1.AnomalySettingsR\bsettings\"\xa4\x01\n" +
+	"\x17VersionInventoryRequest\x127\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x1b.monitor.v1.VersionGroupingR\bgroup_by\x12:\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x1c.monitor.v1.VersionComponentR\tcomponent\x12\x14\n" +
+	"\x05below\x18\x03 \x01(\tR\x05below\"\x9e\x01\n" +
+	"\fVersionCount\x12:\n" +
+	"\tcomponent\x18\x01 \x01(\x0e2\x1c.monitor.v1.VersionComponentR\tcomponent\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x18\n" +
+	"\adevices\x18\x03 \x01(\x05R\adevices\x12\x1e\n" +
+	"\n" +
+	"device_ids\x18\x04 \x03(\tR\n" +
+	"device_ids\"t\n" +
+	"\fVersionGroup\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
+	"\adevices\x18\x02 \x01(\x05R\adevices\x124\n" +
+	"\bversions\x18\x03 \x03(\v2\x18.monitor.v1.VersionCountR\bversions\"\xa2\x02\n" +
+	"\x0eDeviceVersions\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12\"\n" +
+	"\farchitecture\x18\x02 \x01(\tR\farchitecture\x12*\n" +
+	"\x10hardware_version\x18\x03 \x01(\tR\x10hardware_version\x12*\n" +
+	"\x10software_version\x18\x04 \x01(\tR\x10software_version\x12*\n" +
+	"\x10firmware_version\x18\x05 \x01(\tR\x10firmware_version\x12J\n" +
+	"\x11compliance_status\x18\x06 \x01(\x0e2\x1c.monitor.v1.ComplianceStatusR\x11compliance_status\"\xbb\x01\n" +
+	"\x18VersionInventoryResponse\x127\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x1b.monitor.v1.VersionGroupingR\bgroup_by\x120\n" +
+	"\x06groups\x18\x02 \x03(\v2\x18.monitor.v1.VersionGroupR\x06groups\x124\n" +
+	"\adevices\x18\x03 \x03(\v2\x1a.monitor.v1.DeviceVersionsR\adevices\"\xa3\x03\n" +
+	"\x10CompliancePolicy\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12@\n" +
+	"\x06labels\x18\x02 \x03(\v2(.monitor.v1.CompliancePolicy.LabelsEntryR\x06labels\x12:\n" +
+	"\tcomponent\x18\x03 \x01(\x0e2\x1c.monitor.v1.VersionComponentR\tcomponent\x12 \n" +
+	"\vmin_version\x18\x04 \x01(\tR\vmin_version\x12\x14\n" +
+	"\x05allow\x18\x05 \x03(\tR\x05allow\x12\x12\n" +
+	"\x04deny\x18\x06 \x03(\tR\x04deny\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
+	"\x1aSetCompliancePolicyRequest\x124\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1c.monitor.v1.CompliancePolicyR\x06policy\"P\n" +
+	"\x18CompliancePolicyResponse\x124\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1c.monitor.v1.CompliancePolicyR\x06policy\"Z\n" +
+	"\x1eListCompliancePoliciesResponse\x128\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x1c.monitor.v1.CompliancePolicyR\bpolicies\"3\n" +
+	"\x1dDeleteCompliancePolicyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x8b\x02\n" +
+	"\rVersionChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\tdevice_id\x12:\n" +
+	"\tcomponent\x18\x03 \x01(\x0e2\x1c.monitor.v1.VersionComponentR\tcomponent\x12*\n" +
+	"\x10previous_version\x18\x04 \x01(\tR\x10previous_version\x12(\n" +
+	"\x0fcurrent_version\x18\x05 \x01(\tR\x0fcurrent_version\x12:\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changed_at\"\xe7\x01\n" +
+	"\x19ListVersionChangesRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12:\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x1c.monitor.v1.VersionComponentR\tcomponent\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"Q\n" +
+	"\x1aListVersionChangesResponse\x123\n" +
+	"\achanges\x18\x01 \x03(\v2\x19.monitor.v1.VersionChangeR\achanges\"w\n" +
+	"\x1bStreamVersionChangesRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12:\n" +
+	"\tcomponent\x18\x02 \x01(\x0e2\x1c.monitor.v1.VersionComponentR\tcomponent\"\xa9\x02\n" +
+	"\x12GetForecastRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12*\n" +
+	"\x06metric\x18\x02 \x01(\x0e2\x12.monitor.v1.MetricR\x06metric\x12/\n" +
+	"\x05model\x18\x03 \x01(\x0e2\x19.monitor.v1.ForecastModelR\x05model\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x123\n" +
+	"\ahistory\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\ahistory\x123\n" +
+	"\ahorizon\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\ahorizon\x12\x10\n" +
+	"\x03top\x18\a \x01(\x05R\x03top\"\x8b\x01\n" +
+	"\rForecastPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x14\n" +
+	"\x05lower\x18\x03 \x01(\x01R\x05lower\x12\x14\n" +
+	"\x05upper\x18\x04 \x01(\x01R\x05upper\"\xb9\x03\n" +
+	"\bForecast\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12*\n" +
+	"\x06metric\x18\x02 \x01(\x0e2\x12.monitor.v1.MetricR\x06metric\x12/\n" +
+	"\x05model\x18\x03 \x01(\x0e2\x19.monitor.v1.ForecastModelR\x05model\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\x01R\acurrent\x12$\n" +
+	"\rtrend_per_day\x18\x05 \x01(\x01R\rtrend_per_day\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x12>\n" +
+	"\fthreshold_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fthreshold_at\x12G\n" +
+	"\x11time_to_threshold\x18\b \x01(\v2\x19.google.protobuf.DurationR\x11time_to_threshold\x12\x18\n" +
+	"\asamples\x18\t \x01(\x05R\asamples\x121\n" +
+	"\x06points\x18\n" +
+	" \x03(\v2\x19.monitor.v1.ForecastPointR\x06points\"I\n" +
+	"\x13GetForecastResponse\x122\n" +
+	"\tforecasts\x18\x01 \x03(\v2\x14.monitor.v1.ForecastR\tforecasts\"\xc5\x03\n" +
+	"\rDesiredConfig\x12>\n" +
+	"\rdevice_status\x18\x01 \x01(\x0e2\x18.monitor.v1.DeviceStatusR\rdevice_status\x12C\n" +
+	"\x0fstream_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fstream_interval\x12=\n" +
+	"\x06labels\x18\x03 \x03(\v2%.monitor.v1.DesiredConfig.LabelsEntryR\x06labels\x12=\n" +
+	"\x06config\x18\x04 \x03(\v2%.monitor.v1.DesiredConfig.ConfigEntryR\x06config\x12;\n" +
+	"\fdrift_policy\x18\x05 \x01(\x0e2\x17.monitor.v1.DriftPolicyR\fdrift_policy\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdb\x03\n" +
+	"\fDeviceConfig\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x123\n" +
+	"\adesired\x18\x02 \x01(\v2\x19.monitor.v1.DesiredConfigR\adesired\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.monitor.v1.ConfigStatusR\x06status\x12\x14\n" +
+	"\x05drift\x18\x04 \x03(\tR\x05drift\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12$\n" +
+	"\rreapply_count\x18\x06 \x01(\x05R\rreapply_count\x12:\n" +
+	"\n" +
+	"applied_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"applied_at\x12@\n" +
+	"\rreconciled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rreconciled_at\x12:\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"i\n" +
+	"\x16SetDeviceConfigRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x121\n" +
+	"\x06config\x18\x02 \x01(\v2\x19.monitor.v1.DesiredConfigR\x06config\"6\n" +
+	"\x16GetDeviceConfigRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\"9\n" +
+	"\x19DeleteDeviceConfigRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\"H\n" +
+	"\x14DeviceConfigResponse\x120\n" +
+	"\x06config\x18\x01 \x01(\v2\x18.monitor.v1.DeviceConfigR\x06config\"2\n" +
 	"\x12DiagnosticsRequest\x12\x1c\n" +
 	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\"\xb8\x01\n" +
 	"\x13DiagnosticsResponse\x12*\n" +
@@ -737,13 +6824,128 @@ const file_proto_monitor_v1_monitor_proto_rawDesc = "" +
 	"\vdiagnostics\x18\x02 \x01(\v2\x17.monitor.v1.DiagnosticsR\vdiagnostics\x12:\n" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updated_at*~\n" +
+	"updated_at\"\xa8\x01\n" +
+	"\x16ListDiagnosticsRequest\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"T\n" +
+	"\x17ListDiagnosticsResponse\x129\n" +
+	"\vdiagnostics\x18\x01 \x03(\v2\x17.monitor.v1.DiagnosticsR\vdiagnostics\"\xc8\x01\n" +
+	"\x18ExportDiagnosticsRequest\x12\x1e\n" +
+	"\n" +
+	"device_ids\x18\x01 \x03(\tR\n" +
+	"device_ids\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x120\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x18.monitor.v1.ExportFormatR\x06format\"/\n" +
+	"\x19ExportDiagnosticsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xe5\x01\n" +
+	"\x19AvailabilityReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1e\n" +
+	"\n" +
+	"device_ids\x18\x03 \x03(\tR\n" +
+	"device_ids\x12\x1a\n" +
+	"\bgroup_by\x18\x04 \x01(\tR\bgroup_by\x120\n" +
+	"\x13exclude_maintenance\x18\x05 \x01(\bR\x13exclude_maintenance\"\x8f\x01\n" +
+	"\n" +
+	"StatusTime\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.monitor.v1.DeviceStatusR\x06status\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\"\x93\x02\n" +
+	"\fAvailability\x121\n" +
+	"\x06period\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06period\x12\"\n" +
+	"\favailability\x18\x02 \x01(\x01R\favailability\x122\n" +
+	"\bstatuses\x18\x03 \x03(\v2\x16.monitor.v1.StatusTimeR\bstatuses\x12\x1a\n" +
+	"\bfailures\x18\x04 \x01(\x05R\bfailures\x12-\n" +
+	"\x04mttr\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x04mttr\x12-\n" +
+	"\x04mtbf\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x04mtbf\"\xef\x01\n" +
+	"\x12DeviceAvailability\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12B\n" +
+	"\x06labels\x18\x02 \x03(\v2*.monitor.v1.DeviceAvailability.LabelsEntryR\x06labels\x12<\n" +
+	"\favailability\x18\x03 \x01(\v2\x18.monitor.v1.AvailabilityR\favailability\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x01\n" +
+	"\x11GroupAvailability\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1e\n" +
+	"\n" +
+	"device_ids\x18\x02 \x03(\tR\n" +
+	"device_ids\x12<\n" +
+	"\favailability\x18\x03 \x01(\v2\x18.monitor.v1.AvailabilityR\favailability\"\xe7\x02\n" +
+	"\x1aAvailabilityReportResponse\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
+	"\bgroup_by\x18\x03 \x01(\tR\bgroup_by\x120\n" +
+	"\x13exclude_maintenance\x18\x04 \x01(\bR\x13exclude_maintenance\x12.\n" +
+	"\x05fleet\x18\x05 \x01(\v2\x18.monitor.v1.AvailabilityR\x05fleet\x128\n" +
+	"\adevices\x18\x06 \x03(\v2\x1e.monitor.v1.DeviceAvailabilityR\adevices\x125\n" +
+	"\x06groups\x18\a \x03(\v2\x1d.monitor.v1.GroupAvailabilityR\x06groups\"\xbc\x01\n" +
+	"\x0eDeviceSelector\x12\x1e\n" +
+	"\n" +
+	"device_ids\x18\x01 \x03(\tR\n" +
+	"device_ids\x12*\n" +
+	"\x10hardware_version\x18\x02 \x01(\tR\x10hardware_version\x12*\n" +
+	"\x10firmware_version\x18\x03 \x01(\tR\x10firmware_version\x12\"\n" +
+	"\farchitecture\x18\x04 \x01(\tR\farchitecture\x12\x0e\n" +
+	"\x02os\x18\x05 \x01(\tR\x02os\"\xc2\x05\n" +
+	"\bCampaign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0etarget_version\x18\x02 \x01(\tR\x0etarget_version\x126\n" +
+	"\bselector\x18\x03 \x01(\v2\x1a.monitor.v1.DeviceSelectorR\bselector\x12\x1c\n" +
+	"\twave_size\x18\x04 \x01(\x05R\twave_size\x12(\n" +
+	"\x0fmax_unavailable\x18\x05 \x01(\x05R\x0fmax_unavailable\x12=\n" +
+	"\fwave_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fwave_timeout\x12A\n" +
+	"\x0efailure_policy\x18\a \x01(\x0e2\x19.monitor.v1.FailurePolicyR\x0efailure_policy\x122\n" +
+	"\x06status\x18\b \x01(\x0e2\x1a.monitor.v1.CampaignStatusR\x06status\x12\"\n" +
+	"\fcurrent_wave\x18\t \x01(\x05R\fcurrent_wave\x12 \n" +
+	"\vtotal_waves\x18\n" +
+	" \x01(\x05R\vtotal_waves\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x124\n" +
+	"\adevices\x18\f \x03(\v2\x1a.monitor.v1.CampaignDeviceR\adevices\x12:\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12>\n" +
+	"\fcompleted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\fcompleted_at\"\xfa\x01\n" +
+	"\x0eCampaignDevice\x12\x1c\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\tdevice_id\x12\x12\n" +
+	"\x04wave\x18\x02 \x01(\x05R\x04wave\x128\n" +
+	"\x06status\x18\x03 \x01(\x0e2 .monitor.v1.CampaignDeviceStatusR\x06status\x12*\n" +
+	"\x10previous_version\x18\x04 \x01(\tR\x10previous_version\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12:\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xc1\x02\n" +
+	"\x15CreateCampaignRequest\x12&\n" +
+	"\x0etarget_version\x18\x01 \x01(\tR\x0etarget_version\x126\n" +
+	"\bselector\x18\x02 \x01(\v2\x1a.monitor.v1.DeviceSelectorR\bselector\x12\x1c\n" +
+	"\twave_size\x18\x03 \x01(\x05R\twave_size\x12(\n" +
+	"\x0fmax_unavailable\x18\x04 \x01(\x05R\x0fmax_unavailable\x12=\n" +
+	"\fwave_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fwave_timeout\x12A\n" +
+	"\x0efailure_policy\x18\x06 \x01(\x0e2\x19.monitor.v1.FailurePolicyR\x0efailure_policy\"J\n" +
+	"\x16CreateCampaignResponse\x120\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x14.monitor.v1.CampaignR\bcampaign\"K\n" +
+	"\x15ListCampaignsResponse\x122\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x14.monitor.v1.CampaignR\tcampaigns\"6\n" +
+	"\x12GetCampaignRequest\x12 \n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\vcampaign_id\"G\n" +
+	"\x13GetCampaignResponse\x120\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x14.monitor.v1.CampaignR\bcampaign\"9\n" +
+	"\x15CancelCampaignRequest\x12 \n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\vcampaign_id*\xb9\x01\n" +
 	"\bProtocol\x12\x18\n" +
 	"\x14PROTOCOL_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rPROTOCOL_HTTP\x10\x01\x12\x18\n" +
 	"\x14PROTOCOL_HTTP_STREAM\x10\x02\x12\x11\n" +
 	"\rPROTOCOL_GRPC\x10\x03\x12\x18\n" +
-	"\x14PROTOCOL_GRPC_STREAM\x10\x04*\xd2\x01\n" +
+	"\x14PROTOCOL_GRPC_STREAM\x10\x04\x12\x11\n" +
+	"\rPROTOCOL_MQTT\x10\x05\x12\x11\n" +
+	"\rPROTOCOL_SNMP\x10\x06\x12\x13\n" +
+	"\x0fPROTOCOL_PLUGIN\x10\a*\xd2\x01\n" +
 	"\fDeviceStatus\x12\x1d\n" +
 	"\x19DEVICE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15DEVICE_STATUS_HEALTHY\x10\x01\x12\x1a\n" +
@@ -751,15 +6953,175 @@ const file_proto_monitor_v1_monitor_proto_rawDesc = "" +
 	"\x13DEVICE_STATUS_ERROR\x10\x03\x12\x1d\n" +
 	"\x19DEVICE_STATUS_MAINTENANCE\x10\x04\x12\x19\n" +
 	"\x15DEVICE_STATUS_BOOTING\x10\x05\x12\x19\n" +
-	"\x15DEVICE_STATUS_OFFLINE\x10\x062\x9e\x05\n" +
+	"\x15DEVICE_STATUS_OFFLINE\x10\x06*w\n" +
+	"\x10SigningAlgorithm\x12!\n" +
+	"\x1dSIGNING_ALGORITHM_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSIGNING_ALGORITHM_HMAC_SHA256\x10\x01\x12\x1d\n" +
+	"\x19SIGNING_ALGORITHM_ED25519\x10\x02*V\n" +
+	"\vSnmpVersion\x12\x1c\n" +
+	"\x18SNMP_VERSION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SNMP_VERSION_V2C\x10\x01\x12\x13\n" +
+	"\x0fSNMP_VERSION_V3\x10\x02*\xac\x01\n" +
+	"\x10SnmpAuthProtocol\x12\"\n" +
+	"\x1eSNMP_AUTH_PROTOCOL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SNMP_AUTH_PROTOCOL_MD5\x10\x01\x12\x1a\n" +
+	"\x16SNMP_AUTH_PROTOCOL_SHA\x10\x02\x12\x1d\n" +
+	"\x19SNMP_AUTH_PROTOCOL_SHA256\x10\x03\x12\x1d\n" +
+	"\x19SNMP_AUTH_PROTOCOL_SHA512\x10\x04*\x8d\x01\n" +
+	"\x10SnmpPrivProtocol\x12\"\n" +
+	"\x1eSNMP_PRIV_PROTOCOL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SNMP_PRIV_PROTOCOL_DES\x10\x01\x12\x1a\n" +
+	"\x16SNMP_PRIV_PROTOCOL_AES\x10\x02\x12\x1d\n" +
+	"\x19SNMP_PRIV_PROTOCOL_AES256\x10\x03*\x9f\x01\n" +
+	"\x12VerificationStatus\x12#\n" +
+	"\x1fVERIFICATION_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cVERIFICATION_STATUS_UNSIGNED\x10\x01\x12!\n" +
+	"\x1dVERIFICATION_STATUS_AUTHENTIC\x10\x02\x12\x1f\n" +
+	"\x1bVERIFICATION_STATUS_INVALID\x10\x03*O\n" +
+	"\tLinkState\x12\x1a\n" +
+	"\x16LINK_STATE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rLINK_STATE_UP\x10\x01\x12\x13\n" +
+	"\x0fLINK_STATE_DOWN\x10\x02*\xe6\x01\n" +
+	"\x0eCampaignStatus\x12\x1f\n" +
+	"\x1bCAMPAIGN_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CAMPAIGN_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17CAMPAIGN_STATUS_RUNNING\x10\x02\x12\x1d\n" +
+	"\x19CAMPAIGN_STATUS_COMPLETED\x10\x03\x12\x1a\n" +
+	"\x16CAMPAIGN_STATUS_HALTED\x10\x04\x12\x1f\n" +
+	"\x1bCAMPAIGN_STATUS_ROLLED_BACK\x10\x05\x12\x1d\n" +
+	"\x19CAMPAIGN_STATUS_CANCELLED\x10\x06*\x9c\x02\n" +
+	"\x14CampaignDeviceStatus\x12&\n" +
+	"\"CAMPAIGN_DEVICE_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCAMPAIGN_DEVICE_STATUS_PENDING\x10\x01\x12$\n" +
+	" CAMPAIGN_DEVICE_STATUS_UPGRADING\x10\x02\x12#\n" +
+	"\x1fCAMPAIGN_DEVICE_STATUS_UPGRADED\x10\x03\x12!\n" +
+	"\x1dCAMPAIGN_DEVICE_STATUS_FAILED\x10\x04\x12&\n" +
+	"\"CAMPAIGN_DEVICE_STATUS_ROLLED_BACK\x10\x05\x12\"\n" +
+	"\x1eCAMPAIGN_DEVICE_STATUS_SKIPPED\x10\x06*e\n" +
+	"\rFailurePolicy\x12\x1e\n" +
+	"\x1aFAILURE_POLICY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13FAILURE_POLICY_HALT\x10\x01\x12\x1b\n" +
+	"\x17FAILURE_POLICY_ROLLBACK\x10\x02*y\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14EXPORT_FORMAT_NDJSON\x10\x02\x12\x19\n" +
+	"\x15EXPORT_FORMAT_PARQUET\x10\x03*h\n" +
+	"\x0fInventoryFormat\x12 \n" +
+	"\x1cINVENTORY_FORMAT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15INVENTORY_FORMAT_YAML\x10\x01\x12\x18\n" +
+	"\x14INVENTORY_FORMAT_CSV\x10\x02*\xb4\x01\n" +
+	"\fImportStatus\x12\x1d\n" +
+	"\x19IMPORT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15IMPORT_STATUS_CREATED\x10\x01\x12\x19\n" +
+	"\x15IMPORT_STATUS_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17IMPORT_STATUS_UNCHANGED\x10\x03\x12\x18\n" +
+	"\x14IMPORT_STATUS_FAILED\x10\x04\x12\x18\n" +
+	"\x14IMPORT_STATUS_PRUNED\x10\x05*\x8d\x01\n" +
+	"\x0fTransitionCause\x12 \n" +
+	"\x1cTRANSITION_CAUSE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TRANSITION_CAUSE_DEVICE\x10\x01\x12\x1d\n" +
+	"\x19TRANSITION_CAUSE_OPERATOR\x10\x02\x12\x1c\n" +
+	"\x18TRANSITION_CAUSE_OFFLINE\x10\x03*\xb4\x01\n" +
+	"\fConfigStatus\x12\x1d\n" +
+	"\x19CONFIG_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CONFIG_STATUS_PENDING\x10\x01\x12\x19\n" +
+	"\x15CONFIG_STATUS_IN_SYNC\x10\x02\x12\x1b\n" +
+	"\x17CONFIG_STATUS_REAPPLIED\x10\x03\x12\x19\n" +
+	"\x15CONFIG_STATUS_DRIFTED\x10\x04\x12\x17\n" +
+	"\x13CONFIG_STATUS_ERROR\x10\x05*\\\n" +
+	"\vDriftPolicy\x12\x1c\n" +
+	"\x18DRIFT_POLICY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DRIFT_POLICY_REAPPLY\x10\x01\x12\x15\n" +
+	"\x11DRIFT_POLICY_FLAG\x10\x02*\x93\x01\n" +
+	"\x06Metric\x12\x16\n" +
+	"\x12METRIC_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"METRIC_CPU\x10\x01\x12\x11\n" +
+	"\rMETRIC_MEMORY\x10\x02\x12\x16\n" +
+	"\x12METRIC_TEMPERATURE\x10\x03\x12\x0f\n" +
+	"\vMETRIC_LOAD\x10\x04\x12\x0f\n" +
+	"\vMETRIC_DISK\x10\x05\x12\x14\n" +
+	"\x10METRIC_PROCESSES\x10\x06*[\n" +
+	"\vAnomalyKind\x12\x1c\n" +
+	"\x18ANOMALY_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ANOMALY_KIND_SPIKE\x10\x01\x12\x16\n" +
+	"\x12ANOMALY_KIND_DRIFT\x10\x02*\x87\x01\n" +
+	"\vSensitivity\x12\x1b\n" +
+	"\x17SENSITIVITY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SENSITIVITY_DISABLED\x10\x01\x12\x13\n" +
+	"\x0fSENSITIVITY_LOW\x10\x02\x12\x16\n" +
+	"\x12SENSITIVITY_MEDIUM\x10\x03\x12\x14\n" +
+	"\x10SENSITIVITY_HIGH\x10\x04*k\n" +
+	"\rForecastModel\x12\x1e\n" +
+	"\x1aFORECAST_MODEL_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FORECAST_MODEL_LINEAR\x10\x01\x12\x1f\n" +
+	"\x1bFORECAST_MODEL_HOLT_WINTERS\x10\x02*\x95\x01\n" +
+	"\x10VersionComponent\x12!\n" +
+	"\x1dVERSION_COMPONENT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aVERSION_COMPONENT_HARDWARE\x10\x01\x12\x1e\n" +
+	"\x1aVERSION_COMPONENT_SOFTWARE\x10\x02\x12\x1e\n" +
+	"\x1aVERSION_COMPONENT_FIRMWARE\x10\x03*r\n" +
+	"\x0fVersionGrouping\x12 \n" +
+	"\x1cVERSION_GROUPING_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16VERSION_GROUPING_MODEL\x10\x01\x12!\n" +
+	"\x1dVERSION_GROUPING_ARCHITECTURE\x10\x02*\x9a\x02\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EVENT_TYPE_REGISTERED\x10\x01\x12\x1d\n" +
+	"\x19EVENT_TYPE_STATUS_CHANGED\x10\x02\x12\x16\n" +
+	"\x12EVENT_TYPE_OFFLINE\x10\x03\x12 \n" +
+	"\x1cEVENT_TYPE_CHECKSUM_MISMATCH\x10\x04\x12\x1e\n" +
+	"\x1aEVENT_TYPE_VERSION_CHANGED\x10\x05\x12\x1d\n" +
+	"\x19EVENT_TYPE_WORKER_STARTED\x10\x06\x12\x1f\n" +
+	"\x1bEVENT_TYPE_WORKER_RESTARTED\x10\a\x12\x1d\n" +
+	"\x19EVENT_TYPE_WORKER_STOPPED\x10\b*~\n" +
+	"\rEventSeverity\x12\x1e\n" +
+	"\x1aEVENT_SEVERITY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13EVENT_SEVERITY_INFO\x10\x01\x12\x1a\n" +
+	"\x16EVENT_SEVERITY_WARNING\x10\x02\x12\x18\n" +
+	"\x14EVENT_SEVERITY_ERROR\x10\x03*\x9a\x01\n" +
+	"\x10ComplianceStatus\x12!\n" +
+	"\x1dCOMPLIANCE_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bCOMPLIANCE_STATUS_COMPLIANT\x10\x01\x12#\n" +
+	"\x1fCOMPLIANCE_STATUS_NON_COMPLIANT\x10\x02\x12\x1d\n" +
+	"\x19COMPLIANCE_STATUS_UNKNOWN\x10\x032\xaf \n" +
 	"\aMonitor\x12O\n" +
 	"\tGetHealth\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/health\x12{\n" +
 	"\x0eRegisterDevice\x12!.monitor.v1.RegisterDeviceRequest\x1a\".monitor.v1.RegisterDeviceResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/devices/{device_id}\x12[\n" +
 	"\vListDevices\x12\x16.google.protobuf.Empty\x1a\x1f.monitor.v1.ListDevicesResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/devices\x12k\n" +
-	"\fUpdateDevice\x12\x1f.monitor.v1.UpdateDeviceRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/devices/{device_id}\x12v\n" +
+	"\fUpdateDevice\x12\x1f.monitor.v1.UpdateDeviceRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/devices/{device_id}\x12h\n" +
+	"\fDeleteDevice\x12\x1f.monitor.v1.DeleteDeviceRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/devices/{device_id}\x12s\n" +
+	"\rImportDevices\x12 .monitor.v1.ImportDevicesRequest\x1a!.monitor.v1.ImportDevicesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/import/devices\x12|\n" +
+	"\fRebootDevice\x12\x1f.monitor.v1.RebootDeviceRequest\x1a .monitor.v1.RebootDeviceResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/devices/{device_id}/reboot\x12\x82\x01\n" +
+	"\x0fSetDeviceConfig\x12\".monitor.v1.SetDeviceConfigRequest\x1a .monitor.v1.DeviceConfigResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/devices/{device_id}/config\x12\x7f\n" +
+	"\x0fGetDeviceConfig\x12\".monitor.v1.GetDeviceConfigRequest\x1a .monitor.v1.DeviceConfigResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/devices/{device_id}/config\x12{\n" +
+	"\x12DeleteDeviceConfig\x12%.monitor.v1.DeleteDeviceConfigRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/devices/{device_id}/config\x12\x99\x01\n" +
+	"\x15ListStatusTransitions\x12(.monitor.v1.ListStatusTransitionsRequest\x1a).monitor.v1.ListStatusTransitionsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/devices/{device_id}/transitions\x12\x93\x01\n" +
+	"\x10ListDeviceEvents\x12#.monitor.v1.ListDeviceEventsRequest\x1a$.monitor.v1.ListDeviceEventsResponse\"4\x82\xd3\xe4\x93\x02.Z \x12\x1e/v1/devices/{device_id}/events\x12\n" +
+	"/v1/events\x12q\n" +
+	"\x12StreamDeviceEvents\x12%.monitor.v1.StreamDeviceEventsRequest\x1a\x17.monitor.v1.DeviceEvent\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/events/stream0\x01\x12k\n" +
+	"\rListAnomalies\x12 .monitor.v1.ListAnomaliesRequest\x1a!.monitor.v1.ListAnomaliesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/anomalies\x12j\n" +
+	"\x0fStreamAnomalies\x12\".monitor.v1.StreamAnomaliesRequest\x1a\x13.monitor.v1.Anomaly\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/anomalies/stream0\x01\x12\xa0\x01\n" +
+	"\x15SetAnomalySensitivity\x12(.monitor.v1.SetAnomalySensitivityRequest\x1a#.monitor.v1.AnomalySettingsResponse\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/v1/devices/{device_id}/anomalies/sensitivity\x12\x97\x01\n" +
+	"\x12GetAnomalySettings\x12%.monitor.v1.GetAnomalySettingsRequest\x1a#.monitor.v1.AnomalySettingsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/devices/{device_id}/anomalies/sensitivity\x12\x80\x01\n" +
+	"\x13GetVersionInventory\x12#.monitor.v1.VersionInventoryRequest\x1a$.monitor.v1.VersionInventoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/inventory/versions\x12\x9a\x01\n" +
+	"\x13SetCompliancePolicy\x12&.monitor.v1.SetCompliancePolicyRequest\x1a$.monitor.v1.CompliancePolicyResponse\"5\x82\xd3\xe4\x93\x02/:\x06policy\x1a%/v1/compliance/policies/{policy.name}\x12}\n" +
+	"\x16ListCompliancePolicies\x12\x16.google.protobuf.Empty\x1a*.monitor.v1.ListCompliancePoliciesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/compliance/policies\x12\x83\x01\n" +
+	"\x16DeleteCompliancePolicy\x12).monitor.v1.DeleteCompliancePolicyRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/compliance/policies/{name}\x12\x81\x01\n" +
+	"\x12ListVersionChanges\x12%.monitor.v1.ListVersionChangesRequest\x1a&.monitor.v1.ListVersionChangesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/versions/changes\x12\x81\x01\n" +
+	"\x14StreamVersionChanges\x12'.monitor.v1.StreamVersionChangesRequest\x1a\x19.monitor.v1.VersionChange\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/versions/changes/stream0\x01\x12\x89\x01\n" +
+	"\vGetForecast\x12\x1e.monitor.v1.GetForecastRequest\x1a\x1f.monitor.v1.GetForecastResponse\"9\x82\xd3\xe4\x93\x023Z\"\x12 /v1/devices/{device_id}/forecast\x12\r/v1/forecasts\x12v\n" +
 	"\x0eGetDiagnostics\x12\x1e.monitor.v1.DiagnosticsRequest\x1a\x1f.monitor.v1.DiagnosticsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/diagnostics/{device_id}\x12\x82\x01\n" +
-	"\x11StreamDiagnostics\x12\x1e.monitor.v1.DiagnosticsRequest\x1a\x1f.monitor.v1.DiagnosticsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/diagnostics/{device_id}/stream0\x01BFZDgithub.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1;monitorv1b\x06proto3"
+	"\x11StreamDiagnostics\x12\x1e.monitor.v1.DiagnosticsRequest\x1a\x1f.monitor.v1.DiagnosticsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/diagnostics/{device_id}/stream0\x01\x12\x87\x01\n" +
+	"\x0fListDiagnostics\x12\".monitor.v1.ListDiagnosticsRequest\x1a#.monitor.v1.ListDiagnosticsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/diagnostics/{device_id}/history\x12b\n" +
+	"\x11ExportDiagnostics\x12$.monitor.v1.ExportDiagnosticsRequest\x1a%.monitor.v1.ExportDiagnosticsResponse0\x01\x12\x88\x01\n" +
+	"\x15GetAvailabilityReport\x12%.monitor.v1.AvailabilityReportRequest\x1a&.monitor.v1.AvailabilityReportResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/reports/availability\x12q\n" +
+	"\x0eCreateCampaign\x12!.monitor.v1.CreateCampaignRequest\x1a\".monitor.v1.CreateCampaignResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/campaigns\x12a\n" +
+	"\rListCampaigns\x12\x16.google.protobuf.Empty\x1a!.monitor.v1.ListCampaignsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/campaigns\x12s\n" +
+	"\vGetCampaign\x12\x1e.monitor.v1.GetCampaignRequest\x1a\x1f.monitor.v1.GetCampaignResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/campaigns/{campaign_id}\x12w\n" +
+	"\x0eCancelCampaign\x12!.monitor.v1.CancelCampaignRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$\"\"/v1/campaigns/{campaign_id}/cancelBFZDgithub.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1;monitorv1b\x06proto3"
 
 var (
 	file_proto_monitor_v1_monitor_proto_rawDescOnce sync.Once
@@ -773,51 +7135,332 @@ func file_proto_monitor_v1_monitor_proto_rawDescGZIP() []byte {
 	return file_proto_monitor_v1_monitor_proto_rawDescData
 }
 
-var file_proto_monitor_v1_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_monitor_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_monitor_v1_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 26)
+var file_proto_monitor_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_proto_monitor_v1_monitor_proto_goTypes = []any{
-	(Protocol)(0),                  // 0: monitor.v1.Protocol
-	(DeviceStatus)(0),              // 1: monitor.v1.DeviceStatus
-	(*Device)(nil),                 // 2: monitor.v1.Device
-	(*Diagnostics)(nil),            // 3: monitor.v1.Diagnostics
-	(*RegisterDeviceRequest)(nil),  // 4: monitor.v1.RegisterDeviceRequest
-	(*RegisterDeviceResponse)(nil), // 5: monitor.v1.RegisterDeviceResponse
-	(*ListDevicesResponse)(nil),    // 6: monitor.v1.ListDevicesResponse
-	(*UpdateDeviceRequest)(nil),    // 7: monitor.v1.UpdateDeviceRequest
-	(*DiagnosticsRequest)(nil),     // 8: monitor.v1.DiagnosticsRequest
-	(*DiagnosticsResponse)(nil),    // 9: monitor.v1.DiagnosticsResponse
-	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),          // 11: google.protobuf.Empty
+	(Protocol)(0),                          // 0: monitor.v1.Protocol
+	(DeviceStatus)(0),                      // 1: monitor.v1.DeviceStatus
+	(SigningAlgorithm)(0),                  // 2: monitor.v1.SigningAlgorithm
+	(SnmpVersion)(0),                       // 3: monitor.v1.SnmpVersion
+	(SnmpAuthProtocol)(0),                  // 4: monitor.v1.SnmpAuthProtocol
+	(SnmpPrivProtocol)(0),                  // 5: monitor.v1.SnmpPrivProtocol
+	(VerificationStatus)(0),                // 6: monitor.v1.VerificationStatus
+	(LinkState)(0),                         // 7: monitor.v1.LinkState
+	(CampaignStatus)(0),                    // 8: monitor.v1.CampaignStatus
+	(CampaignDeviceStatus)(0),              // 9: monitor.v1.CampaignDeviceStatus
+	(FailurePolicy)(0),                     // 10: monitor.v1.FailurePolicy
+	(ExportFormat)(0),                      // 11: monitor.v1.ExportFormat
+	(InventoryFormat)(0),                   // 12: monitor.v1.InventoryFormat
+	(ImportStatus)(0),                      // 13: monitor.v1.ImportStatus
+	(TransitionCause)(0),                   // 14: monitor.v1.TransitionCause
+	(ConfigStatus)(0),                      // 15: monitor.v1.ConfigStatus
+	(DriftPolicy)(0),                       // 16: monitor.v1.DriftPolicy
+	(Metric)(0),                            // 17: monitor.v1.Metric
+	(AnomalyKind)(0),                       // 18: monitor.v1.AnomalyKind
+	(Sensitivity)(0),                       // 19: monitor.v1.Sensitivity
+	(ForecastModel)(0),                     // 20: monitor.v1.ForecastModel
+	(VersionComponent)(0),                  // 21: monitor.v1.VersionComponent
+	(VersionGrouping)(0),                   // 22: monitor.v1.VersionGrouping
+	(EventType)(0),                         // 23: monitor.v1.EventType
+	(EventSeverity)(0),                     // 24: monitor.v1.EventSeverity
+	(ComplianceStatus)(0),                  // 25: monitor.v1.ComplianceStatus
+	(*Device)(nil),                         // 26: monitor.v1.Device
+	(*Diagnostics)(nil),                    // 27: monitor.v1.Diagnostics
+	(*NetworkInterface)(nil),               // 28: monitor.v1.NetworkInterface
+	(*RegisterDeviceRequest)(nil),          // 29: monitor.v1.RegisterDeviceRequest
+	(*SnmpCredentials)(nil),                // 30: monitor.v1.SnmpCredentials
+	(*RegisterDeviceResponse)(nil),         // 31: monitor.v1.RegisterDeviceResponse
+	(*ListDevicesResponse)(nil),            // 32: monitor.v1.ListDevicesResponse
+	(*UpdateDeviceRequest)(nil),            // 33: monitor.v1.UpdateDeviceRequest
+	(*DeleteDeviceRequest)(nil),            // 34: monitor.v1.DeleteDeviceRequest
+	(*ImportDevicesRequest)(nil),           // 35: monitor.v1.ImportDevicesRequest
+	(*ImportResult)(nil),                   // 36: monitor.v1.ImportResult
+	(*ImportDevicesResponse)(nil),          // 37: monitor.v1.ImportDevicesResponse
+	(*RebootDeviceRequest)(nil),            // 38: monitor.v1.RebootDeviceRequest
+	(*RebootDeviceResponse)(nil),           // 39: monitor.v1.RebootDeviceResponse
+	(*StatusTransition)(nil),               // 40: monitor.v1.StatusTransition
+	(*ListStatusTransitionsRequest)(nil),   // 41: monitor.v1.ListStatusTransitionsRequest
+	(*ListStatusTransitionsResponse)(nil),  // 42: monitor.v1.ListStatusTransitionsResponse
+	(*Anomaly)(nil),                        // 43: monitor.v1.Anomaly
+	(*DeviceEvent)(nil),                    // 44: monitor.v1.DeviceEvent
+	(*ListDeviceEventsRequest)(nil),        // 45: monitor.v1.ListDeviceEventsRequest
+	(*ListDeviceEventsResponse)(nil),       // 46: monitor.v1.ListDeviceEventsResponse
+	(*StreamDeviceEventsRequest)(nil),      // 47: monitor.v1.StreamDeviceEventsRequest
+	(*ListAnomaliesRequest)(nil),           // 48: monitor.v1.ListAnomaliesRequest
+	(*ListAnomaliesResponse)(nil),          // 49: monitor.v1.ListAnomaliesResponse
+	(*StreamAnomaliesRequest)(nil),         // 50: monitor.v1.StreamAnomaliesRequest
+	(*AnomalySettings)(nil),                // 51: monitor.v1.AnomalySettings
+	(*SetAnomalySensitivityRequest)(nil),   // 52: monitor.v1.SetAnomalySensitivityRequest
+	(*GetAnomalySettingsRequest)(nil),      // 53: monitor.v1.GetAnomalySettingsRequest
+	(*AnomalySettingsResponse)(nil),        // 54: monitor.v1.AnomalySettingsResponse
+	(*VersionInventoryRequest)(nil),        // 55: monitor.v1.VersionInventoryRequest
+	(*VersionCount)(nil),                   // 56: monitor.v1.VersionCount
+	(*VersionGroup)(nil),                   // 57: monitor.v1.VersionGroup
+	(*DeviceVersions)(nil),                 // 58: monitor.v1.DeviceVersions
+	(*VersionInventoryResponse)(nil),       // 59: monitor.v1.VersionInventoryResponse
+	(*CompliancePolicy)(nil),               // 60: monitor.v1.CompliancePolicy
+	(*SetCompliancePolicyRequest)(nil),     // 61: monitor.v1.SetCompliancePolicyRequest
+	(*CompliancePolicyResponse)(nil),       // 62: monitor.v1.CompliancePolicyResponse
+	(*ListCompliancePoliciesResponse)(nil), // 63: monitor.v1.ListCompliancePoliciesResponse
+	(*DeleteCompliancePolicyRequest)(nil),  // 64: monitor.v1.DeleteCompliancePolicyRequest
+	(*VersionChange)(nil),                  // 65: monitor.v1.VersionChange
+	(*ListVersionChangesRequest)(nil),      // 66: monitor.v1.ListVersionChangesRequest
+	(*ListVersionChangesResponse)(nil),     // 67: monitor.v1.ListVersionChangesResponse
+	(*StreamVersionChangesRequest)(nil),    // 68: monitor.v1.StreamVersionChangesRequest
+	(*GetForecastRequest)(nil),             // 69: monitor.v1.GetForecastRequest
+	(*ForecastPoint)(nil),                  // 70: monitor.v1.ForecastPoint
+	(*Forecast)(nil),                       // 71: monitor.v1.Forecast
+	(*GetForecastResponse)(nil),            // 72: monitor.v1.GetForecastResponse
+	(*DesiredConfig)(nil),                  // 73: monitor.v1.DesiredConfig
+	(*DeviceConfig)(nil),                   // 74: monitor.v1.DeviceConfig
+	(*SetDeviceConfigRequest)(nil),         // 75: monitor.v1.SetDeviceConfigRequest
+	(*GetDeviceConfigRequest)(nil),         // 76: monitor.v1.GetDeviceConfigRequest
+	(*DeleteDeviceConfigRequest)(nil),      // 77: monitor.v1.DeleteDeviceConfigRequest
+	(*DeviceConfigResponse)(nil),           // 78: monitor.v1.DeviceConfigResponse
+	(*DiagnosticsRequest)(nil),             // 79: monitor.v1.DiagnosticsRequest
+	(*DiagnosticsResponse)(nil),            // 80: monitor.v1.DiagnosticsResponse
+	(*ListDiagnosticsRequest)(nil),         // 81: monitor.v1.ListDiagnosticsRequest
+	(*ListDiagnosticsResponse)(nil),        // 82: monitor.v1.ListDiagnosticsResponse
+	(*ExportDiagnosticsRequest)(nil),       // 83: monitor.v1.ExportDiagnosticsRequest
+	(*ExportDiagnosticsResponse)(nil),      // 84: monitor.v1.ExportDiagnosticsResponse
+	(*AvailabilityReportRequest)(nil),      // 85: monitor.v1.AvailabilityReportRequest
+	(*StatusTime)(nil),                     // 86: monitor.v1.StatusTime
+	(*Availability)(nil),                   // 87: monitor.v1.Availability
+	(*DeviceAvailability)(nil),             // 88: monitor.v1.DeviceAvailability
+	(*GroupAvailability)(nil),              // 89: monitor.v1.GroupAvailability
+	(*AvailabilityReportResponse)(nil),     // 90: monitor.v1.AvailabilityReportResponse
+	(*DeviceSelector)(nil),                 // 91: monitor.v1.DeviceSelector
+	(*Campaign)(nil),                       // 92: monitor.v1.Campaign
+	(*CampaignDevice)(nil),                 // 93: monitor.v1.CampaignDevice
+	(*CreateCampaignRequest)(nil),          // 94: monitor.v1.CreateCampaignRequest
+	(*CreateCampaignResponse)(nil),         // 95: monitor.v1.CreateCampaignResponse
+	(*ListCampaignsResponse)(nil),          // 96: monitor.v1.ListCampaignsResponse
+	(*GetCampaignRequest)(nil),             // 97: monitor.v1.GetCampaignRequest
+	(*GetCampaignResponse)(nil),            // 98: monitor.v1.GetCampaignResponse
+	(*CancelCampaignRequest)(nil),          // 99: monitor.v1.CancelCampaignRequest
+	nil,                                    // 100: monitor.v1.DeviceEvent.DetailsEntry
+	nil,                                    // 101: monitor.v1.CompliancePolicy.LabelsEntry
+	nil,                                    // 102: monitor.v1.DesiredConfig.LabelsEntry
+	nil,                                    // 103: monitor.v1.DesiredConfig.ConfigEntry
+	nil,                                    // 104: monitor.v1.DeviceAvailability.LabelsEntry
+	(*timestamppb.Timestamp)(nil),          // 105: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 106: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                  // 107: google.protobuf.Empty
 }
 var file_proto_monitor_v1_monitor_proto_depIdxs = []int32{
-	0,  // 0: monitor.v1.Device.supported_protocols:type_name -> monitor.v1.Protocol
-	10, // 1: monitor.v1.Device.created_at:type_name -> google.protobuf.Timestamp
-	10, // 2: monitor.v1.Device.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: monitor.v1.Diagnostics.device_status:type_name -> monitor.v1.DeviceStatus
-	0,  // 4: monitor.v1.RegisterDeviceRequest.protocol:type_name -> monitor.v1.Protocol
-	2,  // 5: monitor.v1.RegisterDeviceResponse.device:type_name -> monitor.v1.Device
-	2,  // 6: monitor.v1.ListDevicesResponse.devices:type_name -> monitor.v1.Device
-	1,  // 7: monitor.v1.UpdateDeviceRequest.device_status:type_name -> monitor.v1.DeviceStatus
-	2,  // 8: monitor.v1.DiagnosticsResponse.device:type_name -> monitor.v1.Device
-	3,  // 9: monitor.v1.DiagnosticsResponse.diagnostics:type_name -> monitor.v1.Diagnostics
-	10, // 10: monitor.v1.DiagnosticsResponse.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 11: monitor.v1.Monitor.GetHealth:input_type -> google.protobuf.Empty
-	4,  // 12: monitor.v1.Monitor.RegisterDevice:input_type -> monitor.v1.RegisterDeviceRequest
-	11, // 13: monitor.v1.Monitor.ListDevices:input_type -> google.protobuf.Empty
-	7,  // 14: monitor.v1.Monitor.UpdateDevice:input_type -> monitor.v1.UpdateDeviceRequest
-	8,  // 15: monitor.v1.Monitor.GetDiagnostics:input_type -> monitor.v1.DiagnosticsRequest
-	8,  // 16: monitor.v1.Monitor.StreamDiagnostics:input_type -> monitor.v1.DiagnosticsRequest
-	11, // 17: monitor.v1.Monitor.GetHealth:output_type -> google.protobuf.Empty
-	5,  // 18: monitor.v1.Monitor.RegisterDevice:output_type -> monitor.v1.RegisterDeviceResponse
-	6,  // 19: monitor.v1.Monitor.ListDevices:output_type -> monitor.v1.ListDevicesResponse
-	11, // 20: monitor.v1.Monitor.UpdateDevice:output_type -> google.protobuf.Empty
-	9,  // 21: monitor.v1.Monitor.GetDiagnostics:output_type -> monitor.v1.DiagnosticsResponse
-	9,  // 22: monitor.v1.Monitor.StreamDiagnostics:output_type -> monitor.v1.DiagnosticsResponse
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	0,   // 0: monitor.v1.Device.supported_protocols:type_name -> monitor.v1.Protocol
+	105, // 1: monitor.v1.Device.created_at:type_name -> google.protobuf.Timestamp
+	105, // 2: monitor.v1.Device.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 3: monitor.v1.Device.signing_algorithm:type_name -> monitor.v1.SigningAlgorithm
+	25,  // 4: monitor.v1.Device.compliance_status:type_name -> monitor.v1.ComplianceStatus
+	1,   // 5: monitor.v1.Diagnostics.device_status:type_name -> monitor.v1.DeviceStatus
+	6,   // 6: monitor.v1.Diagnostics.verification_status:type_name -> monitor.v1.VerificationStatus
+	28,  // 7: monitor.v1.Diagnostics.interfaces:type_name -> monitor.v1.NetworkInterface
+	105, // 8: monitor.v1.Diagnostics.timestamp:type_name -> google.protobuf.Timestamp
+	7,   // 9: monitor.v1.NetworkInterface.link_state:type_name -> monitor.v1.LinkState
+	0,   // 10: monitor.v1.RegisterDeviceRequest.protocol:type_name -> monitor.v1.Protocol
+	2,   // 11: monitor.v1.RegisterDeviceRequest.signing_algorithm:type_name -> monitor.v1.SigningAlgorithm
+	30,  // 12: monitor.v1.RegisterDeviceRequest.snmp:type_name -> monitor.v1.SnmpCredentials
+	3,   // 13: monitor.v1.SnmpCredentials.version:type_name -> monitor.v1.SnmpVersion
+	4,   // 14: monitor.v1.SnmpCredentials.auth_protocol:type_name -> monitor.v1.SnmpAuthProtocol
+	5,   // 15: monitor.v1.SnmpCredentials.priv_protocol:type_name -> monitor.v1.SnmpPrivProtocol
+	26,  // 16: monitor.v1.RegisterDeviceResponse.device:type_name -> monitor.v1.Device
+	26,  // 17: monitor.v1.ListDevicesResponse.devices:type_name -> monitor.v1.Device
+	1,   // 18: monitor.v1.UpdateDeviceRequest.device_status:type_name -> monitor.v1.DeviceStatus
+	12,  // 19: monitor.v1.ImportDevicesRequest.format:type_name -> monitor.v1.InventoryFormat
+	13,  // 20: monitor.v1.ImportResult.status:type_name -> monitor.v1.ImportStatus
+	36,  // 21: monitor.v1.ImportDevicesResponse.results:type_name -> monitor.v1.ImportResult
+	106, // 22: monitor.v1.RebootDeviceRequest.duration:type_name -> google.protobuf.Duration
+	106, // 23: monitor.v1.RebootDeviceRequest.timeout:type_name -> google.protobuf.Duration
+	106, // 24: monitor.v1.RebootDeviceResponse.downtime:type_name -> google.protobuf.Duration
+	27,  // 25: monitor.v1.RebootDeviceResponse.diagnostics:type_name -> monitor.v1.Diagnostics
+	1,   // 26: monitor.v1.StatusTransition.from_status:type_name -> monitor.v1.DeviceStatus
+	1,   // 27: monitor.v1.StatusTransition.to_status:type_name -> monitor.v1.DeviceStatus
+	14,  // 28: monitor.v1.StatusTransition.cause:type_name -> monitor.v1.TransitionCause
+	106, // 29: monitor.v1.StatusTransition.duration:type_name -> google.protobuf.Duration
+	105, // 30: monitor.v1.StatusTransition.transitioned_at:type_name -> google.protobuf.Timestamp
+	105, // 31: monitor.v1.ListStatusTransitionsRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 32: monitor.v1.ListStatusTransitionsRequest.to:type_name -> google.protobuf.Timestamp
+	40,  // 33: monitor.v1.ListStatusTransitionsResponse.transitions:type_name -> monitor.v1.StatusTransition
+	17,  // 34: monitor.v1.Anomaly.metric:type_name -> monitor.v1.Metric
+	18,  // 35: monitor.v1.Anomaly.kind:type_name -> monitor.v1.AnomalyKind
+	105, // 36: monitor.v1.Anomaly.detected_at:type_name -> google.protobuf.Timestamp
+	23,  // 37: monitor.v1.DeviceEvent.type:type_name -> monitor.v1.EventType
+	24,  // 38: monitor.v1.DeviceEvent.severity:type_name -> monitor.v1.EventSeverity
+	100, // 39: monitor.v1.DeviceEvent.details:type_name -> monitor.v1.DeviceEvent.DetailsEntry
+	105, // 40: monitor.v1.DeviceEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	23,  // 41: monitor.v1.ListDeviceEventsRequest.types:type_name -> monitor.v1.EventType
+	24,  // 42: monitor.v1.ListDeviceEventsRequest.severity:type_name -> monitor.v1.EventSeverity
+	105, // 43: monitor.v1.ListDeviceEventsRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 44: monitor.v1.ListDeviceEventsRequest.to:type_name -> google.protobuf.Timestamp
+	44,  // 45: monitor.v1.ListDeviceEventsResponse.events:type_name -> monitor.v1.DeviceEvent
+	23,  // 46: monitor.v1.StreamDeviceEventsRequest.types:type_name -> monitor.v1.EventType
+	24,  // 47: monitor.v1.StreamDeviceEventsRequest.severity:type_name -> monitor.v1.EventSeverity
+	105, // 48: monitor.v1.ListAnomaliesRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 49: monitor.v1.ListAnomaliesRequest.to:type_name -> google.protobuf.Timestamp
+	43,  // 50: monitor.v1.ListAnomaliesResponse.anomalies:type_name -> monitor.v1.Anomaly
+	19,  // 51: monitor.v1.AnomalySettings.sensitivity:type_name -> monitor.v1.Sensitivity
+	105, // 52: monitor.v1.AnomalySettings.updated_at:type_name -> google.protobuf.Timestamp
+	19,  // 53: monitor.v1.SetAnomalySensitivityRequest.sensitivity:type_name -> monitor.v1.Sensitivity
+	51,  // 54: monitor.v1.AnomalySettingsResponse.settings:type_name -> monitor.v1.AnomalySettings
+	22,  // 55: monitor.v1.VersionInventoryRequest.group_by:type_name -> monitor.v1.VersionGrouping
+	21,  // 56: monitor.v1.VersionInventoryRequest.component:type_name -> monitor.v1.VersionComponent
+	21,  // 57: monitor.v1.VersionCount.component:type_name -> monitor.v1.VersionComponent
+	56,  // 58: monitor.v1.VersionGroup.versions:type_name -> monitor.v1.VersionCount
+	25,  // 59: monitor.v1.DeviceVersions.compliance_status:type_name -> monitor.v1.ComplianceStatus
+	22,  // 60: monitor.v1.VersionInventoryResponse.group_by:type_name -> monitor.v1.VersionGrouping
+	57,  // 61: monitor.v1.VersionInventoryResponse.groups:type_name -> monitor.v1.VersionGroup
+	58,  // 62: monitor.v1.VersionInventoryResponse.devices:type_name -> monitor.v1.DeviceVersions
+	101, // 63: monitor.v1.CompliancePolicy.labels:type_name -> monitor.v1.CompliancePolicy.LabelsEntry
+	21,  // 64: monitor.v1.CompliancePolicy.component:type_name -> monitor.v1.VersionComponent
+	105, // 65: monitor.v1.CompliancePolicy.created_at:type_name -> google.protobuf.Timestamp
+	105, // 66: monitor.v1.CompliancePolicy.updated_at:type_name -> google.protobuf.Timestamp
+	60,  // 67: monitor.v1.SetCompliancePolicyRequest.policy:type_name -> monitor.v1.CompliancePolicy
+	60,  // 68: monitor.v1.CompliancePolicyResponse.policy:type_name -> monitor.v1.CompliancePolicy
+	60,  // 69: monitor.v1.ListCompliancePoliciesResponse.policies:type_name -> monitor.v1.CompliancePolicy
+	21,  // 70: monitor.v1.VersionChange.component:type_name -> monitor.v1.VersionComponent
+	105, // 71: monitor.v1.VersionChange.changed_at:type_name -> google.protobuf.Timestamp
+	21,  // 72: monitor.v1.ListVersionChangesRequest.component:type_name -> monitor.v1.VersionComponent
+	105, // 73: monitor.v1.ListVersionChangesRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 74: monitor.v1.ListVersionChangesRequest.to:type_name -> google.protobuf.Timestamp
+	65,  // 75: monitor.v1.ListVersionChangesResponse.changes:type_name -> monitor.v1.VersionChange
+	21,  // 76: monitor.v1.StreamVersionChangesRequest.component:type_name -> monitor.v1.VersionComponent
+	17,  // 77: monitor.v1.GetForecastRequest.metric:type_name -> monitor.v1.Metric
+	20,  // 78: monitor.v1.GetForecastRequest.model:type_name -> monitor.v1.ForecastModel
+	106, // 79: monitor.v1.GetForecastRequest.history:type_name -> google.protobuf.Duration
+	106, // 80: monitor.v1.GetForecastRequest.horizon:type_name -> google.protobuf.Duration
+	105, // 81: monitor.v1.ForecastPoint.timestamp:type_name -> google.protobuf.Timestamp
+	17,  // 82: monitor.v1.Forecast.metric:type_name -> monitor.v1.Metric
+	20,  // 83: monitor.v1.Forecast.model:type_name -> monitor.v1.ForecastModel
+	105, // 84: monitor.v1.Forecast.threshold_at:type_name -> google.protobuf.Timestamp
+	106, // 85: monitor.v1.Forecast.time_to_threshold:type_name -> google.protobuf.Duration
+	70,  // 86: monitor.v1.Forecast.points:type_name -> monitor.v1.ForecastPoint
+	71,  // 87: monitor.v1.GetForecastResponse.forecasts:type_name -> monitor.v1.Forecast
+	1,   // 88: monitor.v1.DesiredConfig.device_status:type_name -> monitor.v1.DeviceStatus
+	106, // 89: monitor.v1.DesiredConfig.stream_interval:type_name -> google.protobuf.Duration
+	102, // 90: monitor.v1.DesiredConfig.labels:type_name -> monitor.v1.DesiredConfig.LabelsEntry
+	103, // 91: monitor.v1.DesiredConfig.config:type_name -> monitor.v1.DesiredConfig.ConfigEntry
+	16,  // 92: monitor.v1.DesiredConfig.drift_policy:type_name -> monitor.v1.DriftPolicy
+	73,  // 93: monitor.v1.DeviceConfig.desired:type_name -> monitor.v1.DesiredConfig
+	15,  // 94: monitor.v1.DeviceConfig.status:type_name -> monitor.v1.ConfigStatus
+	105, // 95: monitor.v1.DeviceConfig.applied_at:type_name -> google.protobuf.Timestamp
+	105, // 96: monitor.v1.DeviceConfig.reconciled_at:type_name -> google.protobuf.Timestamp
+	105, // 97: monitor.v1.DeviceConfig.created_at:type_name -> google.protobuf.Timestamp
+	105, // 98: monitor.v1.DeviceConfig.updated_at:type_name -> google.protobuf.Timestamp
+	73,  // 99: monitor.v1.SetDeviceConfigRequest.config:type_name -> monitor.v1.DesiredConfig
+	74,  // 100: monitor.v1.DeviceConfigResponse.config:type_name -> monitor.v1.DeviceConfig
+	26,  // 101: monitor.v1.DiagnosticsResponse.device:type_name -> monitor.v1.Device
+	27,  // 102: monitor.v1.DiagnosticsResponse.diagnostics:type_name -> monitor.v1.Diagnostics
+	105, // 103: monitor.v1.DiagnosticsResponse.updated_at:type_name -> google.protobuf.Timestamp
+	105, // 104: monitor.v1.ListDiagnosticsRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 105: monitor.v1.ListDiagnosticsRequest.to:type_name -> google.protobuf.Timestamp
+	27,  // 106: monitor.v1.ListDiagnosticsResponse.diagnostics:type_name -> monitor.v1.Diagnostics
+	105, // 107: monitor.v1.ExportDiagnosticsRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 108: monitor.v1.ExportDiagnosticsRequest.to:type_name -> google.protobuf.Timestamp
+	11,  // 109: monitor.v1.ExportDiagnosticsRequest.format:type_name -> monitor.v1.ExportFormat
+	105, // 110: monitor.v1.AvailabilityReportRequest.from:type_name -> google.protobuf.Timestamp
+	105, // 111: monitor.v1.AvailabilityReportRequest.to:type_name -> google.protobuf.Timestamp
+	1,   // 112: monitor.v1.StatusTime.status:type_name -> monitor.v1.DeviceStatus
+	106, // 113: monitor.v1.StatusTime.duration:type_name -> google.protobuf.Duration
+	106, // 114: monitor.v1.Availability.period:type_name -> google.protobuf.Duration
+	86,  // 115: monitor.v1.Availability.statuses:type_name -> monitor.v1.StatusTime
+	106, // 116: monitor.v1.Availability.mttr:type_name -> google.protobuf.Duration
+	106, // 117: monitor.v1.Availability.mtbf:type_name -> google.protobuf.Duration
+	104, // 118: monitor.v1.DeviceAvailability.labels:type_name -> monitor.v1.DeviceAvailability.LabelsEntry
+	87,  // 119: monitor.v1.DeviceAvailability.availability:type_name -> monitor.v1.Availability
+	87,  // 120: monitor.v1.GroupAvailability.availability:type_name -> monitor.v1.Availability
+	105, // 121: monitor.v1.AvailabilityReportResponse.from:type_name -> google.protobuf.Timestamp
+	105, // 122: monitor.v1.AvailabilityReportResponse.to:type_name -> google.protobuf.Timestamp
+	87,  // 123: monitor.v1.AvailabilityReportResponse.fleet:type_name -> monitor.v1.Availability
+	88,  // 124: monitor.v1.AvailabilityReportResponse.devices:type_name -> monitor.v1.DeviceAvailability
+	89,  // 125: monitor.v1.AvailabilityReportResponse.groups:type_name -> monitor.v1.GroupAvailability
+	91,  // 126: monitor.v1.Campaign.selector:type_name -> monitor.v1.DeviceSelector
+	106, // 127: monitor.v1.Campaign.wave_timeout:type_name -> google.protobuf.Duration
+	10,  // 128: monitor.v1.Campaign.failure_policy:type_name -> monitor.v1.FailurePolicy
+	8,   // 129: monitor.v1.Campaign.status:type_name -> monitor.v1.CampaignStatus
+	93,  // 130: monitor.v1.Campaign.devices:type_name -> monitor.v1.CampaignDevice
+	105, // 131: monitor.v1.Campaign.created_at:type_name -> google.protobuf.Timestamp
+	105, // 132: monitor.v1.Campaign.updated_at:type_name -> google.protobuf.Timestamp
+	105, // 133: monitor.v1.Campaign.completed_at:type_name -> google.protobuf.Timestamp
+	9,   // 134: monitor.v1.CampaignDevice.status:type_name -> monitor.v1.CampaignDeviceStatus
+	105, // 135: monitor.v1.CampaignDevice.updated_at:type_name -> google.protobuf.Timestamp
+	91,  // 136: monitor.v1.CreateCampaignRequest.selector:type_name -> monitor.v1.DeviceSelector
+	106, // 137: monitor.v1.CreateCampaignRequest.wave_timeout:type_name -> google.protobuf.Duration
+	10,  // 138: monitor.v1.CreateCampaignRequest.failure_policy:type_name -> monitor.v1.FailurePolicy
+	92,  // 139: monitor.v1.CreateCampaignResponse.campaign:type_name -> monitor.v1.Campaign
+	92,  // 140: monitor.v1.ListCampaignsResponse.campaigns:type_name -> monitor.v1.Campaign
+	92,  // 141: monitor.v1.GetCampaignResponse.campaign:type_name -> monitor.v1.Campaign
+	107, // 142: monitor.v1.Monitor.GetHealth:input_type -> google.protobuf.Empty
+	29,  // 143: monitor.v1.Monitor.RegisterDevice:input_type -> monitor.v1.RegisterDeviceRequest
+	107, // 144: monitor.v1.Monitor.ListDevices:input_type -> google.protobuf.Empty
+	33,  // 145: monitor.v1.Monitor.UpdateDevice:input_type -> monitor.v1.UpdateDeviceRequest
+	34,  // 146: monitor.v1.Monitor.DeleteDevice:input_type -> monitor.v1.DeleteDeviceRequest
+	35,  // 147: monitor.v1.Monitor.ImportDevices:input_type -> monitor.v1.ImportDevicesRequest
+	38,  // 148: monitor.v1.Monitor.RebootDevice:input_type -> monitor.v1.RebootDeviceRequest
+	75,  // 149: monitor.v1.Monitor.SetDeviceConfig:input_type -> monitor.v1.SetDeviceConfigRequest
+	76,  // 150: monitor.v1.Monitor.GetDeviceConfig:input_type -> monitor.v1.GetDeviceConfigRequest
+	77,  // 151: monitor.v1.Monitor.DeleteDeviceConfig:input_type -> monitor.v1.DeleteDeviceConfigRequest
+	41,  // 152: monitor.v1.Monitor.ListStatusTransitions:input_type -> monitor.v1.ListStatusTransitionsRequest
+	45,  // 153: monitor.v1.Monitor.ListDeviceEvents:input_type -> monitor.v1.ListDeviceEventsRequest
+	47,  // 154: monitor.v1.Monitor.StreamDeviceEvents:input_type -> monitor.v1.StreamDeviceEventsRequest
+	48,  // 155: monitor.v1.Monitor.ListAnomalies:input_type -> monitor.v1.ListAnomaliesRequest
+	50,  // 156: monitor.v1.Monitor.StreamAnomalies:input_type -> monitor.v1.StreamAnomaliesRequest
+	52,  // 157: monitor.v1.Monitor.SetAnomalySensitivity:input_type -> monitor.v1.SetAnomalySensitivityRequest
+	53,  // 158: monitor.v1.Monitor.GetAnomalySettings:input_type -> monitor.v1.GetAnomalySettingsRequest
+	55,  // 159: monitor.v1.Monitor.GetVersionInventory:input_type -> monitor.v1.VersionInventoryRequest
+	61,  // 160: monitor.v1.Monitor.SetCompliancePolicy:input_type -> monitor.v1.SetCompliancePolicyRequest
+	107, // 161: monitor.v1.Monitor.ListCompliancePolicies:input_type -> google.protobuf.Empty
+	64,  // 162: monitor.v1.Monitor.DeleteCompliancePolicy:input_type -> monitor.v1.DeleteCompliancePolicyRequest
+	66,  // 163: monitor.v1.Monitor.ListVersionChanges:input_type -> monitor.v1.ListVersionChangesRequest
+	68,  // 164: monitor.v1.Monitor.StreamVersionChanges:input_type -> monitor.v1.StreamVersionChangesRequest
+	69,  // 165: monitor.v1.Monitor.GetForecast:input_type -> monitor.v1.GetForecastRequest
+	79,  // 166: monitor.v1.Monitor.GetDiagnostics:input_type -> monitor.v1.DiagnosticsRequest
+	79,  // 167: monitor.v1.Monitor.StreamDiagnostics:input_type -> monitor.v1.DiagnosticsRequest
+	81,  // 168: monitor.v1.Monitor.ListDiagnostics:input_type -> monitor.v1.ListDiagnosticsRequest
+	83,  // 169: monitor.v1.Monitor.ExportDiagnostics:input_type -> monitor.v1.ExportDiagnosticsRequest
+	85,  // 170: monitor.v1.Monitor.GetAvailabilityReport:input_type -> monitor.v1.AvailabilityReportRequest
+	94,  // 171: monitor.v1.Monitor.CreateCampaign:input_type -> monitor.v1.CreateCampaignRequest
+	107, // 172: monitor.v1.Monitor.ListCampaigns:input_type -> google.protobuf.Empty
+	97,  // 173: monitor.v1.Monitor.GetCampaign:input_type -> monitor.v1.GetCampaignRequest
+	99,  // 174: monitor.v1.Monitor.CancelCampaign:input_type -> monitor.v1.CancelCampaignRequest
+	107, // 175: monitor.v1.Monitor.GetHealth:output_type -> google.protobuf.Empty
+	31,  // 176: monitor.v1.Monitor.RegisterDevice:output_type -> monitor.v1.RegisterDeviceResponse
+	32,  // 177: monitor.v1.Monitor.ListDevices:output_type -> monitor.v1.ListDevicesResponse
+	107, // 178: monitor.v1.Monitor.UpdateDevice:output_type -> google.protobuf.Empty
+	107, // 179: monitor.v1.Monitor.DeleteDevice:output_type -> google.protobuf.Empty
+	37,  // 180: monitor.v1.Monitor.ImportDevices:output_type -> monitor.v1.ImportDevicesResponse
+	39,  // 181: monitor.v1.Monitor.RebootDevice:output_type -> monitor.v1.RebootDeviceResponse
+	78,  // 182: monitor.v1.Monitor.SetDeviceConfig:output_type -> monitor.v1.DeviceConfigResponse
+	78,  // 183: monitor.v1.Monitor.GetDeviceConfig:output_type -> monitor.v1.DeviceConfigResponse
+	107, // 184: monitor.v1.Monitor.DeleteDeviceConfig:output_type -> google.protobuf.Empty
+	42,  // 185: monitor.v1.Monitor.ListStatusTransitions:output_type -> monitor.v1.ListStatusTransitionsResponse
+	46,  // 186: monitor.v1.Monitor.ListDeviceEvents:output_type -> monitor.v1.ListDeviceEventsResponse
+	44,  // 187: monitor.v1.Monitor.StreamDeviceEvents:output_type -> monitor.v1.DeviceEvent
+	49,  // 188: monitor.v1.Monitor.ListAnomalies:output_type -> monitor.v1.ListAnomaliesResponse
+	43,  // 189: monitor.v1.Monitor.StreamAnomalies:output_type -> monitor.v1.Anomaly
+	54,  // 190: monitor.v1.Monitor.SetAnomalySensitivity:output_type -> monitor.v1.AnomalySettingsResponse
+	54,  // 191: monitor.v1.Monitor.GetAnomalySettings:output_type -> monitor.v1.AnomalySettingsResponse
+	59,  // 192: monitor.v1.Monitor.GetVersionInventory:output_type -> monitor.v1.VersionInventoryResponse
+	62,  // 193: monitor.v1.Monitor.SetCompliancePolicy:output_type -> monitor.v1.CompliancePolicyResponse
+	63,  // 194: monitor.v1.Monitor.ListCompliancePolicies:output_type -> monitor.v1.ListCompliancePoliciesResponse
+	107, // 195: monitor.v1.Monitor.DeleteCompliancePolicy:output_type -> google.protobuf.Empty
+	67,  // 196: monitor.v1.Monitor.ListVersionChanges:output_type -> monitor.v1.ListVersionChangesResponse
+	65,  // 197: monitor.v1.Monitor.StreamVersionChanges:output_type -> monitor.v1.VersionChange
+	72,  // 198: monitor.v1.Monitor.GetForecast:output_type -> monitor.v1.GetForecastResponse
+	80,  // 199: monitor.v1.Monitor.GetDiagnostics:output_type -> monitor.v1.DiagnosticsResponse
+	80,  // 200: monitor.v1.Monitor.StreamDiagnostics:output_type -> monitor.v1.DiagnosticsResponse
+	82,  // 201: monitor.v1.Monitor.ListDiagnostics:output_type -> monitor.v1.ListDiagnosticsResponse
+	84,  // 202: monitor.v1.Monitor.ExportDiagnostics:output_type -> monitor.v1.ExportDiagnosticsResponse
+	90,  // 203: monitor.v1.Monitor.GetAvailabilityReport:output_type -> monitor.v1.AvailabilityReportResponse
+	95,  // 204: monitor.v1.Monitor.CreateCampaign:output_type -> monitor.v1.CreateCampaignResponse
+	96,  // 205: monitor.v1.Monitor.ListCampaigns:output_type -> monitor.v1.ListCampaignsResponse
+	98,  // 206: monitor.v1.Monitor.GetCampaign:output_type -> monitor.v1.GetCampaignResponse
+	107, // 207: monitor.v1.Monitor.CancelCampaign:output_type -> google.protobuf.Empty
+	175, // [175:208] is the sub-list for method output_type
+	142, // [142:175] is the sub-list for method input_type
+	142, // [142:142] is the sub-list for extension type_name
+	142, // [142:142] is the sub-list for extension extendee
+	0,   // [0:142] is the sub-list for field type_name
 }
 
 func init() { file_proto_monitor_v1_monitor_proto_init() }
@@ -830,8 +7473,8 @@ func file_proto_monitor_v1_monitor_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_monitor_v1_monitor_proto_rawDesc), len(file_proto_monitor_v1_monitor_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      26,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
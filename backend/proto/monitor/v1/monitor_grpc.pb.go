@@ -21,12 +21,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Monitor_GetHealth_FullMethodName         = "/monitor.v1.Monitor/GetHealth"
-	Monitor_RegisterDevice_FullMethodName    = "/monitor.v1.Monitor/RegisterDevice"
-	Monitor_ListDevices_FullMethodName       = "/monitor.v1.Monitor/ListDevices"
-	Monitor_UpdateDevice_FullMethodName      = "/monitor.v1.Monitor/UpdateDevice"
-	Monitor_GetDiagnostics_FullMethodName    = "/monitor.v1.Monitor/GetDiagnostics"
-	Monitor_StreamDiagnostics_FullMethodName = "/monitor.v1.Monitor/StreamDiagnostics"
+	Monitor_GetHealth_FullMethodName              = "/monitor.v1.Monitor/GetHealth"
+	Monitor_RegisterDevice_FullMethodName         = "/monitor.v1.Monitor/RegisterDevice"
+	Monitor_ListDevices_FullMethodName            = "/monitor.v1.Monitor/ListDevices"
+	Monitor_UpdateDevice_FullMethodName           = "/monitor.v1.Monitor/UpdateDevice"
+	Monitor_DeleteDevice_FullMethodName           = "/monitor.v1.Monitor/DeleteDevice"
+	Monitor_ImportDevices_FullMethodName          = "/monitor.v1.Monitor/ImportDevices"
+	Monitor_RebootDevice_FullMethodName           = "/monitor.v1.Monitor/RebootDevice"
+	Monitor_SetDeviceConfig_FullMethodName        = "/monitor.v1.Monitor/SetDeviceConfig"
+	Monitor_GetDeviceConfig_FullMethodName        = "/monitor.v1.Monitor/GetDeviceConfig"
+	Monitor_DeleteDeviceConfig_FullMethodName     = "/monitor.v1.Monitor/DeleteDeviceConfig"
+	Monitor_ListStatusTransitions_FullMethodName  = "/monitor.v1.Monitor/ListStatusTransitions"
+	Monitor_ListDeviceEvents_FullMethodName       = "/monitor.v1.Monitor/ListDeviceEvents"
+	Monitor_StreamDeviceEvents_FullMethodName     = "/monitor.v1.Monitor/StreamDeviceEvents"
+	Monitor_ListAnomalies_FullMethodName          = "/monitor.v1.Monitor/ListAnomalies"
+	Monitor_StreamAnomalies_FullMethodName        = "/monitor.v1.Monitor/StreamAnomalies"
+	Monitor_SetAnomalySensitivity_FullMethodName  = "/monitor.v1.Monitor/SetAnomalySensitivity"
+	Monitor_GetAnomalySettings_FullMethodName     = "/monitor.v1.Monitor/GetAnomalySettings"
+	Monitor_GetVersionInventory_FullMethodName    = "/monitor.v1.Monitor/GetVersionInventory"
+	Monitor_SetCompliancePolicy_FullMethodName    = "/monitor.v1.Monitor/SetCompliancePolicy"
+	Monitor_ListCompliancePolicies_FullMethodName = "/monitor.v1.Monitor/ListCompliancePolicies"
+	Monitor_DeleteCompliancePolicy_FullMethodName = "/monitor.v1.Monitor/DeleteCompliancePolicy"
+	Monitor_ListVersionChanges_FullMethodName     = "/monitor.v1.Monitor/ListVersionChanges"
+	Monitor_StreamVersionChanges_FullMethodName   = "/monitor.v1.Monitor/StreamVersionChanges"
+	Monitor_GetForecast_FullMethodName            = "/monitor.v1.Monitor/GetForecast"
+	Monitor_GetDiagnostics_FullMethodName         = "/monitor.v1.Monitor/GetDiagnostics"
+	Monitor_StreamDiagnostics_FullMethodName      = "/monitor.v1.Monitor/StreamDiagnostics"
+	Monitor_ListDiagnostics_FullMethodName        = "/monitor.v1.Monitor/ListDiagnostics"
+	Monitor_ExportDiagnostics_FullMethodName      = "/monitor.v1.Monitor/ExportDiagnostics"
+	Monitor_GetAvailabilityReport_FullMethodName  = "/monitor.v1.Monitor/GetAvailabilityReport"
+	Monitor_CreateCampaign_FullMethodName         = "/monitor.v1.Monitor/CreateCampaign"
+	Monitor_ListCampaigns_FullMethodName          = "/monitor.v1.Monitor/ListCampaigns"
+	Monitor_GetCampaign_FullMethodName            = "/monitor.v1.Monitor/GetCampaign"
+	Monitor_CancelCampaign_FullMethodName         = "/monitor.v1.Monitor/CancelCampaign"
 )
 
 // MonitorClient is the client API for Monitor service.
@@ -37,8 +64,36 @@ type MonitorClient interface {
 	RegisterDevice(ctx context.Context, in *RegisterDeviceRequest, opts ...grpc.CallOption) (*RegisterDeviceResponse, error)
 	ListDevices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDevicesResponse, error)
 	UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportDevices(ctx context.Context, in *ImportDevicesRequest, opts ...grpc.CallOption) (*ImportDevicesResponse, error)
+	RebootDevice(ctx context.Context, in *RebootDeviceRequest, opts ...grpc.CallOption) (*RebootDeviceResponse, error)
+	SetDeviceConfig(ctx context.Context, in *SetDeviceConfigRequest, opts ...grpc.CallOption) (*DeviceConfigResponse, error)
+	GetDeviceConfig(ctx context.Context, in *GetDeviceConfigRequest, opts ...grpc.CallOption) (*DeviceConfigResponse, error)
+	DeleteDeviceConfig(ctx context.Context, in *DeleteDeviceConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListStatusTransitions(ctx context.Context, in *ListStatusTransitionsRequest, opts ...grpc.CallOption) (*ListStatusTransitionsResponse, error)
+	ListDeviceEvents(ctx context.Context, in *ListDeviceEventsRequest, opts ...grpc.CallOption) (*ListDeviceEventsResponse, error)
+	StreamDeviceEvents(ctx context.Context, in *StreamDeviceEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeviceEvent], error)
+	ListAnomalies(ctx context.Context, in *ListAnomaliesRequest, opts ...grpc.CallOption) (*ListAnomaliesResponse, error)
+	StreamAnomalies(ctx context.Context, in *StreamAnomaliesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Anomaly], error)
+	SetAnomalySensitivity(ctx context.Context, in *SetAnomalySensitivityRequest, opts ...grpc.CallOption) (*AnomalySettingsResponse, error)
+	GetAnomalySettings(ctx context.Context, in *GetAnomalySettingsRequest, opts ...grpc.CallOption) (*AnomalySettingsResponse, error)
+	GetVersionInventory(ctx context.Context, in *VersionInventoryRequest, opts ...grpc.CallOption) (*VersionInventoryResponse, error)
+	SetCompliancePolicy(ctx context.Context, in *SetCompliancePolicyRequest, opts ...grpc.CallOption) (*CompliancePolicyResponse, error)
+	ListCompliancePolicies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCompliancePoliciesResponse, error)
+	DeleteCompliancePolicy(ctx context.Context, in *DeleteCompliancePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListVersionChanges(ctx context.Context, in *ListVersionChangesRequest, opts ...grpc.CallOption) (*ListVersionChangesResponse, error)
+	StreamVersionChanges(ctx context.Context, in *StreamVersionChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VersionChange], error)
+	GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error)
 	GetDiagnostics(ctx context.Context, in *DiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error)
 	StreamDiagnostics(ctx context.Context, in *DiagnosticsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiagnosticsResponse], error)
+	ListDiagnostics(ctx context.Context, in *ListDiagnosticsRequest, opts ...grpc.CallOption) (*ListDiagnosticsResponse, error)
+	// Served by a dedicated gateway handler (GET /v1/export/diagnostics) writing the raw file
+	ExportDiagnostics(ctx context.Context, in *ExportDiagnosticsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDiagnosticsResponse], error)
+	GetAvailabilityReport(ctx context.Context, in *AvailabilityReportRequest, opts ...grpc.CallOption) (*AvailabilityReportResponse, error)
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error)
+	ListCampaigns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCampaignsResponse, error)
+	GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type monitorClient struct {
@@ -89,6 +144,233 @@ func (c *monitorClient) UpdateDevice(ctx context.Context, in *UpdateDeviceReques
 	return out, nil
 }
 
+func (c *monitorClient) DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Monitor_DeleteDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) ImportDevices(ctx context.Context, in *ImportDevicesRequest, opts ...grpc.CallOption) (*ImportDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDevicesResponse)
+	err := c.cc.Invoke(ctx, Monitor_ImportDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) RebootDevice(ctx context.Context, in *RebootDeviceRequest, opts ...grpc.CallOption) (*RebootDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebootDeviceResponse)
+	err := c.cc.Invoke(ctx, Monitor_RebootDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) SetDeviceConfig(ctx context.Context, in *SetDeviceConfigRequest, opts ...grpc.CallOption) (*DeviceConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceConfigResponse)
+	err := c.cc.Invoke(ctx, Monitor_SetDeviceConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) GetDeviceConfig(ctx context.Context, in *GetDeviceConfigRequest, opts ...grpc.CallOption) (*DeviceConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceConfigResponse)
+	err := c.cc.Invoke(ctx, Monitor_GetDeviceConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) DeleteDeviceConfig(ctx context.Context, in *DeleteDeviceConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Monitor_DeleteDeviceConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) ListStatusTransitions(ctx context.Context, in *ListStatusTransitionsRequest, opts ...grpc.CallOption) (*ListStatusTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, Monitor_ListStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) ListDeviceEvents(ctx context.Context, in *ListDeviceEventsRequest, opts ...grpc.CallOption) (*ListDeviceEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeviceEventsResponse)
+	err := c.cc.Invoke(ctx, Monitor_ListDeviceEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) StreamDeviceEvents(ctx context.Context, in *StreamDeviceEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeviceEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Monitor_ServiceDesc.Streams[0], Monitor_StreamDeviceEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamDeviceEventsRequest, DeviceEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_StreamDeviceEventsClient = grpc.ServerStreamingClient[DeviceEvent]
+
+func (c *monitorClient) ListAnomalies(ctx context.Context, in *ListAnomaliesRequest, opts ...grpc.CallOption) (*ListAnomaliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnomaliesResponse)
+	err := c.cc.Invoke(ctx, Monitor_ListAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) StreamAnomalies(ctx context.Context, in *StreamAnomaliesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Anomaly], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Monitor_ServiceDesc.Streams[1], Monitor_StreamAnomalies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamAnomaliesRequest, Anomaly]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_StreamAnomaliesClient = grpc.ServerStreamingClient[Anomaly]
+
+func (c *monitorClient) SetAnomalySensitivity(ctx context.Context, in *SetAnomalySensitivityRequest, opts ...grpc.CallOption) (*AnomalySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalySettingsResponse)
+	err := c.cc.Invoke(ctx, Monitor_SetAnomalySensitivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) GetAnomalySettings(ctx context.Context, in *GetAnomalySettingsRequest, opts ...grpc.CallOption) (*AnomalySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalySettingsResponse)
+	err := c.cc.Invoke(ctx, Monitor_GetAnomalySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) GetVersionInventory(ctx context.Context, in *VersionInventoryRequest, opts ...grpc.CallOption) (*VersionInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VersionInventoryResponse)
+	err := c.cc.Invoke(ctx, Monitor_GetVersionInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) SetCompliancePolicy(ctx context.Context, in *SetCompliancePolicyRequest, opts ...grpc.CallOption) (*CompliancePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompliancePolicyResponse)
+	err := c.cc.Invoke(ctx, Monitor_SetCompliancePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) ListCompliancePolicies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCompliancePoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompliancePoliciesResponse)
+	err := c.cc.Invoke(ctx, Monitor_ListCompliancePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) DeleteCompliancePolicy(ctx context.Context, in *DeleteCompliancePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Monitor_DeleteCompliancePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) ListVersionChanges(ctx context.Context, in *ListVersionChangesRequest, opts ...grpc.CallOption) (*ListVersionChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVersionChangesResponse)
+	err := c.cc.Invoke(ctx, Monitor_ListVersionChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) StreamVersionChanges(ctx context.Context, in *StreamVersionChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VersionChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Monitor_ServiceDesc.Streams[2], Monitor_StreamVersionChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamVersionChangesRequest, VersionChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_StreamVersionChangesClient = grpc.ServerStreamingClient[VersionChange]
+
+func (c *monitorClient) GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForecastResponse)
+	err := c.cc.Invoke(ctx, Monitor_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *monitorClient) GetDiagnostics(ctx context.Context, in *DiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DiagnosticsResponse)
@@ -101,7 +383,7 @@ func (c *monitorClient) GetDiagnostics(ctx context.Context, in *DiagnosticsReque
 
 func (c *monitorClient) StreamDiagnostics(ctx context.Context, in *DiagnosticsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiagnosticsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Monitor_ServiceDesc.Streams[0], Monitor_StreamDiagnostics_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Monitor_ServiceDesc.Streams[3], Monitor_StreamDiagnostics_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +400,85 @@ func (c *monitorClient) StreamDiagnostics(ctx context.Context, in *DiagnosticsRe
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Monitor_StreamDiagnosticsClient = grpc.ServerStreamingClient[DiagnosticsResponse]
 
+func (c *monitorClient) ListDiagnostics(ctx context.Context, in *ListDiagnosticsRequest, opts ...grpc.CallOption) (*ListDiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, Monitor_ListDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) ExportDiagnostics(ctx context.Context, in *ExportDiagnosticsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDiagnosticsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Monitor_ServiceDesc.Streams[4], Monitor_ExportDiagnostics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportDiagnosticsRequest, ExportDiagnosticsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_ExportDiagnosticsClient = grpc.ServerStreamingClient[ExportDiagnosticsResponse]
+
+func (c *monitorClient) GetAvailabilityReport(ctx context.Context, in *AvailabilityReportRequest, opts ...grpc.CallOption) (*AvailabilityReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityReportResponse)
+	err := c.cc.Invoke(ctx, Monitor_GetAvailabilityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampaignResponse)
+	err := c.cc.Invoke(ctx, Monitor_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) ListCampaigns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignsResponse)
+	err := c.cc.Invoke(ctx, Monitor_ListCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, Monitor_GetCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *monitorClient) CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Monitor_CancelCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MonitorServer is the server API for Monitor service.
 // All implementations must embed UnimplementedMonitorServer
 // for forward compatibility.
@@ -126,8 +487,36 @@ type MonitorServer interface {
 	RegisterDevice(context.Context, *RegisterDeviceRequest) (*RegisterDeviceResponse, error)
 	ListDevices(context.Context, *emptypb.Empty) (*ListDevicesResponse, error)
 	UpdateDevice(context.Context, *UpdateDeviceRequest) (*emptypb.Empty, error)
+	DeleteDevice(context.Context, *DeleteDeviceRequest) (*emptypb.Empty, error)
+	ImportDevices(context.Context, *ImportDevicesRequest) (*ImportDevicesResponse, error)
+	RebootDevice(context.Context, *RebootDeviceRequest) (*RebootDeviceResponse, error)
+	SetDeviceConfig(context.Context, *SetDeviceConfigRequest) (*DeviceConfigResponse, error)
+	GetDeviceConfig(context.Context, *GetDeviceConfigRequest) (*DeviceConfigResponse, error)
+	DeleteDeviceConfig(context.Context, *DeleteDeviceConfigRequest) (*emptypb.Empty, error)
+	ListStatusTransitions(context.Context, *ListStatusTransitionsRequest) (*ListStatusTransitionsResponse, error)
+	ListDeviceEvents(context.Context, *ListDeviceEventsRequest) (*ListDeviceEventsResponse, error)
+	StreamDeviceEvents(*StreamDeviceEventsRequest, grpc.ServerStreamingServer[DeviceEvent]) error
+	ListAnomalies(context.Context, *ListAnomaliesRequest) (*ListAnomaliesResponse, error)
+	StreamAnomalies(*StreamAnomaliesRequest, grpc.ServerStreamingServer[Anomaly]) error
+	SetAnomalySensitivity(context.Context, *SetAnomalySensitivityRequest) (*AnomalySettingsResponse, error)
+	GetAnomalySettings(context.Context, *GetAnomalySettingsRequest) (*AnomalySettingsResponse, error)
+	GetVersionInventory(context.Context, *VersionInventoryRequest) (*VersionInventoryResponse, error)
+	SetCompliancePolicy(context.Context, *SetCompliancePolicyRequest) (*CompliancePolicyResponse, error)
+	ListCompliancePolicies(context.Context, *emptypb.Empty) (*ListCompliancePoliciesResponse, error)
+	DeleteCompliancePolicy(context.Context, *DeleteCompliancePolicyRequest) (*emptypb.Empty, error)
+	ListVersionChanges(context.Context, *ListVersionChangesRequest) (*ListVersionChangesResponse, error)
+	StreamVersionChanges(*StreamVersionChangesRequest, grpc.ServerStreamingServer[VersionChange]) error
+	GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error)
 	GetDiagnostics(context.Context, *DiagnosticsRequest) (*DiagnosticsResponse, error)
 	StreamDiagnostics(*DiagnosticsRequest, grpc.ServerStreamingServer[DiagnosticsResponse]) error
+	ListDiagnostics(context.Context, *ListDiagnosticsRequest) (*ListDiagnosticsResponse, error)
+	// Served by a dedicated gateway handler (GET /v1/export/diagnostics) writing the raw file
+	ExportDiagnostics(*ExportDiagnosticsRequest, grpc.ServerStreamingServer[ExportDiagnosticsResponse]) error
+	GetAvailabilityReport(context.Context, *AvailabilityReportRequest) (*AvailabilityReportResponse, error)
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error)
+	ListCampaigns(context.Context, *emptypb.Empty) (*ListCampaignsResponse, error)
+	GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error)
+	CancelCampaign(context.Context, *CancelCampaignRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMonitorServer()
 }
 
@@ -150,12 +539,93 @@ func (UnimplementedMonitorServer) ListDevices(context.Context, *emptypb.Empty) (
 func (UnimplementedMonitorServer) UpdateDevice(context.Context, *UpdateDeviceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevice not implemented")
 }
+func (UnimplementedMonitorServer) DeleteDevice(context.Context, *DeleteDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDevice not implemented")
+}
+func (UnimplementedMonitorServer) ImportDevices(context.Context, *ImportDevicesRequest) (*ImportDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDevices not implemented")
+}
+func (UnimplementedMonitorServer) RebootDevice(context.Context, *RebootDeviceRequest) (*RebootDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootDevice not implemented")
+}
+func (UnimplementedMonitorServer) SetDeviceConfig(context.Context, *SetDeviceConfigRequest) (*DeviceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeviceConfig not implemented")
+}
+func (UnimplementedMonitorServer) GetDeviceConfig(context.Context, *GetDeviceConfigRequest) (*DeviceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceConfig not implemented")
+}
+func (UnimplementedMonitorServer) DeleteDeviceConfig(context.Context, *DeleteDeviceConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeviceConfig not implemented")
+}
+func (UnimplementedMonitorServer) ListStatusTransitions(context.Context, *ListStatusTransitionsRequest) (*ListStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatusTransitions not implemented")
+}
+func (UnimplementedMonitorServer) ListDeviceEvents(context.Context, *ListDeviceEventsRequest) (*ListDeviceEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeviceEvents not implemented")
+}
+func (UnimplementedMonitorServer) StreamDeviceEvents(*StreamDeviceEventsRequest, grpc.ServerStreamingServer[DeviceEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDeviceEvents not implemented")
+}
+func (UnimplementedMonitorServer) ListAnomalies(context.Context, *ListAnomaliesRequest) (*ListAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnomalies not implemented")
+}
+func (UnimplementedMonitorServer) StreamAnomalies(*StreamAnomaliesRequest, grpc.ServerStreamingServer[Anomaly]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAnomalies not implemented")
+}
+func (UnimplementedMonitorServer) SetAnomalySensitivity(context.Context, *SetAnomalySensitivityRequest) (*AnomalySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnomalySensitivity not implemented")
+}
+func (UnimplementedMonitorServer) GetAnomalySettings(context.Context, *GetAnomalySettingsRequest) (*AnomalySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalySettings not implemented")
+}
+func (UnimplementedMonitorServer) GetVersionInventory(context.Context, *VersionInventoryRequest) (*VersionInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersionInventory not implemented")
+}
+func (UnimplementedMonitorServer) SetCompliancePolicy(context.Context, *SetCompliancePolicyRequest) (*CompliancePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompliancePolicy not implemented")
+}
+func (UnimplementedMonitorServer) ListCompliancePolicies(context.Context, *emptypb.Empty) (*ListCompliancePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompliancePolicies not implemented")
+}
+func (UnimplementedMonitorServer) DeleteCompliancePolicy(context.Context, *DeleteCompliancePolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompliancePolicy not implemented")
+}
+func (UnimplementedMonitorServer) ListVersionChanges(context.Context, *ListVersionChangesRequest) (*ListVersionChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersionChanges not implemented")
+}
+func (UnimplementedMonitorServer) StreamVersionChanges(*StreamVersionChangesRequest, grpc.ServerStreamingServer[VersionChange]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamVersionChanges not implemented")
+}
+func (UnimplementedMonitorServer) GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecast not implemented")
+}
 func (UnimplementedMonitorServer) GetDiagnostics(context.Context, *DiagnosticsRequest) (*DiagnosticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnostics not implemented")
 }
 func (UnimplementedMonitorServer) StreamDiagnostics(*DiagnosticsRequest, grpc.ServerStreamingServer[DiagnosticsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamDiagnostics not implemented")
 }
+func (UnimplementedMonitorServer) ListDiagnostics(context.Context, *ListDiagnosticsRequest) (*ListDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiagnostics not implemented")
+}
+func (UnimplementedMonitorServer) ExportDiagnostics(*ExportDiagnosticsRequest, grpc.ServerStreamingServer[ExportDiagnosticsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDiagnostics not implemented")
+}
+func (UnimplementedMonitorServer) GetAvailabilityReport(context.Context, *AvailabilityReportRequest) (*AvailabilityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityReport not implemented")
+}
+func (UnimplementedMonitorServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedMonitorServer) ListCampaigns(context.Context, *emptypb.Empty) (*ListCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedMonitorServer) GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedMonitorServer) CancelCampaign(context.Context, *CancelCampaignRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCampaign not implemented")
+}
 func (UnimplementedMonitorServer) mustEmbedUnimplementedMonitorServer() {}
 func (UnimplementedMonitorServer) testEmbeddedByValue()                 {}
 
@@ -249,17 +719,356 @@ func _Monitor_UpdateDevice_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Monitor_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiagnosticsRequest)
+func _Monitor_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MonitorServer).GetDiagnostics(ctx, in)
+		return srv.(MonitorServer).DeleteDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Monitor_GetDiagnostics_FullMethodName,
+		FullMethod: Monitor_DeleteDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).DeleteDevice(ctx, req.(*DeleteDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_ImportDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ImportDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ImportDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ImportDevices(ctx, req.(*ImportDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_RebootDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).RebootDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_RebootDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).RebootDevice(ctx, req.(*RebootDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_SetDeviceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).SetDeviceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_SetDeviceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).SetDeviceConfig(ctx, req.(*SetDeviceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_GetDeviceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).GetDeviceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_GetDeviceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).GetDeviceConfig(ctx, req.(*GetDeviceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_DeleteDeviceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).DeleteDeviceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_DeleteDeviceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).DeleteDeviceConfig(ctx, req.(*DeleteDeviceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_ListStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ListStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ListStatusTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ListStatusTransitions(ctx, req.(*ListStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_ListDeviceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ListDeviceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ListDeviceEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ListDeviceEvents(ctx, req.(*ListDeviceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_StreamDeviceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDeviceEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MonitorServer).StreamDeviceEvents(m, &grpc.GenericServerStream[StreamDeviceEventsRequest, DeviceEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_StreamDeviceEventsServer = grpc.ServerStreamingServer[DeviceEvent]
+
+func _Monitor_ListAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ListAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ListAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ListAnomalies(ctx, req.(*ListAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_StreamAnomalies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAnomaliesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MonitorServer).StreamAnomalies(m, &grpc.GenericServerStream[StreamAnomaliesRequest, Anomaly]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_StreamAnomaliesServer = grpc.ServerStreamingServer[Anomaly]
+
+func _Monitor_SetAnomalySensitivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnomalySensitivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).SetAnomalySensitivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_SetAnomalySensitivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).SetAnomalySensitivity(ctx, req.(*SetAnomalySensitivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_GetAnomalySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnomalySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).GetAnomalySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_GetAnomalySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).GetAnomalySettings(ctx, req.(*GetAnomalySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_GetVersionInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).GetVersionInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_GetVersionInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).GetVersionInventory(ctx, req.(*VersionInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_SetCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompliancePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).SetCompliancePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_SetCompliancePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).SetCompliancePolicy(ctx, req.(*SetCompliancePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_ListCompliancePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ListCompliancePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ListCompliancePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ListCompliancePolicies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_DeleteCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompliancePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).DeleteCompliancePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_DeleteCompliancePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).DeleteCompliancePolicy(ctx, req.(*DeleteCompliancePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_ListVersionChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ListVersionChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ListVersionChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ListVersionChanges(ctx, req.(*ListVersionChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_StreamVersionChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamVersionChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MonitorServer).StreamVersionChanges(m, &grpc.GenericServerStream[StreamVersionChangesRequest, VersionChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_StreamVersionChangesServer = grpc.ServerStreamingServer[VersionChange]
+
+func _Monitor_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).GetForecast(ctx, req.(*GetForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).GetDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_GetDiagnostics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MonitorServer).GetDiagnostics(ctx, req.(*DiagnosticsRequest))
@@ -278,6 +1087,125 @@ func _Monitor_StreamDiagnostics_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Monitor_StreamDiagnosticsServer = grpc.ServerStreamingServer[DiagnosticsResponse]
 
+func _Monitor_ListDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ListDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ListDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ListDiagnostics(ctx, req.(*ListDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_ExportDiagnostics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDiagnosticsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MonitorServer).ExportDiagnostics(m, &grpc.GenericServerStream[ExportDiagnosticsRequest, ExportDiagnosticsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitor_ExportDiagnosticsServer = grpc.ServerStreamingServer[ExportDiagnosticsResponse]
+
+func _Monitor_GetAvailabilityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailabilityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).GetAvailabilityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_GetAvailabilityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).GetAvailabilityReport(ctx, req.(*AvailabilityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).ListCampaigns(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).GetCampaign(ctx, req.(*GetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Monitor_CancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).CancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Monitor_CancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).CancelCampaign(ctx, req.(*CancelCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Monitor_ServiceDesc is the grpc.ServiceDesc for Monitor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -301,17 +1229,129 @@ var Monitor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDevice",
 			Handler:    _Monitor_UpdateDevice_Handler,
 		},
+		{
+			MethodName: "DeleteDevice",
+			Handler:    _Monitor_DeleteDevice_Handler,
+		},
+		{
+			MethodName: "ImportDevices",
+			Handler:    _Monitor_ImportDevices_Handler,
+		},
+		{
+			MethodName: "RebootDevice",
+			Handler:    _Monitor_RebootDevice_Handler,
+		},
+		{
+			MethodName: "SetDeviceConfig",
+			Handler:    _Monitor_SetDeviceConfig_Handler,
+		},
+		{
+			MethodName: "GetDeviceConfig",
+			Handler:    _Monitor_GetDeviceConfig_Handler,
+		},
+		{
+			MethodName: "DeleteDeviceConfig",
+			Handler:    _Monitor_DeleteDeviceConfig_Handler,
+		},
+		{
+			MethodName: "ListStatusTransitions",
+			Handler:    _Monitor_ListStatusTransitions_Handler,
+		},
+		{
+			MethodName: "ListDeviceEvents",
+			Handler:    _Monitor_ListDeviceEvents_Handler,
+		},
+		{
+			MethodName: "ListAnomalies",
+			Handler:    _Monitor_ListAnomalies_Handler,
+		},
+		{
+			MethodName: "SetAnomalySensitivity",
+			Handler:    _Monitor_SetAnomalySensitivity_Handler,
+		},
+		{
+			MethodName: "GetAnomalySettings",
+			Handler:    _Monitor_GetAnomalySettings_Handler,
+		},
+		{
+			MethodName: "GetVersionInventory",
+			Handler:    _Monitor_GetVersionInventory_Handler,
+		},
+		{
+			MethodName: "SetCompliancePolicy",
+			Handler:    _Monitor_SetCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "ListCompliancePolicies",
+			Handler:    _Monitor_ListCompliancePolicies_Handler,
+		},
+		{
+			MethodName: "DeleteCompliancePolicy",
+			Handler:    _Monitor_DeleteCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "ListVersionChanges",
+			Handler:    _Monitor_ListVersionChanges_Handler,
+		},
+		{
+			MethodName: "GetForecast",
+			Handler:    _Monitor_GetForecast_Handler,
+		},
 		{
 			MethodName: "GetDiagnostics",
 			Handler:    _Monitor_GetDiagnostics_Handler,
 		},
+		{
+			MethodName: "ListDiagnostics",
+			Handler:    _Monitor_ListDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityReport",
+			Handler:    _Monitor_GetAvailabilityReport_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _Monitor_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _Monitor_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _Monitor_GetCampaign_Handler,
+		},
+		{
+			MethodName: "CancelCampaign",
+			Handler:    _Monitor_CancelCampaign_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDeviceEvents",
+			Handler:       _Monitor_StreamDeviceEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAnomalies",
+			Handler:       _Monitor_StreamAnomalies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamVersionChanges",
+			Handler:       _Monitor_StreamVersionChanges_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamDiagnostics",
 			Handler:       _Monitor_StreamDiagnostics_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportDiagnostics",
+			Handler:       _Monitor_ExportDiagnostics_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/monitor/v1/monitor.proto",
 }
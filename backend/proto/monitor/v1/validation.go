@@ -1,6 +1,26 @@
 package monitorv1
 
-import "errors"
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	MaxDiagnosticsLimit = 1000
+	MaxCampaignWaveSize = 1000
+	MinStreamInterval   = 100 * time.Millisecond
+	MaxForecastPeriod   = 90 * 24 * time.Hour
+	MaxForecastTop      = 100
+	MaxVersionLength    = 50
+	MinSnmpPassphrase   = 8
+)
 
 func (r *RegisterDeviceRequest) Validate() error {
 	if r == nil {
@@ -12,12 +32,79 @@ func (r *RegisterDeviceRequest) Validate() error {
 	if r.GetPort() <= 0 || r.GetPort() > 65535 {
 		return errors.New("invalid port in request")
 	}
-	if r.GetPortGateway() <= 0 || r.GetPortGateway() > 65535 {
-		return errors.New("invalid gateway port in request")
-	}
 	if r.GetProtocol() == Protocol_PROTOCOL_UNSPECIFIED {
 		return errors.New("missing protocol in request")
 	}
+	// Agents polled over SNMP and devices served by plugins have no gateway
+	if r.GetProtocol() != Protocol_PROTOCOL_SNMP && r.GetProtocol() != Protocol_PROTOCOL_PLUGIN &&
+		(r.GetPortGateway() <= 0 || r.GetPortGateway() > 65535) {
+		return errors.New("invalid gateway port in request")
+	}
+	// Plugins are addressed by the driver name and do not report the identifier of the device
+	if r.GetProtocol() == Protocol_PROTOCOL_PLUGIN {
+		if len(r.GetDriver()) == 0 {
+			return errors.New("missing driver in request (required by plugin)")
+		}
+		if len(r.GetDeviceId()) == 0 {
+			return errors.New("missing device_id in request (required by plugin)")
+		}
+	}
+	if r.GetProtocol() != Protocol_PROTOCOL_PLUGIN && len(r.GetDriver()) > 0 {
+		return errors.New("driver in request is only supported by plugin")
+	}
+	// Topics of devices publishing to a broker are derived from their identifier
+	if r.GetProtocol() == Protocol_PROTOCOL_MQTT && len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request (required by mqtt)")
+	}
+	// Agents do not report the identifier of the device
+	if r.GetProtocol() == Protocol_PROTOCOL_SNMP {
+		if len(r.GetDeviceId()) == 0 {
+			return errors.New("missing device_id in request (required by snmp)")
+		}
+		if r.GetSnmp() == nil {
+			return errors.New("missing snmp credentials in request")
+		}
+	}
+	if r.GetSnmp() != nil {
+		if err := r.GetSnmp().Validate(); err != nil {
+			return err
+		}
+	}
+	if r.GetSigningAlgorithm() != SigningAlgorithm_SIGNING_ALGORITHM_UNSPECIFIED {
+		if _, err := base64.StdEncoding.DecodeString(r.GetSigningKey()); err != nil ||
+			len(r.GetSigningKey()) == 0 {
+			return errors.New("invalid signing_key in request")
+		}
+	}
+	return nil
+}
+
+func (c *SnmpCredentials) Validate() error {
+	switch c.GetVersion() {
+	case SnmpVersion_SNMP_VERSION_V2C:
+		if len(c.GetCommunity()) == 0 {
+			return errors.New("missing snmp community in request")
+		}
+	case SnmpVersion_SNMP_VERSION_V3:
+		if len(c.GetUsername()) == 0 {
+			return errors.New("missing snmp username in request")
+		}
+		// Passphrases are localized into keys and must have at least 8 characters (RFC 3414)
+		if c.GetAuthProtocol() != SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_UNSPECIFIED &&
+			len(c.GetAuthPassphrase()) < MinSnmpPassphrase {
+			return errors.New("invalid snmp auth_passphrase in request")
+		}
+		if c.GetPrivProtocol() != SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_UNSPECIFIED {
+			if c.GetAuthProtocol() == SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_UNSPECIFIED {
+				return errors.New("snmp priv_protocol requires an auth_protocol in request")
+			}
+			if len(c.GetPrivPassphrase()) < MinSnmpPassphrase {
+				return errors.New("invalid snmp priv_passphrase in request")
+			}
+		}
+	default:
+		return errors.New("missing snmp version in request")
+	}
 	return nil
 }
 
@@ -34,6 +121,94 @@ func (r *UpdateDeviceRequest) Validate() error {
 	return nil
 }
 
+func (r *RebootDeviceRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	if r.Duration != nil && (!r.GetDuration().IsValid() || r.GetDuration().AsDuration() < 0) {
+		return errors.New("invalid duration in request (must be non-negative)")
+	}
+	if r.Timeout != nil && (!r.GetTimeout().IsValid() || r.GetTimeout().AsDuration() <= 0) {
+		return errors.New("invalid timeout in request (must be positive)")
+	}
+	return nil
+}
+
+func (r *SetDeviceConfigRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	config := r.GetConfig()
+	if config == nil {
+		return errors.New("missing config in request")
+	}
+	if config.StreamInterval != nil &&
+		(!config.GetStreamInterval().IsValid() || config.GetStreamInterval().AsDuration() < MinStreamInterval) {
+		return fmt.Errorf("invalid stream_interval in request (minimum %s)", MinStreamInterval)
+	}
+	for key := range config.GetLabels() {
+		if len(key) == 0 {
+			return errors.New("invalid labels in request (empty key)")
+		}
+	}
+	for key := range config.GetConfig() {
+		if len(key) == 0 {
+			return errors.New("invalid config in request (empty key)")
+		}
+	}
+	return nil
+}
+
+func (r *GetDeviceConfigRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	return nil
+}
+
+func (r *DeleteDeviceRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	return nil
+}
+
+func (r *ImportDevicesRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetData()) == 0 {
+		return errors.New("missing data in request")
+	}
+	if _, ok := InventoryFormat_name[int32(r.GetFormat())]; !ok ||
+		r.GetFormat() == InventoryFormat_INVENTORY_FORMAT_UNSPECIFIED {
+		return errors.New("invalid format in request")
+	}
+	return nil
+}
+
+func (r *DeleteDeviceConfigRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	return nil
+}
+
 func (r *DiagnosticsRequest) Validate() error {
 	if r == nil {
 		return errors.New("empty request")
@@ -43,3 +218,292 @@ func (r *DiagnosticsRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r *ListDiagnosticsRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	if r.GetLimit() < 0 || r.GetLimit() > MaxDiagnosticsLimit {
+		return fmt.Errorf("invalid limit in request (maximum %d)", MaxDiagnosticsLimit)
+	}
+	if r.From != nil && r.To != nil && !r.GetFrom().AsTime().Before(r.GetTo().AsTime()) {
+		return errors.New("invalid time range in request (from must be before to)")
+	}
+	return nil
+}
+
+func (r *ListStatusTransitionsRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	if r.GetLimit() < 0 || r.GetLimit() > MaxDiagnosticsLimit {
+		return fmt.Errorf("invalid limit in request (maximum %d)", MaxDiagnosticsLimit)
+	}
+	if r.From != nil && r.To != nil && !r.GetFrom().AsTime().Before(r.GetTo().AsTime()) {
+		return errors.New("invalid time range in request (from must be before to)")
+	}
+	return nil
+}
+
+func (r *ListDeviceEventsRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if err := validateEventFilter(r.GetTypes(), r.GetSeverity()); err != nil {
+		return err
+	}
+	if r.GetLimit() < 0 || r.GetLimit() > MaxDiagnosticsLimit {
+		return fmt.Errorf("invalid limit in request (maximum %d)", MaxDiagnosticsLimit)
+	}
+	if r.From != nil && r.To != nil && !r.GetFrom().AsTime().Before(r.GetTo().AsTime()) {
+		return errors.New("invalid time range in request (from must be before to)")
+	}
+	return nil
+}
+
+func (r *StreamDeviceEventsRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	return validateEventFilter(r.GetTypes(), r.GetSeverity())
+}
+
+func (r *ListAnomaliesRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if r.GetLimit() < 0 || r.GetLimit() > MaxDiagnosticsLimit {
+		return fmt.Errorf("invalid limit in request (maximum %d)", MaxDiagnosticsLimit)
+	}
+	if r.From != nil && r.To != nil && !r.GetFrom().AsTime().Before(r.GetTo().AsTime()) {
+		return errors.New("invalid time range in request (from must be before to)")
+	}
+	return nil
+}
+
+func (r *StreamAnomaliesRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	return nil
+}
+
+func (r *SetAnomalySensitivityRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	if _, ok := Sensitivity_name[int32(r.GetSensitivity())]; !ok ||
+		r.GetSensitivity() == Sensitivity_SENSITIVITY_UNSPECIFIED {
+		return errors.New("invalid sensitivity in request")
+	}
+	return nil
+}
+
+func (r *GetAnomalySettingsRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetDeviceId()) == 0 {
+		return errors.New("missing device_id in request")
+	}
+	return nil
+}
+
+func (r *VersionInventoryRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if _, ok := VersionGrouping_name[int32(r.GetGroupBy())]; !ok {
+		return errors.New("invalid group_by in request")
+	}
+	if _, ok := VersionComponent_name[int32(r.GetComponent())]; !ok {
+		return errors.New("invalid component in request")
+	}
+	if len(r.GetBelow()) > MaxVersionLength {
+		return fmt.Errorf("invalid below in request (maximum %d characters)", MaxVersionLength)
+	}
+	return nil
+}
+
+func (r *SetCompliancePolicyRequest) Validate() error {
+	if r == nil || r.GetPolicy() == nil {
+		return errors.New("empty request")
+	}
+	policy := r.GetPolicy()
+	if len(policy.GetName()) == 0 || strings.Contains(policy.GetName(), "/") {
+		return errors.New("invalid name in policy")
+	}
+	if _, ok := VersionComponent_name[int32(policy.GetComponent())]; !ok ||
+		policy.GetComponent() == VersionComponent_VERSION_COMPONENT_UNSPECIFIED {
+		return errors.New("invalid component in policy")
+	}
+	if policy.GetMinVersion() == "" && len(policy.GetAllow()) == 0 && len(policy.GetDeny()) == 0 {
+		return errors.New("missing min_version, allow or deny in policy")
+	}
+	if len(policy.GetMinVersion()) > MaxVersionLength {
+		return fmt.Errorf("invalid min_version in policy (maximum %d characters)", MaxVersionLength)
+	}
+	for _, pattern := range slices.Concat(policy.GetAllow(), policy.GetDeny()) {
+		if _, err := path.Match(pattern, ""); pattern == "" || err != nil {
+			return fmt.Errorf("invalid version pattern '%s' in policy", pattern)
+		}
+	}
+	return nil
+}
+
+func (r *DeleteCompliancePolicyRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetName()) == 0 {
+		return errors.New("missing name in request")
+	}
+	return nil
+}
+
+func (r *ListVersionChangesRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if _, ok := VersionComponent_name[int32(r.GetComponent())]; !ok {
+		return errors.New("invalid component in request")
+	}
+	if r.GetLimit() < 0 || r.GetLimit() > MaxDiagnosticsLimit {
+		return fmt.Errorf("invalid limit in request (maximum %d)", MaxDiagnosticsLimit)
+	}
+	if r.From != nil && r.To != nil && !r.GetFrom().AsTime().Before(r.GetTo().AsTime()) {
+		return errors.New("invalid time range in request (from must be before to)")
+	}
+	return nil
+}
+
+func (r *StreamVersionChangesRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if _, ok := VersionComponent_name[int32(r.GetComponent())]; !ok {
+		return errors.New("invalid component in request")
+	}
+	return nil
+}
+
+func (r *GetForecastRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	switch r.GetMetric() {
+	case Metric_METRIC_UNSPECIFIED, Metric_METRIC_CPU, Metric_METRIC_MEMORY, Metric_METRIC_DISK,
+		Metric_METRIC_TEMPERATURE:
+	default:
+		return errors.New("invalid metric in request (cpu, memory, disk or temperature)")
+	}
+	if _, ok := ForecastModel_name[int32(r.GetModel())]; !ok {
+		return errors.New("invalid model in request")
+	}
+	if r.GetThreshold() < 0 {
+		return errors.New("invalid threshold in request")
+	}
+	periods := []struct {
+		name  string
+		value *durationpb.Duration
+	}{{"history", r.History}, {"horizon", r.Horizon}}
+	for _, period := range periods {
+		if period.value != nil &&
+			(period.value.AsDuration() < time.Hour || period.value.AsDuration() > MaxForecastPeriod) {
+			return fmt.Errorf("invalid %s in request (between 1h and %s)", period.name, MaxForecastPeriod)
+		}
+	}
+	if r.GetTop() < 0 || r.GetTop() > MaxForecastTop {
+		return fmt.Errorf("invalid top in request (maximum %d)", MaxForecastTop)
+	}
+	return nil
+}
+
+func (r *ExportDiagnosticsRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if r.From != nil && r.To != nil && !r.GetFrom().AsTime().Before(r.GetTo().AsTime()) {
+		return errors.New("invalid time range in request (from must be before to)")
+	}
+	if slices.Contains(r.GetDeviceIds(), "") {
+		return errors.New("invalid device_ids in request (empty identifier)")
+	}
+	if _, ok := ExportFormat_name[int32(r.GetFormat())]; !ok {
+		return errors.New("invalid format in request")
+	}
+	return nil
+}
+
+func (r *AvailabilityReportRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if r.From != nil && r.To != nil && !r.GetFrom().AsTime().Before(r.GetTo().AsTime()) {
+		return errors.New("invalid time range in request (from must be before to)")
+	}
+	if slices.Contains(r.GetDeviceIds(), "") {
+		return errors.New("invalid device_ids in request (empty identifier)")
+	}
+	return nil
+}
+
+func (r *CreateCampaignRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetTargetVersion()) == 0 {
+		return errors.New("missing target_version in request")
+	}
+	if r.GetWaveSize() < 0 || r.GetWaveSize() > MaxCampaignWaveSize {
+		return fmt.Errorf("invalid wave_size in request (maximum %d)", MaxCampaignWaveSize)
+	}
+	if r.GetMaxUnavailable() < 0 {
+		return errors.New("invalid max_unavailable in request")
+	}
+	if r.WaveTimeout != nil && (!r.GetWaveTimeout().IsValid() || r.GetWaveTimeout().AsDuration() <= 0) {
+		return errors.New("invalid wave_timeout in request (must be positive)")
+	}
+	return nil
+}
+
+func (r *GetCampaignRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetCampaignId()) == 0 {
+		return errors.New("missing campaign_id in request")
+	}
+	return nil
+}
+
+func (r *CancelCampaignRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetCampaignId()) == 0 {
+		return errors.New("missing campaign_id in request")
+	}
+	return nil
+}
+
+func validateEventFilter(types []EventType, severity EventSeverity) error {
+	for _, t := range types {
+		if _, ok := EventType_name[int32(t)]; !ok || t == EventType_EVENT_TYPE_UNSPECIFIED {
+			return errors.New("invalid types in request")
+		}
+	}
+	if _, ok := EventSeverity_name[int32(severity)]; !ok {
+		return errors.New("invalid severity in request")
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrorTrailingData = errors.New("unexpected data after JSON value")
+
+// Canonicalize writes a normalized form of a single JSON value: insignificant whitespace
+// is removed, object keys are sorted and non-integer numbers use their shortest form,
+// so semantically equal documents produce identical bytes regardless of the encoder.
+func Canonicalize(r io.Reader, w io.Writer) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return ErrorTrailingData
+	}
+	return encode(w, value)
+}
+
+func encode(w io.Writer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		_, err := io.WriteString(w, "null")
+		return err
+	case bool:
+		_, err := io.WriteString(w, strconv.FormatBool(v))
+		return err
+	case json.Number:
+		_, err := io.WriteString(w, number(v))
+		return err
+	case string:
+		return encodeString(w, v)
+	case []any:
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+		for i, item := range v {
+			if i > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			if err := encode(w, item); err != nil {
+				return err
+			}
+		}
+		_, err := io.WriteString(w, "]")
+		return err
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		if _, err := io.WriteString(w, "{"); err != nil {
+			return err
+		}
+		for i, key := range keys {
+			if i > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			if err := encodeString(w, key); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, ":"); err != nil {
+				return err
+			}
+			if err := encode(w, v[key]); err != nil {
+				return err
+			}
+		}
+		_, err := io.WriteString(w, "}")
+		return err
+	default:
+		return errors.New("unsupported JSON value")
+	}
+}
+
+func encodeString(w io.Writer, value string) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return err
+	}
+	_, err := w.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	return err
+}
+
+func number(value json.Number) string {
+	text := value.String()
+	if !strings.ContainsAny(text, ".eE") {
+		return text
+	}
+	f, err := value.Float64()
+	if err != nil {
+		return text
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -1,27 +1,162 @@
 package main
 
 import (
+	"bufio"
 	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
 	"encoding/hex"
+	"flag"
 	"fmt"
+	"hash"
 	"io"
 	"os"
+	"slices"
+	"strings"
 )
 
-const Version = "v1.0.0"
+var Version = "v1.1.0"
+
+const (
+	ExitOK       = 0
+	ExitMismatch = 1
+	ExitError    = 2
+)
+
+const DefaultAlgorithm = "sha256"
+
+var algorithms = map[string]func() hash.Hash{
+	"sha256":   sha256.New,
+	"sha384":   sha512.New384,
+	"sha512":   sha512.New,
+	"sha3-256": func() hash.Hash { return sha3.New256() },
+	"sha3-512": func() hash.Hash { return sha3.New512() },
+}
+
+type options struct {
+	algorithm string
+	expected  string
+	canonical bool
+	version   bool
+	inputs    []string
+}
 
 func main() {
-	if len(os.Args) > 1 && os.Args[1] == "--version" {
-		fmt.Printf("Checksum Generator %s", Version)
-		os.Exit(0)
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	opts, err := parse(args, stderr)
+	if err != nil {
+		return ExitError
+	}
+	if opts.version {
+		fmt.Fprintf(stdout, "Checksum Generator %s", Version)
+		return ExitOK
+	}
+	newHash, ok := algorithms[opts.algorithm]
+	if !ok {
+		fmt.Fprintf(stderr, "Error: unsupported algorithm %q (supported: %s)\n", opts.algorithm, supported())
+		return ExitError
+	}
+	if opts.expected != "" && len(opts.inputs) > 1 {
+		fmt.Fprintln(stderr, "Error: --verify accepts a single input")
+		return ExitError
+	}
+
+	// Stdin (default)
+	if len(opts.inputs) == 0 {
+		checksum, err := digest(stdin, newHash(), opts.canonical)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+			return ExitError
+		}
+		if opts.expected != "" {
+			return verify(checksum, opts.expected, stderr)
+		}
+		fmt.Fprint(stdout, checksum)
+		return ExitOK
+	}
+
+	// Files ("-" reads from stdin)
+	code := ExitOK
+	for _, input := range opts.inputs {
+		checksum, err := digestFile(input, stdin, newHash(), opts.canonical)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error reading input %s: %v\n", input, err)
+			code = ExitError
+			continue
+		}
+		if opts.expected != "" {
+			return verify(checksum, opts.expected, stderr)
+		}
+		fmt.Fprintf(stdout, "%s  %s\n", checksum, input)
 	}
-	data, err := io.ReadAll(os.Stdin)
+	return code
+}
+
+func parse(args []string, stderr io.Writer) (options, error) {
+	var opts options
+	fs := flag.NewFlagSet("checksum", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.algorithm, "algo", DefaultAlgorithm, "hash algorithm ("+supported()+")")
+	fs.StringVar(&opts.expected, "verify", "", "verify the input against an expected checksum")
+	fs.BoolVar(&opts.canonical, "canonical", false, "normalize JSON input before hashing")
+	fs.BoolVar(&opts.version, "version", false, "print version")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: checksum [--algo name] [--canonical] [--verify checksum] [file ...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+	opts.algorithm = strings.ToLower(opts.algorithm)
+	opts.expected = strings.ToLower(strings.TrimSpace(opts.expected))
+	opts.inputs = fs.Args()
+	return opts, nil
+}
+
+func digestFile(path string, stdin io.Reader, h hash.Hash, canonical bool) (string, error) {
+	if path == "-" {
+		return digest(stdin, h, canonical)
+	}
+	file, err := os.Open(path)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading input: %v\n", err)
-		os.Exit(1)
+		return "", err
+	}
+	defer file.Close() // nolint:errcheck
+	return digest(file, h, canonical)
+}
+
+func digest(r io.Reader, h hash.Hash, canonical bool) (string, error) {
+	reader := bufio.NewReader(r)
+	if canonical {
+		writer := bufio.NewWriter(h)
+		if err := Canonicalize(reader, writer); err != nil {
+			return "", err
+		}
+		if err := writer.Flush(); err != nil {
+			return "", err
+		}
+	} else if _, err := io.Copy(h, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func verify(actual, expected string, stderr io.Writer) int {
+	if actual != expected {
+		fmt.Fprintf(stderr, "checksum mismatch: expected %s, got %s\n", expected, actual)
+		return ExitMismatch
+	}
+	return ExitOK
+}
+
+func supported() string {
+	names := make([]string, 0, len(algorithms))
+	for name := range algorithms {
+		names = append(names, name)
 	}
-	hash := sha256.Sum256(data)
-	checksum := hex.EncodeToString(hash[:])
-	fmt.Print(checksum)
-	os.Exit(0)
+	slices.Sort(names)
+	return strings.Join(names, ", ")
 }
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func sum(data string) string {
+	digest := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(digest[:])
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "diagnostics.json")
+	if err := os.WriteFile(file, []byte(`{"b": 1, "a": [true, null]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	canonical := sum(`{"a":[true,null],"b":1}`)
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			name:   "should print checksum of stdin",
+			stdin:  "payload",
+			code:   ExitOK,
+			stdout: sum("payload"),
+		},
+		{
+			name:   "should print checksum of canonical stdin",
+			args:   []string{"--canonical"},
+			stdin:  "{\n  \"b\": 1,\n  \"a\": [true, null]\n}\n",
+			code:   ExitOK,
+			stdout: canonical,
+		},
+		{
+			name:   "should print checksum of canonical file",
+			args:   []string{"--canonical", file},
+			code:   ExitOK,
+			stdout: canonical + "  " + file + "\n",
+		},
+		{
+			name:   "should return error due to invalid canonical json",
+			args:   []string{"--canonical"},
+			stdin:  `{"a": `,
+			code:   ExitError,
+			stderr: "Error reading input",
+		},
+		{
+			name:   "should return error due to trailing canonical data",
+			args:   []string{"--canonical"},
+			stdin:  `{"a": 1} {"b": 2}`,
+			code:   ExitError,
+			stderr: ErrorTrailingData.Error(),
+		},
+		{
+			name:  "should match verified checksum",
+			args:  []string{"--verify", sum("payload")},
+			stdin: "payload",
+			code:  ExitOK,
+		},
+		{
+			name:  "should match verified checksum (uppercase, canonical file)",
+			args:  []string{"--canonical", "--verify", strings.ToUpper(canonical), file},
+			code:  ExitOK,
+			stdin: "",
+		},
+		{
+			name:   "should return mismatch of verified checksum",
+			args:   []string{"--verify", sum("other")},
+			stdin:  "payload",
+			code:   ExitMismatch,
+			stderr: "checksum mismatch",
+		},
+		{
+			name:   "should return mismatch of verified canonical checksum",
+			args:   []string{"--canonical", "--verify", sum(`{"b": 1, "a": [true, null]}`), file},
+			code:   ExitMismatch,
+			stderr: "checksum mismatch",
+		},
+		{
+			name:   "should return error due to verification of multiple inputs",
+			args:   []string{"--verify", canonical, file, file},
+			code:   ExitError,
+			stderr: "--verify accepts a single input",
+		},
+		{
+			name:   "should return error due to missing verified file",
+			args:   []string{"--verify", canonical, filepath.Join(dir, "missing.json")},
+			code:   ExitError,
+			stderr: "Error reading input",
+		},
+		{
+			name:   "should return error due to unsupported algorithm",
+			args:   []string{"--algo", "md5"},
+			code:   ExitError,
+			stderr: "unsupported algorithm",
+		},
+		{
+			name: "should return error due to unknown flag",
+			args: []string{"--unknown"},
+			code: ExitError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.code {
+				t.Fatalf("expected exit status %d, got %d (%s)", tt.code, code, stderr.String())
+			}
+			if tt.stdout != "" && stdout.String() != tt.stdout {
+				t.Errorf("expected output %q, got %q", tt.stdout, stdout.String())
+			}
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("expected error output containing %q, got %q", tt.stderr, stderr.String())
+			}
+		})
+	}
+}
@@ -7,14 +7,22 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
 	"github.com/emil-j-olsson/ubiquiti/device/internal/cache"
 	"github.com/emil-j-olsson/ubiquiti/device/internal/checksum"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/emulator"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/fault"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/firmware"
 	"github.com/emil-j-olsson/ubiquiti/device/internal/logging"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/publisher"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/reboot"
 	"github.com/emil-j-olsson/ubiquiti/device/internal/server"
 	"github.com/emil-j-olsson/ubiquiti/device/internal/service"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/signature"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/simulation"
 	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
 	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -70,28 +78,148 @@ func run() error {
 	defer cancel()
 
 	// Checksum
-	generator := checksum.NewGenerator(config.ChecksumBinaryPath)
+	generator := checksum.NewGenerator(config.ChecksumBinaryPath, config.ChecksumAlgorithm)
 
-	// Application Layer
-	deviceState := cache.NewDeviceState(config)
-	deviceService := service.NewDeviceService(deviceState, generator, logger)
-	deviceServer := server.NewDeviceServer(deviceService, logger)
+	// Metrics & Simulation
+	if config.Simulation.Enabled && config.MetricsMode == types.MetricsModeRuntime {
+		logger.Warn("DEVICE_SIMULATION_ENABLED is deprecated, use DEVICE_METRICS_MODE=simulated")
+		config.MetricsMode = types.MetricsModeSimulated
+	}
+	var collector service.MetricsCollector = service.NewRuntimeCollector()
+	if config.MetricsMode == types.MetricsModeHost {
+		host, err := service.NewHostCollector(
+			config.MetricsProcPath,
+			config.MetricsSysPath,
+			config.MetricsDiskPath,
+		)
+		if err != nil {
+			return err
+		}
+		collector = host
+	}
+	config.Simulation.Enabled = config.MetricsMode == types.MetricsModeSimulated
+	if config.Simulation.ProfileFile != "" {
+		if err := simulation.LoadProfileFile(config.Simulation.ProfileFile, &config.Simulation); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("metrics collection configured",
+		zap.String("mode", config.MetricsMode.String()),
+		zap.Bool("simulation", config.Simulation.Enabled),
+	)
+
+	// Fault Injection
+	var faults []types.Fault
+	if config.FaultProfileFile != "" {
+		var err error
+		if faults, err = fault.LoadProfileFile(config.FaultProfileFile); err != nil {
+			return err
+		}
+	}
+
+	// Devices (Emulator)
+	configs, err := emulator.Devices(config)
+	if err != nil {
+		return err
+	}
+	devices := make([]*device, len(configs))
+	manifest := &emulator.Manifest{Devices: make([]emulator.Entry, len(configs))}
+	for i, deviceConfig := range configs {
+		deviceLogger := logger
+		if config.Emulator.Enabled() {
+			deviceLogger = logger.With(zap.String("device_id", deviceConfig.Identifier))
+		}
+		if devices[i], err = newDevice(deviceConfig, collector, generator, deviceLogger); err != nil {
+			return err
+		}
+		manifest.Devices[i] = emulator.NewEntry(
+			deviceConfig,
+			config.Emulator.Host,
+			devices[i].signer.VerificationKey(),
+		)
+	}
+	if config.Emulator.Enabled() {
+		logger.Info("emulator configured", zap.Int("devices", len(devices)))
+		if err := manifest.Write(config.Emulator.ManifestOutput); err != nil {
+			return err
+		}
+	}
 
 	// Server Lifecycle
 	g, gctx := errgroup.WithContext(ctx)
-	g.Go(func() error {
-		return startServer(gctx, config, deviceServer, logger)
-	})
-	g.Go(func() error {
-		return startGateway(gctx, config, logger)
-	})
-	deviceState.UpdateState(func(ds *types.DeviceState) {
-		ds.DeviceStatus = types.DeviceStatusHealthy
-	})
+	for _, d := range devices {
+		g.Go(func() error {
+			return startServer(gctx, d.config, d.server, d.injector, d.logger)
+		})
+		g.Go(func() error {
+			return startGateway(gctx, d.config, manifest, d.logger)
+		})
+		if slices.Contains(d.config.SupportedProtocols, types.ProtocolMqtt) {
+			g.Go(func() error {
+				return startPublisher(gctx, d.config, d.logger)
+			})
+		}
+		d.state.UpdateState(func(ds *types.DeviceState) {
+			ds.DeviceStatus = types.DeviceStatusHealthy
+		})
+		for _, f := range faults {
+			d.injector.InjectFault(f)
+		}
+	}
 	return g.Wait()
 }
 
-func startServer(ctx context.Context, config types.Config, srv *server.Server, logger *zap.Logger) error {
+// Device (application layer of a single device)
+type device struct {
+	config   types.Config
+	state    *cache.State
+	injector *fault.Injector
+	signer   *signature.Signer
+	server   *server.Server
+	logger   *zap.Logger
+}
+
+func newDevice(
+	config types.Config,
+	collector service.MetricsCollector,
+	generator *checksum.Generator,
+	logger *zap.Logger,
+) (*device, error) {
+	signer, err := signature.NewSigner(config.Signing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer (%s): %w", config.Identifier, err)
+	}
+	deviceState := cache.NewDeviceState(config)
+	injector := fault.NewInjector(deviceState, logger)
+	deviceService := service.NewDeviceService(
+		deviceState,
+		collector,
+		simulation.NewEngine(config.Simulation),
+		injector,
+		firmware.NewUpgrader(config.Firmware, deviceState, logger),
+		reboot.NewRebooter(config.RebootDuration, deviceState, logger),
+		generator,
+		signer,
+		logger,
+	)
+	return &device{
+		config:   config,
+		state:    deviceState,
+		injector: injector,
+		signer:   signer,
+		server:   server.NewDeviceServer(deviceService, logger),
+		logger:   logger,
+	}, nil
+}
+
+func startServer(
+	ctx context.Context,
+	config types.Config,
+	srv *server.Server,
+	faults server.FaultProvider,
+	logger *zap.Logger,
+) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Port))
 	if err != nil {
 		return fmt.Errorf("failed to register listener on port %d: %w", config.Port, err)
@@ -100,10 +228,12 @@ func startServer(ctx context.Context, config types.Config, srv *server.Server, l
 		grpc.ChainUnaryInterceptor(
 			server.UnaryLoggingInterceptor(logger),
 			server.UnaryRecoveryInterceptor(logger),
+			server.UnaryFaultInterceptor(faults),
 		),
 		grpc.ChainStreamInterceptor(
 			server.StreamLoggingInterceptor(logger),
 			server.StreamRecoveryInterceptor(logger),
+			server.StreamFaultInterceptor(faults),
 		),
 	)
 	devicev1.RegisterDeviceServer(grpcServer, srv)
@@ -118,11 +248,33 @@ func startServer(ctx context.Context, config types.Config, srv *server.Server, l
 	return grpcServer.Serve(lis)
 }
 
-func startGateway(ctx context.Context, config types.Config, logger *zap.Logger) error {
-	mux := runtime.NewServeMux()
+func startGateway(
+	ctx context.Context,
+	config types.Config,
+	manifest *emulator.Manifest,
+	logger *zap.Logger,
+) error {
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	endpoint := fmt.Sprintf("%s:%d", config.GatewayHost, config.Port)
-
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create client (gateway): %w", err)
+	}
+	defer conn.Close() //nolint:errcheck
+	mux := runtime.NewServeMux(
+		runtime.WithErrorHandler(server.GatewayErrorHandler),
+		runtime.WithMiddlewares(server.StreamMiddleware(devicev1.NewDeviceClient(conn))),
+	)
+	err = mux.HandlePath(
+		http.MethodGet,
+		"/v1/manifest",
+		func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			manifest.Handler()(w, r)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register manifest handler (gateway): %w", err)
+	}
 	if err := devicev1.RegisterDeviceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
 		return fmt.Errorf("failed to register handler (gateway): %w", err)
 	}
@@ -146,3 +298,15 @@ func startGateway(ctx context.Context, config types.Config, logger *zap.Logger)
 	}
 	return nil
 }
+
+func startPublisher(ctx context.Context, config types.Config, logger *zap.Logger) error {
+	endpoint := fmt.Sprintf("%s:%d", config.GatewayHost, config.Port)
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to create client (mqtt): %w", err)
+	}
+	defer conn.Close() //nolint:errcheck
+	logger.Info("publisher started (mqtt)", zap.String("broker", config.MQTT.Broker))
+	client := devicev1.NewDeviceClient(conn)
+	return publisher.NewPublisher(client, config.MQTT, config.Identifier, logger).Run(ctx)
+}
@@ -0,0 +1,225 @@
+// Package gateway serves server-streaming RPCs of a grpc-gateway as Server-Sent Events or over
+// WebSocket, events are identified by the timestamp of the message they carry.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Interval of the comments that keep idle event streams from being closed by proxies
+	DefaultKeepAliveInterval time.Duration = 15 * time.Second
+
+	HeaderLastEventID = "Last-Event-ID"
+)
+
+var (
+	ErrorForbiddenOrigin = errors.New("websocket origin not allowed")
+
+	marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+)
+
+// Event is a marshalled message of a stream or the error that ended it
+type Event struct {
+	ID   string
+	Data []byte
+	Err  error
+}
+
+// NextFunc returns the next message of a stream and its event id, io.EOF ends the stream
+type NextFunc func() (string, proto.Message, error)
+
+// Events marshals the messages of a stream until it ends, an error ends the stream unless it is
+// caused by the closed connection.
+func Events(ctx context.Context, next NextFunc) <-chan Event {
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+		for {
+			var event Event
+			id, message, err := next()
+			switch {
+			case errors.Is(err, io.EOF):
+				return
+			case err != nil:
+				event.Err = err
+			default:
+				event.ID = id
+				event.Data, event.Err = marshalOptions.Marshal(message)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- event:
+			}
+			if event.Err != nil {
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// Requested reports whether the stream is requested as Server-Sent Events or over WebSocket
+func Requested(r *http.Request) bool {
+	return IsWebSocket(r) || AcceptsEvents(r)
+}
+
+// Serve serves the events over WebSocket if the request is an upgrade and as Server-Sent Events
+// otherwise, cancel is called once a WebSocket is closed by the client.
+func Serve(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	w http.ResponseWriter,
+	r *http.Request,
+	name string,
+	events <-chan Event,
+) {
+	if IsWebSocket(r) {
+		ServeWebSocket(cancel, w, r, events)
+		return
+	}
+	ServeEvents(ctx, w, name, events, DefaultKeepAliveInterval)
+}
+
+// ServeEvents writes each message as an event of the given name and a failure as an error
+// event, comments are written while the stream is idle for the keep-alive interval.
+func ServeEvents(
+	ctx context.Context,
+	w http.ResponseWriter,
+	name string,
+	events <-chan Event,
+	keepAlive time.Duration,
+) {
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	ticker := time.NewTicker(keepAlive)
+	defer ticker.Stop()
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.Err != nil {
+				data, _ := marshalOptions.Marshal(status.Convert(event.Err).Proto())
+				_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+				_ = rc.Flush()
+				return
+			}
+			if event.ID != "" {
+				_, err = fmt.Fprintf(w, "id: %s\n", event.ID)
+			}
+			if err == nil {
+				_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, event.Data)
+			}
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// ServeWebSocket sends each message as a text message in the format of the chunks of the
+// gateway ({"result":...} or {"error":...}). Browsers may only connect from the origin of the
+// gateway, clients without an Origin header are accepted.
+func ServeWebSocket(
+	cancel context.CancelFunc,
+	w http.ResponseWriter,
+	r *http.Request,
+	events <-chan Event,
+) {
+	websocket.Server{
+		Handshake: checkOrigin,
+		Handler: func(conn *websocket.Conn) {
+			// Clients send no messages, reading only observes the connection being closed
+			go func() {
+				_, _ = io.Copy(io.Discard, conn)
+				cancel()
+			}()
+			for event := range events {
+				var message string
+				if event.Err != nil {
+					data, _ := marshalOptions.Marshal(status.Convert(event.Err).Proto())
+					message = fmt.Sprintf(`{"error":%s}`, data)
+				} else {
+					message = fmt.Sprintf(`{"result":%s}`, event.Data)
+				}
+				if err := websocket.Message.Send(conn, message); err != nil || event.Err != nil {
+					return
+				}
+			}
+		},
+	}.ServeHTTP(w, r)
+}
+
+// checkOrigin accepts a missing origin or an origin of the host of the request
+func checkOrigin(config *websocket.Config, r *http.Request) error {
+	origin, err := websocket.Origin(config, r)
+	if err != nil {
+		return err
+	}
+	if origin == nil {
+		return nil
+	}
+	config.Origin = origin
+	if !strings.EqualFold(origin.Host, r.Host) {
+		return fmt.Errorf("%w: %s", ErrorForbiddenOrigin, origin)
+	}
+	return nil
+}
+
+func IsWebSocket(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+func AcceptsEvents(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// LastEventID returns the time of the last event a client received (Last-Event-ID header or
+// last_event_id parameter), zero if the client is not resuming.
+func LastEventID(r *http.Request) (time.Time, error) {
+	value := r.Header.Get(HeaderLastEventID)
+	if value == "" {
+		value = r.URL.Query().Get("last_event_id")
+	}
+	if value == "" {
+		return time.Time{}, nil
+	}
+	last, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid last event id in request: %w", err)
+	}
+	return last, nil
+}
+
+func EventID(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	return timestamp.AsTime().UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,265 @@
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// compact removes the whitespace of json, the output of protojson is deliberately unstable
+func compact(t *testing.T, data string) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(data)); err != nil {
+		t.Fatalf("expected json, got %q: %v", data, err)
+	}
+	return buf.String()
+}
+
+// events returns a closed channel of the events
+func events(values ...Event) <-chan Event {
+	ch := make(chan Event, len(values))
+	for _, value := range values {
+		ch <- value
+	}
+	close(ch)
+	return ch
+}
+
+func TestEvents(t *testing.T) {
+	failure := status.Error(codes.Unavailable, "device unavailable")
+	tests := []struct {
+		name     string
+		messages []string
+		err      error
+		expected []Event
+	}{
+		{
+			name:     "should marshal messages until end of stream",
+			messages: []string{"a", "b"},
+			err:      io.EOF,
+			expected: []Event{{ID: "0", Data: []byte(`"a"`)}, {ID: "1", Data: []byte(`"b"`)}},
+		},
+		{
+			name:     "should end stream with error",
+			messages: []string{"a"},
+			err:      failure,
+			expected: []Event{{ID: "0", Data: []byte(`"a"`)}, {Err: failure}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent int
+			next := func() (string, proto.Message, error) {
+				if sent == len(tt.messages) {
+					return "", nil, tt.err
+				}
+				sent++
+				return strconv.Itoa(sent - 1), wrapperspb.String(tt.messages[sent-1]), nil
+			}
+			var result []Event
+			for event := range Events(context.Background(), next) {
+				result = append(result, event)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("expected events %v, got %v", tt.expected, result)
+			}
+			for i, expected := range tt.expected {
+				if result[i].ID != expected.ID || string(result[i].Data) != string(expected.Data) ||
+					!errors.Is(result[i].Err, expected.Err) {
+					t.Errorf("expected event %v, got %v", expected, result[i])
+				}
+			}
+		})
+	}
+}
+
+func TestServeEvents(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ServeEvents(context.Background(), recorder, "diagnostics", events(
+		Event{ID: "2026-01-01T00:00:00Z", Data: []byte(`{"a":1}`)},
+		Event{Data: []byte(`{"a":2}`)},
+		Event{Err: status.Error(codes.NotFound, "device not found")},
+		Event{Data: []byte(`{"a":3}`)},
+	), DefaultKeepAliveInterval)
+	if recorder.Header().Get("Content-Type") != "text/event-stream" {
+		t.Errorf("expected event stream, got %q", recorder.Header().Get("Content-Type"))
+	}
+	expected := "id: 2026-01-01T00:00:00Z\nevent: diagnostics\ndata: {\"a\":1}\n\n" +
+		"event: diagnostics\ndata: {\"a\":2}\n\n" +
+		"event: error\ndata: {\"code\":5,\"message\":\"device not found\",\"details\":[]}\n\n"
+	lines := strings.Split(recorder.Body.String(), "\n")
+	for i, line := range lines {
+		if data, ok := strings.CutPrefix(line, "data: "); ok {
+			lines[i] = "data: " + compact(t, data)
+		}
+	}
+	if result := strings.Join(lines, "\n"); result != expected {
+		t.Errorf("expected events %q, got %q", expected, result)
+	}
+}
+
+func TestServeEvents_KeepAlive(t *testing.T) {
+	idle := make(chan Event)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeEvents(r.Context(), w, "diagnostics", idle, 10*time.Millisecond)
+	}))
+	defer server.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close() // nolint:errcheck
+	// Comments are flushed while no events are sent
+	reader := bufio.NewReader(res.Body)
+	for range 3 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line != ": keep-alive\n" {
+			t.Fatalf("expected keep-alive comment, got %q", line)
+		}
+		if _, err := reader.ReadString('\n'); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestServeWebSocket(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		Serve(ctx, cancel, w, r, "diagnostics", events(
+			Event{ID: "2026-01-01T00:00:00Z", Data: []byte(`{"a":1}`)},
+			Event{Err: status.Error(codes.NotFound, "device not found")},
+		))
+	}))
+	defer server.Close()
+	endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	t.Run("should send results and error of stream", func(t *testing.T) {
+		conn, err := websocket.Dial(endpoint, "", server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close() // nolint:errcheck
+		var messages []string
+		for {
+			var message string
+			if err := websocket.Message.Receive(conn, &message); err != nil {
+				break
+			}
+			messages = append(messages, compact(t, message))
+		}
+		expected := []string{
+			`{"result":{"a":1}}`,
+			`{"error":{"code":5,"message":"device not found","details":[]}}`,
+		}
+		if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+			t.Errorf("expected messages %v, got %v", expected, messages)
+		}
+	})
+	t.Run("should return error due to foreign origin", func(t *testing.T) {
+		if _, err := websocket.Dial(endpoint, "", "http://example.com"); err == nil {
+			t.Error("expected handshake of foreign origin to fail")
+		}
+	})
+}
+
+func TestCheckOrigin(t *testing.T) {
+	tests := []struct {
+		name     string
+		origin   string
+		host     string
+		expected error
+	}{
+		{name: "should accept missing origin", host: "localhost:8080"},
+		{name: "should accept origin of host", origin: "http://localhost:8080", host: "localhost:8080"},
+		{name: "should accept origin in any case", origin: "http://LOCALHOST:8080", host: "localhost:8080"},
+		{
+			name:     "should return error due to other port",
+			origin:   "http://localhost:3000",
+			host:     "localhost:8080",
+			expected: ErrorForbiddenOrigin,
+		},
+		{
+			name:     "should return error due to other host",
+			origin:   "http://example.com",
+			host:     "localhost:8080",
+			expected: ErrorForbiddenOrigin,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://"+tt.host+"/v1/diagnostics/stream", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			config := &websocket.Config{Version: websocket.ProtocolVersionHybi13}
+			if err := checkOrigin(config, r); !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestLastEventID(t *testing.T) {
+	last := time.Date(2026, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	tests := []struct {
+		name     string
+		header   string
+		query    string
+		expected time.Time
+		err      bool
+	}{
+		{name: "should return zero time without last event"},
+		{name: "should parse header", header: EventID(timestamppb.New(last)), expected: last},
+		{name: "should parse parameter", query: EventID(timestamppb.New(last)), expected: last},
+		{
+			name:     "should prefer header over parameter",
+			header:   EventID(timestamppb.New(last)),
+			query:    "2020-01-01T00:00:00Z",
+			expected: last,
+		},
+		{name: "should return error due to invalid id", header: "yesterday", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(
+				http.MethodGet,
+				"/v1/diagnostics/stream?last_event_id="+url.QueryEscape(tt.query),
+				nil,
+			)
+			if tt.header != "" {
+				r.Header.Set(HeaderLastEventID, tt.header)
+			}
+			result, err := LastEventID(r)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected error %t, got %v", tt.err, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package emulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+// Consecutive devices of a template are assigned consecutive port pairs (port, gateway port)
+const DefaultPortStride = 2
+
+var (
+	ErrorInvalidManifest = errors.New("invalid emulator manifest")
+)
+
+type manifestFile struct {
+	Devices []deviceSpec `json:"devices"`
+}
+
+type deviceSpec struct {
+	Identifier  string        `json:"identifier"`
+	Protocols   []string      `json:"protocols"`
+	Versions    *versionsSpec `json:"versions"`
+	Port        int           `json:"port"`
+	GatewayPort int           `json:"gateway_port"`
+	Signing     *signingSpec  `json:"signing"`
+}
+
+type versionsSpec struct {
+	Hardware string `json:"hardware"`
+	Software string `json:"software"`
+	Firmware string `json:"firmware"`
+}
+
+type signingSpec struct {
+	Algorithm string `json:"algorithm"`
+	Key       string `json:"key"`
+}
+
+// Devices derives the configuration of every device hosted by the process. Without emulator
+// the template configuration is the only device, otherwise the devices of the manifest file
+// or count copies of the template (identifier suffixed by the index, e.g. device-001-0002).
+func Devices(config types.Config) ([]types.Config, error) {
+	if !config.Emulator.Enabled() {
+		return []types.Config{config}, nil
+	}
+	var devices []types.Config
+	if config.Emulator.ManifestFile != "" {
+		loaded, err := LoadManifestFile(config.Emulator.ManifestFile, config)
+		if err != nil {
+			return nil, err
+		}
+		devices = loaded
+	} else {
+		devices = make([]types.Config, config.Emulator.Count)
+		for i := range devices {
+			devices[i] = template(config, i)
+			devices[i].Identifier = fmt.Sprintf("%s-%04d", config.Identifier, i+1)
+		}
+	}
+	if err := validate(devices); err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+// LoadManifestFile reads the devices of a JSON manifest, fields missing in the manifest are
+// taken from the template configuration and ports default to the port pair of the index.
+func LoadManifestFile(path string, config types.Config) ([]types.Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read emulator manifest file: %w", err)
+	}
+	var file manifestFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to decode emulator manifest file: %w", err)
+	}
+	if len(file.Devices) == 0 {
+		return nil, fmt.Errorf("%w: no devices", ErrorInvalidManifest)
+	}
+	devices := make([]types.Config, len(file.Devices))
+	for i, spec := range file.Devices {
+		if devices[i], err = spec.config(template(config, i)); err != nil {
+			return nil, fmt.Errorf("%w: device %d: %w", ErrorInvalidManifest, i, err)
+		}
+	}
+	return devices, nil
+}
+
+func (s *deviceSpec) config(config types.Config) (types.Config, error) {
+	if s.Identifier == "" {
+		return config, errors.New("missing identifier")
+	}
+	config.Identifier = s.Identifier
+	if len(s.Protocols) > 0 {
+		protocols := make([]types.Protocol, len(s.Protocols))
+		for i, protocol := range s.Protocols {
+			parsed, err := types.ParseProtocol(protocol)
+			if err != nil {
+				return config, err
+			}
+			protocols[i] = parsed
+		}
+		config.SupportedProtocols = protocols
+	}
+	if s.Versions != nil {
+		config.DeviceVersions = types.DeviceVersions{
+			Hardware: s.Versions.Hardware,
+			Software: s.Versions.Software,
+			Firmware: s.Versions.Firmware,
+		}
+	}
+	if s.Port != 0 {
+		config.Port = s.Port
+	}
+	if s.GatewayPort != 0 {
+		config.GatewayPort = s.GatewayPort
+	}
+	if s.Signing != nil {
+		algorithm, err := types.ParseSigningAlgorithm(s.Signing.Algorithm)
+		if err != nil {
+			return config, err
+		}
+		config.Signing = types.Signing{Algorithm: algorithm, Key: s.Signing.Key}
+	}
+	return config, nil
+}
+
+// template copies the configuration for the device at index, simulated devices are seeded
+// differently (each engine consumes two seeds) so their load profiles do not move in lockstep.
+func template(config types.Config, index int) types.Config {
+	config.Port += index * DefaultPortStride
+	config.GatewayPort += index * DefaultPortStride
+	config.Simulation.Seed += int64(index) * 2
+	config.SupportedProtocols = append([]types.Protocol(nil), config.SupportedProtocols...)
+	return config
+}
+
+func validate(devices []types.Config) error {
+	identifiers := make(map[string]struct{}, len(devices))
+	ports := make(map[int]string, len(devices)*2)
+	for _, device := range devices {
+		if _, ok := identifiers[device.Identifier]; ok {
+			return fmt.Errorf("%w: duplicate identifier %s", ErrorInvalidManifest, device.Identifier)
+		}
+		identifiers[device.Identifier] = struct{}{}
+		for _, port := range []int{device.Port, device.GatewayPort} {
+			if other, ok := ports[port]; ok {
+				return fmt.Errorf(
+					"%w: port %d of %s is already assigned to %s",
+					ErrorInvalidManifest,
+					port,
+					device.Identifier,
+					other,
+				)
+			}
+			ports[port] = device.Identifier
+		}
+	}
+	return nil
+}
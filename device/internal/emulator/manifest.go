@@ -0,0 +1,93 @@
+package emulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+// Manifest lists the hosted devices in the format of the monitor RegisterDevice request, so
+// the entries can be registered with the monitor as they are.
+type Manifest struct {
+	Devices []Entry `json:"devices"`
+}
+
+type Entry struct {
+	DeviceID         string `json:"device_id"`
+	Alias            string `json:"alias"`
+	Host             string `json:"host"`
+	Port             int    `json:"port"`
+	PortGateway      int    `json:"port_gateway"`
+	Protocol         string `json:"protocol"`
+	SigningAlgorithm string `json:"signing_algorithm,omitempty"`
+	SigningKey       string `json:"signing_key,omitempty"`
+}
+
+// NewEntry describes a device as reachable through host, the device is registered with its
+// first supported protocol and the key the monitor verifies its signatures with.
+func NewEntry(config types.Config, host, key string) Entry {
+	entry := Entry{
+		DeviceID:    config.Identifier,
+		Alias:       config.Identifier,
+		Host:        host,
+		Port:        config.Port,
+		PortGateway: config.GatewayPort,
+		SigningKey:  key,
+	}
+	if len(config.SupportedProtocols) > 0 {
+		entry.Protocol = config.SupportedProtocols[0].Proto().String()
+	}
+	switch config.Signing.Algorithm {
+	case types.SigningAlgorithmHmacSha256:
+		entry.SigningAlgorithm = "SIGNING_ALGORITHM_HMAC_SHA256"
+	case types.SigningAlgorithmEd25519:
+		entry.SigningAlgorithm = "SIGNING_ALGORITHM_ED25519"
+	}
+	return entry
+}
+
+// Public returns the manifest without symmetric keys, shared secrets (hmac-sha256) would let
+// anyone able to read the manifest forge signed diagnostics. Public keys (ed25519) are kept.
+func (m *Manifest) Public() *Manifest {
+	public := &Manifest{Devices: make([]Entry, len(m.Devices))}
+	for i, entry := range m.Devices {
+		if entry.SigningAlgorithm != "SIGNING_ALGORITHM_ED25519" {
+			entry.SigningKey = ""
+		}
+		public.Devices[i] = entry
+	}
+	return public
+}
+
+// Handler serves the public manifest, the gateway is unauthenticated
+func (m *Manifest) Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(m.Public())
+	}
+}
+
+// Write stores the manifest at path (readable by the owner only, it may contain shared
+// secrets) or prints the public manifest to stdout if no path is given, stdout ends up in
+// container and CI logs.
+func (m *Manifest) Write(path string) error {
+	manifest := m
+	if path == "" {
+		manifest = m.Public()
+	}
+	data, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to encode emulator manifest: %w", err)
+	}
+	if path == "" {
+		_, err = fmt.Fprintln(os.Stdout, string(data))
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write emulator manifest file: %w", err)
+	}
+	return nil
+}
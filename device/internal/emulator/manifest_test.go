@@ -0,0 +1,96 @@
+package emulator
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func manifest() *Manifest {
+	return &Manifest{Devices: []Entry{
+		{DeviceID: "hmac", SigningAlgorithm: "SIGNING_ALGORITHM_HMAC_SHA256", SigningKey: "c2VjcmV0"},
+		{DeviceID: "ed25519", SigningAlgorithm: "SIGNING_ALGORITHM_ED25519", SigningKey: "cHVibGlj"},
+		{DeviceID: "unsigned"},
+	}}
+}
+
+func TestManifest_Handler(t *testing.T) {
+	manifest := manifest()
+	rec := httptest.NewRecorder()
+	manifest.Handler()(rec, httptest.NewRequest("GET", "/v1/manifest", nil))
+	var served Manifest
+	if err := json.NewDecoder(rec.Body).Decode(&served); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"hmac": "", "ed25519": "cHVibGlj", "unsigned": ""}
+	if len(served.Devices) != len(expected) {
+		t.Fatalf("expected %d devices, got %d", len(expected), len(served.Devices))
+	}
+	for _, entry := range served.Devices {
+		if entry.SigningKey != expected[entry.DeviceID] {
+			t.Errorf("expected key %q of %s, got %q", expected[entry.DeviceID], entry.DeviceID, entry.SigningKey)
+		}
+	}
+	// The manifest keeps the shared secret
+	if manifest.Devices[0].SigningKey != "c2VjcmV0" {
+		t.Errorf("expected manifest to keep shared secret, got %q", manifest.Devices[0].SigningKey)
+	}
+}
+
+func TestManifest_Write(t *testing.T) {
+	t.Run("should write manifest with secrets to file of owner", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "manifest.json")
+		if err := manifest().Write(path); err != nil {
+			t.Fatal(err)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mode := info.Mode().Perm(); mode != 0o600 {
+			t.Errorf("expected mode 0600, got %o", mode)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var written Manifest
+		if err := json.Unmarshal(data, &written); err != nil {
+			t.Fatal(err)
+		}
+		if key := written.Devices[0].SigningKey; key != "c2VjcmV0" {
+			t.Errorf("expected shared secret in file, got %q", key)
+		}
+	})
+	t.Run("should print public manifest to stdout", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdout := os.Stdout
+		os.Stdout = w
+		err = manifest().Write("")
+		os.Stdout = stdout
+		_ = w.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var printed Manifest
+		if err := json.Unmarshal(data, &printed); err != nil {
+			t.Fatal(err)
+		}
+		if key := printed.Devices[0].SigningKey; key != "" {
+			t.Errorf("expected shared secret to be omitted, got %q", key)
+		}
+		if key := printed.Devices[1].SigningKey; key != "cHVibGlj" {
+			t.Errorf("expected public key, got %q", key)
+		}
+	})
+}
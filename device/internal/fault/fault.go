@@ -0,0 +1,172 @@
+package fault
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	randv2 "math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	"go.uber.org/zap"
+)
+
+const DefaultRebootDuration = 10 * time.Second
+
+var ErrorFaultNotFound = errors.New("fault not found")
+
+// Fault management is never subject to faults, otherwise an injected fault could prevent
+// its own removal.
+var exempt = []string{"ListFaults", "InjectFault", "RemoveFault", "ClearFaults"}
+
+type StateProvider interface {
+	GetState() types.DeviceState
+	UpdateState(fn func(*types.DeviceState)) types.DeviceState
+}
+
+type entry struct {
+	fault    types.Fault
+	timer    *time.Timer
+	previous types.DeviceStatus
+}
+
+// Fault Injector
+type Injector struct {
+	mu     sync.Mutex
+	faults map[string]*entry
+	state  StateProvider
+	logger *zap.Logger
+}
+
+func NewInjector(state StateProvider, logger *zap.Logger) *Injector {
+	return &Injector{
+		faults: make(map[string]*entry),
+		state:  state,
+		logger: logger,
+	}
+}
+
+// InjectFault activates a fault until it is removed or its TTL expires. Reboots are active
+// for their duration, the device reports BOOTING meanwhile and its previous status after.
+func (i *Injector) InjectFault(fault types.Fault) types.Fault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	fault.ID = rand.Text()
+	fault.Created = time.Now()
+	if fault.Kind == types.FaultKindReboot {
+		if fault.Duration <= 0 {
+			fault.Duration = DefaultRebootDuration
+		}
+		fault.TTL = fault.Duration
+	}
+	e := &entry{fault: fault}
+	if fault.TTL > 0 {
+		e.fault.Expires = fault.Created.Add(fault.TTL)
+		e.timer = time.AfterFunc(fault.TTL, func() {
+			_ = i.RemoveFault(fault.ID)
+		})
+	}
+	if fault.Kind == types.FaultKindReboot {
+		e.previous = i.state.GetState().DeviceStatus
+		if rebooting := i.rebooting(); rebooting != nil {
+			e.previous = rebooting.previous
+		}
+		i.state.UpdateState(func(state *types.DeviceState) {
+			state.DeviceStatus = types.DeviceStatusBooting
+		})
+	}
+	i.faults[fault.ID] = e
+	i.logger.Info("fault injected", zap.String("id", fault.ID), zap.String("kind", fault.Kind.String()))
+	return e.fault
+}
+
+func (i *Injector) RemoveFault(id string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	e, ok := i.faults[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrorFaultNotFound, id)
+	}
+	i.remove(e)
+	return nil
+}
+
+func (i *Injector) ClearFaults() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, e := range i.faults {
+		i.remove(e)
+	}
+}
+
+func (i *Injector) ListFaults() []types.Fault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	faults := make([]types.Fault, 0, len(i.faults))
+	for _, e := range i.faults {
+		faults = append(faults, e.fault)
+	}
+	slices.SortFunc(faults, func(a, b types.Fault) int {
+		return a.Created.Compare(b.Created)
+	})
+	return faults
+}
+
+// Faults returns the active faults scoped to an RPC method (short name, e.g. GetDiagnostics),
+// faults without methods apply to every method.
+func (i *Injector) Faults(method string) []types.Fault {
+	if slices.Contains(exempt, method) {
+		return nil
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	var faults []types.Fault
+	for _, e := range i.faults {
+		if len(e.fault.Methods) == 0 || slices.Contains(e.fault.Methods, method) {
+			faults = append(faults, e.fault)
+		}
+	}
+	return faults
+}
+
+// Trigger samples the probability of a fault
+func Trigger(fault types.Fault) bool {
+	return randv2.Float64() < fault.Probability // nolint:gosec
+}
+
+// Delay returns the latency of a fault with a uniformly distributed jitter of [0, jitter)
+func Delay(fault types.Fault) time.Duration {
+	delay := fault.Latency
+	if fault.Jitter > 0 {
+		delay += randv2.N(fault.Jitter) // nolint:gosec
+	}
+	return delay
+}
+
+// remove deletes a fault, the previous status of a reboot is restored once no other reboot
+// is active and the status has not been changed in the meantime. Requires the lock.
+func (i *Injector) remove(e *entry) {
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+	delete(i.faults, e.fault.ID)
+	if e.fault.Kind == types.FaultKindReboot && i.rebooting() == nil {
+		i.state.UpdateState(func(state *types.DeviceState) {
+			if state.DeviceStatus == types.DeviceStatusBooting {
+				state.DeviceStatus = e.previous
+			}
+		})
+	}
+	i.logger.Info("fault removed", zap.String("id", e.fault.ID), zap.String("kind", e.fault.Kind.String()))
+}
+
+func (i *Injector) rebooting() *entry {
+	for _, e := range i.faults {
+		if e.fault.Kind == types.FaultKindReboot {
+			return e
+		}
+	}
+	return nil
+}
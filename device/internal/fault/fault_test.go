@@ -0,0 +1,254 @@
+package fault
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	"go.uber.org/zap"
+)
+
+type state struct {
+	mu    sync.Mutex
+	state types.DeviceState
+}
+
+func (s *state) GetState() types.DeviceState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state
+}
+
+func (s *state) UpdateState(fn func(*types.DeviceState)) types.DeviceState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.state)
+	return s.state
+}
+
+func injector() (*Injector, *state) {
+	s := &state{state: types.DeviceState{DeviceStatus: types.DeviceStatusHealthy}}
+	return NewInjector(s, zap.NewNop()), s
+}
+
+func TestInjector_Faults(t *testing.T) {
+	i, _ := injector()
+	all := i.InjectFault(types.Fault{Kind: types.FaultKindLatency, Probability: 1})
+	scoped := i.InjectFault(
+		types.Fault{Kind: types.FaultKindError, Methods: []string{"GetHealth"}, Probability: 1},
+	)
+	tests := []struct {
+		name     string
+		method   string
+		expected []string
+	}{
+		{name: "should apply faults without methods", method: "GetDiagnostics", expected: []string{all.ID}},
+		{name: "should apply scoped faults", method: "GetHealth", expected: []string{all.ID, scoped.ID}},
+		{name: "should exempt fault management", method: "ClearFaults"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			faults := i.Faults(tt.method)
+			if len(faults) != len(tt.expected) {
+				t.Fatalf("expected %d faults, got %+v", len(tt.expected), faults)
+			}
+			for _, id := range tt.expected {
+				if _, ok := find(faults, id); !ok {
+					t.Errorf("expected fault %s, got %+v", id, faults)
+				}
+			}
+		})
+	}
+}
+
+func find(faults []types.Fault, id string) (types.Fault, bool) {
+	for _, f := range faults {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return types.Fault{}, false
+}
+
+func TestInjector_RemoveFault(t *testing.T) {
+	i, _ := injector()
+	fault := i.InjectFault(types.Fault{Kind: types.FaultKindLatency, Probability: 1})
+	if err := i.RemoveFault(fault.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.RemoveFault(fault.ID); !errors.Is(err, ErrorFaultNotFound) {
+		t.Errorf("expected fault not found, got %v", err)
+	}
+	if faults := i.ListFaults(); len(faults) != 0 {
+		t.Errorf("expected no faults, got %+v", faults)
+	}
+}
+
+func TestInjector_ClearFaults(t *testing.T) {
+	i, s := injector()
+	i.InjectFault(types.Fault{Kind: types.FaultKindLatency, Probability: 1})
+	i.InjectFault(types.Fault{Kind: types.FaultKindReboot, Duration: time.Hour, Probability: 1})
+	i.ClearFaults()
+	if faults := i.ListFaults(); len(faults) != 0 {
+		t.Errorf("expected no faults, got %+v", faults)
+	}
+	if status := s.GetState().DeviceStatus; status != types.DeviceStatusHealthy {
+		t.Errorf("expected status to be restored, got %s", status)
+	}
+}
+
+func TestInjector_Expiry(t *testing.T) {
+	i, s := injector()
+	fault := i.InjectFault(
+		types.Fault{Kind: types.FaultKindLatency, TTL: 20 * time.Millisecond, Probability: 1},
+	)
+	if !fault.Expires.Equal(fault.Created.Add(20 * time.Millisecond)) {
+		t.Errorf("expected fault to expire after its ttl, got %s", fault.Expires)
+	}
+	reboot := i.InjectFault(types.Fault{Kind: types.FaultKindReboot, Duration: 20 * time.Millisecond})
+	if reboot.TTL != reboot.Duration {
+		t.Errorf("expected reboot to expire after its duration, got %s", reboot.TTL)
+	}
+	if status := s.GetState().DeviceStatus; status != types.DeviceStatusBooting {
+		t.Errorf("expected booting device, got %s", status)
+	}
+	deadline := time.Now().Add(time.Second)
+	for len(i.ListFaults()) > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if faults := i.ListFaults(); len(faults) != 0 {
+		t.Fatalf("expected faults to expire, got %+v", faults)
+	}
+	if status := s.GetState().DeviceStatus; status != types.DeviceStatusHealthy {
+		t.Errorf("expected status to be restored, got %s", status)
+	}
+}
+
+func TestInjector_Reboot(t *testing.T) {
+	t.Run("should apply default duration", func(t *testing.T) {
+		i, _ := injector()
+		if fault := i.InjectFault(types.Fault{Kind: types.FaultKindReboot}); fault.Duration != DefaultRebootDuration {
+			t.Errorf("expected default duration, got %s", fault.Duration)
+		}
+		i.ClearFaults()
+	})
+	t.Run("should restore status before overlapping reboots", func(t *testing.T) {
+		i, s := injector()
+		first := i.InjectFault(types.Fault{Kind: types.FaultKindReboot, Duration: time.Hour})
+		second := i.InjectFault(types.Fault{Kind: types.FaultKindReboot, Duration: time.Hour})
+		if err := i.RemoveFault(first.ID); err != nil {
+			t.Fatal(err)
+		}
+		if status := s.GetState().DeviceStatus; status != types.DeviceStatusBooting {
+			t.Errorf("expected device to boot until the last reboot is removed, got %s", status)
+		}
+		if err := i.RemoveFault(second.ID); err != nil {
+			t.Fatal(err)
+		}
+		if status := s.GetState().DeviceStatus; status != types.DeviceStatusHealthy {
+			t.Errorf("expected status before the first reboot, got %s", status)
+		}
+	})
+	t.Run("should keep status changed during reboot", func(t *testing.T) {
+		i, s := injector()
+		i.InjectFault(types.Fault{Kind: types.FaultKindReboot, Duration: time.Hour})
+		s.UpdateState(func(state *types.DeviceState) { state.DeviceStatus = types.DeviceStatusDegraded })
+		i.ClearFaults()
+		if status := s.GetState().DeviceStatus; status != types.DeviceStatusDegraded {
+			t.Errorf("expected changed status, got %s", status)
+		}
+	})
+}
+
+func TestTrigger(t *testing.T) {
+	tests := []struct {
+		name        string
+		probability float64
+		expected    int
+	}{
+		{name: "should always trigger", probability: 1, expected: 1000},
+		{name: "should never trigger", probability: 0, expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var triggered int
+			for range 1000 {
+				if Trigger(types.Fault{Probability: tt.probability}) {
+					triggered++
+				}
+			}
+			if triggered != tt.expected {
+				t.Errorf("expected %d triggers, got %d", tt.expected, triggered)
+			}
+		})
+	}
+	t.Run("should trigger with probability", func(t *testing.T) {
+		var triggered int
+		for range 10000 {
+			if Trigger(types.Fault{Probability: 0.5}) {
+				triggered++
+			}
+		}
+		if triggered < 4000 || triggered > 6000 {
+			t.Errorf("expected about 5000 triggers, got %d", triggered)
+		}
+	})
+}
+
+func TestDelay(t *testing.T) {
+	fault := types.Fault{Latency: 100 * time.Millisecond, Jitter: 50 * time.Millisecond}
+	for range 100 {
+		if delay := Delay(fault); delay < fault.Latency || delay >= fault.Latency+fault.Jitter {
+			t.Fatalf("expected delay within [100ms, 150ms), got %s", delay)
+		}
+	}
+	if delay := Delay(types.Fault{Latency: time.Second}); delay != time.Second {
+		t.Errorf("expected latency without jitter, got %s", delay)
+	}
+}
+
+func TestLoadProfileFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []float64
+		err      bool
+	}{
+		{
+			name:     "should default probability",
+			data:     `{"faults": [{"kind": "FAULT_KIND_STREAM_CLOSE"}, {"kind": "FAULT_KIND_STREAM_CLOSE", "probability": 0.5}]}`,
+			expected: []float64{1, 0.5},
+		},
+		{
+			name: "should return error due to probability of zero",
+			data: `{"faults": [{"kind": "FAULT_KIND_STREAM_CLOSE", "probability": 0}]}`,
+			err:  true,
+		},
+		{
+			name: "should return error due to probability above one",
+			data: `{"faults": [{"kind": "FAULT_KIND_STREAM_CLOSE", "probability": 1.5}]}`,
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "faults.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			faults, err := LoadProfileFile(path)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected error %t, got %v", tt.err, err)
+			}
+			for i, fault := range faults {
+				if fault.Probability != tt.expected[i] {
+					t.Errorf("expected probability %v, got %v", tt.expected[i], fault.Probability)
+				}
+			}
+		})
+	}
+}
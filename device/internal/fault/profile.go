@@ -0,0 +1,31 @@
+package fault
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// LoadProfileFile reads faults from a JSON file in the format of the ListFaults response
+// ({"faults": [...]}), each fault is validated like an InjectFault request.
+func LoadProfileFile(path string) ([]types.Fault, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fault profile file: %w", err)
+	}
+	var file devicev1.ListFaultsResponse
+	if err := protojson.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to decode fault profile file: %w", err)
+	}
+	faults := make([]types.Fault, len(file.GetFaults()))
+	for i, fault := range file.GetFaults() {
+		if err := (&devicev1.InjectFaultRequest{Fault: fault}).Validate(); err != nil {
+			return nil, fmt.Errorf("invalid fault %d: %w", i, err)
+		}
+		faults[i] = types.FaultFromProto(fault)
+	}
+	return faults, nil
+}
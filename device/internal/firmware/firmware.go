@@ -0,0 +1,140 @@
+package firmware
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	"go.uber.org/zap"
+)
+
+var ErrorUpgradeInProgress = errors.New("firmware upgrade in progress")
+
+type StateProvider interface {
+	GetState() types.DeviceState
+	UpdateState(fn func(*types.DeviceState)) types.DeviceState
+}
+
+// Firmware Upgrader (simulated)
+type Upgrader struct {
+	mu      sync.Mutex
+	upgrade types.FirmwareUpgrade
+	config  types.Firmware
+	state   StateProvider
+	logger  *zap.Logger
+}
+
+func NewUpgrader(config types.Firmware, state StateProvider, logger *zap.Logger) *Upgrader {
+	return &Upgrader{
+		config: config,
+		state:  state,
+		logger: logger,
+	}
+}
+
+// GetFirmwareUpgrade returns the active or last upgrade, the phase is empty if the device
+// has never been upgraded.
+func (u *Upgrader) GetFirmwareUpgrade() types.FirmwareUpgrade {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.upgrade
+}
+
+// UpgradeFirmware starts an upgrade in the background. The device keeps serving while the
+// firmware is downloaded, reports MAINTENANCE while installing and BOOTING while booting
+// into the new firmware, after which it reports HEALTHY and the new version. A failed
+// upgrade keeps the previous firmware and reports ERROR.
+func (u *Upgrader) UpgradeFirmware(req types.FirmwareUpgradeRequest) (types.FirmwareUpgrade, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.inProgress() {
+		return u.upgrade, ErrorUpgradeInProgress
+	}
+	now := time.Now()
+	u.upgrade = types.FirmwareUpgrade{
+		TargetVersion:   req.Version,
+		PreviousVersion: u.state.GetState().DeviceVersions.Firmware,
+		Phase:           types.UpgradePhaseDownloading,
+		Started:         now,
+		Updated:         now,
+	}
+	u.logger.Info(
+		"firmware upgrade started",
+		zap.String("previous_version", u.upgrade.PreviousVersion),
+		zap.String("target_version", u.upgrade.TargetVersion),
+	)
+	go u.run(req.FailPhase)
+	return u.upgrade, nil
+}
+
+func (u *Upgrader) run(fail types.UpgradePhase) {
+	phases := []struct {
+		phase    types.UpgradePhase
+		status   types.DeviceStatus
+		duration time.Duration
+	}{
+		{phase: types.UpgradePhaseDownloading, duration: u.config.DownloadDuration},
+		{
+			phase:    types.UpgradePhaseInstalling,
+			status:   types.DeviceStatusMaintenance,
+			duration: u.config.InstallDuration,
+		},
+		{
+			phase:    types.UpgradePhaseBooting,
+			status:   types.DeviceStatusBooting,
+			duration: u.config.BootDuration,
+		},
+	}
+	for _, p := range phases {
+		u.transition(p.phase, p.status, func(*types.FirmwareUpgrade, *types.DeviceState) {})
+		time.Sleep(p.duration)
+		if p.phase == fail {
+			u.transition(
+				types.UpgradePhaseFailed,
+				types.DeviceStatusError,
+				func(upgrade *types.FirmwareUpgrade, _ *types.DeviceState) {
+					upgrade.FailedPhase = p.phase
+					upgrade.Error = "simulated failure while " + p.phase.String()
+				},
+			)
+			u.logger.Warn("firmware upgrade failed", zap.String("phase", p.phase.String()))
+			return
+		}
+	}
+	u.transition(
+		types.UpgradePhaseCompleted,
+		types.DeviceStatusHealthy,
+		func(upgrade *types.FirmwareUpgrade, state *types.DeviceState) {
+			state.DeviceVersions.Firmware = upgrade.TargetVersion
+		},
+	)
+	u.logger.Info("firmware upgrade completed", zap.String("version", u.GetFirmwareUpgrade().TargetVersion))
+}
+
+// transition moves the upgrade to a phase and the device to a status (kept if empty)
+func (u *Upgrader) transition(
+	phase types.UpgradePhase,
+	status types.DeviceStatus,
+	fn func(*types.FirmwareUpgrade, *types.DeviceState),
+) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.upgrade.Phase = phase
+	u.upgrade.Updated = time.Now()
+	u.state.UpdateState(func(state *types.DeviceState) {
+		if status != "" {
+			state.DeviceStatus = status
+		}
+		fn(&u.upgrade, state)
+	})
+}
+
+func (u *Upgrader) inProgress() bool {
+	switch u.upgrade.Phase {
+	case types.UpgradePhaseDownloading, types.UpgradePhaseInstalling, types.UpgradePhaseBooting:
+		return true
+	default:
+		return false
+	}
+}
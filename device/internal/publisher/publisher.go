@@ -0,0 +1,173 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultRetryInterval   time.Duration = 2 * time.Second
+	DefaultRequestTimeout  time.Duration = 3 * time.Second
+	DefaultDisconnectQuiet uint          = 250 // milliseconds
+)
+
+// Publisher bridges a device to an MQTT broker: the diagnostics stream of the device service
+// (including injected faults, checksums and signatures) is published to the diagnostics topic
+// and the commands received on the command topic are applied through the device service.
+// Diagnostics and health are retained so that new subscribers receive the latest sample.
+type Publisher struct {
+	client     devicev1.DeviceClient
+	broker     string
+	password   string
+	identifier string
+	logger     *zap.Logger
+}
+
+// NewPublisher creates a publisher authenticating with the identifier of the device as username
+func NewPublisher(
+	client devicev1.DeviceClient,
+	config types.MQTT,
+	identifier string,
+	logger *zap.Logger,
+) *Publisher {
+	return &Publisher{
+		client:     client,
+		broker:     config.Broker,
+		password:   config.Password,
+		identifier: identifier,
+		logger:     logger,
+	}
+}
+
+// Run connects to the broker (retrying until it is reachable) and publishes the diagnostics of
+// the device until the context is done, streams ended by the device (e.g. by a reboot or an
+// injected fault) are reopened.
+func (p *Publisher) Run(ctx context.Context) error {
+	options := mqtt.NewClientOptions().
+		AddBroker(p.broker).
+		SetClientID(p.identifier).
+		SetUsername(p.identifier).
+		SetPassword(p.password).
+		SetCleanSession(true).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(DefaultRetryInterval).
+		SetAutoReconnect(true).
+		SetOnConnectHandler(p.connected).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			p.logger.Warn("connection lost (mqtt)", zap.String("broker", p.broker), zap.Error(err))
+		})
+	client := mqtt.NewClient(options)
+	token := client.Connect()
+	defer client.Disconnect(DefaultDisconnectQuiet)
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-token.Done():
+		if err := token.Error(); err != nil {
+			return fmt.Errorf("failed to connect to broker (mqtt): %w", err)
+		}
+	}
+	for {
+		err := p.publish(ctx, client)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			p.logger.Warn("diagnostics stream ended (mqtt)", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(DefaultRetryInterval):
+		}
+	}
+}
+
+func (p *Publisher) publish(ctx context.Context, client mqtt.Client) error {
+	stream, err := p.client.StreamDiagnostics(ctx, &devicev1.DiagnosticsRequest{})
+	if err != nil {
+		return err
+	}
+	topic := devicev1.DiagnosticsTopic(p.identifier)
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := p.send(client, topic, res); err != nil {
+			p.logger.Warn("failed to publish diagnostics (mqtt)", zap.Error(err))
+		}
+	}
+}
+
+// connected subscribes to the commands of the device and publishes its health, on the first
+// connection and on every reconnection (sessions are clean).
+func (p *Publisher) connected(client mqtt.Client) {
+	p.logger.Info("connected to broker (mqtt)", zap.String("broker", p.broker))
+	topic := devicev1.CommandTopic(p.identifier)
+	if token := client.Subscribe(topic, 1, p.command); token.Wait() && token.Error() != nil {
+		p.logger.Error("failed to subscribe to commands (mqtt)", zap.Error(token.Error()))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultRequestTimeout)
+	defer cancel()
+	health, err := p.client.GetHealth(ctx, &devicev1.GetHealthRequest{})
+	if err != nil {
+		p.logger.Error("failed to get health (mqtt)", zap.Error(err))
+		return
+	}
+	if err := p.send(client, devicev1.HealthTopic(p.identifier), health); err != nil {
+		p.logger.Error("failed to publish health (mqtt)", zap.Error(err))
+	}
+}
+
+// command applies a command received from the broker, commands are not acknowledged beyond
+// the delivery by the broker so failures are only logged.
+func (p *Publisher) command(_ mqtt.Client, message mqtt.Message) {
+	var command devicev1.DeviceCommand
+	if err := protojson.Unmarshal(message.Payload(), &command); err != nil {
+		p.logger.Warn("failed to decode command (mqtt)", zap.Error(err))
+		return
+	}
+	if err := command.Validate(); err != nil {
+		p.logger.Warn("invalid command (mqtt)", zap.Error(err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultRequestTimeout)
+	defer cancel()
+	switch c := command.GetCommand().(type) {
+	case *devicev1.DeviceCommand_UpdateDevice:
+		if _, err := p.client.UpdateDevice(ctx, c.UpdateDevice); err != nil {
+			p.logger.Warn("failed to update device (mqtt)", zap.Error(err))
+			return
+		}
+		p.logger.Info(
+			"device updated by command (mqtt)",
+			zap.String("status", c.UpdateDevice.GetDeviceStatus().String()),
+		)
+	}
+}
+
+func (p *Publisher) send(client mqtt.Client, topic string, message proto.Message) error {
+	payload, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+	token := client.Publish(topic, 0, true, payload)
+	if !token.WaitTimeout(DefaultRequestTimeout) {
+		return fmt.Errorf("timed out publishing to %s", topic)
+	}
+	return token.Error()
+}
@@ -0,0 +1,78 @@
+package reboot
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	"go.uber.org/zap"
+)
+
+var ErrorRebootInProgress = errors.New("reboot in progress")
+
+type StateProvider interface {
+	GetState() types.DeviceState
+	UpdateState(fn func(*types.DeviceState)) types.DeviceState
+}
+
+// Rebooter (simulated)
+type Rebooter struct {
+	mu       sync.Mutex
+	booting  bool
+	done     chan struct{}
+	duration time.Duration
+	state    StateProvider
+	logger   *zap.Logger
+}
+
+func NewRebooter(duration time.Duration, state StateProvider, logger *zap.Logger) *Rebooter {
+	return &Rebooter{
+		done:     make(chan struct{}),
+		duration: duration,
+		state:    state,
+		logger:   logger,
+	}
+}
+
+// Done returns a channel that is closed by the next reboot, streams end with it
+func (r *Rebooter) Done() <-chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.done
+}
+
+// Reboot ends the open streams and reports BOOTING for the given duration (the configured
+// duration if zero), after which the device reports HEALTHY.
+func (r *Rebooter) Reboot(duration time.Duration) (types.Reboot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.booting {
+		return types.Reboot{}, ErrorRebootInProgress
+	}
+	if duration <= 0 {
+		duration = r.duration
+	}
+	r.booting = true
+	r.state.UpdateState(func(state *types.DeviceState) {
+		state.DeviceStatus = types.DeviceStatusBooting
+	})
+	close(r.done)
+	r.done = make(chan struct{})
+	time.AfterFunc(duration, r.boot)
+	r.logger.Info("device rebooting", zap.Duration("duration", duration))
+	return types.Reboot{Started: time.Now(), Duration: duration}, nil
+}
+
+// boot completes a reboot, the status is kept if it has been changed in the meantime
+func (r *Rebooter) boot() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.booting = false
+	r.state.UpdateState(func(state *types.DeviceState) {
+		if state.DeviceStatus == types.DeviceStatusBooting {
+			state.DeviceStatus = types.DeviceStatusHealthy
+		}
+	})
+	r.logger.Info("device rebooted")
+}
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/fault"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// HeaderHTTPStatus carries the HTTP status of an injected error to the gateway
+const HeaderHTTPStatus = "x-fault-http-status"
+
+// Interval at which stalled streams check whether the stall fault has been removed
+const DefaultStallPollInterval = 100 * time.Millisecond
+
+type FaultProvider interface {
+	Faults(method string) []types.Fault
+}
+
+func UnaryFaultInterceptor(p FaultProvider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+		faults := p.Faults(path.Base(info.FullMethod))
+		if err := injectLatency(ctx, faults); err != nil {
+			return nil, err
+		}
+		if err := injectError(faults, func(md metadata.MD) { _ = grpc.SetTrailer(ctx, md) }); err != nil {
+			return nil, err
+		}
+		resp, err := h(ctx, req)
+		if err == nil {
+			corruptChecksum(faults, resp)
+		}
+		return resp, err
+	}
+}
+
+func StreamFaultInterceptor(p FaultProvider) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, h grpc.StreamHandler) error {
+		method := path.Base(info.FullMethod)
+		if err := injectError(p.Faults(method), ss.SetTrailer); err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		stream := &faultStream{ServerStream: ss, ctx: ctx, cancel: cancel, provider: p, method: method}
+		err := h(srv, stream)
+		if stream.closed {
+			return nil
+		}
+		return err
+	}
+}
+
+// GatewayErrorHandler responds with the HTTP status of an injected error instead of the
+// status mapped from its gRPC code.
+func GatewayErrorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		values := append(md.TrailerMD.Get(HeaderHTTPStatus), md.HeaderMD.Get(HeaderHTTPStatus)...)
+		if len(values) > 0 {
+			if code, err := strconv.Atoi(values[0]); err == nil {
+				w = &statusWriter{ResponseWriter: w, code: code}
+			}
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// Stream (fault injection)
+type faultStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	cancel   context.CancelFunc
+	provider FaultProvider
+	method   string
+	sent     uint32
+	closed   bool
+}
+
+func (s *faultStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg applies the faults active at the time of each message, so faults injected or
+// removed during a stream take effect immediately. Streams closed by a fault drop the
+// remaining messages and end without error once the handler observes the cancellation.
+func (s *faultStream) SendMsg(m any) error {
+	if s.closed {
+		return nil
+	}
+	for {
+		faults := s.provider.Faults(s.method)
+		if stall, ok := find(faults, types.FaultKindStreamStall); ok && s.sent >= stall.AfterMessages {
+			select {
+			case <-s.ctx.Done():
+				return s.ctx.Err()
+			case <-time.After(DefaultStallPollInterval):
+				continue
+			}
+		}
+		if closing, ok := find(faults, types.FaultKindStreamClose); ok && s.sent >= closing.AfterMessages {
+			s.closed = true
+			s.cancel()
+			return nil
+		}
+		if err := injectLatency(s.ctx, faults); err != nil {
+			return err
+		}
+		corruptChecksum(faults, m)
+		s.sent++
+		return s.ServerStream.SendMsg(m)
+	}
+}
+
+func injectLatency(ctx context.Context, faults []types.Fault) error {
+	for _, f := range faults {
+		if f.Kind != types.FaultKindLatency || !fault.Trigger(f) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-time.After(fault.Delay(f)):
+		}
+	}
+	return nil
+}
+
+func injectError(faults []types.Fault, trailer func(metadata.MD)) error {
+	for _, f := range faults {
+		if f.Kind != types.FaultKindError || !fault.Trigger(f) {
+			continue
+		}
+		code := codes.Code(f.GRPCCode)
+		if f.HTTPStatus != 0 {
+			trailer(metadata.Pairs(HeaderHTTPStatus, strconv.Itoa(int(f.HTTPStatus))))
+			if code == codes.OK {
+				code = codeFromHTTPStatus(int(f.HTTPStatus))
+			}
+		}
+		return status.Errorf(code, "injected fault (%s)", f.ID)
+	}
+	return nil
+}
+
+func corruptChecksum(faults []types.Fault, m any) {
+	res, ok := m.(*devicev1.DiagnosticsResponse)
+	if !ok {
+		return
+	}
+	if f, ok := find(faults, types.FaultKindCorruptChecksum); ok && fault.Trigger(f) {
+		checksum := []byte(res.Checksum)
+		for i := range checksum {
+			checksum[i] ^= 0x01
+		}
+		res.Checksum = string(checksum)
+	}
+}
+
+func find(faults []types.Fault, kind types.FaultKind) (types.Fault, bool) {
+	for _, f := range faults {
+		if f.Kind == kind {
+			return f, true
+		}
+	}
+	return types.Fault{}, false
+}
+
+// codeFromHTTPStatus is the inverse of the gateway mapping (runtime.HTTPStatusFromCode)
+func codeFromHTTPStatus(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		if code >= 500 {
+			return codes.Internal
+		}
+		return codes.Unknown
+	}
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.code)
+}
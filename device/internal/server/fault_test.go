@@ -0,0 +1,318 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/fault"
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const method = "/device.v1.Device/GetDiagnostics"
+
+type faults struct {
+	mu     sync.Mutex
+	faults []types.Fault
+}
+
+func (f *faults) Faults(string) []types.Fault {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.faults
+}
+
+func (f *faults) set(faults ...types.Fault) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.faults = faults
+}
+
+type stream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	mu      sync.Mutex
+	sent    []any
+	trailer metadata.MD
+}
+
+func (s *stream) Context() context.Context { return s.ctx }
+
+func (s *stream) SetTrailer(md metadata.MD) { s.trailer = md }
+
+func (s *stream) SendMsg(m any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func (s *stream) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.sent)
+}
+
+func TestUnaryFaultInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		fault    types.Fault
+		code     codes.Code
+		checksum string
+		latency  time.Duration
+	}{
+		{
+			name: "should delay response",
+			fault: types.Fault{
+				Kind:        types.FaultKindLatency,
+				Latency:     50 * time.Millisecond,
+				Probability: 1,
+			},
+			checksum: "ab",
+			latency:  50 * time.Millisecond,
+		},
+		{
+			name: "should fail with code",
+			fault: types.Fault{
+				Kind:        types.FaultKindError,
+				GRPCCode:    uint32(codes.Unavailable),
+				Probability: 1,
+			},
+			code: codes.Unavailable,
+		},
+		{
+			name: "should fail with code of http status",
+			fault: types.Fault{
+				Kind:        types.FaultKindError,
+				HTTPStatus:  http.StatusTooManyRequests,
+				Probability: 1,
+			},
+			code: codes.ResourceExhausted,
+		},
+		{
+			name:     "should not fail without probability",
+			fault:    types.Fault{Kind: types.FaultKindError, GRPCCode: uint32(codes.Unavailable)},
+			checksum: "ab",
+		},
+		{
+			name:     "should corrupt checksum",
+			fault:    types.Fault{Kind: types.FaultKindCorruptChecksum, Probability: 1},
+			checksum: "`c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := UnaryFaultInterceptor(&faults{faults: []types.Fault{tt.fault}})
+			handler := func(ctx context.Context, req any) (any, error) {
+				return &devicev1.DiagnosticsResponse{Checksum: "ab"}, nil
+			}
+			start := time.Now()
+			resp, err := interceptor(
+				context.Background(),
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: method},
+				handler,
+			)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("expected code %s, got %v", tt.code, err)
+			}
+			if elapsed := time.Since(start); elapsed < tt.latency {
+				t.Errorf("expected latency of %s, got %s", tt.latency, elapsed)
+			}
+			if err != nil {
+				return
+			}
+			if checksum := resp.(*devicev1.DiagnosticsResponse).GetChecksum(); checksum != tt.checksum {
+				t.Errorf("expected checksum %q, got %q", tt.checksum, checksum)
+			}
+		})
+	}
+	t.Run("should abort latency with the call", func(t *testing.T) {
+		fault := types.Fault{Kind: types.FaultKindLatency, Latency: time.Hour, Probability: 1}
+		interceptor := UnaryFaultInterceptor(&faults{faults: []types.Fault{fault}})
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, nil)
+		if code := status.Code(err); code != codes.DeadlineExceeded {
+			t.Errorf("expected deadline exceeded, got %v", err)
+		}
+	})
+}
+
+func TestInjectError(t *testing.T) {
+	var trailer metadata.MD
+	fault := types.Fault{
+		Kind:        types.FaultKindError,
+		GRPCCode:    uint32(codes.Internal),
+		HTTPStatus:  http.StatusBadGateway,
+		Probability: 1,
+	}
+	err := injectError([]types.Fault{fault}, func(md metadata.MD) { trailer = md })
+	if code := status.Code(err); code != codes.Internal {
+		t.Errorf("expected code of fault to take precedence, got %v", err)
+	}
+	if values := trailer.Get(HeaderHTTPStatus); len(values) != 1 || values[0] != "502" {
+		t.Errorf("expected http status in trailer, got %v", trailer)
+	}
+}
+
+func TestGatewayErrorHandler(t *testing.T) {
+	md := runtime.ServerMetadata{TrailerMD: metadata.Pairs(HeaderHTTPStatus, "502")}
+	ctx := runtime.NewServerMetadataContext(context.Background(), md)
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/diagnostics", nil)
+	GatewayErrorHandler(
+		ctx,
+		runtime.NewServeMux(),
+		&runtime.JSONPb{},
+		rec,
+		req,
+		status.Error(codes.Internal, "fault"),
+	)
+	if rec.Code != http.StatusBadGateway {
+		t.Errorf("expected status of fault, got %d", rec.Code)
+	}
+}
+
+func TestStreamFaultInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/device.v1.Device/StreamDiagnostics"}
+	// send returns a handler streaming up to limit messages
+	send := func(limit int) grpc.StreamHandler {
+		return func(srv any, ss grpc.ServerStream) error {
+			for range limit {
+				if ss.Context().Err() != nil {
+					return ss.Context().Err()
+				}
+				if err := ss.SendMsg(&devicev1.DiagnosticsResponse{}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	t.Run("should fail stream with code", func(t *testing.T) {
+		fault := types.Fault{Kind: types.FaultKindError, GRPCCode: uint32(codes.Aborted), Probability: 1}
+		interceptor := StreamFaultInterceptor(&faults{faults: []types.Fault{fault}})
+		ss := &stream{ctx: context.Background()}
+		if err := interceptor(nil, ss, info, send(5)); status.Code(err) != codes.Aborted {
+			t.Errorf("expected aborted, got %v", err)
+		}
+		if count := ss.count(); count != 0 {
+			t.Errorf("expected no messages, got %d", count)
+		}
+	})
+	t.Run("should close stream after messages", func(t *testing.T) {
+		fault := types.Fault{Kind: types.FaultKindStreamClose, AfterMessages: 2, Probability: 1}
+		interceptor := StreamFaultInterceptor(&faults{faults: []types.Fault{fault}})
+		ss := &stream{ctx: context.Background()}
+		if err := interceptor(nil, ss, info, send(5)); err != nil {
+			t.Errorf("expected stream to end without error, got %v", err)
+		}
+		if count := ss.count(); count != 2 {
+			t.Errorf("expected 2 messages, got %d", count)
+		}
+	})
+	t.Run("should stall stream until fault is removed", func(t *testing.T) {
+		provider := &faults{faults: []types.Fault{{Kind: types.FaultKindStreamStall, AfterMessages: 1}}}
+		interceptor := StreamFaultInterceptor(provider)
+		ss := &stream{ctx: context.Background()}
+		done := make(chan error, 1)
+		go func() { done <- interceptor(nil, ss, info, send(3)) }()
+		time.Sleep(3 * DefaultStallPollInterval)
+		if count := ss.count(); count != 1 {
+			t.Fatalf("expected stream to stall after 1 message, got %d", count)
+		}
+		provider.set()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected stream to resume")
+		}
+		if count := ss.count(); count != 3 {
+			t.Errorf("expected 3 messages, got %d", count)
+		}
+	})
+}
+
+// provider serves the faults of an injector, other methods are not used by the tests
+type provider struct {
+	Provider
+	injector *fault.Injector
+}
+
+func (p *provider) ListFaults() []types.Fault { return p.injector.ListFaults() }
+
+func (p *provider) InjectFault(f types.Fault) types.Fault { return p.injector.InjectFault(f) }
+
+func (p *provider) RemoveFault(id string) error { return p.injector.RemoveFault(id) }
+
+func (p *provider) ClearFaults() { p.injector.ClearFaults() }
+
+func TestServer_Faults(t *testing.T) {
+	injector := fault.NewInjector(nil, zap.NewNop())
+	s := NewDeviceServer(&provider{injector: injector}, zap.NewNop())
+	ctx := context.Background()
+
+	t.Run("should default probability", func(t *testing.T) {
+		res, err := s.InjectFault(ctx, &devicev1.InjectFaultRequest{Fault: &devicev1.Fault{
+			Kind:    devicev1.FaultKind_FAULT_KIND_LATENCY,
+			Latency: durationpb.New(time.Millisecond),
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetFault().GetId() == "" || res.GetFault().GetProbability() != 1 {
+			t.Errorf("expected fault with probability 1, got %v", res.GetFault())
+		}
+	})
+	t.Run("should return error due to invalid probability", func(t *testing.T) {
+		for _, probability := range []float64{0, -0.5, 1.5} {
+			_, err := s.InjectFault(ctx, &devicev1.InjectFaultRequest{Fault: &devicev1.Fault{
+				Kind:        devicev1.FaultKind_FAULT_KIND_STREAM_CLOSE,
+				Probability: proto.Float64(probability),
+			}})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected invalid argument of probability %v, got %v", probability, err)
+			}
+		}
+	})
+	t.Run("should list faults", func(t *testing.T) {
+		res, err := s.ListFaults(ctx, &devicev1.ListFaultsRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.GetFaults()) != 1 {
+			t.Errorf("expected 1 fault, got %v", res.GetFaults())
+		}
+	})
+	t.Run("should return error due to unknown fault", func(t *testing.T) {
+		_, err := s.RemoveFault(ctx, &devicev1.RemoveFaultRequest{Id: "unknown"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+	t.Run("should clear faults", func(t *testing.T) {
+		if _, err := s.ClearFaults(ctx, &devicev1.ClearFaultsRequest{}); err != nil {
+			t.Fatal(err)
+		}
+		if faults := injector.ListFaults(); len(faults) != 0 {
+			t.Errorf("expected no faults, got %+v", faults)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,8 +29,19 @@ type Provider interface {
 	GetHealth() *types.HealthStatus
 	GetDiagnostics() *types.Diagnostics
 	StreamDiagnostics(context.Context) <-chan *types.Diagnostics
-	UpdateDevice(types.DeviceMutation)
+	UpdateDevice(types.DeviceMutation) error
+	ApplyConfig(types.DeviceConfig) (types.DeviceConfig, error)
+	GetSimulation() types.Simulation
+	UpdateSimulation(types.Simulation) types.Simulation
+	ListFaults() []types.Fault
+	InjectFault(types.Fault) types.Fault
+	RemoveFault(id string) error
+	ClearFaults()
+	GetFirmwareUpgrade() types.FirmwareUpgrade
+	UpgradeFirmware(types.FirmwareUpgradeRequest) (types.FirmwareUpgrade, error)
+	Reboot(duration time.Duration) (types.Reboot, error)
 	GenerateChecksum(ctx context.Context, data []byte) (string, error)
+	GenerateSignature(data []byte) (string, error)
 }
 
 type Server struct {
@@ -101,24 +113,242 @@ func (s *Server) UpdateDevice(
 	if err := req.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	s.provider.UpdateDevice(types.DeviceMutation{
+	err := s.provider.UpdateDevice(types.DeviceMutation{
 		DeviceStatus: types.DeviceStatus(req.GetDeviceStatus().String()),
 	})
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	return &devicev1.UpdateDeviceResponse{}, nil
 }
 
+func (s *Server) ApplyConfig(
+	ctx context.Context,
+	req *devicev1.ApplyConfigRequest,
+) (*devicev1.ApplyConfigResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	config, err := s.provider.ApplyConfig(types.DeviceConfigFromProto(req.GetConfig()))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &devicev1.ApplyConfigResponse{Config: config.Proto()}, nil
+}
+
+func (s *Server) GetSimulation(
+	ctx context.Context,
+	_ *devicev1.GetSimulationRequest,
+) (*devicev1.GetSimulationResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	simulation := s.provider.GetSimulation()
+	return &devicev1.GetSimulationResponse{
+		Enabled: simulation.Enabled,
+		Cpu:     simulationProfile(simulation.CPU),
+		Memory:  simulationProfile(simulation.Memory),
+	}, nil
+}
+
+func (s *Server) UpdateSimulation(
+	ctx context.Context,
+	req *devicev1.UpdateSimulationRequest,
+) (*devicev1.UpdateSimulationResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Profiles omitted from the request are kept as is
+	config := s.provider.GetSimulation()
+	config.Enabled = req.GetEnabled()
+	if req.GetCpu() != nil {
+		config.CPU = simulationProfileFromProto(req.GetCpu())
+	}
+	if req.GetMemory() != nil {
+		config.Memory = simulationProfileFromProto(req.GetMemory())
+	}
+	simulation := s.provider.UpdateSimulation(config)
+	return &devicev1.UpdateSimulationResponse{
+		Enabled: simulation.Enabled,
+		Cpu:     simulationProfile(simulation.CPU),
+		Memory:  simulationProfile(simulation.Memory),
+	}, nil
+}
+
+func (s *Server) ListFaults(
+	ctx context.Context,
+	_ *devicev1.ListFaultsRequest,
+) (*devicev1.ListFaultsResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	result := s.provider.ListFaults()
+	faults := make([]*devicev1.Fault, len(result))
+	for i, fault := range result {
+		faults[i] = fault.Proto()
+	}
+	return &devicev1.ListFaultsResponse{Faults: faults}, nil
+}
+
+func (s *Server) InjectFault(
+	ctx context.Context,
+	req *devicev1.InjectFaultRequest,
+) (*devicev1.InjectFaultResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fault := s.provider.InjectFault(types.FaultFromProto(req.GetFault()))
+	return &devicev1.InjectFaultResponse{Fault: fault.Proto()}, nil
+}
+
+func (s *Server) RemoveFault(
+	ctx context.Context,
+	req *devicev1.RemoveFaultRequest,
+) (*devicev1.RemoveFaultResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.provider.RemoveFault(req.GetId()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &devicev1.RemoveFaultResponse{}, nil
+}
+
+func (s *Server) ClearFaults(
+	ctx context.Context,
+	_ *devicev1.ClearFaultsRequest,
+) (*devicev1.ClearFaultsResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	s.provider.ClearFaults()
+	return &devicev1.ClearFaultsResponse{}, nil
+}
+
+func (s *Server) GetFirmwareUpgrade(
+	ctx context.Context,
+	_ *devicev1.GetFirmwareUpgradeRequest,
+) (*devicev1.GetFirmwareUpgradeResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	upgrade := s.provider.GetFirmwareUpgrade()
+	return &devicev1.GetFirmwareUpgradeResponse{Upgrade: upgrade.Proto()}, nil
+}
+
+func (s *Server) UpgradeFirmware(
+	ctx context.Context,
+	req *devicev1.UpgradeFirmwareRequest,
+) (*devicev1.UpgradeFirmwareResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	upgrade, err := s.provider.UpgradeFirmware(types.FirmwareUpgradeRequest{
+		Version:   req.GetVersion(),
+		FailPhase: types.UpgradePhaseFromProto(req.GetFailPhase()),
+	})
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &devicev1.UpgradeFirmwareResponse{Upgrade: upgrade.Proto()}, nil
+}
+
+func (s *Server) Reboot(ctx context.Context, req *devicev1.RebootRequest) (*devicev1.RebootResponse, error) {
+	_, cancel := context.WithTimeout(ctx, DefaultContextTimeout)
+	defer cancel()
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	reboot, err := s.provider.Reboot(req.GetDuration().AsDuration())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return reboot.Proto(), nil
+}
+
 func (s *Server) diagnostics(ctx context.Context, diag *types.Diagnostics) *devicev1.DiagnosticsResponse {
 	res := &devicev1.DiagnosticsResponse{
-		DeviceId:        diag.Identifier,
-		HardwareVersion: diag.DeviceVersions.Hardware,
-		SoftwareVersion: diag.DeviceVersions.Software,
-		FirmwareVersion: diag.DeviceVersions.Firmware,
-		CpuUsage:        diag.CPU,
-		MemoryUsage:     diag.Memory,
-		DeviceStatus:    diag.DeviceStatus.Proto(),
-		Checksum:        diag.Checksum,
-		Timestamp:       timestamppb.Now(),
+		DeviceId:           diag.Identifier,
+		HardwareVersion:    diag.DeviceVersions.Hardware,
+		SoftwareVersion:    diag.DeviceVersions.Software,
+		FirmwareVersion:    diag.DeviceVersions.Firmware,
+		CpuUsage:           diag.CPU,
+		MemoryUsage:        diag.Memory,
+		DeviceStatus:       diag.DeviceStatus.Proto(),
+		Checksum:           diag.Checksum,
+		UptimeSeconds:      uint64(diag.Uptime.Seconds()),
+		LoadAverage_1M:     diag.LoadAverage.One,
+		LoadAverage_5M:     diag.LoadAverage.Five,
+		LoadAverage_15M:    diag.LoadAverage.Fifteen,
+		TemperatureCelsius: diag.Temperature,
+		DiskUsedBytes:      diag.DiskUsed,
+		DiskTotalBytes:     diag.DiskTotal,
+		ProcessCount:       diag.Processes,
+		Interfaces:         networkInterfaces(diag.Interfaces),
+		StreamInterval:     durationpb.New(diag.StreamInterval),
+		Labels:             diag.Labels,
+		Config:             diag.Config,
+		Timestamp:          timestamppb.Now(),
 	}
 	res.Checksum = res.GenerateChecksum(ctx, s.provider)
+	res.Signature = res.GenerateSignature(s.provider)
 	return res
 }
+
+func networkInterfaces(interfaces []types.Interface) []*devicev1.NetworkInterface {
+	result := make([]*devicev1.NetworkInterface, len(interfaces))
+	for i, iface := range interfaces {
+		result[i] = &devicev1.NetworkInterface{
+			Name:        iface.Name,
+			LinkState:   iface.LinkState.Proto(),
+			RxBytes:     iface.RxBytes,
+			TxBytes:     iface.TxBytes,
+			RxPackets:   iface.RxPackets,
+			TxPackets:   iface.TxPackets,
+			RxErrors:    iface.RxErrors,
+			TxErrors:    iface.TxErrors,
+			CounterBits: iface.CounterBits,
+		}
+	}
+	return result
+}
+
+func simulationProfile(profile types.SimulationProfile) *devicev1.SimulationProfile {
+	return &devicev1.SimulationProfile{
+		Kind:             profile.Kind.Proto(),
+		Base:             profile.Base,
+		Amplitude:        profile.Amplitude,
+		Period:           durationpb.New(profile.Period),
+		Step:             profile.Step,
+		Min:              profile.Min,
+		Max:              profile.Max,
+		SpikeProbability: profile.SpikeProbability,
+		SpikeDuration:    durationpb.New(profile.SpikeDuration),
+		Target:           profile.Target,
+		Duration:         durationpb.New(profile.Duration),
+		Seed:             profile.Seed,
+	}
+}
+
+func simulationProfileFromProto(profile *devicev1.SimulationProfile) types.SimulationProfile {
+	return types.SimulationProfile{
+		Kind:             types.ProfileKindFromProto(profile.GetKind()),
+		Base:             profile.GetBase(),
+		Amplitude:        profile.GetAmplitude(),
+		Period:           profile.GetPeriod().AsDuration(),
+		Step:             profile.GetStep(),
+		Min:              profile.GetMin(),
+		Max:              profile.GetMax(),
+		SpikeProbability: profile.GetSpikeProbability(),
+		SpikeDuration:    profile.GetSpikeDuration().AsDuration(),
+		Target:           profile.GetTarget(),
+		Duration:         profile.GetDuration().AsDuration(),
+		Seed:             profile.GetSeed(),
+	}
+}
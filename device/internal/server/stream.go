@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/gateway"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const streamDiagnosticsPattern = "/v1/diagnostics/stream"
+
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// StreamMiddleware serves StreamDiagnostics as Server-Sent Events (Accept: text/event-stream)
+// or over WebSocket (Upgrade: websocket), other requests are passed on to the generated handler
+// that serves the stream as chunked JSON. Events are identified by the timestamp of their
+// sample, the device keeps no history so a client resuming after an event (Last-Event-ID header
+// or last_event_id parameter) is only sent the samples taken after it.
+func StreamMiddleware(client devicev1.DeviceClient) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			pattern, _ := runtime.HTTPPathPattern(r.Context())
+			if pattern != streamDiagnosticsPattern || !gateway.Requested(r) {
+				next(w, r, params)
+				return
+			}
+			last, err := gateway.LastEventID(r)
+			if err != nil {
+				streamError(w, status.Error(codes.InvalidArgument, err.Error()), nil)
+				return
+			}
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+			var trailer metadata.MD
+			stream, err := client.StreamDiagnostics(
+				ctx,
+				&devicev1.DiagnosticsRequest{},
+				grpc.Trailer(&trailer),
+			)
+			if err != nil {
+				streamError(w, err, nil)
+				return
+			}
+			// Await the first sample so that a failing stream (e.g. an injected error) still
+			// responds with an error status
+			first, err := stream.Recv()
+			if err != nil && !errors.Is(err, io.EOF) {
+				streamError(w, err, trailer)
+				return
+			}
+			events := gateway.Events(ctx, diagnosticsEvents(stream, first, err, last))
+			gateway.Serve(ctx, cancel, w, r, "diagnostics", events)
+		}
+	}
+}
+
+// diagnosticsEvents returns the samples taken after the last event, starting with the first
+// sample received
+func diagnosticsEvents(
+	stream devicev1.Device_StreamDiagnosticsClient,
+	res *devicev1.DiagnosticsResponse,
+	err error,
+	last time.Time,
+) gateway.NextFunc {
+	received := true
+	return func() (string, proto.Message, error) {
+		for {
+			if !received {
+				res, err = stream.Recv()
+			}
+			received = false
+			if err != nil {
+				return "", nil, err
+			}
+			if timestamp := res.GetTimestamp(); timestamp == nil || timestamp.AsTime().After(last) {
+				return gateway.EventID(timestamp), res, nil
+			}
+		}
+	}
+}
+
+// streamError responds with the HTTP status of an injected error (see GatewayErrorHandler) or
+// the status mapped from its gRPC code.
+func streamError(w http.ResponseWriter, err error, trailer metadata.MD) {
+	st := status.Convert(err)
+	code := runtime.HTTPStatusFromCode(st.Code())
+	if values := trailer.Get(HeaderHTTPStatus); len(values) > 0 {
+		if injected, err := strconv.Atoi(values[0]); err == nil {
+			code = injected
+		}
+	}
+	data, _ := marshalOptions.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
-	"runtime"
+	"errors"
+	"fmt"
+	"maps"
 	"time"
 
 	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
@@ -18,14 +20,74 @@ type ChecksumGenerator interface {
 	GenerateChecksum(ctx context.Context, data []byte) (string, error)
 }
 
+type SignatureGenerator interface {
+	GenerateSignature(data []byte) (string, error)
+}
+
+type MetricsCollector interface {
+	Collect() types.Metrics
+}
+
+type Simulator interface {
+	MetricsCollector
+	Enabled() bool
+	GetSimulation() types.Simulation
+	UpdateSimulation(config types.Simulation)
+}
+
+type FaultInjector interface {
+	ListFaults() []types.Fault
+	InjectFault(fault types.Fault) types.Fault
+	RemoveFault(id string) error
+	ClearFaults()
+}
+
+type FirmwareUpgrader interface {
+	GetFirmwareUpgrade() types.FirmwareUpgrade
+	UpgradeFirmware(req types.FirmwareUpgradeRequest) (types.FirmwareUpgrade, error)
+}
+
+type Rebooter interface {
+	Reboot(duration time.Duration) (types.Reboot, error)
+	Done() <-chan struct{}
+}
+
+var ErrorInvalidTransition = errors.New("invalid device status transition")
+
 type Service struct {
-	provider StateProvider
-	checksum ChecksumGenerator
-	logger   *zap.Logger
+	provider  StateProvider
+	metrics   MetricsCollector
+	simulator Simulator
+	faults    FaultInjector
+	firmware  FirmwareUpgrader
+	rebooter  Rebooter
+	checksum  ChecksumGenerator
+	signature SignatureGenerator
+	logger    *zap.Logger
 }
 
-func NewDeviceService(provider StateProvider, checksum ChecksumGenerator, logger *zap.Logger) *Service {
-	return &Service{provider: provider, checksum: checksum, logger: logger}
+func NewDeviceService(
+	provider StateProvider,
+	metrics MetricsCollector,
+	simulator Simulator,
+	faults FaultInjector,
+	firmware FirmwareUpgrader,
+	rebooter Rebooter,
+	checksum ChecksumGenerator,
+	signature SignatureGenerator,
+	logger *zap.Logger,
+) *Service {
+	return &Service{
+		provider:  provider,
+		metrics:   metrics,
+		simulator: simulator,
+		faults:    faults,
+		firmware:  firmware,
+		rebooter:  rebooter,
+		checksum:  checksum,
+		signature: signature,
+		logger:    logger,
+	}
 }
 
 func (s *Service) GetHealth() *types.HealthStatus {
@@ -40,15 +102,15 @@ func (s *Service) GetHealth() *types.HealthStatus {
 }
 
 func (s *Service) GetDiagnostics() *types.Diagnostics {
-	var m runtime.MemStats
-	runtime.ReadMemStats(&m)
 	state := s.provider.GetState()
 	return s.diagnostics(state)
 }
 
+// StreamDiagnostics streams diagnostics until the context is done or the device reboots
 func (s *Service) StreamDiagnostics(ctx context.Context) <-chan *types.Diagnostics {
 	ch := make(chan *types.Diagnostics)
 	interval := s.provider.GetState().StreamInterval
+	reboot := s.rebooter.Done()
 	go func() {
 		defer close(ch)
 		ticker := time.NewTicker(interval)
@@ -57,8 +119,14 @@ func (s *Service) StreamDiagnostics(ctx context.Context) <-chan *types.Diagnosti
 			select {
 			case <-ctx.Done():
 				return
+			case <-reboot:
+				return
 			case <-ticker.C:
 				state := s.provider.GetState()
+				if state.StreamInterval != interval {
+					interval = state.StreamInterval
+					ticker.Reset(interval)
+				}
 				ch <- s.diagnostics(state)
 			}
 		}
@@ -66,24 +134,133 @@ func (s *Service) StreamDiagnostics(ctx context.Context) <-chan *types.Diagnosti
 	return ch
 }
 
-func (s *Service) UpdateDevice(mutation types.DeviceMutation) {
+// UpdateDevice changes the device status, transitions not allowed from the current status
+// are rejected.
+func (s *Service) UpdateDevice(mutation types.DeviceMutation) error {
+	var err error
 	s.provider.UpdateState(func(state *types.DeviceState) {
-		state.DeviceStatus = mutation.DeviceStatus
+		err = transition(state, mutation.DeviceStatus)
+	})
+	return err
+}
+
+// ApplyConfig applies a configuration document, streams pick up a new interval with their
+// next message. The configuration is rejected as a whole if the status transition is not
+// allowed.
+func (s *Service) ApplyConfig(config types.DeviceConfig) (types.DeviceConfig, error) {
+	var err error
+	state := s.provider.UpdateState(func(state *types.DeviceState) {
+		if config.DeviceStatus != "" {
+			if err = transition(state, config.DeviceStatus); err != nil {
+				return
+			}
+		}
+		if config.StreamInterval > 0 {
+			state.StreamInterval = config.StreamInterval
+		}
+		state.Labels = maps.Clone(config.Labels)
+		state.Config = maps.Clone(config.Config)
 	})
+	if err != nil {
+		return types.DeviceConfig{}, err
+	}
+	s.logger.Info(
+		"applied config",
+		zap.String("device_status", state.DeviceStatus.String()),
+		zap.Duration("stream_interval", state.StreamInterval),
+	)
+	return types.DeviceConfig{
+		DeviceStatus:   state.DeviceStatus,
+		StreamInterval: state.StreamInterval,
+		Labels:         state.Labels,
+		Config:         state.Config,
+	}, nil
+}
+
+func (s *Service) GetSimulation() types.Simulation {
+	return s.simulator.GetSimulation()
+}
+
+func (s *Service) UpdateSimulation(config types.Simulation) types.Simulation {
+	s.simulator.UpdateSimulation(config)
+	s.logger.Info("updated simulation", zap.Bool("enabled", config.Enabled))
+	return s.simulator.GetSimulation()
+}
+
+func (s *Service) ListFaults() []types.Fault {
+	return s.faults.ListFaults()
+}
+
+func (s *Service) InjectFault(fault types.Fault) types.Fault {
+	return s.faults.InjectFault(fault)
+}
+
+func (s *Service) RemoveFault(id string) error {
+	return s.faults.RemoveFault(id)
+}
+
+func (s *Service) ClearFaults() {
+	s.faults.ClearFaults()
+}
+
+func (s *Service) GetFirmwareUpgrade() types.FirmwareUpgrade {
+	return s.firmware.GetFirmwareUpgrade()
+}
+
+func (s *Service) UpgradeFirmware(req types.FirmwareUpgradeRequest) (types.FirmwareUpgrade, error) {
+	return s.firmware.UpgradeFirmware(req)
+}
+
+func (s *Service) Reboot(duration time.Duration) (types.Reboot, error) {
+	return s.rebooter.Reboot(duration)
 }
 
 func (s *Service) GenerateChecksum(ctx context.Context, data []byte) (string, error) {
 	return s.checksum.GenerateChecksum(ctx, data)
 }
 
+func (s *Service) GenerateSignature(data []byte) (string, error) {
+	return s.signature.GenerateSignature(data)
+}
+
 func (s *Service) diagnostics(state types.DeviceState) *types.Diagnostics {
-	var m runtime.MemStats
-	runtime.ReadMemStats(&m)
+	metrics := s.collect()
 	return &types.Diagnostics{
 		Identifier:     state.Identifier,
 		DeviceVersions: state.DeviceVersions,
-		CPU:            m.GCCPUFraction * 100.0,
-		Memory:         float64(m.Alloc) / float64(m.Sys) * 100.0,
+		CPU:            metrics.CPU,
+		Memory:         metrics.Memory,
 		DeviceStatus:   state.DeviceStatus,
+		Uptime:         metrics.Uptime,
+		LoadAverage:    metrics.LoadAverage,
+		Temperature:    metrics.Temperature,
+		DiskUsed:       metrics.DiskUsed,
+		DiskTotal:      metrics.DiskTotal,
+		Processes:      metrics.Processes,
+		Interfaces:     metrics.Interfaces,
+		StreamInterval: state.StreamInterval,
+		Labels:         state.Labels,
+		Config:         state.Config,
+	}
+}
+
+// transition moves the device to a status, transitions that are not allowed are rejected
+func transition(state *types.DeviceState, status types.DeviceStatus) error {
+	if !state.DeviceStatus.CanTransition(status) {
+		return fmt.Errorf("%w: %s to %s", ErrorInvalidTransition, state.DeviceStatus, status)
+	}
+	state.DeviceStatus = status
+	return nil
+}
+
+// collect overrides the simulated metrics (CPU and memory) of the configured collector
+// while the simulation is enabled.
+func (s *Service) collect() types.Metrics {
+	metrics := s.metrics.Collect()
+	if s.simulator.Enabled() {
+		simulated := s.simulator.Collect()
+		metrics.CPU = simulated.CPU
+		metrics.Memory = simulated.Memory
 	}
+	return metrics
 }
@@ -0,0 +1,17 @@
+//go:build unix
+
+package service
+
+import "syscall"
+
+// diskUsage reports the used and total bytes of the filesystem containing path, space
+// reserved for the superuser counts as used.
+func diskUsage(path string) (uint64, uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
+	}
+	size := uint64(stat.Bsize) // nolint:gosec
+	total := uint64(stat.Blocks) * size
+	return total - uint64(stat.Bavail)*size, total, nil
+}
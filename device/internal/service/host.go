@@ -0,0 +1,217 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+// Samples closer than this share the previous result, concurrent streams would otherwise
+// compute CPU usage over near-zero windows.
+const DefaultHostSampleInterval = 100 * time.Millisecond
+
+type cpuTimes struct {
+	idle  uint64
+	total uint64
+}
+
+// Metrics Collector (Linux host)
+type HostCollector struct {
+	path     string
+	sys      string
+	disk     string
+	interval time.Duration
+	mu       sync.Mutex
+	previous cpuTimes
+	sampled  time.Time
+	last     types.Metrics
+}
+
+func NewHostCollector(path, sys, disk string) (*HostCollector, error) {
+	c := &HostCollector{path: path, sys: sys, disk: disk, interval: DefaultHostSampleInterval}
+	if _, err := c.cpuTimes(); err != nil {
+		return nil, fmt.Errorf("host metrics are unavailable: %w", err)
+	}
+	// The first window starts at boot (zero jiffies)
+	c.sample(time.Now())
+	return c, nil
+}
+
+func (c *HostCollector) Collect() types.Metrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now := time.Now(); now.Sub(c.sampled) >= c.interval {
+		c.sample(now)
+	}
+	return c.last
+}
+
+func (c *HostCollector) sample(now time.Time) {
+	// Failed reads keep the last known value of the metric
+	if times, err := c.cpuTimes(); err == nil {
+		if times.total > c.previous.total {
+			c.last.CPU = usage(c.previous, times)
+		}
+		c.previous = times
+	}
+	if memory, err := c.memory(); err == nil {
+		c.last.Memory = memory
+	}
+	if load, processes, err := c.load(); err == nil {
+		c.last.LoadAverage = load
+		c.last.Processes = processes
+	}
+	if uptime, err := c.uptime(); err == nil {
+		c.last.Uptime = uptime
+	}
+	if temperature, err := c.temperature(); err == nil {
+		c.last.Temperature = temperature
+	}
+	if interfaces, err := c.interfaces(); err == nil {
+		c.last.Interfaces = interfaces
+	}
+	if used, total, err := diskUsage(c.disk); err == nil {
+		c.last.DiskUsed = used
+		c.last.DiskTotal = total
+	}
+	c.sampled = now
+}
+
+// cpuTimes reads the aggregated jiffies of /proc/stat, guest time is already accounted
+// for in user and nice and is therefore excluded from the total.
+func (c *HostCollector) cpuTimes() (cpuTimes, error) {
+	data, err := os.ReadFile(filepath.Join(c.path, "stat"))
+	if err != nil {
+		return cpuTimes{}, err
+	}
+	line, _, _ := bytes.Cut(data, []byte("\n"))
+	fields := strings.Fields(string(line))
+	if len(fields) < 5 || fields[0] != "cpu" {
+		return cpuTimes{}, fmt.Errorf("unexpected format of %s/stat", c.path)
+	}
+	var times cpuTimes
+	for i, field := range fields[1:min(len(fields), 9)] {
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return cpuTimes{}, err
+		}
+		times.total += value
+		if i == 3 || i == 4 { // idle, iowait
+			times.idle += value
+		}
+	}
+	return times, nil
+}
+
+func (c *HostCollector) memory() (float64, error) {
+	file, err := os.Open(filepath.Join(c.path, "meminfo"))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close() // nolint:errcheck
+	var total, available float64
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() && (total == 0 || available == 0) {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			total, _ = strconv.ParseFloat(fields[1], 64)
+		case "MemAvailable:":
+			available, _ = strconv.ParseFloat(fields[1], 64)
+		}
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("missing MemTotal in %s/meminfo", c.path)
+	}
+	return (total - available) / total * 100.0, nil
+}
+
+// load reads the load averages and the number of scheduling entities (running/total) of
+// /proc/loadavg, the total is used as the process count.
+func (c *HostCollector) load() (types.LoadAverage, uint32, error) {
+	data, err := os.ReadFile(filepath.Join(c.path, "loadavg"))
+	if err != nil {
+		return types.LoadAverage{}, 0, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 4 {
+		return types.LoadAverage{}, 0, fmt.Errorf("unexpected format of %s/loadavg", c.path)
+	}
+	var values [3]float64
+	for i := range values {
+		if values[i], err = strconv.ParseFloat(fields[i], 64); err != nil {
+			return types.LoadAverage{}, 0, err
+		}
+	}
+	_, total, _ := strings.Cut(fields[3], "/")
+	processes, err := strconv.ParseUint(total, 10, 32)
+	if err != nil {
+		return types.LoadAverage{}, 0, err
+	}
+	return types.LoadAverage{One: values[0], Five: values[1], Fifteen: values[2]}, uint32(processes), nil
+}
+
+// temperature reports the hottest thermal zone in °C, hosts without thermal zones (most
+// virtual machines and containers on non-Linux hosts) report an error.
+func (c *HostCollector) temperature() (float64, error) {
+	zones, err := filepath.Glob(filepath.Join(c.sys, "class", "thermal", "thermal_zone*", "temp"))
+	if err != nil {
+		return 0, err
+	}
+	if len(zones) == 0 {
+		return 0, fmt.Errorf("no thermal zones in %s", c.sys)
+	}
+	hottest := math.Inf(-1)
+	for _, zone := range zones {
+		data, err := os.ReadFile(zone)
+		if err != nil {
+			continue
+		}
+		millidegrees, err := strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+		if err != nil {
+			continue
+		}
+		hottest = math.Max(hottest, millidegrees/1000.0)
+	}
+	if math.IsInf(hottest, -1) {
+		return 0, fmt.Errorf("no readable thermal zones in %s", c.sys)
+	}
+	return hottest, nil
+}
+
+func (c *HostCollector) uptime() (time.Duration, error) {
+	data, err := os.ReadFile(filepath.Join(c.path, "uptime"))
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 1 {
+		return 0, fmt.Errorf("unexpected format of %s/uptime", c.path)
+	}
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func usage(previous, current cpuTimes) float64 {
+	total := current.total - previous.total
+	if total == 0 {
+		return 0
+	}
+	busy := total - min(current.idle-previous.idle, total)
+	return float64(busy) / float64(total) * 100.0
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+// host copies the procfs and sysfs fixture of testdata/host so tests can modify the files
+func host(t *testing.T) (string, string) {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.CopyFS(root, os.DirFS(filepath.Join("testdata", "host"))); err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(root, "proc"), filepath.Join(root, "sys")
+}
+
+func collector(t *testing.T, proc, sys string) *HostCollector {
+	t.Helper()
+	c, err := NewHostCollector(proc, sys, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func overwrite(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHostCollector_Collect(t *testing.T) {
+	proc, sys := host(t)
+	metrics := collector(t, proc, sys).Collect()
+
+	// The first window starts at boot: 800 of 4000 jiffies are busy
+	if metrics.CPU != 20 {
+		t.Errorf("expected cpu usage since boot of 20, got %v", metrics.CPU)
+	}
+	if metrics.Memory != 25 {
+		t.Errorf("expected memory usage of 25, got %v", metrics.Memory)
+	}
+	if expected := (types.LoadAverage{One: 0.52, Five: 0.34, Fifteen: 0.21}); metrics.LoadAverage != expected {
+		t.Errorf("expected load average %+v, got %+v", expected, metrics.LoadAverage)
+	}
+	if metrics.Processes != 412 {
+		t.Errorf("expected 412 processes, got %d", metrics.Processes)
+	}
+	if expected := 86400*time.Second + 500*time.Millisecond; metrics.Uptime != expected {
+		t.Errorf("expected uptime %s, got %s", expected, metrics.Uptime)
+	}
+	if metrics.Temperature != 55.5 {
+		t.Errorf("expected temperature of the hottest zone of 55.5, got %v", metrics.Temperature)
+	}
+	if metrics.DiskTotal == 0 || metrics.DiskUsed > metrics.DiskTotal {
+		t.Errorf("expected disk usage, got %d of %d", metrics.DiskUsed, metrics.DiskTotal)
+	}
+}
+
+func TestHostCollector_CPU(t *testing.T) {
+	proc, sys := host(t)
+	c := collector(t, proc, sys)
+	tests := []struct {
+		name     string
+		stat     string
+		expected float64
+	}{
+		{
+			name:     "should compute usage of the window between samples",
+			stat:     "cpu  900 50 250 3500 300 0 0 0 0 0\n",
+			expected: 40,
+		},
+		{
+			name:     "should count iowait as idle and exclude guest time",
+			stat:     "cpu  1000 50 250 3700 400 0 0 0 100 0\n",
+			expected: 25,
+		},
+		{
+			name:     "should keep usage of a window without jiffies",
+			stat:     "cpu  1000 50 250 3700 400 0 0 0 100 0\n",
+			expected: 25,
+		},
+		{
+			name:     "should keep usage of malformed line",
+			stat:     "cpu  1000 fifty 250 3700 400 0 0 0 0 0\n",
+			expected: 25,
+		},
+		{
+			name:     "should keep usage of line without aggregate",
+			stat:     "cpu0 2000 50 250 3700 400 0 0 0 0 0\n",
+			expected: 25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overwrite(t, filepath.Join(proc, "stat"), tt.stat)
+			c.sample(time.Now())
+			if result := c.Collect().CPU; result != tt.expected {
+				t.Errorf("expected cpu usage of %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestHostCollector_Unavailable(t *testing.T) {
+	t.Run("should return error due to missing stat", func(t *testing.T) {
+		proc, sys := host(t)
+		if err := os.Remove(filepath.Join(proc, "stat")); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewHostCollector(proc, sys, t.TempDir()); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("should return error due to malformed stat", func(t *testing.T) {
+		proc, sys := host(t)
+		overwrite(t, filepath.Join(proc, "stat"), "cpu 1 2\n")
+		if _, err := NewHostCollector(proc, sys, t.TempDir()); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("should report no temperature without thermal zones", func(t *testing.T) {
+		proc, sys := host(t)
+		if err := os.RemoveAll(filepath.Join(sys, "class", "thermal")); err != nil {
+			t.Fatal(err)
+		}
+		metrics := collector(t, proc, sys).Collect()
+		if metrics.Temperature != 0 {
+			t.Errorf("expected no temperature, got %v", metrics.Temperature)
+		}
+		if metrics.Memory != 25 {
+			t.Errorf("expected remaining metrics to be collected, got memory %v", metrics.Memory)
+		}
+	})
+	t.Run("should skip unreadable thermal zone", func(t *testing.T) {
+		proc, sys := host(t)
+		overwrite(t, filepath.Join(sys, "class", "thermal", "thermal_zone1", "temp"), "hot\n")
+		if result := collector(t, proc, sys).Collect().Temperature; result != 42 {
+			t.Errorf("expected temperature of the readable zone of 42, got %v", result)
+		}
+	})
+}
+
+func TestHostCollector_Malformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		file  string
+		data  string
+		check func(before, after types.Metrics) bool
+	}{
+		{
+			name:  "should skip malformed lines of meminfo",
+			file:  "meminfo",
+			data:  "MemTotal: 4000000 kB\nMemFree:\nMemAvailable: 1000000 kB\n",
+			check: func(before, after types.Metrics) bool { return after.Memory == 75 },
+		},
+		{
+			name:  "should keep memory without total",
+			file:  "meminfo",
+			data:  "MemAvailable: 1000000 kB\n",
+			check: func(before, after types.Metrics) bool { return after.Memory == before.Memory },
+		},
+		{
+			name: "should keep load average of malformed loadavg",
+			file: "loadavg",
+			data: "0.52 high 0.21 2/412 98765\n",
+			check: func(before, after types.Metrics) bool {
+				return after.LoadAverage == before.LoadAverage && after.Processes == before.Processes
+			},
+		},
+		{
+			name: "should keep load average of truncated loadavg",
+			file: "loadavg",
+			data: "0.52 0.34\n",
+			check: func(before, after types.Metrics) bool {
+				return after.LoadAverage == before.LoadAverage
+			},
+		},
+		{
+			name:  "should keep uptime of malformed uptime",
+			file:  "uptime",
+			data:  "forever\n",
+			check: func(before, after types.Metrics) bool { return after.Uptime == before.Uptime },
+		},
+		{
+			name: "should keep interfaces of truncated line",
+			file: "net/dev",
+			data: "  eth0: 1 2 3\n",
+			check: func(before, after types.Metrics) bool {
+				return slices.Equal(after.Interfaces, before.Interfaces)
+			},
+		},
+		{
+			name: "should keep interfaces of malformed counter",
+			file: "net/dev",
+			data: "  eth0: 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 -16\n",
+			check: func(before, after types.Metrics) bool {
+				return slices.Equal(after.Interfaces, before.Interfaces)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proc, sys := host(t)
+			c := collector(t, proc, sys)
+			before := c.Collect()
+			overwrite(t, filepath.Join(proc, tt.file), tt.data)
+			c.sample(time.Now())
+			if after := c.Collect(); !tt.check(before, after) {
+				t.Errorf("unexpected metrics after malformed %s: %+v", tt.file, after)
+			}
+		})
+	}
+}
+
+func TestHostCollector_Interfaces(t *testing.T) {
+	proc, sys := host(t)
+	expected := []types.Interface{
+		{
+			Name:        "eth0",
+			LinkState:   types.LinkStateUp,
+			RxBytes:     5000000,
+			TxBytes:     3000000,
+			RxPackets:   4000,
+			TxPackets:   2500,
+			RxErrors:    2,
+			TxErrors:    1,
+			CounterBits: strconv.IntSize,
+		},
+		{
+			Name:        "eth1",
+			LinkState:   types.LinkStateDown,
+			RxBytes:     700,
+			TxBytes:     300,
+			RxPackets:   7,
+			TxPackets:   3,
+			CounterBits: strconv.IntSize,
+		},
+		// Without carrier detection the link state follows IFF_UP of the flags
+		{
+			Name:        "wg0",
+			LinkState:   types.LinkStateUp,
+			RxBytes:     9000,
+			TxBytes:     8000,
+			RxPackets:   90,
+			TxPackets:   80,
+			CounterBits: strconv.IntSize,
+		},
+	}
+	if result := collector(t, proc, sys).Collect().Interfaces; !slices.Equal(result, expected) {
+		t.Errorf("expected interfaces without loopback %+v, got %+v", expected, result)
+	}
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+// Metrics Collector (Go runtime)
+type RuntimeCollector struct {
+	started time.Time
+}
+
+func NewRuntimeCollector() *RuntimeCollector {
+	return &RuntimeCollector{started: time.Now()}
+}
+
+func (c *RuntimeCollector) Collect() types.Metrics {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return types.Metrics{
+		CPU:    m.GCCPUFraction * 100.0,
+		Memory: float64(m.Alloc) / float64(m.Sys) * 100.0,
+		Uptime: time.Since(c.started),
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+// Loopback traffic never leaves the device and is not reported
+const loopbackInterface = "lo"
+
+// Column offsets of /proc/net/dev (after the interface name)
+const (
+	netDevRxBytes   = 0
+	netDevRxPackets = 1
+	netDevRxErrors  = 2
+	netDevTxBytes   = 8
+	netDevTxPackets = 9
+	netDevTxErrors  = 10
+	netDevColumns   = 16
+)
+
+// interfaces reads the cumulative counters of /proc/net/dev, counters are reported as is
+// with their width so rates (including counter wraps and resets) are left to the consumer.
+func (c *HostCollector) interfaces() ([]types.Interface, error) {
+	file, err := os.Open(filepath.Join(c.path, "net", "dev"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() // nolint:errcheck
+	var result []types.Interface
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name, counters, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue // headers
+		}
+		name = strings.TrimSpace(name)
+		if name == loopbackInterface {
+			continue
+		}
+		fields := strings.Fields(counters)
+		if len(fields) < netDevColumns {
+			return nil, fmt.Errorf("unexpected format of %s/net/dev", c.path)
+		}
+		values := make([]uint64, netDevColumns)
+		for i := range values {
+			if values[i], err = strconv.ParseUint(fields[i], 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, types.Interface{
+			Name:      name,
+			LinkState: c.linkState(name),
+			RxBytes:   values[netDevRxBytes],
+			TxBytes:   values[netDevTxBytes],
+			RxPackets: values[netDevRxPackets],
+			TxPackets: values[netDevTxPackets],
+			RxErrors:  values[netDevRxErrors],
+			TxErrors:  values[netDevTxErrors],
+			// The counters of /proc/net/dev are unsigned longs of the kernel
+			CounterBits: strconv.IntSize,
+		})
+	}
+	return result, scanner.Err()
+}
+
+// linkState maps the operational state of an interface, virtual interfaces without carrier
+// detection report "unknown" and fall back to the administrative state (IFF_UP).
+func (c *HostCollector) linkState(name string) types.LinkState {
+	base := filepath.Join(c.sys, "class", "net", name)
+	data, err := os.ReadFile(filepath.Join(base, "operstate"))
+	if err != nil {
+		return types.LinkState("")
+	}
+	switch strings.TrimSpace(string(data)) {
+	case "up":
+		return types.LinkStateUp
+	case "unknown":
+		data, err := os.ReadFile(filepath.Join(base, "flags"))
+		if err != nil {
+			return types.LinkState("")
+		}
+		flags, err := strconv.ParseUint(strings.TrimSpace(string(data)), 0, 32)
+		if err != nil {
+			return types.LinkState("")
+		}
+		if flags&0x1 != 0 {
+			return types.LinkStateUp
+		}
+		return types.LinkStateDown
+	default:
+		return types.LinkStateDown
+	}
+}
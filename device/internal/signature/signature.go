@@ -0,0 +1,91 @@
+package signature
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+var (
+	ErrorInvalidKey = errors.New("invalid signing key")
+)
+
+type Signer struct {
+	algorithm types.SigningAlgorithm
+	secret    []byte
+	private   ed25519.PrivateKey
+}
+
+// NewSigner creates a signer from a base64 encoded key, the key is either a shared
+// secret (hmac-sha256) or a private key or seed (ed25519).
+func NewSigner(config types.Signing) (*Signer, error) {
+	signer := &Signer{algorithm: config.Algorithm}
+	if config.Algorithm == types.SigningAlgorithmNone || config.Algorithm == "" {
+		signer.algorithm = types.SigningAlgorithmNone
+		return signer, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(config.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode key (%s): %w", ErrorInvalidKey, config.Algorithm, err)
+	}
+	switch config.Algorithm {
+	case types.SigningAlgorithmHmacSha256:
+		if len(key) == 0 {
+			return nil, fmt.Errorf("%w: empty secret (%s)", ErrorInvalidKey, config.Algorithm)
+		}
+		signer.secret = key
+	case types.SigningAlgorithmEd25519:
+		switch len(key) {
+		case ed25519.SeedSize:
+			signer.private = ed25519.NewKeyFromSeed(key)
+		case ed25519.PrivateKeySize:
+			signer.private = ed25519.PrivateKey(key)
+		default:
+			return nil, fmt.Errorf(
+				"%w: unexpected key size %d (%s)",
+				ErrorInvalidKey,
+				len(key),
+				config.Algorithm,
+			)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported algorithm %s", ErrorInvalidKey, config.Algorithm)
+	}
+	return signer, nil
+}
+
+func (s *Signer) Algorithm() types.SigningAlgorithm {
+	return s.algorithm
+}
+
+// VerificationKey returns the base64 encoded key signatures are verified with, the shared
+// secret (hmac-sha256) or the public key (ed25519).
+func (s *Signer) VerificationKey() string {
+	switch s.algorithm {
+	case types.SigningAlgorithmHmacSha256:
+		return base64.StdEncoding.EncodeToString(s.secret)
+	case types.SigningAlgorithmEd25519:
+		public, _ := s.private.Public().(ed25519.PublicKey)
+		return base64.StdEncoding.EncodeToString(public)
+	default:
+		return ""
+	}
+}
+
+func (s *Signer) GenerateSignature(data []byte) (string, error) {
+	switch s.algorithm {
+	case types.SigningAlgorithmHmacSha256:
+		mac := hmac.New(sha256.New, s.secret)
+		mac.Write(data)
+		return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+	case types.SigningAlgorithmEd25519:
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(s.private, data)), nil
+	default:
+		return "", nil
+	}
+}
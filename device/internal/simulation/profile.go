@@ -0,0 +1,99 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+type profileFile struct {
+	Seed   *int64       `json:"seed"`
+	CPU    *profileSpec `json:"cpu"`
+	Memory *profileSpec `json:"memory"`
+}
+
+type profileSpec struct {
+	Kind             string  `json:"kind"`
+	Base             float64 `json:"base"`
+	Amplitude        float64 `json:"amplitude"`
+	Period           string  `json:"period"`
+	Step             float64 `json:"step"`
+	Min              float64 `json:"min"`
+	Max              float64 `json:"max"`
+	SpikeProbability float64 `json:"spike_probability"`
+	SpikeDuration    string  `json:"spike_duration"`
+	Target           float64 `json:"target"`
+	Duration         string  `json:"duration"`
+	Seed             int64   `json:"seed"`
+}
+
+// LoadProfileFile overrides the simulation configuration with the profiles of a JSON file,
+// profiles present in the file replace the environment profile of the same metric as a whole.
+// Whether the simulation is enabled is decided by the metrics mode, not by the file.
+func LoadProfileFile(path string, config *types.Simulation) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read simulation profile file: %w", err)
+	}
+	var file profileFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to decode simulation profile file: %w", err)
+	}
+	if file.Seed != nil {
+		config.Seed = *file.Seed
+	}
+	if file.CPU != nil {
+		if config.CPU, err = file.CPU.profile(); err != nil {
+			return fmt.Errorf("invalid cpu profile: %w", err)
+		}
+	}
+	if file.Memory != nil {
+		if config.Memory, err = file.Memory.profile(); err != nil {
+			return fmt.Errorf("invalid memory profile: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *profileSpec) profile() (types.SimulationProfile, error) {
+	kind, err := types.ParseProfileKind(s.Kind)
+	if err != nil {
+		return types.SimulationProfile{}, err
+	}
+	period, err := duration(s.Period)
+	if err != nil {
+		return types.SimulationProfile{}, err
+	}
+	spike, err := duration(s.SpikeDuration)
+	if err != nil {
+		return types.SimulationProfile{}, err
+	}
+	total, err := duration(s.Duration)
+	if err != nil {
+		return types.SimulationProfile{}, err
+	}
+	return types.SimulationProfile{
+		Kind:             kind,
+		Base:             s.Base,
+		Amplitude:        s.Amplitude,
+		Period:           period,
+		Step:             s.Step,
+		Min:              s.Min,
+		Max:              s.Max,
+		SpikeProbability: s.SpikeProbability,
+		SpikeDuration:    spike,
+		Target:           s.Target,
+		Duration:         total,
+		Seed:             s.Seed,
+	}, nil
+}
+
+func duration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,140 @@
+package simulation
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+const (
+	DefaultPeriod        = 60 * time.Second
+	DefaultSpikeDuration = 5 * time.Second
+	DefaultDuration      = 10 * time.Minute
+	DefaultMax           = 100.0
+)
+
+// Simulation Engine
+type Engine struct {
+	mu      sync.Mutex
+	config  types.Simulation
+	cpu     *generator
+	memory  *generator
+	enabled bool
+}
+
+func NewEngine(config types.Simulation) *Engine {
+	engine := &Engine{}
+	engine.UpdateSimulation(config)
+	return engine
+}
+
+func (e *Engine) Enabled() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.enabled
+}
+
+func (e *Engine) Collect() types.Metrics {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := time.Now()
+	return types.Metrics{
+		CPU:    e.cpu.next(now),
+		Memory: e.memory.next(now),
+	}
+}
+
+func (e *Engine) GetSimulation() types.Simulation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.config
+}
+
+// UpdateSimulation replaces the active profiles, generators restart from the beginning of
+// their profile and are reseeded so runs with equal configuration are reproducible.
+func (e *Engine) UpdateSimulation(config types.Simulation) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := time.Now()
+	config.CPU = withDefaults(config.CPU)
+	config.Memory = withDefaults(config.Memory)
+	e.config = config
+	e.enabled = config.Enabled
+	e.cpu = newGenerator(config.CPU, config.Seed, now)
+	e.memory = newGenerator(config.Memory, config.Seed+1, now)
+}
+
+// Profile Generator
+type generator struct {
+	profile    types.SimulationProfile
+	rng        *rand.Rand
+	start      time.Time
+	value      float64
+	spikeUntil time.Time
+}
+
+func newGenerator(profile types.SimulationProfile, seed int64, now time.Time) *generator {
+	if profile.Seed != 0 {
+		seed = profile.Seed
+	}
+	return &generator{
+		profile: profile,
+		rng:     rand.New(rand.NewPCG(uint64(seed), uint64(seed))), // nolint:gosec
+		start:   now,
+		value:   profile.Base,
+	}
+}
+
+func (g *generator) next(now time.Time) float64 {
+	p := g.profile
+	elapsed := now.Sub(g.start)
+	var value float64
+	switch p.Kind {
+	case types.ProfileKindSine:
+		phase := 2 * math.Pi * elapsed.Seconds() / p.Period.Seconds()
+		value = p.Base + p.Amplitude*math.Sin(phase)
+	case types.ProfileKindRandomWalk:
+		g.value = clamp(g.value+g.rng.NormFloat64()*p.Step, p.Min, p.Max)
+		value = g.value
+	case types.ProfileKindSpike:
+		if now.After(g.spikeUntil) && g.rng.Float64() < p.SpikeProbability {
+			g.spikeUntil = now.Add(p.SpikeDuration)
+		}
+		value = p.Base
+		if now.Before(g.spikeUntil) {
+			value = p.Base + p.Amplitude
+		}
+	case types.ProfileKindRamp:
+		progress := min(elapsed.Seconds()/p.Duration.Seconds(), 1)
+		value = p.Base + (p.Target-p.Base)*progress
+	default:
+		value = p.Base
+	}
+	return clamp(value, p.Min, p.Max)
+}
+
+func withDefaults(profile types.SimulationProfile) types.SimulationProfile {
+	if profile.Kind == "" {
+		profile.Kind = types.ProfileKindConstant
+	}
+	if profile.Period <= 0 {
+		profile.Period = DefaultPeriod
+	}
+	if profile.SpikeDuration <= 0 {
+		profile.SpikeDuration = DefaultSpikeDuration
+	}
+	if profile.Duration <= 0 {
+		profile.Duration = DefaultDuration
+	}
+	if profile.Max <= profile.Min {
+		profile.Max = DefaultMax
+	}
+	return profile
+}
+
+func clamp(value, lower, upper float64) float64 {
+	return math.Max(lower, math.Min(upper, value))
+}
@@ -0,0 +1,112 @@
+package simulation
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/emil-j-olsson/ubiquiti/device/internal/types"
+)
+
+// series returns the values of a generator sampled every second from start
+func series(profile types.SimulationProfile, seed int64, start time.Time, n int) []float64 {
+	g := newGenerator(withDefaults(profile), seed, start)
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = g.next(start.Add(time.Duration(i) * time.Second))
+	}
+	return values
+}
+
+func TestGenerator_Seed(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		profile types.SimulationProfile
+	}{
+		{
+			name:    "should reproduce random walk",
+			profile: types.SimulationProfile{Kind: types.ProfileKindRandomWalk, Base: 50, Step: 5, Max: 100},
+		},
+		{
+			name: "should reproduce spikes",
+			profile: types.SimulationProfile{
+				Kind:             types.ProfileKindSpike,
+				Base:             10,
+				Amplitude:        80,
+				SpikeProbability: 0.2,
+				SpikeDuration:    2 * time.Second,
+				Max:              100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := series(tt.profile, 7, start, 200), series(tt.profile, 7, start, 200)
+			if !slices.Equal(first, second) {
+				t.Errorf("expected equal series of equal seeds, got %v and %v", first, second)
+			}
+			if other := series(tt.profile, 8, start, 200); slices.Equal(first, other) {
+				t.Error("expected different series of different seeds")
+			}
+			tt.profile.Seed = 7
+			if profile := series(tt.profile, 8, start, 200); !slices.Equal(first, profile) {
+				t.Error("expected seed of profile to replace seed of simulation")
+			}
+		})
+	}
+}
+
+func TestEngine_UpdateSimulation(t *testing.T) {
+	config := types.Simulation{
+		Enabled: true,
+		Seed:    7,
+		CPU:     types.SimulationProfile{Kind: types.ProfileKindRandomWalk, Base: 50, Step: 5, Max: 100},
+		Memory:  types.SimulationProfile{Kind: types.ProfileKindRandomWalk, Base: 50, Step: 5, Max: 100},
+	}
+	// collect returns the cpu and memory of consecutive samples
+	collect := func(engine *Engine) []float64 {
+		values := make([]float64, 0, 100)
+		for range 50 {
+			metrics := engine.Collect()
+			values = append(values, metrics.CPU, metrics.Memory)
+		}
+		return values
+	}
+	engine := NewEngine(config)
+	first := collect(engine)
+	if !slices.Equal(first, collect(NewEngine(config))) {
+		t.Error("expected engines of equal configuration to collect equal series")
+	}
+	engine.UpdateSimulation(config)
+	if second := collect(engine); !slices.Equal(first, second) {
+		t.Error("expected update to restart the series")
+	}
+	var cpu, memory []float64
+	for i := 0; i < len(first); i += 2 {
+		cpu, memory = append(cpu, first[i]), append(memory, first[i+1])
+	}
+	if slices.Equal(cpu, memory) {
+		t.Error("expected cpu and memory to be seeded differently")
+	}
+}
+
+func TestLoadProfileFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profile.json")
+	data := `{"enabled": true, "seed": 3, "cpu": {"kind": "sine", "base": 40, "period": "30s"}}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config := types.Simulation{Seed: 1}
+	if err := LoadProfileFile(path, &config); err != nil {
+		t.Fatal(err)
+	}
+	if config.Enabled {
+		t.Error("expected profile file not to enable the simulation")
+	}
+	if config.Seed != 3 || config.CPU.Kind != types.ProfileKindSine || config.CPU.Period != 30*time.Second {
+		t.Errorf("expected profile of file, got %+v", config)
+	}
+}
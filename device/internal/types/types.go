@@ -5,6 +5,8 @@ import (
 	"time"
 
 	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -27,6 +29,74 @@ type Config struct {
 	DeviceVersions     DeviceVersions `envconfig:"VERSION"`
 	StreamInterval     time.Duration  `envconfig:"STREAM_INTERVAL"      default:"500ms"`
 	ChecksumBinaryPath string         `envconfig:"CHECKSUM_BINARY_PATH" default:"/usr/local/bin/checksum"`
+	ChecksumAlgorithm  string         `envconfig:"CHECKSUM_ALGORITHM"   default:"sha256"`
+	Signing            Signing        `envconfig:"SIGNING"`
+	FaultProfileFile   string         `envconfig:"FAULT_PROFILE_FILE"`
+	MetricsMode        MetricsMode    `envconfig:"METRICS_MODE"         default:"runtime"`
+	MetricsProcPath    string         `envconfig:"METRICS_PROC_PATH"    default:"/proc"`
+	MetricsSysPath     string         `envconfig:"METRICS_SYS_PATH"     default:"/sys"`
+	MetricsDiskPath    string         `envconfig:"METRICS_DISK_PATH"    default:"/"`
+	Simulation         Simulation     `envconfig:"SIMULATION"`
+	Emulator           Emulator       `envconfig:"EMULATOR"`
+	Firmware           Firmware       `envconfig:"FIRMWARE"`
+	RebootDuration     time.Duration  `envconfig:"REBOOT_DURATION"      default:"10s"`
+	MQTT               MQTT           `envconfig:"MQTT"`
+}
+
+type Firmware struct {
+	DownloadDuration time.Duration `envconfig:"DOWNLOAD_DURATION" default:"3s"`
+	InstallDuration  time.Duration `envconfig:"INSTALL_DURATION"  default:"2s"`
+	BootDuration     time.Duration `envconfig:"BOOT_DURATION"     default:"5s"`
+}
+
+// MQTT configures the broker the device publishes to, the identifier of the device is the
+// username of its credentials.
+type MQTT struct {
+	Broker   string `envconfig:"BROKER"   default:"tcp://localhost:1883"`
+	Password string `envconfig:"PASSWORD"`
+}
+
+type Emulator struct {
+	Count          int    `envconfig:"COUNT"           default:"0"`
+	ManifestFile   string `envconfig:"MANIFEST_FILE"`
+	ManifestOutput string `envconfig:"MANIFEST_OUTPUT"`
+	Host           string `envconfig:"HOST"            default:"localhost"`
+}
+
+// Enabled reports whether the process emulates multiple devices, either from the template
+// configuration (count) or from a manifest file.
+func (e *Emulator) Enabled() bool {
+	return e.Count > 0 || e.ManifestFile != ""
+}
+
+type Signing struct {
+	Algorithm SigningAlgorithm `envconfig:"ALGORITHM" default:"none"`
+	Key       string           `envconfig:"KEY"`
+}
+
+type Simulation struct {
+	// Deprecated: enabling the simulation through SIMULATION_ENABLED is superseded by
+	// METRICS_MODE=simulated, it is only honored while the metrics mode is left at runtime.
+	Enabled     bool              `envconfig:"ENABLED"      default:"false"`
+	ProfileFile string            `envconfig:"PROFILE_FILE"`
+	Seed        int64             `envconfig:"SEED"         default:"1"`
+	CPU         SimulationProfile `envconfig:"CPU"`
+	Memory      SimulationProfile `envconfig:"MEMORY"`
+}
+
+type SimulationProfile struct {
+	Kind             ProfileKind   `envconfig:"KIND"              default:"constant"`
+	Base             float64       `envconfig:"BASE"              default:"25"`
+	Amplitude        float64       `envconfig:"AMPLITUDE"         default:"10"`
+	Period           time.Duration `envconfig:"PERIOD"            default:"60s"`
+	Step             float64       `envconfig:"STEP"              default:"2"`
+	Min              float64       `envconfig:"MIN"               default:"0"`
+	Max              float64       `envconfig:"MAX"               default:"100"`
+	SpikeProbability float64       `envconfig:"SPIKE_PROBABILITY" default:"0.05"`
+	SpikeDuration    time.Duration `envconfig:"SPIKE_DURATION"    default:"5s"`
+	Target           float64       `envconfig:"TARGET"            default:"90"`
+	Duration         time.Duration `envconfig:"DURATION"          default:"10m"`
+	Seed             int64         `envconfig:"SEED"`
 }
 
 type DeviceVersions struct {
@@ -43,6 +113,8 @@ type DeviceState struct {
 	OS                 string
 	DeviceStatus       DeviceStatus
 	StreamInterval     time.Duration
+	Labels             map[string]string
+	Config             map[string]string
 	Updated            time.Time
 }
 
@@ -61,6 +133,179 @@ type Diagnostics struct {
 	Memory         float64
 	DeviceStatus   DeviceStatus
 	Checksum       string
+	Uptime         time.Duration
+	LoadAverage    LoadAverage
+	Temperature    float64
+	DiskUsed       uint64
+	DiskTotal      uint64
+	Processes      uint32
+	Interfaces     []Interface
+	StreamInterval time.Duration
+	Labels         map[string]string
+	Config         map[string]string
+}
+
+type Metrics struct {
+	CPU         float64
+	Memory      float64
+	LoadAverage LoadAverage
+	Uptime      time.Duration
+	Temperature float64
+	DiskUsed    uint64
+	DiskTotal   uint64
+	Processes   uint32
+	Interfaces  []Interface
+}
+
+type LoadAverage struct {
+	One     float64
+	Five    float64
+	Fifteen float64
+}
+
+type Interface struct {
+	Name      string
+	LinkState LinkState
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	RxErrors  uint64
+	TxErrors  uint64
+	// Width of the counters in bits, consumers tell wraps from resets by it
+	CounterBits uint32
+}
+
+type Fault struct {
+	ID            string
+	Kind          FaultKind
+	Methods       []string
+	Latency       time.Duration
+	Jitter        time.Duration
+	Probability   float64
+	GRPCCode      uint32
+	HTTPStatus    uint32
+	AfterMessages uint32
+	Duration      time.Duration
+	TTL           time.Duration
+	Created       time.Time
+	Expires       time.Time
+}
+
+func (f *Fault) Proto() *devicev1.Fault {
+	probability := f.Probability
+	fault := &devicev1.Fault{
+		Id:            f.ID,
+		Kind:          f.Kind.Proto(),
+		Methods:       f.Methods,
+		Latency:       durationpb.New(f.Latency),
+		Jitter:        durationpb.New(f.Jitter),
+		Probability:   &probability,
+		GrpcCode:      f.GRPCCode,
+		HttpStatus:    f.HTTPStatus,
+		AfterMessages: f.AfterMessages,
+		Duration:      durationpb.New(f.Duration),
+		Ttl:           durationpb.New(f.TTL),
+		CreatedAt:     timestamppb.New(f.Created),
+	}
+	if !f.Expires.IsZero() {
+		fault.ExpiresAt = timestamppb.New(f.Expires)
+	}
+	return fault
+}
+
+// FaultFromProto converts a fault, faults without probability always trigger
+func FaultFromProto(fault *devicev1.Fault) Fault {
+	probability := 1.0
+	if fault.Probability != nil {
+		probability = fault.GetProbability()
+	}
+	return Fault{
+		Kind:          FaultKindFromProto(fault.GetKind()),
+		Methods:       fault.GetMethods(),
+		Latency:       fault.GetLatency().AsDuration(),
+		Jitter:        fault.GetJitter().AsDuration(),
+		Probability:   probability,
+		GRPCCode:      fault.GetGrpcCode(),
+		HTTPStatus:    fault.GetHttpStatus(),
+		AfterMessages: fault.GetAfterMessages(),
+		Duration:      fault.GetDuration().AsDuration(),
+		TTL:           fault.GetTtl().AsDuration(),
+	}
+}
+
+type FirmwareUpgrade struct {
+	TargetVersion   string
+	PreviousVersion string
+	Phase           UpgradePhase
+	FailedPhase     UpgradePhase
+	Error           string
+	Started         time.Time
+	Updated         time.Time
+}
+
+func (u *FirmwareUpgrade) Proto() *devicev1.FirmwareUpgrade {
+	if u.Phase == "" {
+		return &devicev1.FirmwareUpgrade{}
+	}
+	return &devicev1.FirmwareUpgrade{
+		TargetVersion:   u.TargetVersion,
+		PreviousVersion: u.PreviousVersion,
+		Phase:           u.Phase.Proto(),
+		FailedPhase:     u.FailedPhase.Proto(),
+		Error:           u.Error,
+		StartedAt:       timestamppb.New(u.Started),
+		UpdatedAt:       timestamppb.New(u.Updated),
+	}
+}
+
+type FirmwareUpgradeRequest struct {
+	Version   string
+	FailPhase UpgradePhase
+}
+
+type Reboot struct {
+	Started  time.Time
+	Duration time.Duration
+}
+
+func (r *Reboot) Proto() *devicev1.RebootResponse {
+	return &devicev1.RebootResponse{
+		StartedAt: timestamppb.New(r.Started),
+		Duration:  durationpb.New(r.Duration),
+	}
+}
+
+// DeviceConfig is a configuration document applied to the device, an empty status or a zero
+// stream interval keeps the current value while labels and config are replaced.
+type DeviceConfig struct {
+	DeviceStatus   DeviceStatus
+	StreamInterval time.Duration
+	Labels         map[string]string
+	Config         map[string]string
+}
+
+func (c *DeviceConfig) Proto() *devicev1.DeviceConfig {
+	return &devicev1.DeviceConfig{
+		DeviceStatus:   c.DeviceStatus.Proto(),
+		StreamInterval: durationpb.New(c.StreamInterval),
+		Labels:         c.Labels,
+		Config:         c.Config,
+	}
+}
+
+func DeviceConfigFromProto(config *devicev1.DeviceConfig) DeviceConfig {
+	result := DeviceConfig{
+		Labels: config.GetLabels(),
+		Config: config.GetConfig(),
+	}
+	if config.GetDeviceStatus() != devicev1.DeviceStatus_DEVICE_STATUS_UNSPECIFIED {
+		result.DeviceStatus = DeviceStatus(config.GetDeviceStatus().String())
+	}
+	if config.StreamInterval != nil {
+		result.StreamInterval = config.GetStreamInterval().AsDuration()
+	}
+	return result
 }
 
 type DeviceMutation struct {
@@ -111,7 +356,182 @@ func (d *DeviceStatus) Proto() devicev1.DeviceStatus {
 	}
 }
 
-// ENUM(http, http-stream, grpc, grpc-stream)
+// CanTransition reports whether the device may move from this status to the given status,
+// remaining in the same status is always allowed.
+func (d *DeviceStatus) CanTransition(to DeviceStatus) bool {
+	return *d == to || d.Proto().CanTransition(to.Proto())
+}
+
+/*
+ENUM(
+
+	up = LINK_STATE_UP
+	down = LINK_STATE_DOWN
+
+)
+*/
+type LinkState string
+
+func (l *LinkState) Proto() devicev1.LinkState {
+	switch *l {
+	case LinkStateUp:
+		return devicev1.LinkState_LINK_STATE_UP
+	case LinkStateDown:
+		return devicev1.LinkState_LINK_STATE_DOWN
+	default:
+		return devicev1.LinkState_LINK_STATE_UNSPECIFIED
+	}
+}
+
+// ENUM(none, hmac-sha256, ed25519)
+type SigningAlgorithm string
+
+func (a *SigningAlgorithm) Decode(value string) error {
+	parsed, err := ParseSigningAlgorithm(value)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// ENUM(runtime, host, simulated)
+type MetricsMode string
+
+func (m *MetricsMode) Decode(value string) error {
+	parsed, err := ParseMetricsMode(value)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// ENUM(constant, sine, random-walk, spike, ramp)
+type ProfileKind string
+
+func (k *ProfileKind) Decode(value string) error {
+	parsed, err := ParseProfileKind(value)
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+func (k *ProfileKind) Proto() devicev1.ProfileKind {
+	switch *k {
+	case ProfileKindConstant:
+		return devicev1.ProfileKind_PROFILE_KIND_CONSTANT
+	case ProfileKindSine:
+		return devicev1.ProfileKind_PROFILE_KIND_SINE
+	case ProfileKindRandomWalk:
+		return devicev1.ProfileKind_PROFILE_KIND_RANDOM_WALK
+	case ProfileKindSpike:
+		return devicev1.ProfileKind_PROFILE_KIND_SPIKE
+	case ProfileKindRamp:
+		return devicev1.ProfileKind_PROFILE_KIND_RAMP
+	default:
+		return devicev1.ProfileKind_PROFILE_KIND_UNSPECIFIED
+	}
+}
+
+func ProfileKindFromProto(kind devicev1.ProfileKind) ProfileKind {
+	switch kind {
+	case devicev1.ProfileKind_PROFILE_KIND_CONSTANT:
+		return ProfileKindConstant
+	case devicev1.ProfileKind_PROFILE_KIND_SINE:
+		return ProfileKindSine
+	case devicev1.ProfileKind_PROFILE_KIND_RANDOM_WALK:
+		return ProfileKindRandomWalk
+	case devicev1.ProfileKind_PROFILE_KIND_SPIKE:
+		return ProfileKindSpike
+	case devicev1.ProfileKind_PROFILE_KIND_RAMP:
+		return ProfileKindRamp
+	default:
+		return ProfileKind("")
+	}
+}
+
+// ENUM(latency, error, stream-stall, stream-close, corrupt-checksum, reboot)
+type FaultKind string
+
+func (k *FaultKind) Proto() devicev1.FaultKind {
+	switch *k {
+	case FaultKindLatency:
+		return devicev1.FaultKind_FAULT_KIND_LATENCY
+	case FaultKindError:
+		return devicev1.FaultKind_FAULT_KIND_ERROR
+	case FaultKindStreamStall:
+		return devicev1.FaultKind_FAULT_KIND_STREAM_STALL
+	case FaultKindStreamClose:
+		return devicev1.FaultKind_FAULT_KIND_STREAM_CLOSE
+	case FaultKindCorruptChecksum:
+		return devicev1.FaultKind_FAULT_KIND_CORRUPT_CHECKSUM
+	case FaultKindReboot:
+		return devicev1.FaultKind_FAULT_KIND_REBOOT
+	default:
+		return devicev1.FaultKind_FAULT_KIND_UNSPECIFIED
+	}
+}
+
+func FaultKindFromProto(kind devicev1.FaultKind) FaultKind {
+	switch kind {
+	case devicev1.FaultKind_FAULT_KIND_LATENCY:
+		return FaultKindLatency
+	case devicev1.FaultKind_FAULT_KIND_ERROR:
+		return FaultKindError
+	case devicev1.FaultKind_FAULT_KIND_STREAM_STALL:
+		return FaultKindStreamStall
+	case devicev1.FaultKind_FAULT_KIND_STREAM_CLOSE:
+		return FaultKindStreamClose
+	case devicev1.FaultKind_FAULT_KIND_CORRUPT_CHECKSUM:
+		return FaultKindCorruptChecksum
+	case devicev1.FaultKind_FAULT_KIND_REBOOT:
+		return FaultKindReboot
+	default:
+		return FaultKind("")
+	}
+}
+
+// ENUM(downloading, installing, booting, completed, failed)
+type UpgradePhase string
+
+func (p *UpgradePhase) Proto() devicev1.UpgradePhase {
+	switch *p {
+	case UpgradePhaseDownloading:
+		return devicev1.UpgradePhase_UPGRADE_PHASE_DOWNLOADING
+	case UpgradePhaseInstalling:
+		return devicev1.UpgradePhase_UPGRADE_PHASE_INSTALLING
+	case UpgradePhaseBooting:
+		return devicev1.UpgradePhase_UPGRADE_PHASE_BOOTING
+	case UpgradePhaseCompleted:
+		return devicev1.UpgradePhase_UPGRADE_PHASE_COMPLETED
+	case UpgradePhaseFailed:
+		return devicev1.UpgradePhase_UPGRADE_PHASE_FAILED
+	default:
+		return devicev1.UpgradePhase_UPGRADE_PHASE_UNSPECIFIED
+	}
+}
+
+func UpgradePhaseFromProto(phase devicev1.UpgradePhase) UpgradePhase {
+	switch phase {
+	case devicev1.UpgradePhase_UPGRADE_PHASE_DOWNLOADING:
+		return UpgradePhaseDownloading
+	case devicev1.UpgradePhase_UPGRADE_PHASE_INSTALLING:
+		return UpgradePhaseInstalling
+	case devicev1.UpgradePhase_UPGRADE_PHASE_BOOTING:
+		return UpgradePhaseBooting
+	case devicev1.UpgradePhase_UPGRADE_PHASE_COMPLETED:
+		return UpgradePhaseCompleted
+	case devicev1.UpgradePhase_UPGRADE_PHASE_FAILED:
+		return UpgradePhaseFailed
+	default:
+		return UpgradePhase("")
+	}
+}
+
+// ENUM(http, http-stream, grpc, grpc-stream, mqtt)
 type Protocol string
 
 func (p *Protocol) Decode(value string) error {
@@ -133,6 +553,8 @@ func (p *Protocol) Proto() devicev1.Protocol {
 		return devicev1.Protocol_PROTOCOL_GRPC
 	case ProtocolGrpcStream:
 		return devicev1.Protocol_PROTOCOL_GRPC_STREAM
+	case ProtocolMqtt:
+		return devicev1.Protocol_PROTOCOL_MQTT
 	default:
 		return devicev1.Protocol_PROTOCOL_UNSPECIFIED
 	}
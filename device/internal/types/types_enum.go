@@ -94,6 +94,166 @@ func ParseEnvironment(name string) (Environment, error) {
 	return Environment(""), fmt.Errorf("%s is %w", name, ErrInvalidEnvironment)
 }
 
+const (
+	// FaultKindLatency is a FaultKind of type latency.
+	FaultKindLatency FaultKind = "latency"
+	// FaultKindError is a FaultKind of type error.
+	FaultKindError FaultKind = "error"
+	// FaultKindStreamStall is a FaultKind of type stream-stall.
+	FaultKindStreamStall FaultKind = "stream-stall"
+	// FaultKindStreamClose is a FaultKind of type stream-close.
+	FaultKindStreamClose FaultKind = "stream-close"
+	// FaultKindCorruptChecksum is a FaultKind of type corrupt-checksum.
+	FaultKindCorruptChecksum FaultKind = "corrupt-checksum"
+	// FaultKindReboot is a FaultKind of type reboot.
+	FaultKindReboot FaultKind = "reboot"
+)
+
+var ErrInvalidFaultKind = errors.New("not a valid FaultKind")
+
+// String implements the Stringer interface.
+func (x FaultKind) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x FaultKind) IsValid() bool {
+	_, err := ParseFaultKind(string(x))
+	return err == nil
+}
+
+var _FaultKindValue = map[string]FaultKind{
+	"latency":          FaultKindLatency,
+	"error":            FaultKindError,
+	"stream-stall":     FaultKindStreamStall,
+	"stream-close":     FaultKindStreamClose,
+	"corrupt-checksum": FaultKindCorruptChecksum,
+	"reboot":           FaultKindReboot,
+}
+
+// ParseFaultKind attempts to convert a string to a FaultKind.
+func ParseFaultKind(name string) (FaultKind, error) {
+	if x, ok := _FaultKindValue[name]; ok {
+		return x, nil
+	}
+	return FaultKind(""), fmt.Errorf("%s is %w", name, ErrInvalidFaultKind)
+}
+
+const (
+	// LinkStateUp is a LinkState of type up.
+	LinkStateUp LinkState = "LINK_STATE_UP"
+	// LinkStateDown is a LinkState of type down.
+	LinkStateDown LinkState = "LINK_STATE_DOWN"
+)
+
+var ErrInvalidLinkState = errors.New("not a valid LinkState")
+
+// String implements the Stringer interface.
+func (x LinkState) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x LinkState) IsValid() bool {
+	_, err := ParseLinkState(string(x))
+	return err == nil
+}
+
+var _LinkStateValue = map[string]LinkState{
+	"LINK_STATE_UP":   LinkStateUp,
+	"LINK_STATE_DOWN": LinkStateDown,
+}
+
+// ParseLinkState attempts to convert a string to a LinkState.
+func ParseLinkState(name string) (LinkState, error) {
+	if x, ok := _LinkStateValue[name]; ok {
+		return x, nil
+	}
+	return LinkState(""), fmt.Errorf("%s is %w", name, ErrInvalidLinkState)
+}
+
+const (
+	// MetricsModeRuntime is a MetricsMode of type runtime.
+	MetricsModeRuntime MetricsMode = "runtime"
+	// MetricsModeHost is a MetricsMode of type host.
+	MetricsModeHost MetricsMode = "host"
+	// MetricsModeSimulated is a MetricsMode of type simulated.
+	MetricsModeSimulated MetricsMode = "simulated"
+)
+
+var ErrInvalidMetricsMode = errors.New("not a valid MetricsMode")
+
+// String implements the Stringer interface.
+func (x MetricsMode) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x MetricsMode) IsValid() bool {
+	_, err := ParseMetricsMode(string(x))
+	return err == nil
+}
+
+var _MetricsModeValue = map[string]MetricsMode{
+	"runtime":   MetricsModeRuntime,
+	"host":      MetricsModeHost,
+	"simulated": MetricsModeSimulated,
+}
+
+// ParseMetricsMode attempts to convert a string to a MetricsMode.
+func ParseMetricsMode(name string) (MetricsMode, error) {
+	if x, ok := _MetricsModeValue[name]; ok {
+		return x, nil
+	}
+	return MetricsMode(""), fmt.Errorf("%s is %w", name, ErrInvalidMetricsMode)
+}
+
+const (
+	// ProfileKindConstant is a ProfileKind of type constant.
+	ProfileKindConstant ProfileKind = "constant"
+	// ProfileKindSine is a ProfileKind of type sine.
+	ProfileKindSine ProfileKind = "sine"
+	// ProfileKindRandomWalk is a ProfileKind of type random-walk.
+	ProfileKindRandomWalk ProfileKind = "random-walk"
+	// ProfileKindSpike is a ProfileKind of type spike.
+	ProfileKindSpike ProfileKind = "spike"
+	// ProfileKindRamp is a ProfileKind of type ramp.
+	ProfileKindRamp ProfileKind = "ramp"
+)
+
+var ErrInvalidProfileKind = errors.New("not a valid ProfileKind")
+
+// String implements the Stringer interface.
+func (x ProfileKind) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ProfileKind) IsValid() bool {
+	_, err := ParseProfileKind(string(x))
+	return err == nil
+}
+
+var _ProfileKindValue = map[string]ProfileKind{
+	"constant":    ProfileKindConstant,
+	"sine":        ProfileKindSine,
+	"random-walk": ProfileKindRandomWalk,
+	"spike":       ProfileKindSpike,
+	"ramp":        ProfileKindRamp,
+}
+
+// ParseProfileKind attempts to convert a string to a ProfileKind.
+func ParseProfileKind(name string) (ProfileKind, error) {
+	if x, ok := _ProfileKindValue[name]; ok {
+		return x, nil
+	}
+	return ProfileKind(""), fmt.Errorf("%s is %w", name, ErrInvalidProfileKind)
+}
+
 const (
 	// ProtocolHttp is a Protocol of type http.
 	ProtocolHttp Protocol = "http"
@@ -103,6 +263,8 @@ const (
 	ProtocolGrpc Protocol = "grpc"
 	// ProtocolGrpcStream is a Protocol of type grpc-stream.
 	ProtocolGrpcStream Protocol = "grpc-stream"
+	// ProtocolMqtt is a Protocol of type mqtt.
+	ProtocolMqtt Protocol = "mqtt"
 )
 
 var ErrInvalidProtocol = errors.New("not a valid Protocol")
@@ -124,6 +286,7 @@ var _ProtocolValue = map[string]Protocol{
 	"http-stream": ProtocolHttpStream,
 	"grpc":        ProtocolGrpc,
 	"grpc-stream": ProtocolGrpcStream,
+	"mqtt":        ProtocolMqtt,
 }
 
 // ParseProtocol attempts to convert a string to a Protocol.
@@ -133,3 +296,83 @@ func ParseProtocol(name string) (Protocol, error) {
 	}
 	return Protocol(""), fmt.Errorf("%s is %w", name, ErrInvalidProtocol)
 }
+
+const (
+	// SigningAlgorithmNone is a SigningAlgorithm of type none.
+	SigningAlgorithmNone SigningAlgorithm = "none"
+	// SigningAlgorithmHmacSha256 is a SigningAlgorithm of type hmac-sha256.
+	SigningAlgorithmHmacSha256 SigningAlgorithm = "hmac-sha256"
+	// SigningAlgorithmEd25519 is a SigningAlgorithm of type ed25519.
+	SigningAlgorithmEd25519 SigningAlgorithm = "ed25519"
+)
+
+var ErrInvalidSigningAlgorithm = errors.New("not a valid SigningAlgorithm")
+
+// String implements the Stringer interface.
+func (x SigningAlgorithm) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x SigningAlgorithm) IsValid() bool {
+	_, err := ParseSigningAlgorithm(string(x))
+	return err == nil
+}
+
+var _SigningAlgorithmValue = map[string]SigningAlgorithm{
+	"none":        SigningAlgorithmNone,
+	"hmac-sha256": SigningAlgorithmHmacSha256,
+	"ed25519":     SigningAlgorithmEd25519,
+}
+
+// ParseSigningAlgorithm attempts to convert a string to a SigningAlgorithm.
+func ParseSigningAlgorithm(name string) (SigningAlgorithm, error) {
+	if x, ok := _SigningAlgorithmValue[name]; ok {
+		return x, nil
+	}
+	return SigningAlgorithm(""), fmt.Errorf("%s is %w", name, ErrInvalidSigningAlgorithm)
+}
+
+const (
+	// UpgradePhaseDownloading is a UpgradePhase of type downloading.
+	UpgradePhaseDownloading UpgradePhase = "downloading"
+	// UpgradePhaseInstalling is a UpgradePhase of type installing.
+	UpgradePhaseInstalling UpgradePhase = "installing"
+	// UpgradePhaseBooting is a UpgradePhase of type booting.
+	UpgradePhaseBooting UpgradePhase = "booting"
+	// UpgradePhaseCompleted is a UpgradePhase of type completed.
+	UpgradePhaseCompleted UpgradePhase = "completed"
+	// UpgradePhaseFailed is a UpgradePhase of type failed.
+	UpgradePhaseFailed UpgradePhase = "failed"
+)
+
+var ErrInvalidUpgradePhase = errors.New("not a valid UpgradePhase")
+
+// String implements the Stringer interface.
+func (x UpgradePhase) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x UpgradePhase) IsValid() bool {
+	_, err := ParseUpgradePhase(string(x))
+	return err == nil
+}
+
+var _UpgradePhaseValue = map[string]UpgradePhase{
+	"downloading": UpgradePhaseDownloading,
+	"installing":  UpgradePhaseInstalling,
+	"booting":     UpgradePhaseBooting,
+	"completed":   UpgradePhaseCompleted,
+	"failed":      UpgradePhaseFailed,
+}
+
+// ParseUpgradePhase attempts to convert a string to a UpgradePhase.
+func ParseUpgradePhase(name string) (UpgradePhase, error) {
+	if x, ok := _UpgradePhaseValue[name]; ok {
+		return x, nil
+	}
+	return UpgradePhase(""), fmt.Errorf("%s is %w", name, ErrInvalidUpgradePhase)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const DefaultInvalidChecksum = "invalid-checksum"
@@ -13,8 +14,10 @@ type generator interface {
 }
 
 func (r *DiagnosticsResponse) GenerateChecksum(ctx context.Context, gen generator) string {
-	r.Checksum = ""
-	data, err := protojson.Marshal(r)
+	payload := proto.CloneOf(r)
+	payload.Checksum = ""
+	payload.Signature = ""
+	data, err := protojson.Marshal(payload)
 	if err != nil {
 		return DefaultInvalidChecksum
 	}
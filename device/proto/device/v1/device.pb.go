@@ -14,6 +14,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -32,6 +33,7 @@ const (
 	Protocol_PROTOCOL_HTTP_STREAM Protocol = 2
 	Protocol_PROTOCOL_GRPC        Protocol = 3
 	Protocol_PROTOCOL_GRPC_STREAM Protocol = 4
+	Protocol_PROTOCOL_MQTT        Protocol = 5
 )
 
 // Enum value maps for Protocol.
@@ -42,6 +44,7 @@ var (
 		2: "PROTOCOL_HTTP_STREAM",
 		3: "PROTOCOL_GRPC",
 		4: "PROTOCOL_GRPC_STREAM",
+		5: "PROTOCOL_MQTT",
 	}
 	Protocol_value = map[string]int32{
 		"PROTOCOL_UNSPECIFIED": 0,
@@ -49,6 +52,7 @@ var (
 		"PROTOCOL_HTTP_STREAM": 2,
 		"PROTOCOL_GRPC":        3,
 		"PROTOCOL_GRPC_STREAM": 4,
+		"PROTOCOL_MQTT":        5,
 	}
 )
 
@@ -137,6 +141,232 @@ func (DeviceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{1}
 }
 
+type ProfileKind int32
+
+const (
+	ProfileKind_PROFILE_KIND_UNSPECIFIED ProfileKind = 0
+	ProfileKind_PROFILE_KIND_CONSTANT    ProfileKind = 1
+	ProfileKind_PROFILE_KIND_SINE        ProfileKind = 2
+	ProfileKind_PROFILE_KIND_RANDOM_WALK ProfileKind = 3
+	ProfileKind_PROFILE_KIND_SPIKE       ProfileKind = 4
+	ProfileKind_PROFILE_KIND_RAMP        ProfileKind = 5
+)
+
+// Enum value maps for ProfileKind.
+var (
+	ProfileKind_name = map[int32]string{
+		0: "PROFILE_KIND_UNSPECIFIED",
+		1: "PROFILE_KIND_CONSTANT",
+		2: "PROFILE_KIND_SINE",
+		3: "PROFILE_KIND_RANDOM_WALK",
+		4: "PROFILE_KIND_SPIKE",
+		5: "PROFILE_KIND_RAMP",
+	}
+	ProfileKind_value = map[string]int32{
+		"PROFILE_KIND_UNSPECIFIED": 0,
+		"PROFILE_KIND_CONSTANT":    1,
+		"PROFILE_KIND_SINE":        2,
+		"PROFILE_KIND_RANDOM_WALK": 3,
+		"PROFILE_KIND_SPIKE":       4,
+		"PROFILE_KIND_RAMP":        5,
+	}
+)
+
+func (x ProfileKind) Enum() *ProfileKind {
+	p := new(ProfileKind)
+	*p = x
+	return p
+}
+
+func (x ProfileKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProfileKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_device_v1_device_proto_enumTypes[2].Descriptor()
+}
+
+func (ProfileKind) Type() protoreflect.EnumType {
+	return &file_proto_device_v1_device_proto_enumTypes[2]
+}
+
+func (x ProfileKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProfileKind.Descriptor instead.
+func (ProfileKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{2}
+}
+
+type LinkState int32
+
+const (
+	LinkState_LINK_STATE_UNSPECIFIED LinkState = 0
+	LinkState_LINK_STATE_UP          LinkState = 1
+	LinkState_LINK_STATE_DOWN        LinkState = 2
+)
+
+// Enum value maps for LinkState.
+var (
+	LinkState_name = map[int32]string{
+		0: "LINK_STATE_UNSPECIFIED",
+		1: "LINK_STATE_UP",
+		2: "LINK_STATE_DOWN",
+	}
+	LinkState_value = map[string]int32{
+		"LINK_STATE_UNSPECIFIED": 0,
+		"LINK_STATE_UP":          1,
+		"LINK_STATE_DOWN":        2,
+	}
+)
+
+func (x LinkState) Enum() *LinkState {
+	p := new(LinkState)
+	*p = x
+	return p
+}
+
+func (x LinkState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_device_v1_device_proto_enumTypes[3].Descriptor()
+}
+
+func (LinkState) Type() protoreflect.EnumType {
+	return &file_proto_device_v1_device_proto_enumTypes[3]
+}
+
+func (x LinkState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkState.Descriptor instead.
+func (LinkState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{3}
+}
+
+type FaultKind int32
+
+const (
+	FaultKind_FAULT_KIND_UNSPECIFIED      FaultKind = 0
+	FaultKind_FAULT_KIND_LATENCY          FaultKind = 1
+	FaultKind_FAULT_KIND_ERROR            FaultKind = 2
+	FaultKind_FAULT_KIND_STREAM_STALL     FaultKind = 3
+	FaultKind_FAULT_KIND_STREAM_CLOSE     FaultKind = 4
+	FaultKind_FAULT_KIND_CORRUPT_CHECKSUM FaultKind = 5
+	FaultKind_FAULT_KIND_REBOOT           FaultKind = 6
+)
+
+// Enum value maps for FaultKind.
+var (
+	FaultKind_name = map[int32]string{
+		0: "FAULT_KIND_UNSPECIFIED",
+		1: "FAULT_KIND_LATENCY",
+		2: "FAULT_KIND_ERROR",
+		3: "FAULT_KIND_STREAM_STALL",
+		4: "FAULT_KIND_STREAM_CLOSE",
+		5: "FAULT_KIND_CORRUPT_CHECKSUM",
+		6: "FAULT_KIND_REBOOT",
+	}
+	FaultKind_value = map[string]int32{
+		"FAULT_KIND_UNSPECIFIED":      0,
+		"FAULT_KIND_LATENCY":          1,
+		"FAULT_KIND_ERROR":            2,
+		"FAULT_KIND_STREAM_STALL":     3,
+		"FAULT_KIND_STREAM_CLOSE":     4,
+		"FAULT_KIND_CORRUPT_CHECKSUM": 5,
+		"FAULT_KIND_REBOOT":           6,
+	}
+)
+
+func (x FaultKind) Enum() *FaultKind {
+	p := new(FaultKind)
+	*p = x
+	return p
+}
+
+func (x FaultKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FaultKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_device_v1_device_proto_enumTypes[4].Descriptor()
+}
+
+func (FaultKind) Type() protoreflect.EnumType {
+	return &file_proto_device_v1_device_proto_enumTypes[4]
+}
+
+func (x FaultKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FaultKind.Descriptor instead.
+func (FaultKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{4}
+}
+
+type UpgradePhase int32
+
+const (
+	UpgradePhase_UPGRADE_PHASE_UNSPECIFIED UpgradePhase = 0
+	UpgradePhase_UPGRADE_PHASE_DOWNLOADING UpgradePhase = 1
+	UpgradePhase_UPGRADE_PHASE_INSTALLING  UpgradePhase = 2
+	UpgradePhase_UPGRADE_PHASE_BOOTING     UpgradePhase = 3
+	UpgradePhase_UPGRADE_PHASE_COMPLETED   UpgradePhase = 4
+	UpgradePhase_UPGRADE_PHASE_FAILED      UpgradePhase = 5
+)
+
+// Enum value maps for UpgradePhase.
+var (
+	UpgradePhase_name = map[int32]string{
+		0: "UPGRADE_PHASE_UNSPECIFIED",
+		1: "UPGRADE_PHASE_DOWNLOADING",
+		2: "UPGRADE_PHASE_INSTALLING",
+		3: "UPGRADE_PHASE_BOOTING",
+		4: "UPGRADE_PHASE_COMPLETED",
+		5: "UPGRADE_PHASE_FAILED",
+	}
+	UpgradePhase_value = map[string]int32{
+		"UPGRADE_PHASE_UNSPECIFIED": 0,
+		"UPGRADE_PHASE_DOWNLOADING": 1,
+		"UPGRADE_PHASE_INSTALLING":  2,
+		"UPGRADE_PHASE_BOOTING":     3,
+		"UPGRADE_PHASE_COMPLETED":   4,
+		"UPGRADE_PHASE_FAILED":      5,
+	}
+)
+
+func (x UpgradePhase) Enum() *UpgradePhase {
+	p := new(UpgradePhase)
+	*p = x
+	return p
+}
+
+func (x UpgradePhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpgradePhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_device_v1_device_proto_enumTypes[5].Descriptor()
+}
+
+func (UpgradePhase) Type() protoreflect.EnumType {
+	return &file_proto_device_v1_device_proto_enumTypes[5]
+}
+
+func (x UpgradePhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpgradePhase.Descriptor instead.
+func (UpgradePhase) EnumDescriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{5}
+}
+
 type GetHealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -286,18 +516,31 @@ func (*DiagnosticsRequest) Descriptor() ([]byte, []int) {
 }
 
 type DiagnosticsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	DeviceId        string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
-	HardwareVersion string                 `protobuf:"bytes,3,opt,name=hardware_version,proto3" json:"hardware_version,omitempty"`
-	SoftwareVersion string                 `protobuf:"bytes,4,opt,name=software_version,proto3" json:"software_version,omitempty"`
-	FirmwareVersion string                 `protobuf:"bytes,5,opt,name=firmware_version,proto3" json:"firmware_version,omitempty"`
-	CpuUsage        float64                `protobuf:"fixed64,6,opt,name=cpu_usage,proto3" json:"cpu_usage,omitempty"`
-	MemoryUsage     float64                `protobuf:"fixed64,7,opt,name=memory_usage,proto3" json:"memory_usage,omitempty"`
-	DeviceStatus    DeviceStatus           `protobuf:"varint,8,opt,name=device_status,proto3,enum=device.v1.DeviceStatus" json:"device_status,omitempty"`
-	Checksum        string                 `protobuf:"bytes,9,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DeviceId           string                 `protobuf:"bytes,2,opt,name=device_id,proto3" json:"device_id,omitempty"`
+	HardwareVersion    string                 `protobuf:"bytes,3,opt,name=hardware_version,proto3" json:"hardware_version,omitempty"`
+	SoftwareVersion    string                 `protobuf:"bytes,4,opt,name=software_version,proto3" json:"software_version,omitempty"`
+	FirmwareVersion    string                 `protobuf:"bytes,5,opt,name=firmware_version,proto3" json:"firmware_version,omitempty"`
+	CpuUsage           float64                `protobuf:"fixed64,6,opt,name=cpu_usage,proto3" json:"cpu_usage,omitempty"`
+	MemoryUsage        float64                `protobuf:"fixed64,7,opt,name=memory_usage,proto3" json:"memory_usage,omitempty"`
+	DeviceStatus       DeviceStatus           `protobuf:"varint,8,opt,name=device_status,proto3,enum=device.v1.DeviceStatus" json:"device_status,omitempty"`
+	Checksum           string                 `protobuf:"bytes,9,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Signature          string                 `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
+	UptimeSeconds      uint64                 `protobuf:"varint,11,opt,name=uptime_seconds,proto3" json:"uptime_seconds,omitempty"`
+	LoadAverage_1M     float64                `protobuf:"fixed64,12,opt,name=load_average_1m,proto3" json:"load_average_1m,omitempty"`
+	LoadAverage_5M     float64                `protobuf:"fixed64,13,opt,name=load_average_5m,proto3" json:"load_average_5m,omitempty"`
+	LoadAverage_15M    float64                `protobuf:"fixed64,14,opt,name=load_average_15m,proto3" json:"load_average_15m,omitempty"`
+	TemperatureCelsius float64                `protobuf:"fixed64,15,opt,name=temperature_celsius,proto3" json:"temperature_celsius,omitempty"`
+	DiskUsedBytes      uint64                 `protobuf:"varint,16,opt,name=disk_used_bytes,proto3" json:"disk_used_bytes,omitempty"`
+	DiskTotalBytes     uint64                 `protobuf:"varint,17,opt,name=disk_total_bytes,proto3" json:"disk_total_bytes,omitempty"`
+	ProcessCount       uint32                 `protobuf:"varint,18,opt,name=process_count,proto3" json:"process_count,omitempty"`
+	Interfaces         []*NetworkInterface    `protobuf:"bytes,19,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	StreamInterval     *durationpb.Duration   `protobuf:"bytes,20,opt,name=stream_interval,proto3" json:"stream_interval,omitempty"`
+	Labels             map[string]string      `protobuf:"bytes,21,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Config             map[string]string      `protobuf:"bytes,22,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *DiagnosticsResponse) Reset() {
@@ -393,6 +636,206 @@ func (x *DiagnosticsResponse) GetChecksum() string {
 	return ""
 }
 
+func (x *DiagnosticsResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DiagnosticsResponse) GetUptimeSeconds() uint64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetLoadAverage_1M() float64 {
+	if x != nil {
+		return x.LoadAverage_1M
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetLoadAverage_5M() float64 {
+	if x != nil {
+		return x.LoadAverage_5M
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetLoadAverage_15M() float64 {
+	if x != nil {
+		return x.LoadAverage_15M
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetTemperatureCelsius() float64 {
+	if x != nil {
+		return x.TemperatureCelsius
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDiskUsedBytes() uint64 {
+	if x != nil {
+		return x.DiskUsedBytes
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDiskTotalBytes() uint64 {
+	if x != nil {
+		return x.DiskTotalBytes
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetProcessCount() uint32 {
+	if x != nil {
+		return x.ProcessCount
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetInterfaces() []*NetworkInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetStreamInterval() *durationpb.Duration {
+	if x != nil {
+		return x.StreamInterval
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type NetworkInterface struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LinkState LinkState              `protobuf:"varint,2,opt,name=link_state,proto3,enum=device.v1.LinkState" json:"link_state,omitempty"`
+	RxBytes   uint64                 `protobuf:"varint,3,opt,name=rx_bytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes   uint64                 `protobuf:"varint,4,opt,name=tx_bytes,proto3" json:"tx_bytes,omitempty"`
+	RxPackets uint64                 `protobuf:"varint,5,opt,name=rx_packets,proto3" json:"rx_packets,omitempty"`
+	TxPackets uint64                 `protobuf:"varint,6,opt,name=tx_packets,proto3" json:"tx_packets,omitempty"`
+	RxErrors  uint64                 `protobuf:"varint,7,opt,name=rx_errors,proto3" json:"rx_errors,omitempty"`
+	TxErrors  uint64                 `protobuf:"varint,8,opt,name=tx_errors,proto3" json:"tx_errors,omitempty"`
+	// Width of the counters in bits (32 or 64), 0 if unknown
+	CounterBits   uint32 `protobuf:"varint,9,opt,name=counter_bits,proto3" json:"counter_bits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkInterface) Reset() {
+	*x = NetworkInterface{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterface) ProtoMessage() {}
+
+func (x *NetworkInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterface.ProtoReflect.Descriptor instead.
+func (*NetworkInterface) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NetworkInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetLinkState() LinkState {
+	if x != nil {
+		return x.LinkState
+	}
+	return LinkState_LINK_STATE_UNSPECIFIED
+}
+
+func (x *NetworkInterface) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetCounterBits() uint32 {
+	if x != nil {
+		return x.CounterBits
+	}
+	return 0
+}
+
 type UpdateDeviceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DeviceStatus  DeviceStatus           `protobuf:"varint,1,opt,name=device_status,proto3,enum=device.v1.DeviceStatus" json:"device_status,omitempty"`
@@ -402,7 +845,7 @@ type UpdateDeviceRequest struct {
 
 func (x *UpdateDeviceRequest) Reset() {
 	*x = UpdateDeviceRequest{}
-	mi := &file_proto_device_v1_device_proto_msgTypes[4]
+	mi := &file_proto_device_v1_device_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -414,7 +857,7 @@ func (x *UpdateDeviceRequest) String() string {
 func (*UpdateDeviceRequest) ProtoMessage() {}
 
 func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_v1_device_proto_msgTypes[4]
+	mi := &file_proto_device_v1_device_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +870,7 @@ func (x *UpdateDeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDeviceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{4}
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateDeviceRequest) GetDeviceStatus() DeviceStatus {
@@ -445,7 +888,7 @@ type UpdateDeviceResponse struct {
 
 func (x *UpdateDeviceResponse) Reset() {
 	*x = UpdateDeviceResponse{}
-	mi := &file_proto_device_v1_device_proto_msgTypes[5]
+	mi := &file_proto_device_v1_device_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +900,7 @@ func (x *UpdateDeviceResponse) String() string {
 func (*UpdateDeviceResponse) ProtoMessage() {}
 
 func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_device_v1_device_proto_msgTypes[5]
+	mi := &file_proto_device_v1_device_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,14 +913,1410 @@ func (x *UpdateDeviceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDeviceResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{5}
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{6}
+}
+
+// Command published to devices/{device_id}/commands for devices reached through an MQTT broker
+type DeviceCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Command:
+	//
+	//	*DeviceCommand_UpdateDevice
+	Command       isDeviceCommand_Command `protobuf_oneof:"command"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceCommand) Reset() {
+	*x = DeviceCommand{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCommand) ProtoMessage() {}
+
+func (x *DeviceCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCommand.ProtoReflect.Descriptor instead.
+func (*DeviceCommand) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeviceCommand) GetCommand() isDeviceCommand_Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *DeviceCommand) GetUpdateDevice() *UpdateDeviceRequest {
+	if x != nil {
+		if x, ok := x.Command.(*DeviceCommand_UpdateDevice); ok {
+			return x.UpdateDevice
+		}
+	}
+	return nil
+}
+
+type isDeviceCommand_Command interface {
+	isDeviceCommand_Command()
+}
+
+type DeviceCommand_UpdateDevice struct {
+	UpdateDevice *UpdateDeviceRequest `protobuf:"bytes,1,opt,name=update_device,proto3,oneof"`
+}
+
+func (*DeviceCommand_UpdateDevice) isDeviceCommand_Command() {}
+
+type SimulationProfile struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Kind             ProfileKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=device.v1.ProfileKind" json:"kind,omitempty"`
+	Base             float64                `protobuf:"fixed64,2,opt,name=base,proto3" json:"base,omitempty"`
+	Amplitude        float64                `protobuf:"fixed64,3,opt,name=amplitude,proto3" json:"amplitude,omitempty"`
+	Period           *durationpb.Duration   `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Step             float64                `protobuf:"fixed64,5,opt,name=step,proto3" json:"step,omitempty"`
+	Min              float64                `protobuf:"fixed64,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max              float64                `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	SpikeProbability float64                `protobuf:"fixed64,8,opt,name=spike_probability,proto3" json:"spike_probability,omitempty"`
+	SpikeDuration    *durationpb.Duration   `protobuf:"bytes,9,opt,name=spike_duration,proto3" json:"spike_duration,omitempty"`
+	Target           float64                `protobuf:"fixed64,10,opt,name=target,proto3" json:"target,omitempty"`
+	Duration         *durationpb.Duration   `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty"`
+	Seed             int64                  `protobuf:"varint,12,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimulationProfile) Reset() {
+	*x = SimulationProfile{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationProfile) ProtoMessage() {}
+
+func (x *SimulationProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationProfile.ProtoReflect.Descriptor instead.
+func (*SimulationProfile) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SimulationProfile) GetKind() ProfileKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ProfileKind_PROFILE_KIND_UNSPECIFIED
+}
+
+func (x *SimulationProfile) GetBase() float64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *SimulationProfile) GetAmplitude() float64 {
+	if x != nil {
+		return x.Amplitude
+	}
+	return 0
+}
+
+func (x *SimulationProfile) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *SimulationProfile) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *SimulationProfile) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *SimulationProfile) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *SimulationProfile) GetSpikeProbability() float64 {
+	if x != nil {
+		return x.SpikeProbability
+	}
+	return 0
+}
+
+func (x *SimulationProfile) GetSpikeDuration() *durationpb.Duration {
+	if x != nil {
+		return x.SpikeDuration
+	}
+	return nil
+}
+
+func (x *SimulationProfile) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *SimulationProfile) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *SimulationProfile) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type GetSimulationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimulationRequest) Reset() {
+	*x = GetSimulationRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimulationRequest) ProtoMessage() {}
+
+func (x *GetSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimulationRequest.ProtoReflect.Descriptor instead.
+func (*GetSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{9}
+}
+
+type GetSimulationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Cpu           *SimulationProfile     `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        *SimulationProfile     `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimulationResponse) Reset() {
+	*x = GetSimulationResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimulationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimulationResponse) ProtoMessage() {}
+
+func (x *GetSimulationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimulationResponse.ProtoReflect.Descriptor instead.
+func (*GetSimulationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSimulationResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetSimulationResponse) GetCpu() *SimulationProfile {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *GetSimulationResponse) GetMemory() *SimulationProfile {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+type UpdateSimulationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Cpu           *SimulationProfile     `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        *SimulationProfile     `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSimulationRequest) Reset() {
+	*x = UpdateSimulationRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSimulationRequest) ProtoMessage() {}
+
+func (x *UpdateSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSimulationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateSimulationRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateSimulationRequest) GetCpu() *SimulationProfile {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *UpdateSimulationRequest) GetMemory() *SimulationProfile {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+type UpdateSimulationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Cpu           *SimulationProfile     `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        *SimulationProfile     `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSimulationResponse) Reset() {
+	*x = UpdateSimulationResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSimulationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSimulationResponse) ProtoMessage() {}
+
+func (x *UpdateSimulationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSimulationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSimulationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateSimulationResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateSimulationResponse) GetCpu() *SimulationProfile {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *UpdateSimulationResponse) GetMemory() *SimulationProfile {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+type Fault struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind    FaultKind              `protobuf:"varint,2,opt,name=kind,proto3,enum=device.v1.FaultKind" json:"kind,omitempty"`
+	Methods []string               `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	Latency *durationpb.Duration   `protobuf:"bytes,4,opt,name=latency,proto3" json:"latency,omitempty"`
+	Jitter  *durationpb.Duration   `protobuf:"bytes,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// Probability within (0, 1] of the fault to trigger, 1 if unset
+	Probability   *float64               `protobuf:"fixed64,6,opt,name=probability,proto3,oneof" json:"probability,omitempty"`
+	GrpcCode      uint32                 `protobuf:"varint,7,opt,name=grpc_code,proto3" json:"grpc_code,omitempty"`
+	HttpStatus    uint32                 `protobuf:"varint,8,opt,name=http_status,proto3" json:"http_status,omitempty"`
+	AfterMessages uint32                 `protobuf:"varint,9,opt,name=after_messages,proto3" json:"after_messages,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,11,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fault) Reset() {
+	*x = Fault{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fault) ProtoMessage() {}
+
+func (x *Fault) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fault.ProtoReflect.Descriptor instead.
+func (*Fault) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Fault) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Fault) GetKind() FaultKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FaultKind_FAULT_KIND_UNSPECIFIED
+}
+
+func (x *Fault) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *Fault) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *Fault) GetJitter() *durationpb.Duration {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *Fault) GetProbability() float64 {
+	if x != nil && x.Probability != nil {
+		return *x.Probability
+	}
+	return 0
+}
+
+func (x *Fault) GetGrpcCode() uint32 {
+	if x != nil {
+		return x.GrpcCode
+	}
+	return 0
+}
+
+func (x *Fault) GetHttpStatus() uint32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *Fault) GetAfterMessages() uint32 {
+	if x != nil {
+		return x.AfterMessages
+	}
+	return 0
+}
+
+func (x *Fault) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Fault) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Fault) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Fault) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ListFaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFaultsRequest) Reset() {
+	*x = ListFaultsRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFaultsRequest) ProtoMessage() {}
+
+func (x *ListFaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFaultsRequest.ProtoReflect.Descriptor instead.
+func (*ListFaultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{14}
+}
+
+type ListFaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Faults        []*Fault               `protobuf:"bytes,1,rep,name=faults,proto3" json:"faults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFaultsResponse) Reset() {
+	*x = ListFaultsResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFaultsResponse) ProtoMessage() {}
+
+func (x *ListFaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFaultsResponse.ProtoReflect.Descriptor instead.
+func (*ListFaultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListFaultsResponse) GetFaults() []*Fault {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+type InjectFaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fault         *Fault                 `protobuf:"bytes,1,opt,name=fault,proto3" json:"fault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InjectFaultRequest) Reset() {
+	*x = InjectFaultRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InjectFaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectFaultRequest) ProtoMessage() {}
+
+func (x *InjectFaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectFaultRequest.ProtoReflect.Descriptor instead.
+func (*InjectFaultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InjectFaultRequest) GetFault() *Fault {
+	if x != nil {
+		return x.Fault
+	}
+	return nil
+}
+
+type InjectFaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fault         *Fault                 `protobuf:"bytes,1,opt,name=fault,proto3" json:"fault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InjectFaultResponse) Reset() {
+	*x = InjectFaultResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InjectFaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectFaultResponse) ProtoMessage() {}
+
+func (x *InjectFaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectFaultResponse.ProtoReflect.Descriptor instead.
+func (*InjectFaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InjectFaultResponse) GetFault() *Fault {
+	if x != nil {
+		return x.Fault
+	}
+	return nil
+}
+
+type RemoveFaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFaultRequest) Reset() {
+	*x = RemoveFaultRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFaultRequest) ProtoMessage() {}
+
+func (x *RemoveFaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFaultRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFaultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveFaultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveFaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFaultResponse) Reset() {
+	*x = RemoveFaultResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFaultResponse) ProtoMessage() {}
+
+func (x *RemoveFaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFaultResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{19}
+}
+
+type ClearFaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearFaultsRequest) Reset() {
+	*x = ClearFaultsRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearFaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearFaultsRequest) ProtoMessage() {}
+
+func (x *ClearFaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearFaultsRequest.ProtoReflect.Descriptor instead.
+func (*ClearFaultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{20}
+}
+
+type ClearFaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearFaultsResponse) Reset() {
+	*x = ClearFaultsResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearFaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearFaultsResponse) ProtoMessage() {}
+
+func (x *ClearFaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearFaultsResponse.ProtoReflect.Descriptor instead.
+func (*ClearFaultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{21}
+}
+
+type FirmwareUpgrade struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TargetVersion   string                 `protobuf:"bytes,1,opt,name=target_version,proto3" json:"target_version,omitempty"`
+	PreviousVersion string                 `protobuf:"bytes,2,opt,name=previous_version,proto3" json:"previous_version,omitempty"`
+	Phase           UpgradePhase           `protobuf:"varint,3,opt,name=phase,proto3,enum=device.v1.UpgradePhase" json:"phase,omitempty"`
+	FailedPhase     UpgradePhase           `protobuf:"varint,4,opt,name=failed_phase,proto3,enum=device.v1.UpgradePhase" json:"failed_phase,omitempty"`
+	Error           string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,proto3" json:"started_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FirmwareUpgrade) Reset() {
+	*x = FirmwareUpgrade{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirmwareUpgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareUpgrade) ProtoMessage() {}
+
+func (x *FirmwareUpgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareUpgrade.ProtoReflect.Descriptor instead.
+func (*FirmwareUpgrade) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FirmwareUpgrade) GetTargetVersion() string {
+	if x != nil {
+		return x.TargetVersion
+	}
+	return ""
+}
+
+func (x *FirmwareUpgrade) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+func (x *FirmwareUpgrade) GetPhase() UpgradePhase {
+	if x != nil {
+		return x.Phase
+	}
+	return UpgradePhase_UPGRADE_PHASE_UNSPECIFIED
+}
+
+func (x *FirmwareUpgrade) GetFailedPhase() UpgradePhase {
+	if x != nil {
+		return x.FailedPhase
+	}
+	return UpgradePhase_UPGRADE_PHASE_UNSPECIFIED
+}
+
+func (x *FirmwareUpgrade) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FirmwareUpgrade) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *FirmwareUpgrade) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetFirmwareUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirmwareUpgradeRequest) Reset() {
+	*x = GetFirmwareUpgradeRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirmwareUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirmwareUpgradeRequest) ProtoMessage() {}
+
+func (x *GetFirmwareUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirmwareUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*GetFirmwareUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{23}
+}
+
+type GetFirmwareUpgradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgrade       *FirmwareUpgrade       `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirmwareUpgradeResponse) Reset() {
+	*x = GetFirmwareUpgradeResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirmwareUpgradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirmwareUpgradeResponse) ProtoMessage() {}
+
+func (x *GetFirmwareUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirmwareUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*GetFirmwareUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetFirmwareUpgradeResponse) GetUpgrade() *FirmwareUpgrade {
+	if x != nil {
+		return x.Upgrade
+	}
+	return nil
+}
+
+type UpgradeFirmwareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	FailPhase     UpgradePhase           `protobuf:"varint,2,opt,name=fail_phase,proto3,enum=device.v1.UpgradePhase" json:"fail_phase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeFirmwareRequest) Reset() {
+	*x = UpgradeFirmwareRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeFirmwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeFirmwareRequest) ProtoMessage() {}
+
+func (x *UpgradeFirmwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeFirmwareRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeFirmwareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpgradeFirmwareRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UpgradeFirmwareRequest) GetFailPhase() UpgradePhase {
+	if x != nil {
+		return x.FailPhase
+	}
+	return UpgradePhase_UPGRADE_PHASE_UNSPECIFIED
+}
+
+type UpgradeFirmwareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upgrade       *FirmwareUpgrade       `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeFirmwareResponse) Reset() {
+	*x = UpgradeFirmwareResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradeFirmwareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeFirmwareResponse) ProtoMessage() {}
+
+func (x *UpgradeFirmwareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeFirmwareResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeFirmwareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpgradeFirmwareResponse) GetUpgrade() *FirmwareUpgrade {
+	if x != nil {
+		return x.Upgrade
+	}
+	return nil
+}
+
+type RebootRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebootRequest) Reset() {
+	*x = RebootRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebootRequest) ProtoMessage() {}
+
+func (x *RebootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootRequest.ProtoReflect.Descriptor instead.
+func (*RebootRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RebootRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type RebootResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,proto3" json:"started_at,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebootResponse) Reset() {
+	*x = RebootResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebootResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebootResponse) ProtoMessage() {}
+
+func (x *RebootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootResponse.ProtoReflect.Descriptor instead.
+func (*RebootResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RebootResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RebootResponse) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type DeviceConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeviceStatus   DeviceStatus           `protobuf:"varint,1,opt,name=device_status,proto3,enum=device.v1.DeviceStatus" json:"device_status,omitempty"`
+	StreamInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=stream_interval,proto3" json:"stream_interval,omitempty"`
+	Labels         map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Config         map[string]string      `protobuf:"bytes,4,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeviceConfig) Reset() {
+	*x = DeviceConfig{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceConfig) ProtoMessage() {}
+
+func (x *DeviceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceConfig.ProtoReflect.Descriptor instead.
+func (*DeviceConfig) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeviceConfig) GetDeviceStatus() DeviceStatus {
+	if x != nil {
+		return x.DeviceStatus
+	}
+	return DeviceStatus_DEVICE_STATUS_UNSPECIFIED
+}
+
+func (x *DeviceConfig) GetStreamInterval() *durationpb.Duration {
+	if x != nil {
+		return x.StreamInterval
+	}
+	return nil
+}
+
+func (x *DeviceConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DeviceConfig) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ApplyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *DeviceConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigRequest) Reset() {
+	*x = ApplyConfigRequest{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigRequest) ProtoMessage() {}
+
+func (x *ApplyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ApplyConfigRequest) GetConfig() *DeviceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ApplyConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *DeviceConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigResponse) Reset() {
+	*x = ApplyConfigResponse{}
+	mi := &file_proto_device_v1_device_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigResponse) ProtoMessage() {}
+
+func (x *ApplyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_device_v1_device_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_device_v1_device_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApplyConfigResponse) GetConfig() *DeviceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
 }
 
 var File_proto_device_v1_device_proto protoreflect.FileDescriptor
 
 const file_proto_device_v1_device_proto_rawDesc = "" +
 	"\n" +
-	"\x1cproto/device/v1/device.proto\x12\tdevice.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x12\n" +
+	"\x1cproto/device/v1/device.proto\x12\tdevice.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x12\n" +
 	"\x10GetHealthRequest\"\xe8\x01\n" +
 	"\x11GetHealthResponse\x12:\n" +
 	"\n" +
@@ -487,7 +2326,7 @@ const file_proto_device_v1_device_proto_rawDesc = "" +
 	"\x13supported_protocols\x18\x03 \x03(\x0e2\x13.device.v1.ProtocolR\x13supported_protocols\x12\"\n" +
 	"\farchitecture\x18\x04 \x01(\tR\farchitecture\x12\x0e\n" +
 	"\x02os\x18\x05 \x01(\tR\x02os\"\x14\n" +
-	"\x12DiagnosticsRequest\"\x8e\x03\n" +
+	"\x12DiagnosticsRequest\"\x82\t\n" +
 	"\x13DiagnosticsResponse\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1c\n" +
 	"\tdevice_id\x18\x02 \x01(\tR\tdevice_id\x12*\n" +
@@ -497,30 +2336,217 @@ const file_proto_device_v1_device_proto_rawDesc = "" +
 	"\tcpu_usage\x18\x06 \x01(\x01R\tcpu_usage\x12\"\n" +
 	"\fmemory_usage\x18\a \x01(\x01R\fmemory_usage\x12=\n" +
 	"\rdevice_status\x18\b \x01(\x0e2\x17.device.v1.DeviceStatusR\rdevice_status\x12\x1a\n" +
-	"\bchecksum\x18\t \x01(\tR\bchecksum\"T\n" +
+	"\bchecksum\x18\t \x01(\tR\bchecksum\x12\x1c\n" +
+	"\tsignature\x18\n" +
+	" \x01(\tR\tsignature\x12&\n" +
+	"\x0euptime_seconds\x18\v \x01(\x04R\x0euptime_seconds\x12(\n" +
+	"\x0fload_average_1m\x18\f \x01(\x01R\x0fload_average_1m\x12(\n" +
+	"\x0fload_average_5m\x18\r \x01(\x01R\x0fload_average_5m\x12*\n" +
+	"\x10load_average_15m\x18\x0e \x01(\x01R\x10load_average_15m\x120\n" +
+	"\x13temperature_celsius\x18\x0f \x01(\x01R\x13temperature_celsius\x12(\n" +
+	"\x0fdisk_used_bytes\x18\x10 \x01(\x04R\x0fdisk_used_bytes\x12*\n" +
+	"\x10disk_total_bytes\x18\x11 \x01(\x04R\x10disk_total_bytes\x12$\n" +
+	"\rprocess_count\x18\x12 \x01(\rR\rprocess_count\x12;\n" +
+	"\n" +
+	"interfaces\x18\x13 \x03(\v2\x1b.device.v1.NetworkInterfaceR\n" +
+	"interfaces\x12C\n" +
+	"\x0fstream_interval\x18\x14 \x01(\v2\x19.google.protobuf.DurationR\x0fstream_interval\x12B\n" +
+	"\x06labels\x18\x15 \x03(\v2*.device.v1.DiagnosticsResponse.LabelsEntryR\x06labels\x12B\n" +
+	"\x06config\x18\x16 \x03(\v2*.device.v1.DiagnosticsResponse.ConfigEntryR\x06config\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb4\x02\n" +
+	"\x10NetworkInterface\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\n" +
+	"link_state\x18\x02 \x01(\x0e2\x14.device.v1.LinkStateR\n" +
+	"link_state\x12\x1a\n" +
+	"\brx_bytes\x18\x03 \x01(\x04R\brx_bytes\x12\x1a\n" +
+	"\btx_bytes\x18\x04 \x01(\x04R\btx_bytes\x12\x1e\n" +
+	"\n" +
+	"rx_packets\x18\x05 \x01(\x04R\n" +
+	"rx_packets\x12\x1e\n" +
+	"\n" +
+	"tx_packets\x18\x06 \x01(\x04R\n" +
+	"tx_packets\x12\x1c\n" +
+	"\trx_errors\x18\a \x01(\x04R\trx_errors\x12\x1c\n" +
+	"\ttx_errors\x18\b \x01(\x04R\ttx_errors\x12\"\n" +
+	"\fcounter_bits\x18\t \x01(\rR\fcounter_bits\"T\n" +
 	"\x13UpdateDeviceRequest\x12=\n" +
 	"\rdevice_status\x18\x01 \x01(\x0e2\x17.device.v1.DeviceStatusR\rdevice_status\"\x16\n" +
-	"\x14UpdateDeviceResponse*~\n" +
+	"\x14UpdateDeviceResponse\"b\n" +
+	"\rDeviceCommand\x12F\n" +
+	"\rupdate_device\x18\x01 \x01(\v2\x1e.device.v1.UpdateDeviceRequestH\x00R\rupdate_deviceB\t\n" +
+	"\acommand\"\xb0\x03\n" +
+	"\x11SimulationProfile\x12*\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x16.device.v1.ProfileKindR\x04kind\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\x01R\x04base\x12\x1c\n" +
+	"\tamplitude\x18\x03 \x01(\x01R\tamplitude\x121\n" +
+	"\x06period\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x06period\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\x01R\x04step\x12\x10\n" +
+	"\x03min\x18\x06 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\a \x01(\x01R\x03max\x12,\n" +
+	"\x11spike_probability\x18\b \x01(\x01R\x11spike_probability\x12A\n" +
+	"\x0espike_duration\x18\t \x01(\v2\x19.google.protobuf.DurationR\x0espike_duration\x12\x16\n" +
+	"\x06target\x18\n" +
+	" \x01(\x01R\x06target\x125\n" +
+	"\bduration\x18\v \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x12\n" +
+	"\x04seed\x18\f \x01(\x03R\x04seed\"\x16\n" +
+	"\x14GetSimulationRequest\"\x97\x01\n" +
+	"\x15GetSimulationResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12.\n" +
+	"\x03cpu\x18\x02 \x01(\v2\x1c.device.v1.SimulationProfileR\x03cpu\x124\n" +
+	"\x06memory\x18\x03 \x01(\v2\x1c.device.v1.SimulationProfileR\x06memory\"\x99\x01\n" +
+	"\x17UpdateSimulationRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12.\n" +
+	"\x03cpu\x18\x02 \x01(\v2\x1c.device.v1.SimulationProfileR\x03cpu\x124\n" +
+	"\x06memory\x18\x03 \x01(\v2\x1c.device.v1.SimulationProfileR\x06memory\"\x9a\x01\n" +
+	"\x18UpdateSimulationResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12.\n" +
+	"\x03cpu\x18\x02 \x01(\v2\x1c.device.v1.SimulationProfileR\x03cpu\x124\n" +
+	"\x06memory\x18\x03 \x01(\v2\x1c.device.v1.SimulationProfileR\x06memory\"\xbe\x04\n" +
+	"\x05Fault\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x14.device.v1.FaultKindR\x04kind\x12\x18\n" +
+	"\amethods\x18\x03 \x03(\tR\amethods\x123\n" +
+	"\alatency\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\alatency\x121\n" +
+	"\x06jitter\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x06jitter\x12%\n" +
+	"\vprobability\x18\x06 \x01(\x01H\x00R\vprobability\x88\x01\x01\x12\x1c\n" +
+	"\tgrpc_code\x18\a \x01(\rR\tgrpc_code\x12 \n" +
+	"\vhttp_status\x18\b \x01(\rR\vhttp_status\x12&\n" +
+	"\x0eafter_messages\x18\t \x01(\rR\x0eafter_messages\x125\n" +
+	"\bduration\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\bduration\x12+\n" +
+	"\x03ttl\x18\v \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12:\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_atB\x0e\n" +
+	"\f_probability\"\x13\n" +
+	"\x11ListFaultsRequest\">\n" +
+	"\x12ListFaultsResponse\x12(\n" +
+	"\x06faults\x18\x01 \x03(\v2\x10.device.v1.FaultR\x06faults\"<\n" +
+	"\x12InjectFaultRequest\x12&\n" +
+	"\x05fault\x18\x01 \x01(\v2\x10.device.v1.FaultR\x05fault\"=\n" +
+	"\x13InjectFaultResponse\x12&\n" +
+	"\x05fault\x18\x01 \x01(\v2\x10.device.v1.FaultR\x05fault\"$\n" +
+	"\x12RemoveFaultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13RemoveFaultResponse\"\x14\n" +
+	"\x12ClearFaultsRequest\"\x15\n" +
+	"\x13ClearFaultsResponse\"\xdf\x02\n" +
+	"\x0fFirmwareUpgrade\x12&\n" +
+	"\x0etarget_version\x18\x01 \x01(\tR\x0etarget_version\x12*\n" +
+	"\x10previous_version\x18\x02 \x01(\tR\x10previous_version\x12-\n" +
+	"\x05phase\x18\x03 \x01(\x0e2\x17.device.v1.UpgradePhaseR\x05phase\x12;\n" +
+	"\ffailed_phase\x18\x04 \x01(\x0e2\x17.device.v1.UpgradePhaseR\ffailed_phase\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12:\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"started_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\x1b\n" +
+	"\x19GetFirmwareUpgradeRequest\"R\n" +
+	"\x1aGetFirmwareUpgradeResponse\x124\n" +
+	"\aupgrade\x18\x01 \x01(\v2\x1a.device.v1.FirmwareUpgradeR\aupgrade\"k\n" +
+	"\x16UpgradeFirmwareRequest\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x127\n" +
+	"\n" +
+	"fail_phase\x18\x02 \x01(\x0e2\x17.device.v1.UpgradePhaseR\n" +
+	"fail_phase\"O\n" +
+	"\x17UpgradeFirmwareResponse\x124\n" +
+	"\aupgrade\x18\x01 \x01(\v2\x1a.device.v1.FirmwareUpgradeR\aupgrade\"F\n" +
+	"\rRebootRequest\x125\n" +
+	"\bduration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bduration\"\x83\x01\n" +
+	"\x0eRebootResponse\x12:\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"started_at\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\"\x82\x03\n" +
+	"\fDeviceConfig\x12=\n" +
+	"\rdevice_status\x18\x01 \x01(\x0e2\x17.device.v1.DeviceStatusR\rdevice_status\x12C\n" +
+	"\x0fstream_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fstream_interval\x12;\n" +
+	"\x06labels\x18\x03 \x03(\v2#.device.v1.DeviceConfig.LabelsEntryR\x06labels\x12;\n" +
+	"\x06config\x18\x04 \x03(\v2#.device.v1.DeviceConfig.ConfigEntryR\x06config\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x12ApplyConfigRequest\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.device.v1.DeviceConfigR\x06config\"F\n" +
+	"\x13ApplyConfigResponse\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.device.v1.DeviceConfigR\x06config*\x91\x01\n" +
 	"\bProtocol\x12\x18\n" +
 	"\x14PROTOCOL_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rPROTOCOL_HTTP\x10\x01\x12\x18\n" +
 	"\x14PROTOCOL_HTTP_STREAM\x10\x02\x12\x11\n" +
 	"\rPROTOCOL_GRPC\x10\x03\x12\x18\n" +
-	"\x14PROTOCOL_GRPC_STREAM\x10\x04*\xb7\x01\n" +
+	"\x14PROTOCOL_GRPC_STREAM\x10\x04\x12\x11\n" +
+	"\rPROTOCOL_MQTT\x10\x05*\xb7\x01\n" +
 	"\fDeviceStatus\x12\x1d\n" +
 	"\x19DEVICE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15DEVICE_STATUS_HEALTHY\x10\x01\x12\x1a\n" +
 	"\x16DEVICE_STATUS_DEGRADED\x10\x02\x12\x17\n" +
 	"\x13DEVICE_STATUS_ERROR\x10\x03\x12\x1d\n" +
 	"\x19DEVICE_STATUS_MAINTENANCE\x10\x04\x12\x19\n" +
-	"\x15DEVICE_STATUS_BOOTING\x10\x052\xac\x03\n" +
+	"\x15DEVICE_STATUS_BOOTING\x10\x05*\xaa\x01\n" +
+	"\vProfileKind\x12\x1c\n" +
+	"\x18PROFILE_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PROFILE_KIND_CONSTANT\x10\x01\x12\x15\n" +
+	"\x11PROFILE_KIND_SINE\x10\x02\x12\x1c\n" +
+	"\x18PROFILE_KIND_RANDOM_WALK\x10\x03\x12\x16\n" +
+	"\x12PROFILE_KIND_SPIKE\x10\x04\x12\x15\n" +
+	"\x11PROFILE_KIND_RAMP\x10\x05*O\n" +
+	"\tLinkState\x12\x1a\n" +
+	"\x16LINK_STATE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rLINK_STATE_UP\x10\x01\x12\x13\n" +
+	"\x0fLINK_STATE_DOWN\x10\x02*\xc7\x01\n" +
+	"\tFaultKind\x12\x1a\n" +
+	"\x16FAULT_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12FAULT_KIND_LATENCY\x10\x01\x12\x14\n" +
+	"\x10FAULT_KIND_ERROR\x10\x02\x12\x1b\n" +
+	"\x17FAULT_KIND_STREAM_STALL\x10\x03\x12\x1b\n" +
+	"\x17FAULT_KIND_STREAM_CLOSE\x10\x04\x12\x1f\n" +
+	"\x1bFAULT_KIND_CORRUPT_CHECKSUM\x10\x05\x12\x15\n" +
+	"\x11FAULT_KIND_REBOOT\x10\x06*\xbc\x01\n" +
+	"\fUpgradePhase\x12\x1d\n" +
+	"\x19UPGRADE_PHASE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19UPGRADE_PHASE_DOWNLOADING\x10\x01\x12\x1c\n" +
+	"\x18UPGRADE_PHASE_INSTALLING\x10\x02\x12\x19\n" +
+	"\x15UPGRADE_PHASE_BOOTING\x10\x03\x12\x1b\n" +
+	"\x17UPGRADE_PHASE_COMPLETED\x10\x04\x12\x18\n" +
+	"\x14UPGRADE_PHASE_FAILED\x10\x052\xc4\v\n" +
 	"\x06Device\x12Z\n" +
 	"\tGetHealth\x12\x1b.device.v1.GetHealthRequest\x1a\x1c.device.v1.GetHealthResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/health\x12h\n" +
 	"\x0eGetDiagnostics\x12\x1d.device.v1.DiagnosticsRequest\x1a\x1e.device.v1.DiagnosticsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/diagnostics\x12t\n" +
 	"\x11StreamDiagnostics\x12\x1d.device.v1.DiagnosticsRequest\x1a\x1e.device.v1.DiagnosticsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/diagnostics/stream0\x01\x12f\n" +
 	"\fUpdateDevice\x12\x1e.device.v1.UpdateDeviceRequest\x1a\x1f.device.v1.UpdateDeviceResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*2\n" +
-	"/v1/deviceBCZAgithub.com/emil-j-olsson/ubiquiti/device/proto/device/v1;devicev1b\x06proto3"
+	"/v1/device\x12j\n" +
+	"\rGetSimulation\x12\x1f.device.v1.GetSimulationRequest\x1a .device.v1.GetSimulationResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/simulation\x12v\n" +
+	"\x10UpdateSimulation\x12\".device.v1.UpdateSimulationRequest\x1a#.device.v1.UpdateSimulationResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/simulation\x12]\n" +
+	"\n" +
+	"ListFaults\x12\x1c.device.v1.ListFaultsRequest\x1a\x1d.device.v1.ListFaultsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/faults\x12c\n" +
+	"\vInjectFault\x12\x1d.device.v1.InjectFaultRequest\x1a\x1e.device.v1.InjectFaultResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/faults\x12e\n" +
+	"\vRemoveFault\x12\x1d.device.v1.RemoveFaultRequest\x1a\x1e.device.v1.RemoveFaultResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/faults/{id}\x12`\n" +
+	"\vClearFaults\x12\x1d.device.v1.ClearFaultsRequest\x1a\x1e.device.v1.ClearFaultsResponse\"\x12\x82\xd3\xe4\x93\x02\f*\n" +
+	"/v1/faults\x12w\n" +
+	"\x12GetFirmwareUpgrade\x12$.device.v1.GetFirmwareUpgradeRequest\x1a%.device.v1.GetFirmwareUpgradeResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/firmware\x12q\n" +
+	"\x0fUpgradeFirmware\x12!.device.v1.UpgradeFirmwareRequest\x1a\".device.v1.UpgradeFirmwareResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/firmware\x12T\n" +
+	"\x06Reboot\x12\x18.device.v1.RebootRequest\x1a\x19.device.v1.RebootResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/reboot\x12c\n" +
+	"\vApplyConfig\x12\x1d.device.v1.ApplyConfigRequest\x1a\x1e.device.v1.ApplyConfigResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\x1a\n" +
+	"/v1/configBCZAgithub.com/emil-j-olsson/ubiquiti/device/proto/device/v1;devicev1b\x06proto3"
 
 var (
 	file_proto_device_v1_device_proto_rawDescOnce sync.Once
@@ -534,38 +2560,135 @@ func file_proto_device_v1_device_proto_rawDescGZIP() []byte {
 	return file_proto_device_v1_device_proto_rawDescData
 }
 
-var file_proto_device_v1_device_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_device_v1_device_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_device_v1_device_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_device_v1_device_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_proto_device_v1_device_proto_goTypes = []any{
-	(Protocol)(0),                 // 0: device.v1.Protocol
-	(DeviceStatus)(0),             // 1: device.v1.DeviceStatus
-	(*GetHealthRequest)(nil),      // 2: device.v1.GetHealthRequest
-	(*GetHealthResponse)(nil),     // 3: device.v1.GetHealthResponse
-	(*DiagnosticsRequest)(nil),    // 4: device.v1.DiagnosticsRequest
-	(*DiagnosticsResponse)(nil),   // 5: device.v1.DiagnosticsResponse
-	(*UpdateDeviceRequest)(nil),   // 6: device.v1.UpdateDeviceRequest
-	(*UpdateDeviceResponse)(nil),  // 7: device.v1.UpdateDeviceResponse
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(Protocol)(0),                      // 0: device.v1.Protocol
+	(DeviceStatus)(0),                  // 1: device.v1.DeviceStatus
+	(ProfileKind)(0),                   // 2: device.v1.ProfileKind
+	(LinkState)(0),                     // 3: device.v1.LinkState
+	(FaultKind)(0),                     // 4: device.v1.FaultKind
+	(UpgradePhase)(0),                  // 5: device.v1.UpgradePhase
+	(*GetHealthRequest)(nil),           // 6: device.v1.GetHealthRequest
+	(*GetHealthResponse)(nil),          // 7: device.v1.GetHealthResponse
+	(*DiagnosticsRequest)(nil),         // 8: device.v1.DiagnosticsRequest
+	(*DiagnosticsResponse)(nil),        // 9: device.v1.DiagnosticsResponse
+	(*NetworkInterface)(nil),           // 10: device.v1.NetworkInterface
+	(*UpdateDeviceRequest)(nil),        // 11: device.v1.UpdateDeviceRequest
+	(*UpdateDeviceResponse)(nil),       // 12: device.v1.UpdateDeviceResponse
+	(*DeviceCommand)(nil),              // 13: device.v1.DeviceCommand
+	(*SimulationProfile)(nil),          // 14: device.v1.SimulationProfile
+	(*GetSimulationRequest)(nil),       // 15: device.v1.GetSimulationRequest
+	(*GetSimulationResponse)(nil),      // 16: device.v1.GetSimulationResponse
+	(*UpdateSimulationRequest)(nil),    // 17: device.v1.UpdateSimulationRequest
+	(*UpdateSimulationResponse)(nil),   // 18: device.v1.UpdateSimulationResponse
+	(*Fault)(nil),                      // 19: device.v1.Fault
+	(*ListFaultsRequest)(nil),          // 20: device.v1.ListFaultsRequest
+	(*ListFaultsResponse)(nil),         // 21: device.v1.ListFaultsResponse
+	(*InjectFaultRequest)(nil),         // 22: device.v1.InjectFaultRequest
+	(*InjectFaultResponse)(nil),        // 23: device.v1.InjectFaultResponse
+	(*RemoveFaultRequest)(nil),         // 24: device.v1.RemoveFaultRequest
+	(*RemoveFaultResponse)(nil),        // 25: device.v1.RemoveFaultResponse
+	(*ClearFaultsRequest)(nil),         // 26: device.v1.ClearFaultsRequest
+	(*ClearFaultsResponse)(nil),        // 27: device.v1.ClearFaultsResponse
+	(*FirmwareUpgrade)(nil),            // 28: device.v1.FirmwareUpgrade
+	(*GetFirmwareUpgradeRequest)(nil),  // 29: device.v1.GetFirmwareUpgradeRequest
+	(*GetFirmwareUpgradeResponse)(nil), // 30: device.v1.GetFirmwareUpgradeResponse
+	(*UpgradeFirmwareRequest)(nil),     // 31: device.v1.UpgradeFirmwareRequest
+	(*UpgradeFirmwareResponse)(nil),    // 32: device.v1.UpgradeFirmwareResponse
+	(*RebootRequest)(nil),              // 33: device.v1.RebootRequest
+	(*RebootResponse)(nil),             // 34: device.v1.RebootResponse
+	(*DeviceConfig)(nil),               // 35: device.v1.DeviceConfig
+	(*ApplyConfigRequest)(nil),         // 36: device.v1.ApplyConfigRequest
+	(*ApplyConfigResponse)(nil),        // 37: device.v1.ApplyConfigResponse
+	nil,                                // 38: device.v1.DiagnosticsResponse.LabelsEntry
+	nil,                                // 39: device.v1.DiagnosticsResponse.ConfigEntry
+	nil,                                // 40: device.v1.DeviceConfig.LabelsEntry
+	nil,                                // 41: device.v1.DeviceConfig.ConfigEntry
+	(*timestamppb.Timestamp)(nil),      // 42: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 43: google.protobuf.Duration
 }
 var file_proto_device_v1_device_proto_depIdxs = []int32{
-	8, // 0: device.v1.GetHealthResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 1: device.v1.GetHealthResponse.supported_protocols:type_name -> device.v1.Protocol
-	8, // 2: device.v1.DiagnosticsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	1, // 3: device.v1.DiagnosticsResponse.device_status:type_name -> device.v1.DeviceStatus
-	1, // 4: device.v1.UpdateDeviceRequest.device_status:type_name -> device.v1.DeviceStatus
-	2, // 5: device.v1.Device.GetHealth:input_type -> device.v1.GetHealthRequest
-	4, // 6: device.v1.Device.GetDiagnostics:input_type -> device.v1.DiagnosticsRequest
-	4, // 7: device.v1.Device.StreamDiagnostics:input_type -> device.v1.DiagnosticsRequest
-	6, // 8: device.v1.Device.UpdateDevice:input_type -> device.v1.UpdateDeviceRequest
-	3, // 9: device.v1.Device.GetHealth:output_type -> device.v1.GetHealthResponse
-	5, // 10: device.v1.Device.GetDiagnostics:output_type -> device.v1.DiagnosticsResponse
-	5, // 11: device.v1.Device.StreamDiagnostics:output_type -> device.v1.DiagnosticsResponse
-	7, // 12: device.v1.Device.UpdateDevice:output_type -> device.v1.UpdateDeviceResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	42, // 0: device.v1.GetHealthResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: device.v1.GetHealthResponse.supported_protocols:type_name -> device.v1.Protocol
+	42, // 2: device.v1.DiagnosticsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 3: device.v1.DiagnosticsResponse.device_status:type_name -> device.v1.DeviceStatus
+	10, // 4: device.v1.DiagnosticsResponse.interfaces:type_name -> device.v1.NetworkInterface
+	43, // 5: device.v1.DiagnosticsResponse.stream_interval:type_name -> google.protobuf.Duration
+	38, // 6: device.v1.DiagnosticsResponse.labels:type_name -> device.v1.DiagnosticsResponse.LabelsEntry
+	39, // 7: device.v1.DiagnosticsResponse.config:type_name -> device.v1.DiagnosticsResponse.ConfigEntry
+	3,  // 8: device.v1.NetworkInterface.link_state:type_name -> device.v1.LinkState
+	1,  // 9: device.v1.UpdateDeviceRequest.device_status:type_name -> device.v1.DeviceStatus
+	11, // 10: device.v1.DeviceCommand.update_device:type_name -> device.v1.UpdateDeviceRequest
+	2,  // 11: device.v1.SimulationProfile.kind:type_name -> device.v1.ProfileKind
+	43, // 12: device.v1.SimulationProfile.period:type_name -> google.protobuf.Duration
+	43, // 13: device.v1.SimulationProfile.spike_duration:type_name -> google.protobuf.Duration
+	43, // 14: device.v1.SimulationProfile.duration:type_name -> google.protobuf.Duration
+	14, // 15: device.v1.GetSimulationResponse.cpu:type_name -> device.v1.SimulationProfile
+	14, // 16: device.v1.GetSimulationResponse.memory:type_name -> device.v1.SimulationProfile
+	14, // 17: device.v1.UpdateSimulationRequest.cpu:type_name -> device.v1.SimulationProfile
+	14, // 18: device.v1.UpdateSimulationRequest.memory:type_name -> device.v1.SimulationProfile
+	14, // 19: device.v1.UpdateSimulationResponse.cpu:type_name -> device.v1.SimulationProfile
+	14, // 20: device.v1.UpdateSimulationResponse.memory:type_name -> device.v1.SimulationProfile
+	4,  // 21: device.v1.Fault.kind:type_name -> device.v1.FaultKind
+	43, // 22: device.v1.Fault.latency:type_name -> google.protobuf.Duration
+	43, // 23: device.v1.Fault.jitter:type_name -> google.protobuf.Duration
+	43, // 24: device.v1.Fault.duration:type_name -> google.protobuf.Duration
+	43, // 25: device.v1.Fault.ttl:type_name -> google.protobuf.Duration
+	42, // 26: device.v1.Fault.created_at:type_name -> google.protobuf.Timestamp
+	42, // 27: device.v1.Fault.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 28: device.v1.ListFaultsResponse.faults:type_name -> device.v1.Fault
+	19, // 29: device.v1.InjectFaultRequest.fault:type_name -> device.v1.Fault
+	19, // 30: device.v1.InjectFaultResponse.fault:type_name -> device.v1.Fault
+	5,  // 31: device.v1.FirmwareUpgrade.phase:type_name -> device.v1.UpgradePhase
+	5,  // 32: device.v1.FirmwareUpgrade.failed_phase:type_name -> device.v1.UpgradePhase
+	42, // 33: device.v1.FirmwareUpgrade.started_at:type_name -> google.protobuf.Timestamp
+	42, // 34: device.v1.FirmwareUpgrade.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 35: device.v1.GetFirmwareUpgradeResponse.upgrade:type_name -> device.v1.FirmwareUpgrade
+	5,  // 36: device.v1.UpgradeFirmwareRequest.fail_phase:type_name -> device.v1.UpgradePhase
+	28, // 37: device.v1.UpgradeFirmwareResponse.upgrade:type_name -> device.v1.FirmwareUpgrade
+	43, // 38: device.v1.RebootRequest.duration:type_name -> google.protobuf.Duration
+	42, // 39: device.v1.RebootResponse.started_at:type_name -> google.protobuf.Timestamp
+	43, // 40: device.v1.RebootResponse.duration:type_name -> google.protobuf.Duration
+	1,  // 41: device.v1.DeviceConfig.device_status:type_name -> device.v1.DeviceStatus
+	43, // 42: device.v1.DeviceConfig.stream_interval:type_name -> google.protobuf.Duration
+	40, // 43: device.v1.DeviceConfig.labels:type_name -> device.v1.DeviceConfig.LabelsEntry
+	41, // 44: device.v1.DeviceConfig.config:type_name -> device.v1.DeviceConfig.ConfigEntry
+	35, // 45: device.v1.ApplyConfigRequest.config:type_name -> device.v1.DeviceConfig
+	35, // 46: device.v1.ApplyConfigResponse.config:type_name -> device.v1.DeviceConfig
+	6,  // 47: device.v1.Device.GetHealth:input_type -> device.v1.GetHealthRequest
+	8,  // 48: device.v1.Device.GetDiagnostics:input_type -> device.v1.DiagnosticsRequest
+	8,  // 49: device.v1.Device.StreamDiagnostics:input_type -> device.v1.DiagnosticsRequest
+	11, // 50: device.v1.Device.UpdateDevice:input_type -> device.v1.UpdateDeviceRequest
+	15, // 51: device.v1.Device.GetSimulation:input_type -> device.v1.GetSimulationRequest
+	17, // 52: device.v1.Device.UpdateSimulation:input_type -> device.v1.UpdateSimulationRequest
+	20, // 53: device.v1.Device.ListFaults:input_type -> device.v1.ListFaultsRequest
+	22, // 54: device.v1.Device.InjectFault:input_type -> device.v1.InjectFaultRequest
+	24, // 55: device.v1.Device.RemoveFault:input_type -> device.v1.RemoveFaultRequest
+	26, // 56: device.v1.Device.ClearFaults:input_type -> device.v1.ClearFaultsRequest
+	29, // 57: device.v1.Device.GetFirmwareUpgrade:input_type -> device.v1.GetFirmwareUpgradeRequest
+	31, // 58: device.v1.Device.UpgradeFirmware:input_type -> device.v1.UpgradeFirmwareRequest
+	33, // 59: device.v1.Device.Reboot:input_type -> device.v1.RebootRequest
+	36, // 60: device.v1.Device.ApplyConfig:input_type -> device.v1.ApplyConfigRequest
+	7,  // 61: device.v1.Device.GetHealth:output_type -> device.v1.GetHealthResponse
+	9,  // 62: device.v1.Device.GetDiagnostics:output_type -> device.v1.DiagnosticsResponse
+	9,  // 63: device.v1.Device.StreamDiagnostics:output_type -> device.v1.DiagnosticsResponse
+	12, // 64: device.v1.Device.UpdateDevice:output_type -> device.v1.UpdateDeviceResponse
+	16, // 65: device.v1.Device.GetSimulation:output_type -> device.v1.GetSimulationResponse
+	18, // 66: device.v1.Device.UpdateSimulation:output_type -> device.v1.UpdateSimulationResponse
+	21, // 67: device.v1.Device.ListFaults:output_type -> device.v1.ListFaultsResponse
+	23, // 68: device.v1.Device.InjectFault:output_type -> device.v1.InjectFaultResponse
+	25, // 69: device.v1.Device.RemoveFault:output_type -> device.v1.RemoveFaultResponse
+	27, // 70: device.v1.Device.ClearFaults:output_type -> device.v1.ClearFaultsResponse
+	30, // 71: device.v1.Device.GetFirmwareUpgrade:output_type -> device.v1.GetFirmwareUpgradeResponse
+	32, // 72: device.v1.Device.UpgradeFirmware:output_type -> device.v1.UpgradeFirmwareResponse
+	34, // 73: device.v1.Device.Reboot:output_type -> device.v1.RebootResponse
+	37, // 74: device.v1.Device.ApplyConfig:output_type -> device.v1.ApplyConfigResponse
+	61, // [61:75] is the sub-list for method output_type
+	47, // [47:61] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_proto_device_v1_device_proto_init() }
@@ -573,13 +2696,17 @@ func file_proto_device_v1_device_proto_init() {
 	if File_proto_device_v1_device_proto != nil {
 		return
 	}
+	file_proto_device_v1_device_proto_msgTypes[7].OneofWrappers = []any{
+		(*DeviceCommand_UpdateDevice)(nil),
+	}
+	file_proto_device_v1_device_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_device_v1_device_proto_rawDesc), len(file_proto_device_v1_device_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,10 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Device_GetHealth_FullMethodName         = "/device.v1.Device/GetHealth"
-	Device_GetDiagnostics_FullMethodName    = "/device.v1.Device/GetDiagnostics"
-	Device_StreamDiagnostics_FullMethodName = "/device.v1.Device/StreamDiagnostics"
-	Device_UpdateDevice_FullMethodName      = "/device.v1.Device/UpdateDevice"
+	Device_GetHealth_FullMethodName          = "/device.v1.Device/GetHealth"
+	Device_GetDiagnostics_FullMethodName     = "/device.v1.Device/GetDiagnostics"
+	Device_StreamDiagnostics_FullMethodName  = "/device.v1.Device/StreamDiagnostics"
+	Device_UpdateDevice_FullMethodName       = "/device.v1.Device/UpdateDevice"
+	Device_GetSimulation_FullMethodName      = "/device.v1.Device/GetSimulation"
+	Device_UpdateSimulation_FullMethodName   = "/device.v1.Device/UpdateSimulation"
+	Device_ListFaults_FullMethodName         = "/device.v1.Device/ListFaults"
+	Device_InjectFault_FullMethodName        = "/device.v1.Device/InjectFault"
+	Device_RemoveFault_FullMethodName        = "/device.v1.Device/RemoveFault"
+	Device_ClearFaults_FullMethodName        = "/device.v1.Device/ClearFaults"
+	Device_GetFirmwareUpgrade_FullMethodName = "/device.v1.Device/GetFirmwareUpgrade"
+	Device_UpgradeFirmware_FullMethodName    = "/device.v1.Device/UpgradeFirmware"
+	Device_Reboot_FullMethodName             = "/device.v1.Device/Reboot"
+	Device_ApplyConfig_FullMethodName        = "/device.v1.Device/ApplyConfig"
 )
 
 // DeviceClient is the client API for Device service.
@@ -34,6 +44,16 @@ type DeviceClient interface {
 	GetDiagnostics(ctx context.Context, in *DiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error)
 	StreamDiagnostics(ctx context.Context, in *DiagnosticsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiagnosticsResponse], error)
 	UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*UpdateDeviceResponse, error)
+	GetSimulation(ctx context.Context, in *GetSimulationRequest, opts ...grpc.CallOption) (*GetSimulationResponse, error)
+	UpdateSimulation(ctx context.Context, in *UpdateSimulationRequest, opts ...grpc.CallOption) (*UpdateSimulationResponse, error)
+	ListFaults(ctx context.Context, in *ListFaultsRequest, opts ...grpc.CallOption) (*ListFaultsResponse, error)
+	InjectFault(ctx context.Context, in *InjectFaultRequest, opts ...grpc.CallOption) (*InjectFaultResponse, error)
+	RemoveFault(ctx context.Context, in *RemoveFaultRequest, opts ...grpc.CallOption) (*RemoveFaultResponse, error)
+	ClearFaults(ctx context.Context, in *ClearFaultsRequest, opts ...grpc.CallOption) (*ClearFaultsResponse, error)
+	GetFirmwareUpgrade(ctx context.Context, in *GetFirmwareUpgradeRequest, opts ...grpc.CallOption) (*GetFirmwareUpgradeResponse, error)
+	UpgradeFirmware(ctx context.Context, in *UpgradeFirmwareRequest, opts ...grpc.CallOption) (*UpgradeFirmwareResponse, error)
+	Reboot(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*RebootResponse, error)
+	ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error)
 }
 
 type deviceClient struct {
@@ -93,6 +113,106 @@ func (c *deviceClient) UpdateDevice(ctx context.Context, in *UpdateDeviceRequest
 	return out, nil
 }
 
+func (c *deviceClient) GetSimulation(ctx context.Context, in *GetSimulationRequest, opts ...grpc.CallOption) (*GetSimulationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimulationResponse)
+	err := c.cc.Invoke(ctx, Device_GetSimulation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) UpdateSimulation(ctx context.Context, in *UpdateSimulationRequest, opts ...grpc.CallOption) (*UpdateSimulationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSimulationResponse)
+	err := c.cc.Invoke(ctx, Device_UpdateSimulation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) ListFaults(ctx context.Context, in *ListFaultsRequest, opts ...grpc.CallOption) (*ListFaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFaultsResponse)
+	err := c.cc.Invoke(ctx, Device_ListFaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) InjectFault(ctx context.Context, in *InjectFaultRequest, opts ...grpc.CallOption) (*InjectFaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InjectFaultResponse)
+	err := c.cc.Invoke(ctx, Device_InjectFault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) RemoveFault(ctx context.Context, in *RemoveFaultRequest, opts ...grpc.CallOption) (*RemoveFaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFaultResponse)
+	err := c.cc.Invoke(ctx, Device_RemoveFault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) ClearFaults(ctx context.Context, in *ClearFaultsRequest, opts ...grpc.CallOption) (*ClearFaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearFaultsResponse)
+	err := c.cc.Invoke(ctx, Device_ClearFaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) GetFirmwareUpgrade(ctx context.Context, in *GetFirmwareUpgradeRequest, opts ...grpc.CallOption) (*GetFirmwareUpgradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFirmwareUpgradeResponse)
+	err := c.cc.Invoke(ctx, Device_GetFirmwareUpgrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) UpgradeFirmware(ctx context.Context, in *UpgradeFirmwareRequest, opts ...grpc.CallOption) (*UpgradeFirmwareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpgradeFirmwareResponse)
+	err := c.cc.Invoke(ctx, Device_UpgradeFirmware_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) Reboot(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*RebootResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebootResponse)
+	err := c.cc.Invoke(ctx, Device_Reboot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyConfigResponse)
+	err := c.cc.Invoke(ctx, Device_ApplyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceServer is the server API for Device service.
 // All implementations must embed UnimplementedDeviceServer
 // for forward compatibility.
@@ -101,6 +221,16 @@ type DeviceServer interface {
 	GetDiagnostics(context.Context, *DiagnosticsRequest) (*DiagnosticsResponse, error)
 	StreamDiagnostics(*DiagnosticsRequest, grpc.ServerStreamingServer[DiagnosticsResponse]) error
 	UpdateDevice(context.Context, *UpdateDeviceRequest) (*UpdateDeviceResponse, error)
+	GetSimulation(context.Context, *GetSimulationRequest) (*GetSimulationResponse, error)
+	UpdateSimulation(context.Context, *UpdateSimulationRequest) (*UpdateSimulationResponse, error)
+	ListFaults(context.Context, *ListFaultsRequest) (*ListFaultsResponse, error)
+	InjectFault(context.Context, *InjectFaultRequest) (*InjectFaultResponse, error)
+	RemoveFault(context.Context, *RemoveFaultRequest) (*RemoveFaultResponse, error)
+	ClearFaults(context.Context, *ClearFaultsRequest) (*ClearFaultsResponse, error)
+	GetFirmwareUpgrade(context.Context, *GetFirmwareUpgradeRequest) (*GetFirmwareUpgradeResponse, error)
+	UpgradeFirmware(context.Context, *UpgradeFirmwareRequest) (*UpgradeFirmwareResponse, error)
+	Reboot(context.Context, *RebootRequest) (*RebootResponse, error)
+	ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error)
 	mustEmbedUnimplementedDeviceServer()
 }
 
@@ -123,6 +253,36 @@ func (UnimplementedDeviceServer) StreamDiagnostics(*DiagnosticsRequest, grpc.Ser
 func (UnimplementedDeviceServer) UpdateDevice(context.Context, *UpdateDeviceRequest) (*UpdateDeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevice not implemented")
 }
+func (UnimplementedDeviceServer) GetSimulation(context.Context, *GetSimulationRequest) (*GetSimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimulation not implemented")
+}
+func (UnimplementedDeviceServer) UpdateSimulation(context.Context, *UpdateSimulationRequest) (*UpdateSimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSimulation not implemented")
+}
+func (UnimplementedDeviceServer) ListFaults(context.Context, *ListFaultsRequest) (*ListFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFaults not implemented")
+}
+func (UnimplementedDeviceServer) InjectFault(context.Context, *InjectFaultRequest) (*InjectFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectFault not implemented")
+}
+func (UnimplementedDeviceServer) RemoveFault(context.Context, *RemoveFaultRequest) (*RemoveFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFault not implemented")
+}
+func (UnimplementedDeviceServer) ClearFaults(context.Context, *ClearFaultsRequest) (*ClearFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearFaults not implemented")
+}
+func (UnimplementedDeviceServer) GetFirmwareUpgrade(context.Context, *GetFirmwareUpgradeRequest) (*GetFirmwareUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFirmwareUpgrade not implemented")
+}
+func (UnimplementedDeviceServer) UpgradeFirmware(context.Context, *UpgradeFirmwareRequest) (*UpgradeFirmwareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeFirmware not implemented")
+}
+func (UnimplementedDeviceServer) Reboot(context.Context, *RebootRequest) (*RebootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reboot not implemented")
+}
+func (UnimplementedDeviceServer) ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfig not implemented")
+}
 func (UnimplementedDeviceServer) mustEmbedUnimplementedDeviceServer() {}
 func (UnimplementedDeviceServer) testEmbeddedByValue()                {}
 
@@ -209,6 +369,186 @@ func _Device_UpdateDevice_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Device_GetSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).GetSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_GetSimulation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).GetSimulation(ctx, req.(*GetSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_UpdateSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).UpdateSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_UpdateSimulation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).UpdateSimulation(ctx, req.(*UpdateSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_ListFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).ListFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_ListFaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).ListFaults(ctx, req.(*ListFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_InjectFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InjectFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).InjectFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_InjectFault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).InjectFault(ctx, req.(*InjectFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_RemoveFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).RemoveFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_RemoveFault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).RemoveFault(ctx, req.(*RemoveFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_ClearFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).ClearFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_ClearFaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).ClearFaults(ctx, req.(*ClearFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_GetFirmwareUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFirmwareUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).GetFirmwareUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_GetFirmwareUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).GetFirmwareUpgrade(ctx, req.(*GetFirmwareUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_UpgradeFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).UpgradeFirmware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_UpgradeFirmware_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).UpgradeFirmware(ctx, req.(*UpgradeFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_Reboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).Reboot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_Reboot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).Reboot(ctx, req.(*RebootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_ApplyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).ApplyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Device_ApplyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).ApplyConfig(ctx, req.(*ApplyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Device_ServiceDesc is the grpc.ServiceDesc for Device service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -228,6 +568,46 @@ var Device_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDevice",
 			Handler:    _Device_UpdateDevice_Handler,
 		},
+		{
+			MethodName: "GetSimulation",
+			Handler:    _Device_GetSimulation_Handler,
+		},
+		{
+			MethodName: "UpdateSimulation",
+			Handler:    _Device_UpdateSimulation_Handler,
+		},
+		{
+			MethodName: "ListFaults",
+			Handler:    _Device_ListFaults_Handler,
+		},
+		{
+			MethodName: "InjectFault",
+			Handler:    _Device_InjectFault_Handler,
+		},
+		{
+			MethodName: "RemoveFault",
+			Handler:    _Device_RemoveFault_Handler,
+		},
+		{
+			MethodName: "ClearFaults",
+			Handler:    _Device_ClearFaults_Handler,
+		},
+		{
+			MethodName: "GetFirmwareUpgrade",
+			Handler:    _Device_GetFirmwareUpgrade_Handler,
+		},
+		{
+			MethodName: "UpgradeFirmware",
+			Handler:    _Device_UpgradeFirmware_Handler,
+		},
+		{
+			MethodName: "Reboot",
+			Handler:    _Device_Reboot_Handler,
+		},
+		{
+			MethodName: "ApplyConfig",
+			Handler:    _Device_ApplyConfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
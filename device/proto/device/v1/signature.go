@@ -0,0 +1,27 @@
+package devicev1
+
+import "google.golang.org/protobuf/proto"
+
+type signer interface {
+	GenerateSignature(data []byte) (string, error)
+}
+
+// SignaturePayload returns the deterministic binary encoding of the response without
+// its signature, the checksum is part of the payload and must be set before signing.
+func (r *DiagnosticsResponse) SignaturePayload() ([]byte, error) {
+	payload := proto.CloneOf(r)
+	payload.Signature = ""
+	return proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+}
+
+func (r *DiagnosticsResponse) GenerateSignature(gen signer) string {
+	data, err := r.SignaturePayload()
+	if err != nil {
+		return ""
+	}
+	signature, err := gen.GenerateSignature(data)
+	if err != nil {
+		return ""
+	}
+	return signature
+}
@@ -0,0 +1,42 @@
+package devicev1
+
+import "slices"
+
+// statusTransitions lists the statuses a device may move to, shared by the device enforcing
+// the transitions and the monitor validating requested updates
+var statusTransitions = map[DeviceStatus][]DeviceStatus{
+	DeviceStatus_DEVICE_STATUS_HEALTHY: {
+		DeviceStatus_DEVICE_STATUS_DEGRADED,
+		DeviceStatus_DEVICE_STATUS_ERROR,
+		DeviceStatus_DEVICE_STATUS_MAINTENANCE,
+		DeviceStatus_DEVICE_STATUS_BOOTING,
+	},
+	DeviceStatus_DEVICE_STATUS_DEGRADED: {
+		DeviceStatus_DEVICE_STATUS_HEALTHY,
+		DeviceStatus_DEVICE_STATUS_ERROR,
+		DeviceStatus_DEVICE_STATUS_MAINTENANCE,
+		DeviceStatus_DEVICE_STATUS_BOOTING,
+	},
+	DeviceStatus_DEVICE_STATUS_ERROR: {
+		DeviceStatus_DEVICE_STATUS_HEALTHY,
+		DeviceStatus_DEVICE_STATUS_DEGRADED,
+		DeviceStatus_DEVICE_STATUS_MAINTENANCE,
+		DeviceStatus_DEVICE_STATUS_BOOTING,
+	},
+	DeviceStatus_DEVICE_STATUS_MAINTENANCE: {
+		DeviceStatus_DEVICE_STATUS_HEALTHY,
+		DeviceStatus_DEVICE_STATUS_ERROR,
+		DeviceStatus_DEVICE_STATUS_BOOTING,
+	},
+	DeviceStatus_DEVICE_STATUS_BOOTING: {
+		DeviceStatus_DEVICE_STATUS_HEALTHY,
+		DeviceStatus_DEVICE_STATUS_DEGRADED,
+		DeviceStatus_DEVICE_STATUS_ERROR,
+	},
+}
+
+// CanTransition reports whether a device may move from this status to another status,
+// remaining in the same status is not a transition.
+func (s DeviceStatus) CanTransition(to DeviceStatus) bool {
+	return slices.Contains(statusTransitions[s], to)
+}
@@ -0,0 +1,17 @@
+package devicev1
+
+import "fmt"
+
+// Topics of a device on an MQTT broker, diagnostics and health are published (retained) by the
+// device and commands (DeviceCommand) are published to the device.
+func DiagnosticsTopic(deviceID string) string {
+	return fmt.Sprintf("devices/%s/diagnostics", deviceID)
+}
+
+func HealthTopic(deviceID string) string {
+	return fmt.Sprintf("devices/%s/health", deviceID)
+}
+
+func CommandTopic(deviceID string) string {
+	return fmt.Sprintf("devices/%s/commands", deviceID)
+}
@@ -1,6 +1,15 @@
 package devicev1
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const MinStreamInterval = 100 * time.Millisecond
 
 func (r *UpdateDeviceRequest) Validate() error {
 	if r == nil {
@@ -11,3 +20,170 @@ func (r *UpdateDeviceRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r *DeviceCommand) Validate() error {
+	if r == nil {
+		return errors.New("empty command")
+	}
+	switch command := r.GetCommand().(type) {
+	case *DeviceCommand_UpdateDevice:
+		return command.UpdateDevice.Validate()
+	default:
+		return errors.New("command is unspecified or unknown")
+	}
+}
+
+func (r *UpdateSimulationRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	profiles := []struct {
+		name    string
+		profile *SimulationProfile
+	}{
+		{name: "cpu", profile: r.GetCpu()},
+		{name: "memory", profile: r.GetMemory()},
+	}
+	for _, p := range profiles {
+		name, profile := p.name, p.profile
+		if profile == nil {
+			continue
+		}
+		if profile.GetKind() == ProfileKind_PROFILE_KIND_UNSPECIFIED {
+			return fmt.Errorf("profile kind is unspecified or unknown (%s)", name)
+		}
+		if profile.GetSpikeProbability() < 0 || profile.GetSpikeProbability() > 1 {
+			return fmt.Errorf("spike probability must be within [0, 1] (%s)", name)
+		}
+		if profile.GetMax() != 0 && profile.GetMax() <= profile.GetMin() {
+			return fmt.Errorf("max must be greater than min (%s)", name)
+		}
+	}
+	return nil
+}
+
+func (r *InjectFaultRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	fault := r.GetFault()
+	if fault == nil {
+		return errors.New("missing fault in request")
+	}
+	if fault.GetKind() == FaultKind_FAULT_KIND_UNSPECIFIED {
+		return errors.New("fault kind is unspecified or unknown")
+	}
+	for _, method := range fault.GetMethods() {
+		if !isDeviceMethod(method) {
+			return fmt.Errorf("unknown method %q", method)
+		}
+	}
+	if fault.Probability != nil && (fault.GetProbability() <= 0 || fault.GetProbability() > 1) {
+		return errors.New("probability must be within (0, 1]")
+	}
+	durations := map[string]*durationpb.Duration{
+		"latency":  fault.GetLatency(),
+		"jitter":   fault.GetJitter(),
+		"duration": fault.GetDuration(),
+		"ttl":      fault.GetTtl(),
+	}
+	for name, duration := range durations {
+		if duration != nil && (!duration.IsValid() || duration.AsDuration() < 0) {
+			return fmt.Errorf("%s must be a non-negative duration", name)
+		}
+	}
+	switch fault.GetKind() {
+	case FaultKind_FAULT_KIND_LATENCY:
+		if fault.GetLatency().AsDuration() <= 0 {
+			return errors.New("latency fault requires a positive latency")
+		}
+	case FaultKind_FAULT_KIND_ERROR:
+		if fault.GetGrpcCode() == 0 && fault.GetHttpStatus() == 0 {
+			return errors.New("error fault requires a grpc_code or http_status")
+		}
+		if fault.GetGrpcCode() > uint32(codes.Unauthenticated) {
+			return errors.New("grpc_code must be a valid non-OK gRPC status code")
+		}
+		if fault.GetHttpStatus() != 0 && (fault.GetHttpStatus() < 400 || fault.GetHttpStatus() > 599) {
+			return errors.New("http_status must be within [400, 599]")
+		}
+	}
+	return nil
+}
+
+func (r *RemoveFaultRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetId()) == 0 {
+		return errors.New("missing id in request")
+	}
+	return nil
+}
+
+func (r *UpgradeFirmwareRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if len(r.GetVersion()) == 0 {
+		return errors.New("missing version in request")
+	}
+	switch r.GetFailPhase() {
+	case UpgradePhase_UPGRADE_PHASE_UNSPECIFIED,
+		UpgradePhase_UPGRADE_PHASE_DOWNLOADING,
+		UpgradePhase_UPGRADE_PHASE_INSTALLING,
+		UpgradePhase_UPGRADE_PHASE_BOOTING:
+	default:
+		return errors.New("fail phase must be downloading, installing or booting")
+	}
+	return nil
+}
+
+func (r *RebootRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	if r.Duration != nil && (!r.GetDuration().IsValid() || r.GetDuration().AsDuration() < 0) {
+		return errors.New("duration must be a non-negative duration")
+	}
+	return nil
+}
+
+func (r *ApplyConfigRequest) Validate() error {
+	if r == nil {
+		return errors.New("empty request")
+	}
+	config := r.GetConfig()
+	if config == nil {
+		return errors.New("missing config in request")
+	}
+	if config.StreamInterval != nil &&
+		(!config.GetStreamInterval().IsValid() || config.GetStreamInterval().AsDuration() < MinStreamInterval) {
+		return fmt.Errorf("stream_interval must be at least %s", MinStreamInterval)
+	}
+	for key := range config.GetLabels() {
+		if len(key) == 0 {
+			return errors.New("label keys must not be empty")
+		}
+	}
+	for key := range config.GetConfig() {
+		if len(key) == 0 {
+			return errors.New("config keys must not be empty")
+		}
+	}
+	return nil
+}
+
+func isDeviceMethod(name string) bool {
+	for _, method := range Device_ServiceDesc.Methods {
+		if method.MethodName == name {
+			return true
+		}
+	}
+	for _, stream := range Device_ServiceDesc.Streams {
+		if stream.StreamName == name {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,9 @@ export enum Protocol {
     PROTOCOL_HTTP_STREAM = 2,
     PROTOCOL_GRPC = 3,
     PROTOCOL_GRPC_STREAM = 4,
+    PROTOCOL_MQTT = 5,
+    PROTOCOL_SNMP = 6,
+    PROTOCOL_PLUGIN = 7,
 }
 
 export enum DeviceStatus {
@@ -4,7 +4,10 @@ create type device_protocol as enum (
     'PROTOCOL_HTTP',
     'PROTOCOL_HTTP_STREAM',
     'PROTOCOL_GRPC',
-    'PROTOCOL_GRPC_STREAM'
+    'PROTOCOL_GRPC_STREAM',
+    'PROTOCOL_MQTT',
+    'PROTOCOL_SNMP',
+    'PROTOCOL_PLUGIN'
 );
 
 create type device_status as enum (
@@ -17,6 +20,108 @@ create type device_status as enum (
     'DEVICE_STATUS_OFFLINE' 
 );
 
+create type signing_algorithm as enum (
+    'SIGNING_ALGORITHM_HMAC_SHA256',
+    'SIGNING_ALGORITHM_ED25519'
+);
+
+create type verification_status as enum (
+    'VERIFICATION_STATUS_UNSIGNED',
+    'VERIFICATION_STATUS_AUTHENTIC',
+    'VERIFICATION_STATUS_INVALID'
+);
+
+create type link_state as enum (
+    'LINK_STATE_UP',
+    'LINK_STATE_DOWN'
+);
+
+create type campaign_status as enum (
+    'CAMPAIGN_STATUS_PENDING',
+    'CAMPAIGN_STATUS_RUNNING',
+    'CAMPAIGN_STATUS_COMPLETED',
+    'CAMPAIGN_STATUS_HALTED',
+    'CAMPAIGN_STATUS_ROLLED_BACK',
+    'CAMPAIGN_STATUS_CANCELLED'
+);
+
+create type campaign_device_status as enum (
+    'CAMPAIGN_DEVICE_STATUS_PENDING',
+    'CAMPAIGN_DEVICE_STATUS_UPGRADING',
+    'CAMPAIGN_DEVICE_STATUS_UPGRADED',
+    'CAMPAIGN_DEVICE_STATUS_FAILED',
+    'CAMPAIGN_DEVICE_STATUS_ROLLED_BACK',
+    'CAMPAIGN_DEVICE_STATUS_SKIPPED'
+);
+
+create type failure_policy as enum (
+    'FAILURE_POLICY_HALT',
+    'FAILURE_POLICY_ROLLBACK'
+);
+
+create type config_status as enum (
+    'CONFIG_STATUS_PENDING',
+    'CONFIG_STATUS_IN_SYNC',
+    'CONFIG_STATUS_REAPPLIED',
+    'CONFIG_STATUS_DRIFTED',
+    'CONFIG_STATUS_ERROR'
+);
+
+create type drift_policy as enum (
+    'DRIFT_POLICY_REAPPLY',
+    'DRIFT_POLICY_FLAG'
+);
+
+create type transition_cause as enum (
+    'TRANSITION_CAUSE_DEVICE',
+    'TRANSITION_CAUSE_OPERATOR',
+    'TRANSITION_CAUSE_OFFLINE'
+);
+
+create type anomaly_metric as enum (
+    'METRIC_CPU',
+    'METRIC_MEMORY',
+    'METRIC_TEMPERATURE',
+    'METRIC_LOAD',
+    'METRIC_DISK',
+    'METRIC_PROCESSES'
+);
+
+create type anomaly_kind as enum (
+    'ANOMALY_KIND_SPIKE',
+    'ANOMALY_KIND_DRIFT'
+);
+
+create type anomaly_sensitivity as enum (
+    'SENSITIVITY_DISABLED',
+    'SENSITIVITY_LOW',
+    'SENSITIVITY_MEDIUM',
+    'SENSITIVITY_HIGH'
+);
+
+create type event_type as enum (
+    'EVENT_TYPE_REGISTERED',
+    'EVENT_TYPE_STATUS_CHANGED',
+    'EVENT_TYPE_OFFLINE',
+    'EVENT_TYPE_CHECKSUM_MISMATCH',
+    'EVENT_TYPE_VERSION_CHANGED',
+    'EVENT_TYPE_WORKER_STARTED',
+    'EVENT_TYPE_WORKER_RESTARTED',
+    'EVENT_TYPE_WORKER_STOPPED'
+);
+
+create type event_severity as enum (
+    'EVENT_SEVERITY_INFO',
+    'EVENT_SEVERITY_WARNING',
+    'EVENT_SEVERITY_ERROR'
+);
+
+create type version_component as enum (
+    'VERSION_COMPONENT_HARDWARE',
+    'VERSION_COMPONENT_SOFTWARE',
+    'VERSION_COMPONENT_FIRMWARE'
+);
+
 -- Tables
 create table if not exists devices (
     id uuid primary key default gen_random_uuid(),
@@ -28,6 +133,10 @@ create table if not exists devices (
     architecture varchar(50) not null,
     os varchar(50) not null,
     supported_protocols device_protocol[] not null,
+    signing_algorithm signing_algorithm,
+    signing_key text,
+    snmp_credentials jsonb,
+    driver varchar(255),
     created_at timestamptz not null default now(),
     updated_at timestamptz not null default now()
 );
@@ -42,20 +151,190 @@ create table if not exists device_diagnostics (
     software_version varchar(50) not null,
     firmware_version varchar(50) not null,
     checksum varchar(255),
+    verification_status verification_status not null default 'VERIFICATION_STATUS_UNSIGNED',
+    uptime_seconds bigint not null default 0,
+    load_average_1m double precision not null default 0,
+    load_average_5m double precision not null default 0,
+    load_average_15m double precision not null default 0,
+    temperature_celsius double precision not null default 0,
+    disk_used_bytes bigint not null default 0,
+    disk_total_bytes bigint not null default 0,
+    process_count integer not null default 0,
     timestamp timestamptz not null,
     created_at timestamptz not null default now()
 );
 
+create table if not exists device_interfaces (
+    id uuid primary key default gen_random_uuid(),
+    diagnostics_id uuid not null references device_diagnostics(id) on delete cascade,
+    device_id uuid not null references devices(id) on delete cascade,
+    name varchar(64) not null,
+    link_state link_state,
+    rx_bytes bigint not null,
+    tx_bytes bigint not null,
+    rx_packets bigint not null,
+    tx_packets bigint not null,
+    rx_errors bigint not null,
+    tx_errors bigint not null,
+    rx_bytes_rate double precision not null default 0,
+    tx_bytes_rate double precision not null default 0,
+    rx_packets_rate double precision not null default 0,
+    tx_packets_rate double precision not null default 0,
+    rx_errors_rate double precision not null default 0,
+    tx_errors_rate double precision not null default 0,
+    timestamp timestamptz not null
+);
+
+create table if not exists device_status_transitions (
+    id uuid primary key default gen_random_uuid(),
+    device_id varchar(255) not null references devices(device_id) on delete cascade,
+    from_status device_status not null,
+    to_status device_status not null,
+    cause transition_cause not null,
+    duration_ms bigint not null,
+    transitioned_at timestamptz not null
+);
+
+create table if not exists firmware_campaigns (
+    id uuid primary key default gen_random_uuid(),
+    monitor_id varchar(255) not null,
+    target_version varchar(50) not null,
+    selector jsonb not null default '{}',
+    wave_size integer not null,
+    max_unavailable integer not null,
+    wave_timeout_ms bigint not null,
+    failure_policy failure_policy not null,
+    status campaign_status not null,
+    current_wave integer not null default 0,
+    total_waves integer not null,
+    error text,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+    completed_at timestamptz
+);
+
+create table if not exists firmware_campaign_devices (
+    campaign_id uuid not null references firmware_campaigns(id) on delete cascade,
+    device_id varchar(255) not null references devices(device_id) on delete cascade,
+    wave integer not null,
+    status campaign_device_status not null,
+    previous_version varchar(50) not null,
+    error text,
+    updated_at timestamptz not null default now(),
+    primary key (campaign_id, device_id)
+);
+
+create table if not exists device_configs (
+    device_id varchar(255) primary key references devices(device_id) on delete cascade,
+    device_status device_status,
+    stream_interval_ms bigint,
+    labels jsonb not null default '{}',
+    config jsonb not null default '{}',
+    drift_policy drift_policy not null default 'DRIFT_POLICY_REAPPLY',
+    status config_status not null default 'CONFIG_STATUS_PENDING',
+    drift text[] not null default '{}',
+    error text,
+    reapply_count integer not null default 0,
+    applied_at timestamptz,
+    reconciled_at timestamptz,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+
+create table if not exists device_metric_baselines (
+    device_id varchar(255) not null references devices(device_id) on delete cascade,
+    metric anomaly_metric not null,
+    mean double precision not null,
+    variance double precision not null,
+    fast_mean double precision not null,
+    samples bigint not null,
+    spike_active boolean not null default false,
+    drift_active boolean not null default false,
+    observed_at timestamptz not null,
+    primary key (device_id, metric)
+);
+
+create table if not exists device_anomalies (
+    id uuid primary key default gen_random_uuid(),
+    device_id varchar(255) not null references devices(device_id) on delete cascade,
+    metric anomaly_metric not null,
+    kind anomaly_kind not null,
+    value double precision not null,
+    baseline double precision not null,
+    deviation double precision not null,
+    score double precision not null,
+    detected_at timestamptz not null,
+    recorded_at timestamptz not null default now()
+);
+
+create table if not exists device_anomaly_settings (
+    device_id varchar(255) primary key references devices(device_id) on delete cascade,
+    sensitivity anomaly_sensitivity not null default 'SENSITIVITY_MEDIUM',
+    updated_at timestamptz not null default now()
+);
+
+create table if not exists device_version_changes (
+    id uuid primary key default gen_random_uuid(),
+    device_id varchar(255) not null references devices(device_id) on delete cascade,
+    component version_component not null,
+    previous_version varchar(50) not null,
+    current_version varchar(50) not null,
+    changed_at timestamptz not null,
+    recorded_at timestamptz not null default now()
+);
+
+create table if not exists device_events (
+    id uuid primary key default gen_random_uuid(),
+    device_id varchar(255) not null references devices(device_id) on delete cascade,
+    type event_type not null,
+    severity event_severity not null,
+    message text not null,
+    details jsonb not null default '{}',
+    occurred_at timestamptz not null,
+    recorded_at timestamptz not null default now()
+);
+
+-- Devices are monitored by every monitor, side effects (reconciliation, worker events) are
+-- performed by the monitor holding the lease of the device
+create table if not exists device_leases (
+    device_id varchar(255) primary key references devices(device_id) on delete cascade,
+    monitor_id varchar(255) not null,
+    acquired_at timestamptz not null default now(),
+    expires_at timestamptz not null
+);
+
+create table if not exists compliance_policies (
+    name varchar(255) primary key,
+    labels jsonb not null default '{}',
+    component version_component not null,
+    min_version varchar(50),
+    allow text[] not null default '{}',
+    deny text[] not null default '{}',
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+
 -- Indexes for efficient queries
 create index if not exists idx_device_device_id on devices(device_id);
 create index if not exists idx_device_diagnostics_device_id on device_diagnostics(device_id);
 create index if not exists idx_device_diagnostics_timestamp on device_diagnostics(timestamp desc);
 create index if not exists idx_device_diagnostics_device_timestamp on device_diagnostics(device_id, timestamp desc);
+create index if not exists idx_device_interfaces_diagnostics_id on device_interfaces(diagnostics_id);
+create index if not exists idx_device_interfaces_device_name_timestamp on device_interfaces(device_id, name, timestamp desc);
+create index if not exists idx_device_status_transitions_device_time on device_status_transitions(device_id, transitioned_at desc);
+create index if not exists idx_device_anomalies_device_time on device_anomalies(device_id, detected_at desc);
+create index if not exists idx_device_anomalies_recorded_at on device_anomalies(recorded_at);
+create index if not exists idx_device_version_changes_device_time on device_version_changes(device_id, changed_at desc);
+create index if not exists idx_device_version_changes_recorded_at on device_version_changes(recorded_at);
+create index if not exists idx_device_events_device_time on device_events(device_id, occurred_at desc);
+create index if not exists idx_device_events_recorded_at on device_events(recorded_at);
+create index if not exists idx_firmware_campaigns_created_at on firmware_campaigns(created_at desc);
 
 -- Composite index for dashboard queries (latest state per device)
 create index if not exists idx_device_diagnostics_latest on device_diagnostics(device_id, timestamp desc, device_status);
 
--- View for latest device diagnostics snapshot (optimized for dashboard)
+-- View for latest device diagnostics snapshot (optimized for dashboard), the versions are those
+-- of the latest sample that reported versions (samples of offline devices carry none)
 create or replace view device_diagnostics_snapshot as
 select distinct on (d.device_id)
     d.id,
@@ -67,17 +346,36 @@ select distinct on (d.device_id)
     d.architecture,
     d.os,
     d.supported_protocols,
-    ds.hardware_version,
-    ds.software_version,
-    ds.firmware_version,
+    d.signing_algorithm,
+    coalesce(rv.hardware_version, ds.hardware_version) as hardware_version,
+    coalesce(rv.software_version, ds.software_version) as software_version,
+    coalesce(rv.firmware_version, ds.firmware_version) as firmware_version,
     ds.cpu_usage,
     ds.memory_usage,
     ds.device_status,
     ds.checksum,
+    ds.verification_status,
+    ds.uptime_seconds,
+    ds.load_average_1m,
+    ds.load_average_5m,
+    ds.load_average_15m,
+    ds.temperature_celsius,
+    ds.disk_used_bytes,
+    ds.disk_total_bytes,
+    ds.process_count,
+    ds.id as diagnostics_id,
     ds.timestamp as last_updated,
     d.created_at,
     d.updated_at
 from devices d
+left join lateral (
+    select hardware_version, software_version, firmware_version
+    from device_diagnostics
+    where device_id = d.id
+        and (hardware_version <> '' or software_version <> '' or firmware_version <> '')
+    order by timestamp desc
+    limit 1
+) rv on true
 left join device_diagnostics ds on d.id = ds.device_id
 order by d.device_id, ds.timestamp desc nulls last;
 
@@ -118,6 +416,24 @@ create trigger trigger_notify_device_change
     execute function notify_device_change();
 
 -- Default device state (demo)
-insert into devices (device_id, alias, host, port, port_gateway, architecture, os, supported_protocols) values
-    ('ubiquiti-device-router-3c2d', 'Dream Machine Pro Max', 'ubiquiti-device-router', 8080, 8081, 'arm64', 'linux', array['PROTOCOL_GRPC'::device_protocol]),
-    ('ubiquiti-device-switch-b87f', 'Pro Max 24 PoE', 'ubiquiti-device-switch', 8080, 8081, 'amd64', 'linux', array['PROTOCOL_GRPC_STREAM'::device_protocol]);
+insert into devices (device_id, alias, host, port, port_gateway, architecture, os, supported_protocols, signing_algorithm, signing_key, driver) values
+    ('ubiquiti-device-router-3c2d', 'Dream Machine Pro Max', 'ubiquiti-device-router', 8080, 8081, 'arm64', 'linux', array['PROTOCOL_GRPC'::device_protocol], 'SIGNING_ALGORITHM_ED25519', 'sbR3PfKM6MGun+1tH2XfUrk78P53AuYRY0weH+zqwLk=', 'grpc'),
+    ('ubiquiti-device-switch-b87f', 'Pro Max 24 PoE', 'ubiquiti-device-switch', 8080, 8081, 'amd64', 'linux', array['PROTOCOL_GRPC_STREAM'::device_protocol], null, null, 'grpc-stream');
+
+-- Diagnostics history of the switch (demo): memory rising 4% per hour over the hours before
+-- the live samples, reported on the previous firmware
+insert into device_diagnostics (
+    device_id, cpu_usage, memory_usage, device_status,
+    hardware_version, software_version, firmware_version, timestamp
+)
+select
+    d.id,
+    35,
+    60 + 4 * extract(epoch from ts - (now() - interval '8 hours')) / 3600,
+    'DEVICE_STATUS_HEALTHY',
+    'HW:2.9.3',
+    'SW:ubuntu:22.04:amd64',
+    'FW:5.10.2.11230',
+    ts
+from devices d, generate_series(now() - interval '8 hours', now() - interval '1 hour', interval '15 minutes') as ts
+where d.device_id = 'ubiquiti-device-switch-b87f';
@@ -1,12 +1,24 @@
 package test
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
 	"github.com/emil-j-olsson/ubiquiti/test/fixtures"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
@@ -98,20 +110,25 @@ func TestMonitor_ListDevices(t *testing.T) {
 
 func TestMonitor_GetDiagnostics(t *testing.T) {
 	tests := []struct {
-		name    string
-		service fixtures.Service
-		device  fixtures.Service
-		err     bool
+		name         string
+		service      fixtures.Service
+		device       fixtures.Service
+		verification monitorv1.VerificationStatus
+		host         bool
+		err          bool
 	}{
 		{
-			name:    "should retrieve diagnostics from available monitor service (arm64)",
-			service: fixtures.ServiceBackendMonitorArm,
-			device:  fixtures.ServiceDeviceRouter,
+			name:         "should retrieve diagnostics from available monitor service (arm64)",
+			service:      fixtures.ServiceBackendMonitorArm,
+			device:       fixtures.ServiceDeviceRouter,
+			verification: monitorv1.VerificationStatus_VERIFICATION_STATUS_AUTHENTIC,
+			host:         true,
 		},
 		{
-			name:    "should retrieve diagnostics from available monitor service (amd64)",
-			service: fixtures.ServiceBackendMonitorAmd,
-			device:  fixtures.ServiceDeviceSwitch,
+			name:         "should retrieve diagnostics from available monitor service (amd64)",
+			service:      fixtures.ServiceBackendMonitorAmd,
+			device:       fixtures.ServiceDeviceSwitch,
+			verification: monitorv1.VerificationStatus_VERIFICATION_STATUS_UNSIGNED,
 		},
 		{
 			name:    "should return error due to invalid device",
@@ -132,6 +149,11 @@ func TestMonitor_GetDiagnostics(t *testing.T) {
 			assert.NoError(t, err)
 			assertValidDevice(t, device, res.GetDevice())
 			assertValidMonitorDiagnostics(t, res.GetDiagnostics())
+			assert.Equal(t, tt.verification, res.GetDiagnostics().GetVerificationStatus())
+			if tt.host {
+				assert.NotZero(t, res.GetDiagnostics().GetProcessCount())
+				assert.NotZero(t, res.GetDiagnostics().GetDiskTotalBytes())
+			}
 			assert.NotNil(t, res.UpdatedAt)
 		})
 	}
@@ -179,6 +201,132 @@ func TestMonitor_StreamDiagnostics(t *testing.T) {
 			assert.NotNil(t, res.UpdatedAt)
 		})
 	}
+	t.Run("should stream diagnostics as server-sent events (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+
+		res, err := monitor.SubscribeDiagnostics(device, "")
+		assert.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+		fields := readEvent(t, bufio.NewReader(res.Body))
+		assert.Equal(t, "diagnostics", fields["event"])
+		assert.NotEmpty(t, fields["id"])
+		var diagnostics monitorv1.DiagnosticsResponse
+		assert.NoError(t, protojson.Unmarshal([]byte(fields["data"]), &diagnostics))
+		assertValidDevice(t, device, diagnostics.GetDevice())
+		assert.Equal(
+			t,
+			fields["id"],
+			diagnostics.GetDiagnostics().GetTimestamp().AsTime().Format(time.RFC3339Nano),
+		)
+
+		// Resuming replays the samples stored after the last event oldest first, before the live
+		// samples
+		last := time.Now().Add(-time.Minute).UTC()
+		subscribed := time.Now()
+		resumed, err := monitor.SubscribeDiagnostics(device, last.Format(time.RFC3339Nano))
+		require.NoError(t, err)
+		defer resumed.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusOK, resumed.StatusCode)
+		reader := bufio.NewReader(resumed.Body)
+		previous := last
+		for i := range 5 {
+			fields = readEvent(t, reader)
+			id, err := time.Parse(time.RFC3339Nano, fields["id"])
+			require.NoError(t, err)
+			assert.True(t, id.After(previous), "event %d at %s after %s", i, id, previous)
+			if i == 0 {
+				assert.True(t, id.Before(subscribed), "event %d at %s replayed", i, id)
+			}
+			previous = id
+		}
+	})
+	t.Run("should return error due to invalid last event id (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		res, err := env.Monitor(fixtures.ServiceBackendMonitorArm).
+			SubscribeDiagnostics(fixtures.Services[fixtures.ServiceDeviceRouter], "yesterday")
+		assert.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+// readEvent reads the fields of the next event of a Server-Sent Events stream, skipping comments
+func readEvent(t *testing.T, reader *bufio.Reader) map[string]string {
+	fields := map[string]string{}
+	for {
+		line, err := reader.ReadString('\n')
+		if !assert.NoError(t, err) {
+			return fields
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" && len(fields) > 0 {
+			return fields
+		}
+		if field, value, ok := strings.Cut(line, ": "); ok && field != "" {
+			fields[field] = value
+		}
+	}
+}
+
+func TestMonitor_ListDiagnostics(t *testing.T) {
+	tests := []struct {
+		name    string
+		service fixtures.Service
+		device  fixtures.Service
+		limit   int32
+		err     bool
+	}{
+		{
+			name:    "should list diagnostics history with interface rates (arm64)",
+			service: fixtures.ServiceBackendMonitorArm,
+			device:  fixtures.ServiceDeviceRouter,
+			limit:   5,
+		},
+		{
+			name:    "should return error due to invalid limit",
+			service: fixtures.ServiceBackendMonitorArm,
+			device:  fixtures.ServiceDeviceRouter,
+			limit:   monitorv1.MaxDiagnosticsLimit + 1,
+			err:     true,
+		},
+		{
+			name:    "should return error due to invalid device",
+			service: fixtures.ServiceInvalid,
+			err:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fixtures.NewEnvironment(t)
+			defer env.Close()
+			device := fixtures.Services[tt.device]
+			res, err := env.Monitor(tt.service).ListDiagnostics(device, tt.limit)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEmpty(t, res.Diagnostics)
+			assert.LessOrEqual(t, len(res.Diagnostics), int(tt.limit))
+			for i, diag := range res.Diagnostics {
+				assertValidMonitorDiagnostics(t, diag)
+				assert.NotEmpty(t, diag.Interfaces)
+				if i > 0 {
+					assert.False(t, diag.Timestamp.AsTime().After(res.Diagnostics[i-1].Timestamp.AsTime()))
+				}
+				for _, iface := range diag.Interfaces {
+					assert.GreaterOrEqual(t, iface.RxBytesPerSecond, 0.0)
+					assert.GreaterOrEqual(t, iface.TxBytesPerSecond, 0.0)
+				}
+			}
+		})
+	}
 }
 
 func TestMonitor_UpdateDevice(t *testing.T) {
@@ -220,6 +368,750 @@ func TestMonitor_UpdateDevice(t *testing.T) {
 	})
 }
 
+func TestMonitor_ListStatusTransitions(t *testing.T) {
+	t.Run("should record operator transitions (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		service := fixtures.ServiceBackendMonitorArm
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+
+		// Healthy -> Degraded -> Healthy
+		statuses := []monitorv1.DeviceStatus{
+			monitorv1.DeviceStatus_DEVICE_STATUS_DEGRADED,
+			monitorv1.DeviceStatus_DEVICE_STATUS_HEALTHY,
+		}
+		for _, status := range statuses {
+			_, err := env.Monitor(service).UpdateDevice(device, status)
+			assert.NoError(t, err)
+			awaitDeviceStatus(t, env.Monitor(service), device, status)
+		}
+		res, err := env.Monitor(service).ListStatusTransitions(device, 2)
+		assert.NoError(t, err)
+		assert.Len(t, res.Transitions, 2)
+		for i, status := range []monitorv1.DeviceStatus{
+			monitorv1.DeviceStatus_DEVICE_STATUS_HEALTHY,
+			monitorv1.DeviceStatus_DEVICE_STATUS_DEGRADED,
+		} {
+			assert.Equal(t, status, res.Transitions[i].ToStatus)
+			assert.Equal(t, monitorv1.TransitionCause_TRANSITION_CAUSE_OPERATOR, res.Transitions[i].Cause)
+		}
+		assert.Equal(t, monitorv1.DeviceStatus_DEVICE_STATUS_DEGRADED, res.Transitions[0].FromStatus)
+	})
+	t.Run("should return error due to invalid transition (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		service := fixtures.ServiceBackendMonitorArm
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+
+		// Healthy -> Booting -> Maintenance (rejected) -> Healthy
+		_, err := env.Monitor(service).UpdateDevice(device, monitorv1.DeviceStatus_DEVICE_STATUS_BOOTING)
+		assert.NoError(t, err)
+		awaitDeviceStatus(t, env.Monitor(service), device, monitorv1.DeviceStatus_DEVICE_STATUS_BOOTING)
+		_, err = env.Monitor(service).UpdateDevice(device, monitorv1.DeviceStatus_DEVICE_STATUS_MAINTENANCE)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = env.Monitor(service).UpdateDevice(device, monitorv1.DeviceStatus_DEVICE_STATUS_HEALTHY)
+		assert.NoError(t, err)
+	})
+	t.Run("should return error due to unknown device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListStatusTransitions(
+			fixtures.Services[fixtures.ServiceInvalid],
+			10,
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestMonitor_ListDeviceEvents(t *testing.T) {
+	t.Run("should list events of device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+
+		res, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListDeviceEvents(
+			device,
+			monitorv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Events)
+		for i, event := range res.Events {
+			assert.Equal(t, device.Identifier, event.DeviceId)
+			assert.NotEqual(t, monitorv1.EventType_EVENT_TYPE_UNSPECIFIED, event.Type)
+			assert.NotEmpty(t, event.Message)
+			if i > 0 {
+				assert.False(t, event.OccurredAt.AsTime().After(res.Events[i-1].OccurredAt.AsTime()))
+			}
+		}
+	})
+	t.Run("should filter events by type and severity (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+
+		res, err := monitor.ListDeviceEvents(
+			device,
+			monitorv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED,
+			monitorv1.EventType_EVENT_TYPE_WORKER_STARTED,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Events)
+		for i, event := range res.Events {
+			assert.Equal(t, monitorv1.EventType_EVENT_TYPE_WORKER_STARTED, event.Type)
+			assert.NotEmpty(t, event.Details["protocol"])
+			// Both monitors start a worker, only the monitor holding the lease records it
+			if i > 0 {
+				assert.Greater(
+					t,
+					res.Events[i-1].OccurredAt.AsTime().Sub(event.OccurredAt.AsTime()),
+					time.Second,
+				)
+			}
+		}
+
+		res, err = monitor.ListDeviceEvents(device, monitorv1.EventSeverity_EVENT_SEVERITY_WARNING)
+		assert.NoError(t, err)
+		for _, event := range res.Events {
+			assert.NotEqual(t, monitorv1.EventSeverity_EVENT_SEVERITY_INFO, event.Severity)
+		}
+	})
+	t.Run("should return error due to unknown device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListDeviceEvents(
+			fixtures.Services[fixtures.ServiceInvalid],
+			monitorv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED,
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("should return error due to invalid type (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListDeviceEvents(
+			fixtures.Services[fixtures.ServiceDeviceRouter],
+			monitorv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED,
+			monitorv1.EventType_EVENT_TYPE_UNSPECIFIED,
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_ListAnomalies(t *testing.T) {
+	t.Run("should list cpu spike of device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+		defer env.Device(fixtures.ServiceDeviceRouter).UpdateSimulation(
+			&devicev1.UpdateSimulationRequest{Enabled: false},
+		) // nolint:errcheck
+		defer monitor.SetAnomalySensitivity(
+			device,
+			monitorv1.Sensitivity_SENSITIVITY_MEDIUM,
+		) // nolint:errcheck
+
+		// The cpu usage jumps from the runtime level of the device to a constant 100%
+		_, err := monitor.SetAnomalySensitivity(device, monitorv1.Sensitivity_SENSITIVITY_HIGH)
+		require.NoError(t, err)
+		started := time.Now()
+		_, err = env.Device(fixtures.ServiceDeviceRouter).UpdateSimulation(&devicev1.UpdateSimulationRequest{
+			Enabled: true,
+			Cpu:     &devicev1.SimulationProfile{Kind: devicev1.ProfileKind_PROFILE_KIND_CONSTANT, Base: 100},
+			Memory:  &devicev1.SimulationProfile{Kind: devicev1.ProfileKind_PROFILE_KIND_CONSTANT, Base: 50},
+		})
+		require.NoError(t, err)
+
+		var spike *monitorv1.Anomaly
+		assert.Eventually(t, func() bool {
+			res, err := monitor.ListAnomalies(device, 10)
+			if err != nil {
+				return false
+			}
+			assert.LessOrEqual(t, len(res.GetAnomalies()), 10)
+			for _, anomaly := range res.GetAnomalies() {
+				if anomaly.GetMetric() == monitorv1.Metric_METRIC_CPU &&
+					anomaly.GetKind() == monitorv1.AnomalyKind_ANOMALY_KIND_SPIKE &&
+					anomaly.GetDetectedAt().AsTime().After(started) {
+					spike = anomaly
+					return true
+				}
+			}
+			return false
+		}, 3*DefaultTickerTimeout, DefaultTickerInterval)
+		require.NotNil(t, spike)
+		assert.Equal(t, device.Identifier, spike.GetDeviceId())
+		assert.Equal(t, 100.0, spike.GetValue())
+		assert.Less(t, spike.GetBaseline(), spike.GetValue())
+		assert.InDelta(t, spike.GetValue()-spike.GetBaseline(), spike.GetDeviation(), 1e-9)
+		assert.GreaterOrEqual(t, spike.GetScore(), 3.0)
+	})
+	t.Run("should return error due to unknown device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListAnomalies(
+			fixtures.Services[fixtures.ServiceInvalid],
+			10,
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestMonitor_ListVersionChanges(t *testing.T) {
+	t.Run("should list firmware changes of device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := fixtures.Services[fixtures.ServiceDeviceSwitch]
+
+		res, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListVersionChanges(
+			device,
+			monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE,
+			10,
+		)
+		assert.NoError(t, err)
+		require.NotEmpty(t, res.GetChanges())
+		assert.LessOrEqual(t, len(res.GetChanges()), 10)
+		for _, change := range res.GetChanges() {
+			assert.Equal(t, device.Identifier, change.DeviceId)
+			assert.Equal(t, monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE, change.Component)
+			assert.NotEqual(t, change.PreviousVersion, change.CurrentVersion)
+			assert.NotNil(t, change.ChangedAt)
+		}
+		// The history of the switch is seeded on the previous firmware (see state.sql)
+		assert.True(t, slices.ContainsFunc(res.GetChanges(), func(change *monitorv1.VersionChange) bool {
+			return change.PreviousVersion == "FW:5.10.2.11230" && change.CurrentVersion == "FW:5.11.0.11599"
+		}))
+	})
+	t.Run("should stream firmware changes of device (amd64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorAmd)
+		device := fixtures.Services[fixtures.ServiceDeviceSwitch]
+		firmware, err := monitor.StreamVersionChanges(
+			device,
+			monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE,
+		)
+		require.NoError(t, err)
+		hardware, err := monitor.StreamVersionChanges(
+			device,
+			monitorv1.VersionComponent_VERSION_COMPONENT_HARDWARE,
+		)
+		require.NoError(t, err)
+		unfiltered := make(chan *monitorv1.VersionChange, 1)
+		go func() {
+			if change, err := hardware.Recv(); err == nil {
+				unfiltered <- change
+			}
+		}()
+
+		// Original -> Target -> Original
+		diag, err := env.Device(fixtures.ServiceDeviceSwitch).GetDiagnostics()
+		require.NoError(t, err)
+		original := diag.FirmwareVersion
+		for _, version := range []string{original + "-stream", original} {
+			_, err := env.Device(fixtures.ServiceDeviceSwitch).UpgradeFirmware(version)
+			require.NoError(t, err)
+			awaitFirmwareUpgrade(t, env.Device(fixtures.ServiceDeviceSwitch))
+			change, err := firmware.Recv()
+			require.NoError(t, err)
+			assert.Equal(t, device.Identifier, change.DeviceId)
+			assert.Equal(t, monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE, change.Component)
+			assert.Equal(t, version, change.CurrentVersion)
+		}
+		select {
+		case change := <-unfiltered:
+			t.Errorf("expected no hardware changes, got %v", change)
+		case <-time.After(DefaultTickerInterval):
+		}
+	})
+	t.Run("should return error due to unknown device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListVersionChanges(
+			fixtures.Services[fixtures.ServiceInvalid],
+			monitorv1.VersionComponent_VERSION_COMPONENT_UNSPECIFIED,
+			10,
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("should return error due to invalid component (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).ListVersionChanges(
+			fixtures.Services[fixtures.ServiceDeviceSwitch],
+			monitorv1.VersionComponent(42),
+			10,
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_SetAnomalySensitivity(t *testing.T) {
+	t.Run("should set anomaly sensitivity of device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceSwitch]
+		defer monitor.SetAnomalySensitivity(
+			device,
+			monitorv1.Sensitivity_SENSITIVITY_MEDIUM,
+		) // nolint:errcheck
+
+		res, err := monitor.SetAnomalySensitivity(device, monitorv1.Sensitivity_SENSITIVITY_HIGH)
+		assert.NoError(t, err)
+		assert.Equal(t, device.Identifier, res.Settings.DeviceId)
+		assert.Equal(t, monitorv1.Sensitivity_SENSITIVITY_HIGH, res.Settings.Sensitivity)
+
+		settings, err := monitor.GetAnomalySettings(device)
+		assert.NoError(t, err)
+		assert.Equal(t, monitorv1.Sensitivity_SENSITIVITY_HIGH, settings.Settings.Sensitivity)
+		assert.Positive(t, settings.Settings.Threshold)
+		assert.Equal(t, res.Settings.Threshold, settings.Settings.Threshold)
+	})
+	t.Run("should return error due to unknown device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).SetAnomalySensitivity(
+			fixtures.Services[fixtures.ServiceInvalid],
+			monitorv1.Sensitivity_SENSITIVITY_LOW,
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("should return error due to invalid sensitivity (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).SetAnomalySensitivity(
+			fixtures.Services[fixtures.ServiceDeviceSwitch],
+			monitorv1.Sensitivity_SENSITIVITY_UNSPECIFIED,
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_GetVersionInventory(t *testing.T) {
+	t.Run("should aggregate versions by architecture (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+
+		res, err := env.Monitor(fixtures.ServiceBackendMonitorArm).GetVersionInventory(
+			monitorv1.VersionGrouping_VERSION_GROUPING_ARCHITECTURE,
+			monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE,
+			"",
+		)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Devices)
+		var devices int32
+		architectures := make(map[string][]string)
+		for _, group := range res.Groups {
+			devices += group.Devices
+			var counted int32
+			for _, count := range group.Versions {
+				assert.Equal(t, monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE, count.Component)
+				assert.Len(t, count.DeviceIds, int(count.Devices))
+				counted += count.Devices
+				architectures[group.Value] = append(architectures[group.Value], count.DeviceIds...)
+			}
+			assert.Equal(t, group.Devices, counted)
+		}
+		assert.Len(t, res.Devices, int(devices))
+		for _, service := range []fixtures.Service{fixtures.ServiceDeviceRouter, fixtures.ServiceDeviceSwitch} {
+			device := fixtures.Services[service]
+			assert.Contains(t, architectures[device.Architecture], device.Identifier)
+		}
+		assert.NotContains(
+			t,
+			architectures[fixtures.Services[fixtures.ServiceDeviceRouter].Architecture],
+			fixtures.Services[fixtures.ServiceDeviceSwitch].Identifier,
+		)
+	})
+	t.Run("should return devices below firmware version (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+
+		res, err := monitor.GetVersionInventory(
+			monitorv1.VersionGrouping_VERSION_GROUPING_UNSPECIFIED,
+			monitorv1.VersionComponent_VERSION_COMPONENT_UNSPECIFIED,
+			"FW:999",
+		)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Devices)
+
+		res, err = monitor.GetVersionInventory(
+			monitorv1.VersionGrouping_VERSION_GROUPING_UNSPECIFIED,
+			monitorv1.VersionComponent_VERSION_COMPONENT_UNSPECIFIED,
+			"0",
+		)
+		assert.NoError(t, err)
+		assert.Empty(t, res.Devices)
+		assert.Empty(t, res.Groups)
+	})
+	t.Run("should return error due to invalid grouping (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).GetVersionInventory(
+			monitorv1.VersionGrouping(42),
+			monitorv1.VersionComponent_VERSION_COMPONENT_UNSPECIFIED,
+			"",
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_SetCompliancePolicy(t *testing.T) {
+	t.Run("should mark devices below minimum version non-compliant (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		defer monitor.DeleteCompliancePolicy("test-minimum-firmware") // nolint:errcheck
+
+		res, err := monitor.SetCompliancePolicy(&monitorv1.CompliancePolicy{
+			Name:       "test-minimum-firmware",
+			Component:  monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE,
+			MinVersion: "FW:999",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "test-minimum-firmware", res.Policy.Name)
+		assert.NotNil(t, res.Policy.CreatedAt)
+
+		policies, err := monitor.ListCompliancePolicies()
+		assert.NoError(t, err)
+		assert.True(t, slices.ContainsFunc(policies.Policies, func(p *monitorv1.CompliancePolicy) bool {
+			return p.Name == "test-minimum-firmware"
+		}))
+
+		devices, err := monitor.ListDevices()
+		assert.NoError(t, err)
+		for _, device := range devices.Devices {
+			if device.ComplianceStatus != monitorv1.ComplianceStatus_COMPLIANCE_STATUS_NON_COMPLIANT {
+				continue
+			}
+			assert.NotEmpty(t, device.ComplianceViolations)
+			assert.Contains(t, device.ComplianceViolations[0], "test-minimum-firmware")
+		}
+		assert.True(t, slices.ContainsFunc(devices.Devices, func(d *monitorv1.Device) bool {
+			return d.ComplianceStatus == monitorv1.ComplianceStatus_COMPLIANCE_STATUS_NON_COMPLIANT
+		}))
+
+		_, err = monitor.DeleteCompliancePolicy("test-minimum-firmware")
+		assert.NoError(t, err)
+		_, err = monitor.DeleteCompliancePolicy("test-minimum-firmware")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("should return error due to missing constraints (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).SetCompliancePolicy(
+			&monitorv1.CompliancePolicy{
+				Name:      "test-empty",
+				Component: monitorv1.VersionComponent_VERSION_COMPONENT_FIRMWARE,
+			},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_GetForecast(t *testing.T) {
+	t.Run("should forecast top at-risk devices (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := fixtures.Services[fixtures.ServiceDeviceSwitch]
+
+		// The memory of the switch is seeded rising 4% per hour (see state.sql)
+		res, err := env.Monitor(fixtures.ServiceBackendMonitorArm).
+			GetForecast(monitorv1.Metric_METRIC_MEMORY, 2, 0)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.GetForecasts())
+		assert.LessOrEqual(t, len(res.GetForecasts()), 2)
+		var switchForecast *monitorv1.Forecast
+		for i, forecast := range res.GetForecasts() {
+			assert.Equal(t, monitorv1.Metric_METRIC_MEMORY, forecast.Metric)
+			assert.Equal(t, monitorv1.ForecastModel_FORECAST_MODEL_LINEAR, forecast.Model)
+			assert.Equal(t, float64(95), forecast.Threshold)
+			assert.NotEmpty(t, forecast.Points)
+			for _, point := range forecast.Points {
+				assert.LessOrEqual(t, point.Lower, point.Value)
+				assert.GreaterOrEqual(t, point.Upper, point.Value)
+			}
+			// Devices reaching the threshold are ranked first, soonest first
+			if i > 0 && forecast.GetThresholdAt() != nil {
+				previous := res.GetForecasts()[i-1].GetThresholdAt()
+				require.NotNil(t, previous)
+				assert.False(t, forecast.GetThresholdAt().AsTime().Before(previous.AsTime()))
+			}
+			if forecast.GetDeviceId() == device.Identifier {
+				switchForecast = forecast
+			}
+		}
+		require.NotNil(t, switchForecast, "expected switch among at-risk devices")
+		assert.Positive(t, switchForecast.GetTrendPerDay())
+		assert.NotNil(t, switchForecast.GetThresholdAt())
+		// 29 samples are seeded over the 8 hours before the live samples
+		assert.GreaterOrEqual(t, switchForecast.GetSamples(), int32(29))
+	})
+	t.Run("should return error due to insufficient history (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+
+		// An hour of history spans at most two hourly aggregates
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).GetForecast(
+			monitorv1.Metric_METRIC_MEMORY,
+			0,
+			time.Hour,
+			fixtures.Services[fixtures.ServiceDeviceRouter],
+		)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("should return error due to unknown device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).GetForecast(
+			monitorv1.Metric_METRIC_MEMORY,
+			0,
+			0,
+			fixtures.Services[fixtures.ServiceInvalid],
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("should return error due to unsupported metric (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).GetForecast(
+			monitorv1.Metric_METRIC_PROCESSES,
+			0,
+			0,
+			fixtures.Services[fixtures.ServiceDeviceRouter],
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_ExportDiagnostics(t *testing.T) {
+	t.Run("should export diagnostics in all formats (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+
+		data, err := monitor.ExportDiagnostics(monitorv1.ExportFormat_EXPORT_FORMAT_CSV, device)
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Greater(t, len(lines), 1)
+		assert.True(t, strings.HasPrefix(lines[0], "device_id,timestamp,device_status"))
+		assert.True(t, strings.HasPrefix(lines[1], device.Identifier+","))
+
+		data, err = monitor.ExportDiagnostics(monitorv1.ExportFormat_EXPORT_FORMAT_NDJSON, device)
+		assert.NoError(t, err)
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			var record map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(line), &record))
+			assert.Equal(t, device.Identifier, record["device_id"])
+		}
+
+		data, err = monitor.ExportDiagnostics(monitorv1.ExportFormat_EXPORT_FORMAT_PARQUET, device)
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data, []byte("PAR1")))
+		assert.True(t, bytes.HasSuffix(data, []byte("PAR1")))
+	})
+	t.Run("should download diagnostics through the gateway (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		res, err := env.Monitor(fixtures.ServiceBackendMonitorArm).DownloadDiagnostics(url.Values{
+			"device_ids": {fixtures.Services[fixtures.ServiceDeviceSwitch].Identifier},
+			"from":       {time.Now().Add(-time.Minute).Format(time.RFC3339)},
+			"format":     {"ndjson"},
+		})
+		assert.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+		assert.Contains(t, res.Header.Get("Content-Disposition"), ".ndjson")
+	})
+	t.Run("should return error due to invalid format (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		res, err := env.Monitor(fixtures.ServiceBackendMonitorArm).DownloadDiagnostics(url.Values{
+			"format": {"xlsx"},
+		})
+		assert.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func TestMonitor_GetAvailabilityReport(t *testing.T) {
+	t.Run("should report availability per device and fleet (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		service := fixtures.ServiceBackendMonitorArm
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+		defer env.Monitor(service).DeleteDeviceConfig(device) // nolint:errcheck
+		_, err := env.Monitor(service).SetDeviceConfig(device, &monitorv1.DesiredConfig{
+			Labels:      map[string]string{"site": "availability"},
+			DriftPolicy: monitorv1.DriftPolicy_DRIFT_POLICY_FLAG,
+		})
+		require.NoError(t, err)
+
+		// Healthy -> Error (~2s) -> Healthy (~2s), operator transitions are recorded when the
+		// update is requested, so the error period is bounded by the request times
+		update := func(status monitorv1.DeviceStatus) (time.Time, time.Time) {
+			started := time.Now()
+			_, err := env.Monitor(service).UpdateDevice(device, status)
+			require.NoError(t, err)
+			return started, time.Now()
+		}
+		from := time.Now()
+		time.Sleep(time.Second)
+		errorStarted, errorRequested := update(monitorv1.DeviceStatus_DEVICE_STATUS_ERROR)
+		time.Sleep(2 * time.Second)
+		healthyStarted, healthyRequested := update(monitorv1.DeviceStatus_DEVICE_STATUS_HEALTHY)
+		time.Sleep(time.Second)
+		to := time.Now()
+
+		res, err := env.Monitor(service).GetAvailabilityReport(from, to, "site", device)
+		require.NoError(t, err)
+		require.Len(t, res.Devices, 1)
+		assert.Equal(t, device.Identifier, res.Devices[0].DeviceId)
+		assert.Equal(t, map[string]string{"site": "availability"}, res.Devices[0].Labels)
+
+		period := to.Sub(from)
+		minDowntime, maxDowntime := healthyStarted.Sub(errorRequested), healthyRequested.Sub(errorStarted)
+		result := res.Devices[0].Availability
+		assert.InDelta(t, period.Seconds(), result.Period.AsDuration().Seconds(), 0.01)
+		assert.GreaterOrEqual(t, result.Availability, 100*(1-maxDowntime.Seconds()/period.Seconds())-0.01)
+		assert.LessOrEqual(t, result.Availability, 100*(1-minDowntime.Seconds()/period.Seconds())+0.01)
+		assert.Equal(t, int32(1), result.Failures)
+		var total float64
+		for _, status := range result.Statuses {
+			total += status.Percent
+			if status.Status == monitorv1.DeviceStatus_DEVICE_STATUS_ERROR {
+				assert.GreaterOrEqual(t, status.Duration.AsDuration(), minDowntime)
+				assert.LessOrEqual(t, status.Duration.AsDuration(), maxDowntime)
+			}
+		}
+		assert.InDelta(t, 100, total, 0.01)
+		assert.InDelta(t, result.Availability, res.Fleet.Availability, 0.01)
+
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, "availability", res.Groups[0].Value)
+		assert.Equal(t, []string{device.Identifier}, res.Groups[0].DeviceIds)
+		assert.InDelta(t, result.Availability, res.Groups[0].Availability.Availability, 0.01)
+	})
+	t.Run("should return error due to invalid time range (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).GetAvailabilityReport(
+			time.Now().Add(time.Hour),
+			time.Time{},
+			"",
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_RebootDevice(t *testing.T) {
+	t.Run("should reboot device and await healthy status (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		service := fixtures.ServiceBackendMonitorArm
+		device := fixtures.Services[fixtures.ServiceDeviceSwitch]
+
+		res, err := env.Monitor(service).RebootDevice(device, 2*time.Second)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, res.Downtime.AsDuration(), 2*time.Second)
+		assert.Equal(t, monitorv1.DeviceStatus_DEVICE_STATUS_HEALTHY, res.Diagnostics.DeviceStatus)
+	})
+	t.Run("should return error due to unknown device (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).RebootDevice(
+			fixtures.ServiceConfig{Identifier: "unknown-device"},
+			time.Second,
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestMonitor_SetDeviceConfig(t *testing.T) {
+	t.Run("should apply and reconcile desired config (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		service := fixtures.ServiceBackendMonitorArm
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+		defer env.Monitor(service).DeleteDeviceConfig(device) // nolint:errcheck
+
+		res, err := env.Monitor(service).SetDeviceConfig(device, &monitorv1.DesiredConfig{
+			Labels:      map[string]string{"site": "lab"},
+			DriftPolicy: monitorv1.DriftPolicy_DRIFT_POLICY_FLAG,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, monitorv1.ConfigStatus_CONFIG_STATUS_IN_SYNC, res.Config.Status)
+		assert.NotNil(t, res.Config.AppliedAt)
+
+		config, err := env.Monitor(service).GetDeviceConfig(device)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"site": "lab"}, config.Config.Desired.Labels)
+		assert.Equal(t, monitorv1.DriftPolicy_DRIFT_POLICY_FLAG, config.Config.Desired.DriftPolicy)
+
+		_, err = env.Monitor(service).DeleteDeviceConfig(device)
+		assert.NoError(t, err)
+		_, err = env.Monitor(service).GetDeviceConfig(device)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("should return error due to missing config", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).SetDeviceConfig(device, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_CreateCampaign(t *testing.T) {
+	t.Run("should complete campaign without devices to upgrade (arm64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		service := fixtures.ServiceBackendMonitorArm
+		device := fixtures.Services[fixtures.ServiceDeviceRouter]
+		diag, err := env.Monitor(service).GetDiagnostics(device)
+		assert.NoError(t, err)
+
+		// Device already runs the target version
+		res, err := env.Monitor(service).CreateCampaign(&monitorv1.CreateCampaignRequest{
+			TargetVersion: diag.Diagnostics.FirmwareVersion,
+			Selector:      &monitorv1.DeviceSelector{DeviceIds: []string{device.Identifier}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, monitorv1.CampaignStatus_CAMPAIGN_STATUS_COMPLETED, res.Campaign.Status)
+		assert.Empty(t, res.Campaign.Devices)
+		assert.Equal(t, monitorv1.FailurePolicy_FAILURE_POLICY_HALT, res.Campaign.FailurePolicy)
+
+		campaign, err := env.Monitor(service).GetCampaign(res.Campaign.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, res.Campaign.Id, campaign.Campaign.Id)
+		assert.NotNil(t, campaign.Campaign.CompletedAt)
+
+		_, err = env.Monitor(service).CancelCampaign(res.Campaign.Id)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("should return error due to missing target version", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).CreateCampaign(
+			&monitorv1.CreateCampaignRequest{},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should return error due to unknown campaign", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Monitor(fixtures.ServiceBackendMonitorArm).GetCampaign(
+			"00000000-0000-0000-0000-000000000000",
+		)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
 func TestMonitor_RegisterDevice(t *testing.T) {
 	t.Run("should register device via available monitor service (amd64)", func(t *testing.T) {
 		env := fixtures.NewEnvironment(t)
@@ -242,6 +1134,266 @@ func TestMonitor_RegisterDevice(t *testing.T) {
 		}
 		t.Error("registered device not found in device list")
 	})
+	t.Run("should collect diagnostics published to broker by registered device (mqtt)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		service := fixtures.ServiceBackendMonitorAmd
+		device := fixtures.Services[fixtures.ServiceDeviceSensor]
+
+		_, err := env.Monitor(service).RegisterDevice(device)
+		require.NoError(t, err)
+		defer env.Monitor(service).DeleteDevice(device) // nolint:errcheck
+		assert.Eventually(t, func() bool {
+			res, err := env.Monitor(service).GetDiagnostics(device)
+			return err == nil && res.GetDevice().GetDeviceId() == device.Identifier &&
+				res.GetDiagnostics() != nil
+		}, DefaultTickerTimeout, DefaultTickerInterval)
+		events, err := env.Monitor(service).ListDeviceEvents(
+			device,
+			monitorv1.EventSeverity_EVENT_SEVERITY_UNSPECIFIED,
+			monitorv1.EventType_EVENT_TYPE_WORKER_STARTED,
+		)
+		assert.NoError(t, err)
+		require.NotEmpty(t, events.GetEvents())
+		assert.Equal(t, "PROTOCOL_MQTT", events.GetEvents()[0].GetDetails()["protocol"])
+	})
+	t.Run("should poll diagnostics of registered device via agent (snmp)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceAgent]
+		credentials := []*monitorv1.SnmpCredentials{
+			{
+				Version:   monitorv1.SnmpVersion_SNMP_VERSION_V2C,
+				Community: "ubiquiti-test",
+			},
+			{
+				Version:        monitorv1.SnmpVersion_SNMP_VERSION_V3,
+				Username:       "monitor",
+				AuthProtocol:   monitorv1.SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_SHA256,
+				AuthPassphrase: "ubiquiti-auth",
+				PrivProtocol:   monitorv1.SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_AES,
+				PrivPassphrase: "ubiquiti-priv",
+			},
+		}
+		env.Agent(fixtures.ServiceDeviceAgent, credentials...)
+		defer monitor.DeleteDevice(device) // nolint:errcheck
+
+		for _, credential := range credentials {
+			object, err := monitor.RegisterAgent(device, credential)
+			assert.NoError(t, err)
+			assert.Equal(t, device.Identifier, object.GetDevice().GetDeviceId())
+			assert.Equal(t, device.Architecture, object.GetDevice().GetArchitecture())
+			assert.Equal(t, device.OS, object.GetDevice().GetOs())
+			assert.Equal(
+				t,
+				[]monitorv1.Protocol{monitorv1.Protocol_PROTOCOL_SNMP},
+				object.GetDevice().GetSupportedProtocols(),
+			)
+		}
+		assert.Eventually(t, func() bool {
+			res, err := monitor.GetDiagnostics(device)
+			return err == nil && res.GetDiagnostics() != nil
+		}, DefaultTickerTimeout, DefaultTickerInterval)
+		res, err := monitor.GetDiagnostics(device)
+		assert.NoError(t, err)
+		diagnostics := res.GetDiagnostics()
+		assert.Equal(t, fixtures.AgentObjectID, diagnostics.GetHardwareVersion())
+		assert.Equal(t, fixtures.AgentRelease, diagnostics.GetSoftwareVersion())
+		assert.InDelta(t, fixtures.AgentCPU, diagnostics.GetCpuUsage(), 0.01)
+		assert.InDelta(t, fixtures.AgentMemory, diagnostics.GetMemoryUsage(), 0.01)
+		assert.Equal(t, uint64(fixtures.AgentUptime.Seconds()), diagnostics.GetUptimeSeconds())
+		assert.Equal(t, uint64(fixtures.AgentDiskUsed), diagnostics.GetDiskUsedBytes())
+		assert.Equal(t, uint64(fixtures.AgentDiskTotal), diagnostics.GetDiskTotalBytes())
+		assert.Equal(t, uint32(fixtures.AgentProcesses), diagnostics.GetProcessCount())
+	})
+	t.Run("should return error due to invalid community (snmp)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceAgent]
+		env.Agent(fixtures.ServiceDeviceAgent, &monitorv1.SnmpCredentials{
+			Version:   monitorv1.SnmpVersion_SNMP_VERSION_V2C,
+			Community: "ubiquiti-test",
+		})
+
+		_, err := monitor.RegisterAgent(device, &monitorv1.SnmpCredentials{
+			Version:   monitorv1.SnmpVersion_SNMP_VERSION_V2C,
+			Community: "public",
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = monitor.RegisterAgent(device, &monitorv1.SnmpCredentials{
+			Version:  monitorv1.SnmpVersion_SNMP_VERSION_V3,
+			Username: "monitor",
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = monitor.RegisterAgent(device, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should poll diagnostics of registered device via driver (plugin)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceMeter]
+		env.Plugin(fixtures.ServiceDeviceMeter)
+		defer monitor.DeleteDevice(device) // nolint:errcheck
+
+		object, err := monitor.RegisterPlugin(device, fixtures.DefaultPluginName)
+		assert.NoError(t, err)
+		assert.Equal(t, device.Identifier, object.GetDevice().GetDeviceId())
+		assert.Equal(t, device.Architecture, object.GetDevice().GetArchitecture())
+		assert.Equal(t, device.OS, object.GetDevice().GetOs())
+		assert.Equal(t, fixtures.DefaultPluginName, object.GetDevice().GetDriver())
+		assert.Equal(
+			t,
+			[]monitorv1.Protocol{monitorv1.Protocol_PROTOCOL_PLUGIN},
+			object.GetDevice().GetSupportedProtocols(),
+		)
+		assert.Eventually(t, func() bool {
+			res, err := monitor.GetDiagnostics(device)
+			return err == nil && res.GetDiagnostics() != nil
+		}, DefaultTickerTimeout, DefaultTickerInterval)
+		res, err := monitor.GetDiagnostics(device)
+		assert.NoError(t, err)
+		diagnostics := res.GetDiagnostics()
+		assert.Equal(t, fixtures.PluginHardware, diagnostics.GetHardwareVersion())
+		assert.Equal(t, fixtures.PluginSoftware, diagnostics.GetSoftwareVersion())
+		assert.InDelta(t, fixtures.PluginCPU, diagnostics.GetCpuUsage(), 0.01)
+		assert.InDelta(t, fixtures.PluginMemory, diagnostics.GetMemoryUsage(), 0.01)
+		assert.Equal(t, uint64(fixtures.PluginUptime.Seconds()), diagnostics.GetUptimeSeconds())
+		assert.Equal(
+			t,
+			monitorv1.VerificationStatus_VERIFICATION_STATUS_UNSIGNED,
+			diagnostics.GetVerificationStatus(),
+		)
+
+		// Updates are issued through the plugin
+		_, err = monitor.UpdateDevice(device, monitorv1.DeviceStatus_DEVICE_STATUS_MAINTENANCE)
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			res, err := monitor.GetDiagnostics(device)
+			return err == nil &&
+				res.GetDiagnostics().GetDeviceStatus() == monitorv1.DeviceStatus_DEVICE_STATUS_MAINTENANCE
+		}, DefaultTickerTimeout, DefaultTickerInterval)
+	})
+	t.Run("should return error due to unknown driver (plugin)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorArm)
+		device := fixtures.Services[fixtures.ServiceDeviceMeter]
+		env.Plugin()
+
+		_, err := monitor.RegisterPlugin(device, fixtures.DefaultPluginName)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = monitor.RegisterPlugin(device, "unknown")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = monitor.RegisterPlugin(device, "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_ImportDevices(t *testing.T) {
+	t.Run("should import inventory and report unchanged devices (yaml, csv)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorAmd)
+		device := fixtures.Services[fixtures.ServiceDeviceAccessPoint]
+
+		object, err := monitor.ImportDevices(monitorv1.InventoryFormat_INVENTORY_FORMAT_YAML, false, device)
+		assert.NoError(t, err)
+		assert.Len(t, object.GetResults(), 1)
+		result := object.GetResults()[0]
+		assert.Equal(t, device.Identifier, result.GetDeviceId())
+		assert.Equal(t, int32(2), result.GetLine())
+		assert.Contains(t, []monitorv1.ImportStatus{
+			monitorv1.ImportStatus_IMPORT_STATUS_CREATED,
+			monitorv1.ImportStatus_IMPORT_STATUS_UPDATED,
+			monitorv1.ImportStatus_IMPORT_STATUS_UNCHANGED,
+		}, result.GetStatus())
+		assert.Empty(t, result.GetError())
+
+		object, err = monitor.ImportDevices(monitorv1.InventoryFormat_INVENTORY_FORMAT_CSV, false, device)
+		assert.NoError(t, err)
+		assert.Len(t, object.GetResults(), 1)
+		assert.Equal(t, monitorv1.ImportStatus_IMPORT_STATUS_UNCHANGED, object.GetResults()[0].GetStatus())
+		assert.False(t, object.GetPruned())
+	})
+	t.Run("should report failed entries and skip prune (csv)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorAmd)
+		device := fixtures.Services[fixtures.ServiceDeviceAccessPoint]
+		unknown := device
+		unknown.Identifier = "ubiquiti-device-unknown"
+
+		object, err := monitor.ImportDevices(
+			monitorv1.InventoryFormat_INVENTORY_FORMAT_CSV,
+			true,
+			device,
+			unknown,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, object.GetResults(), 2)
+		assert.Equal(t, monitorv1.ImportStatus_IMPORT_STATUS_UNCHANGED, object.GetResults()[0].GetStatus())
+		assert.Equal(t, monitorv1.ImportStatus_IMPORT_STATUS_FAILED, object.GetResults()[1].GetStatus())
+		assert.Contains(t, object.GetResults()[1].GetError(), device.Identifier)
+		assert.False(t, object.GetPruned())
+
+		devices, err := monitor.ListDevices()
+		assert.NoError(t, err)
+		identifiers := make([]string, 0, len(devices.GetDevices()))
+		for _, dev := range devices.GetDevices() {
+			identifiers = append(identifiers, dev.GetDeviceId())
+		}
+		assert.Subset(t, identifiers, []string{
+			fixtures.Services[fixtures.ServiceDeviceRouter].Identifier,
+			fixtures.Services[fixtures.ServiceDeviceSwitch].Identifier,
+			device.Identifier,
+		})
+		assert.NotContains(t, identifiers, unknown.Identifier)
+	})
+	t.Run("should return error due to invalid inventory", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorAmd)
+
+		_, err := monitor.ImportDevices(monitorv1.InventoryFormat_INVENTORY_FORMAT_YAML, false)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = monitor.ImportDevices(monitorv1.InventoryFormat_INVENTORY_FORMAT_UNSPECIFIED, false)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMonitor_DeleteDevice(t *testing.T) {
+	t.Run("should delete registered device (amd64)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorAmd)
+		device := fixtures.Services[fixtures.ServiceDeviceAccessPoint]
+
+		_, err := monitor.RegisterDevice(device)
+		assert.NoError(t, err)
+		_, err = monitor.DeleteDevice(device)
+		assert.NoError(t, err)
+
+		devices, err := monitor.ListDevices()
+		assert.NoError(t, err)
+		for _, dev := range devices.GetDevices() {
+			assert.NotEqual(t, device.Identifier, dev.DeviceId)
+		}
+		_, err = monitor.GetDiagnostics(device)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("should return error due to unknown device", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		monitor := env.Monitor(fixtures.ServiceBackendMonitorAmd)
+		device := fixtures.Services[fixtures.ServiceDeviceAccessPoint]
+		device.Identifier = "ubiquiti-device-unknown"
+
+		_, err := monitor.DeleteDevice(device)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
 }
 
 func assertValidDevice(t *testing.T, expected fixtures.ServiceConfig, actual *monitorv1.Device) {
@@ -270,3 +1422,26 @@ func assertValidMonitorDiagnostics(t *testing.T, actual *monitorv1.Diagnostics)
 	assert.NotNil(t, actual.Checksum)
 	assert.NotEqual(t, DefaultInvalidChecksum, actual.Checksum)
 }
+
+func awaitDeviceStatus(
+	t *testing.T,
+	monitor *fixtures.MonitorScenario,
+	device fixtures.ServiceConfig,
+	status monitorv1.DeviceStatus,
+) {
+	timeout := time.After(DefaultTickerTimeout)
+	ticker := time.NewTicker(DefaultTickerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-timeout:
+			t.Fatalf("timeout waiting for device status to change to %v", status)
+		case <-ticker.C:
+			diag, err := monitor.GetDiagnostics(device)
+			assert.NoError(t, err)
+			if diag.Diagnostics.DeviceStatus == status {
+				return
+			}
+		}
+	}
+}
@@ -1,14 +1,26 @@
 package test
 
 import (
+	"bufio"
+	"io"
+	"net/http"
 	"testing"
+	"time"
 
 	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
 	"github.com/emil-j-olsson/ubiquiti/test/fixtures"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-const DefaultInvalidChecksum = "invalid-checksum"
+const (
+	DefaultInvalidChecksum = "invalid-checksum"
+	DefaultUpgradeTimeout  = 30 * time.Second
+)
 
 func TestDevice_GetHealth(t *testing.T) {
 	tests := []struct {
@@ -61,11 +73,15 @@ func TestDevice_GetDiagnostics(t *testing.T) {
 	tests := []struct {
 		name    string
 		service fixtures.Service
+		signed  bool
+		host    bool
 		err     bool
 	}{
 		{
 			name:    "should retrieve diagnostics of available device (router)",
 			service: fixtures.ServiceDeviceRouter,
+			signed:  true,
+			host:    true,
 		},
 		{
 			name:    "should retrieve diagnostics of available device (switch)",
@@ -94,6 +110,14 @@ func TestDevice_GetDiagnostics(t *testing.T) {
 			expected := fixtures.Services[tt.service]
 			assert.Equal(t, expected.Identifier, res.DeviceId)
 			assertValidDeviceDiagnostics(t, res)
+			assert.Equal(t, tt.signed, res.Signature != "")
+			if tt.host {
+				assert.NotZero(t, res.UptimeSeconds)
+				assert.NotZero(t, res.ProcessCount)
+				assert.NotZero(t, res.DiskTotalBytes)
+				assert.LessOrEqual(t, res.DiskUsedBytes, res.DiskTotalBytes)
+				assert.NotEmpty(t, res.Interfaces)
+			}
 		})
 	}
 }
@@ -139,6 +163,115 @@ func TestDevice_StreamDiagnostics(t *testing.T) {
 			assertValidDeviceDiagnostics(t, res)
 		})
 	}
+	t.Run("should stream diagnostics as server-sent events (router)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceRouter)
+
+		res, err := device.SubscribeDiagnostics("")
+		require.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+		fields := readEvent(t, bufio.NewReader(res.Body))
+		assert.Equal(t, "diagnostics", fields["event"])
+		var diagnostics devicev1.DiagnosticsResponse
+		require.NoError(t, protojson.Unmarshal([]byte(fields["data"]), &diagnostics))
+		assertValidDeviceDiagnostics(t, &diagnostics)
+		assert.Equal(t, fields["id"], diagnostics.GetTimestamp().AsTime().Format(time.RFC3339Nano))
+
+		// The device keeps no history, resuming only sends the samples taken after the last event
+		resumed, err := device.SubscribeDiagnostics(fields["id"])
+		require.NoError(t, err)
+		defer resumed.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusOK, resumed.StatusCode)
+		reader := bufio.NewReader(resumed.Body)
+		previous := diagnostics.GetTimestamp().AsTime()
+		for i := range 3 {
+			fields = readEvent(t, reader)
+			id, err := time.Parse(time.RFC3339Nano, fields["id"])
+			require.NoError(t, err)
+			assert.True(t, id.After(previous), "event %d at %s after %s", i, id, previous)
+			previous = id
+		}
+	})
+	t.Run("should return error due to invalid last event id (router)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		res, err := env.Device(fixtures.ServiceDeviceRouter).SubscribeDiagnostics("yesterday")
+		require.NoError(t, err)
+		defer res.Body.Close() // nolint:errcheck
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func TestDevice_PublishDiagnostics(t *testing.T) {
+	t.Run("should publish diagnostics and health to broker (sensor)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceSensor)
+		expected := fixtures.Services[fixtures.ServiceDeviceSensor]
+
+		messages, err := device.Subscribe(devicev1.DiagnosticsTopic)
+		assert.NoError(t, err)
+		select {
+		case payload := <-messages:
+			var res devicev1.DiagnosticsResponse
+			assert.NoError(t, protojson.Unmarshal(payload, &res))
+			assert.Equal(t, expected.Identifier, res.DeviceId)
+			assertValidDeviceDiagnostics(t, &res)
+		case <-time.After(fixtures.DefaultBrokerTimeout):
+			t.Fatal("no diagnostics published to broker")
+		}
+
+		messages, err = device.Subscribe(devicev1.HealthTopic)
+		assert.NoError(t, err)
+		select {
+		case payload := <-messages:
+			var res devicev1.GetHealthResponse
+			assert.NoError(t, protojson.Unmarshal(payload, &res))
+			assert.Equal(t, expected.Identifier, res.DeviceId)
+			assert.Equal(t, expected.Architecture, res.Architecture)
+		case <-time.After(fixtures.DefaultBrokerTimeout):
+			t.Fatal("no health published to broker")
+		}
+	})
+	t.Run("should apply status commands received from broker (sensor)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceSensor)
+		command := func(status devicev1.DeviceStatus) *devicev1.DeviceCommand {
+			return &devicev1.DeviceCommand{
+				Command: &devicev1.DeviceCommand_UpdateDevice{
+					UpdateDevice: &devicev1.UpdateDeviceRequest{DeviceStatus: status},
+				},
+			}
+		}
+		applied := func(status devicev1.DeviceStatus) func() bool {
+			return func() bool {
+				res, err := device.GetDiagnostics()
+				return err == nil && res.DeviceStatus == status
+			}
+		}
+
+		// Healthy -> Maintenance -> Healthy
+		err := device.Publish(devicev1.CommandTopic, command(devicev1.DeviceStatus_DEVICE_STATUS_MAINTENANCE))
+		assert.NoError(t, err)
+		assert.Eventually(
+			t,
+			applied(devicev1.DeviceStatus_DEVICE_STATUS_MAINTENANCE),
+			fixtures.DefaultBrokerTimeout,
+			100*time.Millisecond,
+		)
+		err = device.Publish(devicev1.CommandTopic, command(devicev1.DeviceStatus_DEVICE_STATUS_HEALTHY))
+		assert.NoError(t, err)
+		assert.Eventually(
+			t,
+			applied(devicev1.DeviceStatus_DEVICE_STATUS_HEALTHY),
+			fixtures.DefaultBrokerTimeout,
+			100*time.Millisecond,
+		)
+	})
 }
 
 func TestDevice_UpdateDiagnostics(t *testing.T) {
@@ -164,6 +297,22 @@ func TestDevice_UpdateDiagnostics(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, devicev1.DeviceStatus_DEVICE_STATUS_HEALTHY, res.DeviceStatus)
 	})
+	t.Run("should return error due to invalid transition (router)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceRouter)
+
+		// Healthy -> Booting -> Maintenance (rejected) -> Healthy
+		_, err := device.UpdateDevice(devicev1.DeviceStatus_DEVICE_STATUS_BOOTING)
+		assert.NoError(t, err)
+		_, err = device.UpdateDevice(devicev1.DeviceStatus_DEVICE_STATUS_MAINTENANCE)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		res, err := device.GetDiagnostics()
+		assert.NoError(t, err)
+		assert.Equal(t, devicev1.DeviceStatus_DEVICE_STATUS_BOOTING, res.DeviceStatus)
+		_, err = device.UpdateDevice(devicev1.DeviceStatus_DEVICE_STATUS_HEALTHY)
+		assert.NoError(t, err)
+	})
 	t.Run("should return error due to invalid device", func(t *testing.T) {
 		env := fixtures.NewEnvironment(t)
 		defer env.Close()
@@ -174,6 +323,233 @@ func TestDevice_UpdateDiagnostics(t *testing.T) {
 	})
 }
 
+func TestDevice_UpdateSimulation(t *testing.T) {
+	t.Run("should switch simulation profiles of available device (access point)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceAccessPoint)
+
+		// Runtime -> Simulated (constant)
+		res, err := device.UpdateSimulation(&devicev1.UpdateSimulationRequest{
+			Enabled: true,
+			Cpu:     &devicev1.SimulationProfile{Kind: devicev1.ProfileKind_PROFILE_KIND_CONSTANT, Base: 42},
+			Memory:  &devicev1.SimulationProfile{Kind: devicev1.ProfileKind_PROFILE_KIND_CONSTANT, Base: 64},
+		})
+		assert.NoError(t, err)
+		assert.True(t, res.Enabled)
+		diag, err := device.GetDiagnostics()
+		assert.NoError(t, err)
+		assert.Equal(t, 42.0, diag.CpuUsage)
+		assert.Equal(t, 64.0, diag.MemoryUsage)
+
+		// Simulated -> Runtime
+		_, err = device.UpdateSimulation(&devicev1.UpdateSimulationRequest{Enabled: false})
+		assert.NoError(t, err)
+		sim, err := device.GetSimulation()
+		assert.NoError(t, err)
+		assert.False(t, sim.Enabled)
+		assert.Equal(t, devicev1.ProfileKind_PROFILE_KIND_CONSTANT, sim.Cpu.Kind)
+	})
+	t.Run("should return error due to invalid profile", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Device(fixtures.ServiceDeviceAccessPoint).UpdateSimulation(
+			&devicev1.UpdateSimulationRequest{Enabled: true, Cpu: &devicev1.SimulationProfile{}},
+		)
+		assert.Error(t, err)
+	})
+}
+
+func TestDevice_InjectFault(t *testing.T) {
+	t.Run("should inject and remove error fault of available device (access point)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceAccessPoint)
+		defer device.ClearFaults() // nolint:errcheck
+
+		res, err := device.InjectFault(&devicev1.Fault{
+			Kind:     devicev1.FaultKind_FAULT_KIND_ERROR,
+			Methods:  []string{"GetDiagnostics"},
+			GrpcCode: uint32(codes.Unavailable),
+		})
+		assert.NoError(t, err)
+		_, err = device.GetDiagnostics()
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		_, err = device.GetHealth()
+		assert.NoError(t, err)
+
+		_, err = device.RemoveFault(res.Fault.Id)
+		assert.NoError(t, err)
+		_, err = device.GetDiagnostics()
+		assert.NoError(t, err)
+	})
+	t.Run("should close stream after configured number of messages (access point)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceAccessPoint)
+		defer device.ClearFaults() // nolint:errcheck
+
+		_, err := device.InjectFault(&devicev1.Fault{
+			Kind:          devicev1.FaultKind_FAULT_KIND_STREAM_CLOSE,
+			AfterMessages: 2,
+		})
+		assert.NoError(t, err)
+		stream, err := device.StreamDiagnostics()
+		assert.NoError(t, err)
+		received := 0
+		for {
+			if _, err := stream.Recv(); err != nil {
+				assert.ErrorIs(t, err, io.EOF)
+				break
+			}
+			received++
+		}
+		assert.Equal(t, 2, received)
+	})
+	t.Run("should return error due to invalid fault", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Device(fixtures.ServiceDeviceAccessPoint).InjectFault(&devicev1.Fault{
+			Kind:    devicev1.FaultKind_FAULT_KIND_ERROR,
+			Methods: []string{"GetDiagnostics"},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestDevice_UpgradeFirmware(t *testing.T) {
+	t.Run("should upgrade and revert firmware of available device (switch)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceSwitch)
+		diag, err := device.GetDiagnostics()
+		assert.NoError(t, err)
+		original := diag.FirmwareVersion
+
+		// Original -> Target -> Original
+		for _, version := range []string{original + "-upgrade", original} {
+			res, err := device.UpgradeFirmware(version)
+			assert.NoError(t, err)
+			assert.Equal(t, version, res.Upgrade.TargetVersion)
+			awaitFirmwareUpgrade(t, device)
+
+			diag, err := device.GetDiagnostics()
+			assert.NoError(t, err)
+			assert.Equal(t, version, diag.FirmwareVersion)
+			assert.Equal(t, devicev1.DeviceStatus_DEVICE_STATUS_HEALTHY, diag.DeviceStatus)
+		}
+	})
+	t.Run("should reject upgrade while upgrade is in progress (switch)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceSwitch)
+		diag, err := device.GetDiagnostics()
+		assert.NoError(t, err)
+
+		_, err = device.UpgradeFirmware(diag.FirmwareVersion)
+		assert.NoError(t, err)
+		_, err = device.UpgradeFirmware(diag.FirmwareVersion)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		awaitFirmwareUpgrade(t, device)
+	})
+	t.Run("should return error due to missing version", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Device(fixtures.ServiceDeviceSwitch).UpgradeFirmware("")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestDevice_Reboot(t *testing.T) {
+	t.Run("should reboot available device and close open streams (router)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceRouter)
+		stream, err := device.StreamDiagnostics()
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.NoError(t, err)
+
+		res, err := device.Reboot(2 * time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, 2*time.Second, res.Duration.AsDuration())
+		diag, err := device.GetDiagnostics()
+		assert.NoError(t, err)
+		assert.Equal(t, devicev1.DeviceStatus_DEVICE_STATUS_BOOTING, diag.DeviceStatus)
+		for {
+			if _, err := stream.Recv(); err != nil {
+				assert.ErrorIs(t, err, io.EOF)
+				break
+			}
+		}
+		_, err = device.Reboot(0)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		time.Sleep(3 * time.Second)
+		diag, err = device.GetDiagnostics()
+		assert.NoError(t, err)
+		assert.Equal(t, devicev1.DeviceStatus_DEVICE_STATUS_HEALTHY, diag.DeviceStatus)
+	})
+	t.Run("should return error due to negative duration", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Device(fixtures.ServiceDeviceRouter).Reboot(-time.Second)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestDevice_ApplyConfig(t *testing.T) {
+	t.Run("should apply config to available device (access point)", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		device := env.Device(fixtures.ServiceDeviceAccessPoint)
+		diag, err := device.GetDiagnostics()
+		assert.NoError(t, err)
+		original := diag.StreamInterval
+		defer device.ApplyConfig(&devicev1.DeviceConfig{StreamInterval: original}) // nolint:errcheck
+
+		res, err := device.ApplyConfig(&devicev1.DeviceConfig{
+			StreamInterval: durationpb.New(time.Second),
+			Labels:         map[string]string{"site": "lab"},
+			Config:         map[string]string{"ntp_server": "pool.ntp.org"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, diag.DeviceStatus, res.Config.DeviceStatus)
+
+		diag, err = device.GetDiagnostics()
+		assert.NoError(t, err)
+		assert.Equal(t, time.Second, diag.StreamInterval.AsDuration())
+		assert.Equal(t, map[string]string{"site": "lab"}, diag.Labels)
+		assert.Equal(t, map[string]string{"ntp_server": "pool.ntp.org"}, diag.Config)
+	})
+	t.Run("should return error due to invalid stream interval", func(t *testing.T) {
+		env := fixtures.NewEnvironment(t)
+		defer env.Close()
+		_, err := env.Device(fixtures.ServiceDeviceAccessPoint).ApplyConfig(&devicev1.DeviceConfig{
+			StreamInterval: durationpb.New(time.Millisecond),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func awaitFirmwareUpgrade(t *testing.T, device *fixtures.DeviceScenario) {
+	timeout := time.After(DefaultUpgradeTimeout)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-timeout:
+			t.Fatal("timeout waiting for firmware upgrade to complete")
+		case <-ticker.C:
+			res, err := device.GetFirmwareUpgrade()
+			assert.NoError(t, err)
+			if res.Upgrade.Phase == devicev1.UpgradePhase_UPGRADE_PHASE_COMPLETED {
+				return
+			}
+		}
+	}
+}
+
 func assertValidDeviceDiagnostics(t *testing.T, actual *devicev1.DiagnosticsResponse) {
 	assert.NotNil(t, actual.DeviceStatus)
 	assert.NotNil(t, actual.HardwareVersion)
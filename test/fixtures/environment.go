@@ -2,9 +2,16 @@ package fixtures
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	mqtt "github.com/eclipse/paho.mqtt.golang"
 	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
 	devicev1 "github.com/emil-j-olsson/ubiquiti/device/proto/device/v1"
 	"github.com/joho/godotenv"
@@ -12,11 +19,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	DefaultTestPrefix = "TEST"
+	DefaultTestPrefix    = "TEST"
+	DefaultBrokerTimeout = 5 * time.Second
 )
 
 type Environment struct {
@@ -25,6 +37,9 @@ type Environment struct {
 	config  *Config
 	device  *DeviceClient
 	monitor *MonitorClient
+	broker  mqtt.Client
+	agents  []*Agent
+	plugins []*Plugin
 }
 
 func NewEnvironment(t *testing.T) *Environment {
@@ -65,6 +80,75 @@ func (e *Environment) Close() {
 	if e.monitor != nil {
 		e.monitor.conn.Close() // nolint:errcheck
 	}
+	if e.broker != nil {
+		e.broker.Disconnect(250)
+	}
+	for _, agent := range e.agents {
+		agent.Close() // nolint:errcheck
+	}
+	for _, plugin := range e.plugins {
+		plugin.Close()
+	}
+}
+
+// Agent starts an SNMP agent emulator of the device answering requests with the credentials,
+// the monitors reach the agent on the host of the tests (host.docker.internal).
+func (e *Environment) Agent(service Service, credentials ...*monitorv1.SnmpCredentials) *Agent {
+	config, exists := Services[service]
+	if !exists {
+		e.t.Fatalf("service %s not found in configuration", service)
+		return nil
+	}
+	agent, err := NewAgent(config.Port, credentials...)
+	if err != nil {
+		e.t.Fatalf("failed to start agent: %v", err)
+		return nil
+	}
+	agent.Set(AgentObjects(config)...)
+	e.agents = append(e.agents, agent)
+	return agent
+}
+
+// Plugin starts the driver plugin serving the devices, the monitors reach the plugin on the
+// host of the tests (host.docker.internal).
+func (e *Environment) Plugin(services ...Service) *Plugin {
+	configs := make([]ServiceConfig, len(services))
+	for i, service := range services {
+		config, exists := Services[service]
+		if !exists {
+			e.t.Fatalf("service %s not found in configuration", service)
+			return nil
+		}
+		configs[i] = config
+	}
+	plugin, err := NewPlugin(DefaultPluginPort, configs...)
+	if err != nil {
+		e.t.Fatalf("failed to start plugin: %v", err)
+		return nil
+	}
+	e.plugins = append(e.plugins, plugin)
+	return plugin
+}
+
+// Broker connects to the MQTT broker embedded in the monitor (arm64)
+func (e *Environment) Broker() mqtt.Client {
+	if e.broker != nil {
+		return e.broker
+	}
+	options := mqtt.NewClientOptions().
+		AddBroker(e.config.Broker).
+		SetClientID(fmt.Sprintf("test-%d", time.Now().UnixNano())).
+		SetUsername(e.config.BrokerUsername).
+		SetPassword(e.config.BrokerPassword).
+		SetCleanSession(true)
+	client := mqtt.NewClient(options)
+	token := client.Connect()
+	if !token.WaitTimeout(DefaultBrokerTimeout) {
+		e.t.Fatalf("timed out connecting to broker %s", e.config.Broker)
+	}
+	assert.NoError(e.t, token.Error())
+	e.broker = client
+	return client
 }
 
 type DeviceScenario struct {
@@ -88,6 +172,26 @@ func (s *DeviceScenario) StreamDiagnostics() (grpc.ServerStreamingClient[devicev
 	return device.client.StreamDiagnostics(s.env.ctx, &devicev1.DiagnosticsRequest{})
 }
 
+// SubscribeDiagnostics requests the diagnostics stream of the device as Server-Sent Events
+// through its gateway, resuming after the given event if any
+func (s *DeviceScenario) SubscribeDiagnostics(lastEventID string) (*http.Response, error) {
+	service := Services[s.service]
+	endpoint := fmt.Sprintf(
+		"http://%s:%d/v1/diagnostics/stream",
+		s.env.config.Host,
+		service.GatewayPort,
+	)
+	req, err := http.NewRequestWithContext(s.env.ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	return http.DefaultClient.Do(req)
+}
+
 func (s *DeviceScenario) UpdateDevice(status devicev1.DeviceStatus) (*devicev1.UpdateDeviceResponse, error) {
 	device := s.client(s.env.t)
 	return device.client.UpdateDevice(s.env.ctx, &devicev1.UpdateDeviceRequest{
@@ -95,6 +199,18 @@ func (s *DeviceScenario) UpdateDevice(status devicev1.DeviceStatus) (*devicev1.U
 	})
 }
 
+func (s *DeviceScenario) GetSimulation() (*devicev1.GetSimulationResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.GetSimulation(s.env.ctx, &devicev1.GetSimulationRequest{})
+}
+
+func (s *DeviceScenario) UpdateSimulation(
+	req *devicev1.UpdateSimulationRequest,
+) (*devicev1.UpdateSimulationResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.UpdateSimulation(s.env.ctx, req)
+}
+
 type DeviceClient struct {
 	conn     *grpc.ClientConn
 	client   devicev1.DeviceClient
@@ -102,6 +218,77 @@ type DeviceClient struct {
 	protocol Protocol
 }
 
+func (s *DeviceScenario) InjectFault(fault *devicev1.Fault) (*devicev1.InjectFaultResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.InjectFault(s.env.ctx, &devicev1.InjectFaultRequest{Fault: fault})
+}
+
+func (s *DeviceScenario) RemoveFault(id string) (*devicev1.RemoveFaultResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.RemoveFault(s.env.ctx, &devicev1.RemoveFaultRequest{Id: id})
+}
+
+func (s *DeviceScenario) ClearFaults() (*devicev1.ClearFaultsResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.ClearFaults(s.env.ctx, &devicev1.ClearFaultsRequest{})
+}
+
+func (s *DeviceScenario) GetFirmwareUpgrade() (*devicev1.GetFirmwareUpgradeResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.GetFirmwareUpgrade(s.env.ctx, &devicev1.GetFirmwareUpgradeRequest{})
+}
+
+func (s *DeviceScenario) UpgradeFirmware(version string) (*devicev1.UpgradeFirmwareResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.UpgradeFirmware(s.env.ctx, &devicev1.UpgradeFirmwareRequest{
+		Version: version,
+	})
+}
+
+func (s *DeviceScenario) Reboot(duration time.Duration) (*devicev1.RebootResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.Reboot(s.env.ctx, &devicev1.RebootRequest{
+		Duration: durationpb.New(duration),
+	})
+}
+
+func (s *DeviceScenario) ApplyConfig(config *devicev1.DeviceConfig) (*devicev1.ApplyConfigResponse, error) {
+	device := s.client(s.env.t)
+	return device.client.ApplyConfig(s.env.ctx, &devicev1.ApplyConfigRequest{Config: config})
+}
+
+// Subscribe receives the messages the device publishes to a topic, including the message
+// retained by the broker
+func (s *DeviceScenario) Subscribe(topic func(string) string) (<-chan []byte, error) {
+	service := Services[s.service]
+	messages := make(chan []byte, 16)
+	token := s.env.Broker().
+		Subscribe(topic(service.Identifier), 0, func(_ mqtt.Client, message mqtt.Message) {
+			select {
+			case messages <- message.Payload():
+			default:
+			}
+		})
+	if !token.WaitTimeout(DefaultBrokerTimeout) {
+		return nil, errors.New("timed out subscribing to topic")
+	}
+	return messages, token.Error()
+}
+
+// Publish sends a message to a topic of the device, e.g. a command
+func (s *DeviceScenario) Publish(topic func(string) string, message proto.Message) error {
+	service := Services[s.service]
+	payload, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+	token := s.env.Broker().Publish(topic(service.Identifier), 1, false, payload)
+	if !token.WaitTimeout(DefaultBrokerTimeout) {
+		return errors.New("timed out publishing to topic")
+	}
+	return token.Error()
+}
+
 func (s *DeviceScenario) client(t *testing.T) *DeviceClient {
 	service, exists := Services[s.service]
 	if !exists {
@@ -151,6 +338,80 @@ func (s *MonitorScenario) RegisterDevice(service ServiceConfig) (*monitorv1.Regi
 	})
 }
 
+// RegisterAgent registers a device polled through its SNMP agent with the credentials
+func (s *MonitorScenario) RegisterAgent(
+	service ServiceConfig,
+	credentials *monitorv1.SnmpCredentials,
+) (*monitorv1.RegisterDeviceResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.RegisterDevice(s.env.ctx, &monitorv1.RegisterDeviceRequest{
+		DeviceId: service.Identifier,
+		Alias:    service.Alias,
+		Host:     service.Container,
+		Port:     int64(service.Port),
+		Protocol: monitorv1.Protocol_PROTOCOL_SNMP,
+		Snmp:     credentials,
+	})
+}
+
+// RegisterPlugin registers a device served by the driver plugin
+func (s *MonitorScenario) RegisterPlugin(
+	service ServiceConfig,
+	driver string,
+) (*monitorv1.RegisterDeviceResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.RegisterDevice(s.env.ctx, &monitorv1.RegisterDeviceRequest{
+		DeviceId: service.Identifier,
+		Alias:    service.Alias,
+		Host:     service.Container,
+		Port:     int64(service.Port),
+		Protocol: monitorv1.Protocol_PROTOCOL_PLUGIN,
+		Driver:   driver,
+	})
+}
+
+// ImportDevices imports an inventory listing the devices in the format, as registered by
+// RegisterDevice
+func (s *MonitorScenario) ImportDevices(
+	format monitorv1.InventoryFormat,
+	prune bool,
+	services ...ServiceConfig,
+) (*monitorv1.ImportDevicesResponse, error) {
+	var data strings.Builder
+	switch format {
+	case monitorv1.InventoryFormat_INVENTORY_FORMAT_CSV:
+		data.WriteString("device_id,alias,host,port,port_gateway,protocol\n")
+		for _, service := range services {
+			fmt.Fprintf(
+				&data,
+				"%s,%s,%s,8080,8081,%s\n",
+				service.Identifier,
+				service.Alias,
+				service.Container,
+				service.SupportedProtocols[0].Proto(),
+			)
+		}
+	default:
+		data.WriteString("devices:\n")
+		for _, service := range services {
+			fmt.Fprintf(
+				&data,
+				"  - device_id: %s\n    alias: %q\n    host: %s\n    port: 8080\n    port_gateway: 8081\n    protocol: %s\n",
+				service.Identifier,
+				service.Alias,
+				service.Container,
+				service.SupportedProtocols[0].Proto(),
+			)
+		}
+	}
+	monitor := s.client(s.env.t)
+	return monitor.client.ImportDevices(s.env.ctx, &monitorv1.ImportDevicesRequest{
+		Data:   []byte(data.String()),
+		Format: format,
+		Prune:  prune,
+	})
+}
+
 func (s *MonitorScenario) ListDevices() (*monitorv1.ListDevicesResponse, error) {
 	monitor := s.client(s.env.t)
 	return monitor.client.ListDevices(s.env.ctx, &emptypb.Empty{})
@@ -167,6 +428,49 @@ func (s *MonitorScenario) UpdateDevice(
 	})
 }
 
+func (s *MonitorScenario) DeleteDevice(service ServiceConfig) (*emptypb.Empty, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.DeleteDevice(s.env.ctx, &monitorv1.DeleteDeviceRequest{
+		DeviceId: service.Identifier,
+	})
+}
+
+func (s *MonitorScenario) RebootDevice(
+	service ServiceConfig,
+	duration time.Duration,
+) (*monitorv1.RebootDeviceResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.RebootDevice(s.env.ctx, &monitorv1.RebootDeviceRequest{
+		DeviceId: service.Identifier,
+		Duration: durationpb.New(duration),
+	})
+}
+
+func (s *MonitorScenario) SetDeviceConfig(
+	service ServiceConfig,
+	config *monitorv1.DesiredConfig,
+) (*monitorv1.DeviceConfigResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.SetDeviceConfig(s.env.ctx, &monitorv1.SetDeviceConfigRequest{
+		DeviceId: service.Identifier,
+		Config:   config,
+	})
+}
+
+func (s *MonitorScenario) GetDeviceConfig(service ServiceConfig) (*monitorv1.DeviceConfigResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.GetDeviceConfig(s.env.ctx, &monitorv1.GetDeviceConfigRequest{
+		DeviceId: service.Identifier,
+	})
+}
+
+func (s *MonitorScenario) DeleteDeviceConfig(service ServiceConfig) (*emptypb.Empty, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.DeleteDeviceConfig(s.env.ctx, &monitorv1.DeleteDeviceConfigRequest{
+		DeviceId: service.Identifier,
+	})
+}
+
 func (s *MonitorScenario) GetDiagnostics(service ServiceConfig) (*monitorv1.DiagnosticsResponse, error) {
 	monitor := s.client(s.env.t)
 	return monitor.client.GetDiagnostics(s.env.ctx, &monitorv1.DiagnosticsRequest{
@@ -174,6 +478,207 @@ func (s *MonitorScenario) GetDiagnostics(service ServiceConfig) (*monitorv1.Diag
 	})
 }
 
+func (s *MonitorScenario) ListDiagnostics(
+	service ServiceConfig,
+	limit int32,
+) (*monitorv1.ListDiagnosticsResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.ListDiagnostics(s.env.ctx, &monitorv1.ListDiagnosticsRequest{
+		DeviceId: service.Identifier,
+		Limit:    limit,
+	})
+}
+
+func (s *MonitorScenario) ListStatusTransitions(
+	service ServiceConfig,
+	limit int32,
+) (*monitorv1.ListStatusTransitionsResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.ListStatusTransitions(s.env.ctx, &monitorv1.ListStatusTransitionsRequest{
+		DeviceId: service.Identifier,
+		Limit:    limit,
+	})
+}
+
+func (s *MonitorScenario) ListDeviceEvents(
+	service ServiceConfig,
+	severity monitorv1.EventSeverity,
+	kinds ...monitorv1.EventType,
+) (*monitorv1.ListDeviceEventsResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.ListDeviceEvents(s.env.ctx, &monitorv1.ListDeviceEventsRequest{
+		DeviceId: service.Identifier,
+		Types:    kinds,
+		Severity: severity,
+	})
+}
+
+func (s *MonitorScenario) ListAnomalies(
+	service ServiceConfig,
+	limit int32,
+) (*monitorv1.ListAnomaliesResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.ListAnomalies(s.env.ctx, &monitorv1.ListAnomaliesRequest{
+		DeviceId: service.Identifier,
+		Limit:    limit,
+	})
+}
+
+func (s *MonitorScenario) ListVersionChanges(
+	service ServiceConfig,
+	component monitorv1.VersionComponent,
+	limit int32,
+) (*monitorv1.ListVersionChangesResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.ListVersionChanges(s.env.ctx, &monitorv1.ListVersionChangesRequest{
+		DeviceId:  service.Identifier,
+		Component: component,
+		Limit:     limit,
+	})
+}
+
+func (s *MonitorScenario) SetAnomalySensitivity(
+	service ServiceConfig,
+	sensitivity monitorv1.Sensitivity,
+) (*monitorv1.AnomalySettingsResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.SetAnomalySensitivity(s.env.ctx, &monitorv1.SetAnomalySensitivityRequest{
+		DeviceId:    service.Identifier,
+		Sensitivity: sensitivity,
+	})
+}
+
+func (s *MonitorScenario) GetAnomalySettings(
+	service ServiceConfig,
+) (*monitorv1.AnomalySettingsResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.GetAnomalySettings(s.env.ctx, &monitorv1.GetAnomalySettingsRequest{
+		DeviceId: service.Identifier,
+	})
+}
+
+func (s *MonitorScenario) GetVersionInventory(
+	groupBy monitorv1.VersionGrouping,
+	component monitorv1.VersionComponent,
+	below string,
+) (*monitorv1.VersionInventoryResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.GetVersionInventory(s.env.ctx, &monitorv1.VersionInventoryRequest{
+		GroupBy:   groupBy,
+		Component: component,
+		Below:     below,
+	})
+}
+
+func (s *MonitorScenario) SetCompliancePolicy(
+	policy *monitorv1.CompliancePolicy,
+) (*monitorv1.CompliancePolicyResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.SetCompliancePolicy(s.env.ctx, &monitorv1.SetCompliancePolicyRequest{
+		Policy: policy,
+	})
+}
+
+func (s *MonitorScenario) ListCompliancePolicies() (*monitorv1.ListCompliancePoliciesResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.ListCompliancePolicies(s.env.ctx, &emptypb.Empty{})
+}
+
+func (s *MonitorScenario) DeleteCompliancePolicy(name string) (*emptypb.Empty, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.DeleteCompliancePolicy(s.env.ctx, &monitorv1.DeleteCompliancePolicyRequest{
+		Name: name,
+	})
+}
+
+// GetForecast returns the forecast of a metric of a device, or of the top at-risk devices if
+// no device is given, fitted over the given history (the default history if zero)
+func (s *MonitorScenario) GetForecast(
+	metric monitorv1.Metric,
+	top int32,
+	history time.Duration,
+	services ...ServiceConfig,
+) (*monitorv1.GetForecastResponse, error) {
+	monitor := s.client(s.env.t)
+	req := &monitorv1.GetForecastRequest{Metric: metric, Top: top}
+	if history > 0 {
+		req.History = durationpb.New(history)
+	}
+	if len(services) > 0 {
+		req.DeviceId = services[0].Identifier
+	}
+	return monitor.client.GetForecast(s.env.ctx, req)
+}
+
+// ExportDiagnostics returns the export of the samples of the last minute
+func (s *MonitorScenario) ExportDiagnostics(
+	format monitorv1.ExportFormat,
+	services ...ServiceConfig,
+) ([]byte, error) {
+	monitor := s.client(s.env.t)
+	deviceIDs := make([]string, len(services))
+	for i, service := range services {
+		deviceIDs[i] = service.Identifier
+	}
+	stream, err := monitor.client.ExportDiagnostics(s.env.ctx, &monitorv1.ExportDiagnosticsRequest{
+		DeviceIds: deviceIDs,
+		From:      timestamppb.New(time.Now().Add(-time.Minute)),
+		Format:    format,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, res.GetData()...)
+	}
+}
+
+// DownloadDiagnostics requests the export through the gateway download endpoint
+func (s *MonitorScenario) DownloadDiagnostics(query url.Values) (*http.Response, error) {
+	service := Services[s.service]
+	endpoint := fmt.Sprintf(
+		"http://%s:%d/v1/export/diagnostics?%s",
+		s.env.config.Host,
+		service.GatewayPort,
+		query.Encode(),
+	)
+	req, err := http.NewRequestWithContext(s.env.ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
+
+// GetAvailabilityReport requests the report of the given devices (all if none are given),
+// the period ends now if no end is given
+func (s *MonitorScenario) GetAvailabilityReport(
+	from time.Time,
+	to time.Time,
+	groupBy string,
+	services ...ServiceConfig,
+) (*monitorv1.AvailabilityReportResponse, error) {
+	monitor := s.client(s.env.t)
+	req := &monitorv1.AvailabilityReportRequest{
+		From:    timestamppb.New(from),
+		GroupBy: groupBy,
+	}
+	if !to.IsZero() {
+		req.To = timestamppb.New(to)
+	}
+	for _, service := range services {
+		req.DeviceIds = append(req.DeviceIds, service.Identifier)
+	}
+	return monitor.client.GetAvailabilityReport(s.env.ctx, req)
+}
+
 func (s *MonitorScenario) StreamDiagnostics(
 	service ServiceConfig,
 ) (grpc.ServerStreamingClient[monitorv1.DiagnosticsResponse], error) {
@@ -183,6 +688,60 @@ func (s *MonitorScenario) StreamDiagnostics(
 	})
 }
 
+// StreamVersionChanges streams the version changes of a component of a device recorded after
+// the stream is opened
+func (s *MonitorScenario) StreamVersionChanges(
+	service ServiceConfig,
+	component monitorv1.VersionComponent,
+) (grpc.ServerStreamingClient[monitorv1.VersionChange], error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.StreamVersionChanges(s.env.ctx, &monitorv1.StreamVersionChangesRequest{
+		DeviceId:  service.Identifier,
+		Component: component,
+	})
+}
+
+// SubscribeDiagnostics requests the diagnostics stream of a device as Server-Sent Events through
+// the gateway, resuming after the given event if any
+func (s *MonitorScenario) SubscribeDiagnostics(
+	device ServiceConfig,
+	lastEventID string,
+) (*http.Response, error) {
+	service := Services[s.service]
+	endpoint := fmt.Sprintf(
+		"http://%s:%d/v1/diagnostics/%s/stream",
+		s.env.config.Host,
+		service.GatewayPort,
+		url.PathEscape(device.Identifier),
+	)
+	req, err := http.NewRequestWithContext(s.env.ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	return http.DefaultClient.Do(req)
+}
+
+func (s *MonitorScenario) CreateCampaign(
+	req *monitorv1.CreateCampaignRequest,
+) (*monitorv1.CreateCampaignResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.CreateCampaign(s.env.ctx, req)
+}
+
+func (s *MonitorScenario) GetCampaign(id string) (*monitorv1.GetCampaignResponse, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.GetCampaign(s.env.ctx, &monitorv1.GetCampaignRequest{CampaignId: id})
+}
+
+func (s *MonitorScenario) CancelCampaign(id string) (*emptypb.Empty, error) {
+	monitor := s.client(s.env.t)
+	return monitor.client.CancelCampaign(s.env.ctx, &monitorv1.CancelCampaignRequest{CampaignId: id})
+}
+
 type MonitorClient struct {
 	conn     *grpc.ClientConn
 	client   monitorv1.MonitorClient
@@ -0,0 +1,128 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	driverv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/driver/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Driver plugin configured in the monitors (MONITOR_DRIVER_PLUGINS)
+const (
+	DefaultPluginName = "fixture"
+	DefaultPluginPort = 9190
+)
+
+// Diagnostics reported by the plugin for its devices
+const (
+	PluginHardware = "EM-340"
+	PluginSoftware = "2.1.4"
+	PluginCPU      = 12.5
+	PluginMemory   = 40.0
+	PluginUptime   = 2 * time.Hour
+)
+
+// Plugin emulates an out-of-process driver of devices the monitor cannot reach itself, the
+// plugin serves the devices it was started with and reports other devices as not found.
+// Devices are polled and their status is updated through the plugin.
+type Plugin struct {
+	driverv1.UnimplementedDriverServer
+	server   *grpc.Server
+	mu       sync.RWMutex
+	devices  map[string]ServiceConfig
+	statuses map[string]driverv1.DeviceStatus
+}
+
+func NewPlugin(port int, services ...ServiceConfig) (*Plugin, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, err
+	}
+	plugin := &Plugin{
+		server:   grpc.NewServer(),
+		devices:  make(map[string]ServiceConfig, len(services)),
+		statuses: make(map[string]driverv1.DeviceStatus, len(services)),
+	}
+	for _, service := range services {
+		plugin.devices[service.Identifier] = service
+		plugin.statuses[service.Identifier] = driverv1.DeviceStatus_DEVICE_STATUS_HEALTHY
+	}
+	driverv1.RegisterDriverServer(plugin.server, plugin)
+	go plugin.server.Serve(listener) // nolint:errcheck
+	return plugin, nil
+}
+
+func (p *Plugin) Close() {
+	p.server.Stop()
+}
+
+func (p *Plugin) Describe(
+	ctx context.Context,
+	req *driverv1.DescribeRequest,
+) (*driverv1.DescribeResponse, error) {
+	return &driverv1.DescribeResponse{
+		Name:         DefaultPluginName,
+		Capabilities: &driverv1.Capabilities{Polling: true, Update: true},
+	}, nil
+}
+
+func (p *Plugin) GetHealth(
+	ctx context.Context,
+	req *driverv1.DeviceRequest,
+) (*driverv1.HealthResponse, error) {
+	service, err := p.device(req.GetDevice())
+	if err != nil {
+		return nil, err
+	}
+	return &driverv1.HealthResponse{Architecture: service.Architecture, Os: service.OS}, nil
+}
+
+func (p *Plugin) GetDiagnostics(
+	ctx context.Context,
+	req *driverv1.DeviceRequest,
+) (*driverv1.DiagnosticsResponse, error) {
+	service, err := p.device(req.GetDevice())
+	if err != nil {
+		return nil, err
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &driverv1.DiagnosticsResponse{
+		Timestamp:       timestamppb.Now(),
+		HardwareVersion: PluginHardware,
+		SoftwareVersion: PluginSoftware,
+		CpuUsage:        PluginCPU,
+		MemoryUsage:     PluginMemory,
+		DeviceStatus:    p.statuses[service.Identifier],
+		UptimeSeconds:   uint64(PluginUptime.Seconds()),
+	}, nil
+}
+
+func (p *Plugin) UpdateDevice(
+	ctx context.Context,
+	req *driverv1.UpdateDeviceRequest,
+) (*driverv1.UpdateDeviceResponse, error) {
+	service, err := p.device(req.GetDevice())
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.statuses[service.Identifier] = req.GetDeviceStatus()
+	return &driverv1.UpdateDeviceResponse{}, nil
+}
+
+func (p *Plugin) device(device *driverv1.Device) (ServiceConfig, error) {
+	service, ok := p.devices[device.GetDeviceId()]
+	if !ok || device.GetPort() != int64(service.Port) {
+		return ServiceConfig{}, status.Errorf(codes.NotFound, "device %s not found", device.GetDeviceId())
+	}
+	return service, nil
+}
@@ -0,0 +1,304 @@
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
+	"github.com/gosnmp/gosnmp"
+)
+
+const (
+	DefaultAgentEngineID = "\x80\x00\x9c\xb8\x04ubiquiti-test"
+	DefaultAgentBuffer   = 65535
+)
+
+// Objects served by the agent emulator, the values are the expected diagnostics of the device
+const (
+	AgentObjectID       = "1.3.6.1.4.1.41112.1.6"
+	AgentRelease        = "6.1.0-18-amd64" // release of the system description
+	AgentUptime         = time.Hour
+	AgentProcesses      = 142
+	AgentCPU            = 30.0 // average of the processor loads
+	AgentMemory         = 25.0 // used ram of 1 GiB
+	AgentDiskUsed       = 2 << 30
+	AgentDiskTotal      = 8 << 30
+	agentRamUnits       = 1024
+	agentDiskUnits      = 4096
+	oidUsmUnknownEngine = ".1.3.6.1.6.3.15.1.1.4.0"
+)
+
+// Agent emulates the SNMP agent of a device serving the system and host resources groups.
+// Requests are answered for the v2c communities and v3 users of the agent's credentials,
+// other requests are dropped like a device would.
+type Agent struct {
+	conn        *net.UDPConn
+	decoder     *gosnmp.GoSNMP
+	communities []string
+	started     time.Time
+	mu          sync.RWMutex
+	objects     []gosnmp.SnmpPDU
+}
+
+func NewAgent(port int, credentials ...*monitorv1.SnmpCredentials) (*Agent, error) {
+	table := gosnmp.NewSnmpV3SecurityParametersTable(gosnmp.Logger{})
+	// Discovery requests are unauthenticated and carry no user name
+	if err := table.Add("", &gosnmp.UsmSecurityParameters{
+		AuthenticationProtocol: gosnmp.NoAuth,
+		PrivacyProtocol:        gosnmp.NoPriv,
+	}); err != nil {
+		return nil, err
+	}
+	agent := &Agent{
+		decoder: &gosnmp.GoSNMP{Version: gosnmp.Version3, TrapSecurityParametersTable: table},
+		started: time.Now(),
+	}
+	for _, credential := range credentials {
+		switch credential.GetVersion() {
+		case monitorv1.SnmpVersion_SNMP_VERSION_V2C:
+			agent.communities = append(agent.communities, credential.GetCommunity())
+		case monitorv1.SnmpVersion_SNMP_VERSION_V3:
+			err := table.Add(credential.GetUsername(), &gosnmp.UsmSecurityParameters{
+				UserName:                 credential.GetUsername(),
+				AuthoritativeEngineID:    DefaultAgentEngineID,
+				AuthenticationProtocol:   authProtocols[credential.GetAuthProtocol()],
+				AuthenticationPassphrase: credential.GetAuthPassphrase(),
+				PrivacyProtocol:          privProtocols[credential.GetPrivProtocol()],
+				PrivacyPassphrase:        credential.GetPrivPassphrase(),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{Port: port})
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+	agent.conn = conn
+	go agent.serve()
+	return agent, nil
+}
+
+// Set adds or replaces objects served by the agent
+func (a *Agent) Set(objects ...gosnmp.SnmpPDU) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, object := range objects {
+		i, found := slices.BinarySearchFunc(a.objects, object.Name, func(o gosnmp.SnmpPDU, name string) int {
+			return compareOid(o.Name, name)
+		})
+		if found {
+			a.objects[i] = object
+			continue
+		}
+		a.objects = slices.Insert(a.objects, i, object)
+	}
+}
+
+func (a *Agent) Close() error {
+	return a.conn.Close()
+}
+
+func (a *Agent) serve() {
+	buf := make([]byte, DefaultAgentBuffer)
+	for {
+		n, remote, err := a.conn.ReadFromUDP(buf)
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			continue
+		}
+		response, err := a.handle(buf[:n])
+		if err != nil || response == nil {
+			continue
+		}
+		if msg, err := response.MarshalMsg(); err == nil {
+			a.conn.WriteToUDP(msg, remote) // nolint:errcheck
+		}
+	}
+}
+
+// handle answers a request with the packet received, unauthenticated v3 requests (discovery)
+// are answered with the engine of the agent as a report.
+func (a *Agent) handle(msg []byte) (*gosnmp.SnmpPacket, error) {
+	packet, err := a.decoder.UnmarshalTrap(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	switch packet.Version {
+	case gosnmp.Version2c:
+		if !slices.Contains(a.communities, packet.Community) {
+			return nil, errors.New("unknown community")
+		}
+	case gosnmp.Version3:
+		params, ok := packet.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+		if !ok {
+			return nil, errors.New("unsupported security model")
+		}
+		if params.UserName == "" || params.AuthoritativeEngineID != DefaultAgentEngineID {
+			report := params.Copy().(*gosnmp.UsmSecurityParameters)
+			report.AuthoritativeEngineID = DefaultAgentEngineID
+			report.AuthoritativeEngineBoots = 1
+			report.AuthoritativeEngineTime = uint32(time.Since(a.started).Seconds())
+			packet.SecurityParameters = report
+			packet.PDUType = gosnmp.Report
+			packet.MsgFlags &= gosnmp.AuthPriv
+			packet.Variables = []gosnmp.SnmpPDU{
+				{Name: oidUsmUnknownEngine, Type: gosnmp.Counter32, Value: uint32(1)},
+			}
+			return packet, nil
+		}
+		packet.MsgFlags &= gosnmp.AuthPriv
+	default:
+		return nil, errors.New("unsupported version")
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	switch packet.PDUType {
+	case gosnmp.GetRequest:
+		packet.Variables = a.get(packet.Variables)
+	case gosnmp.GetNextRequest:
+		packet.Variables = a.next(packet.Variables)
+	case gosnmp.GetBulkRequest:
+		packet.Variables = a.bulk(packet.Variables, int(packet.NonRepeaters), int(packet.MaxRepetitions))
+		packet.NonRepeaters, packet.MaxRepetitions = 0, 0
+	default:
+		return nil, errors.New("unsupported request")
+	}
+	packet.PDUType = gosnmp.GetResponse
+	return packet, nil
+}
+
+func (a *Agent) get(variables []gosnmp.SnmpPDU) []gosnmp.SnmpPDU {
+	result := make([]gosnmp.SnmpPDU, len(variables))
+	for i, variable := range variables {
+		result[i] = gosnmp.SnmpPDU{Name: variable.Name, Type: gosnmp.NoSuchObject}
+		for _, object := range a.objects {
+			if compareOid(object.Name, variable.Name) == 0 {
+				result[i] = object
+			}
+		}
+	}
+	return result
+}
+
+func (a *Agent) next(variables []gosnmp.SnmpPDU) []gosnmp.SnmpPDU {
+	result := make([]gosnmp.SnmpPDU, len(variables))
+	for i, variable := range variables {
+		result[i] = a.successor(variable.Name)
+	}
+	return result
+}
+
+func (a *Agent) bulk(variables []gosnmp.SnmpPDU, nonRepeaters, repetitions int) []gosnmp.SnmpPDU {
+	nonRepeaters = min(nonRepeaters, len(variables))
+	result := a.next(variables[:nonRepeaters])
+	for _, variable := range variables[nonRepeaters:] {
+		name := variable.Name
+		for range repetitions {
+			object := a.successor(name)
+			result = append(result, object)
+			if object.Type == gosnmp.EndOfMibView {
+				break
+			}
+			name = object.Name
+		}
+	}
+	return result
+}
+
+// successor returns the first object following the name in lexicographic order
+func (a *Agent) successor(name string) gosnmp.SnmpPDU {
+	for _, object := range a.objects {
+		if compareOid(object.Name, name) > 0 {
+			return object
+		}
+	}
+	return gosnmp.SnmpPDU{Name: name, Type: gosnmp.EndOfMibView}
+}
+
+// AgentObjects are the objects of a device (system and host resources groups) served by the
+// agent emulator, the operating system and architecture are reported in the description.
+func AgentObjects(service ServiceConfig) []gosnmp.SnmpPDU {
+	storage := func(index, kind string, units, size, used int) []gosnmp.SnmpPDU {
+		return []gosnmp.SnmpPDU{
+			{Name: ".1.3.6.1.2.1.25.2.3.1.2." + index, Type: gosnmp.ObjectIdentifier, Value: kind},
+			{Name: ".1.3.6.1.2.1.25.2.3.1.4." + index, Type: gosnmp.Integer, Value: units},
+			{Name: ".1.3.6.1.2.1.25.2.3.1.5." + index, Type: gosnmp.Integer, Value: size},
+			{Name: ".1.3.6.1.2.1.25.2.3.1.6." + index, Type: gosnmp.Integer, Value: used},
+		}
+	}
+	objects := []gosnmp.SnmpPDU{
+		{Name: ".1.3.6.1.2.1.1.1.0", Type: gosnmp.OctetString, Value: AgentDescription(service)},
+		{Name: ".1.3.6.1.2.1.1.2.0", Type: gosnmp.ObjectIdentifier, Value: "." + AgentObjectID},
+		{
+			Name:  ".1.3.6.1.2.1.1.3.0",
+			Type:  gosnmp.TimeTicks,
+			Value: uint32(AgentUptime / (10 * time.Millisecond)),
+		},
+		{Name: ".1.3.6.1.2.1.25.1.6.0", Type: gosnmp.Gauge32, Value: uint32(AgentProcesses)},
+		{Name: ".1.3.6.1.2.1.25.3.3.1.2.196608", Type: gosnmp.Integer, Value: 20},
+		{Name: ".1.3.6.1.2.1.25.3.3.1.2.196609", Type: gosnmp.Integer, Value: 40},
+	}
+	objects = append(objects, storage("1", ".1.3.6.1.2.1.25.2.1.2", agentRamUnits, 1<<20, 1<<18)...)
+	objects = append(objects, storage("3", ".1.3.6.1.2.1.25.2.1.3", agentRamUnits, 1<<21, 0)...)
+	objects = append(objects, storage(
+		"31",
+		".1.3.6.1.2.1.25.2.1.4",
+		agentDiskUnits,
+		AgentDiskTotal/agentDiskUnits,
+		AgentDiskUsed/agentDiskUnits,
+	)...)
+	return objects
+}
+
+// AgentDescription is the system description (uname -a) of a device served by the agent
+// emulator, it exceeds the width of the version columns like the descriptions of real agents
+func AgentDescription(service ServiceConfig) string {
+	return fmt.Sprintf(
+		"%s %s %s #1 SMP PREEMPT_DYNAMIC Debian 6.1.76-1 (2024-02-01) %s",
+		service.OS,
+		service.Identifier,
+		AgentRelease,
+		machines[service.Architecture],
+	)
+}
+
+func compareOid(a, b string) int {
+	x := strings.Split(strings.Trim(a, "."), ".")
+	y := strings.Split(strings.Trim(b, "."), ".")
+	for i := range min(len(x), len(y)) {
+		m, _ := strconv.Atoi(x[i])
+		n, _ := strconv.Atoi(y[i])
+		if m != n {
+			return m - n
+		}
+	}
+	return len(x) - len(y)
+}
+
+// Machine hardware names (uname -m) of architectures
+var machines = map[string]string{"amd64": "x86_64", "arm64": "aarch64", "arm": "armv7l"}
+
+var authProtocols = map[monitorv1.SnmpAuthProtocol]gosnmp.SnmpV3AuthProtocol{
+	monitorv1.SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_UNSPECIFIED: gosnmp.NoAuth,
+	monitorv1.SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_MD5:         gosnmp.MD5,
+	monitorv1.SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_SHA:         gosnmp.SHA,
+	monitorv1.SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_SHA256:      gosnmp.SHA256,
+	monitorv1.SnmpAuthProtocol_SNMP_AUTH_PROTOCOL_SHA512:      gosnmp.SHA512,
+}
+
+var privProtocols = map[monitorv1.SnmpPrivProtocol]gosnmp.SnmpV3PrivProtocol{
+	monitorv1.SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_UNSPECIFIED: gosnmp.NoPriv,
+	monitorv1.SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_DES:         gosnmp.DES,
+	monitorv1.SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_AES:         gosnmp.AES,
+	monitorv1.SnmpPrivProtocol_SNMP_PRIV_PROTOCOL_AES256:      gosnmp.AES256,
+}
@@ -3,11 +3,14 @@ package fixtures
 import monitorv1 "github.com/emil-j-olsson/ubiquiti/backend/proto/monitor/v1"
 
 type Config struct {
-	Environment string      `envconfig:"ENVIRONMENT"  default:"test"`
-	Port        int         `envconfig:"PORT"         default:"8080"`
-	Host        string      `envconfig:"HOST"         default:"localhost"`
-	GatewayPort int         `envconfig:"GATEWAY_PORT" default:"8081"`
-	Persistence Persistence `envconfig:"PERSISTENCE"`
+	Environment    string      `envconfig:"ENVIRONMENT"     default:"test"`
+	Port           int         `envconfig:"PORT"            default:"8080"`
+	Host           string      `envconfig:"HOST"            default:"localhost"`
+	GatewayPort    int         `envconfig:"GATEWAY_PORT"    default:"8081"`
+	Broker         string      `envconfig:"BROKER"          default:"tcp://localhost:1883"`
+	BrokerUsername string      `envconfig:"BROKER_USERNAME" default:"monitor"`
+	BrokerPassword string      `envconfig:"BROKER_PASSWORD" default:"monitor-secret"`
+	Persistence    Persistence `envconfig:"PERSISTENCE"`
 }
 
 type Persistence struct {
@@ -62,6 +65,34 @@ var Services = map[Service]ServiceConfig{
 		Architecture:       "arm",
 		OS:                 "linux",
 	},
+	ServiceDeviceSensor: {
+		Container:          "ubiquiti-device-sensor",
+		Identifier:         "ubiquiti-device-sensor-4a7e",
+		Alias:              "UP Sense",
+		Port:               8092,
+		GatewayPort:        8093,
+		SupportedProtocols: []Protocol{ProtocolMqtt},
+		Architecture:       "arm64",
+		OS:                 "linux",
+	},
+	ServiceDeviceAgent: {
+		Container:          "host.docker.internal",
+		Identifier:         "ubiquiti-device-agent-7e41",
+		Alias:              "EdgeSwitch 24 Lite",
+		Port:               1161,
+		SupportedProtocols: []Protocol{ProtocolSnmp},
+		Architecture:       "amd64",
+		OS:                 "linux",
+	},
+	ServiceDeviceMeter: {
+		Container:          "ubiquiti-device-meter",
+		Identifier:         "ubiquiti-device-meter-d19c",
+		Alias:              "Energy Meter EM-340",
+		Port:               502,
+		SupportedProtocols: []Protocol{ProtocolPlugin},
+		Architecture:       "arm",
+		OS:                 "freertos",
+	},
 	ServiceBackendMonitorArm: {
 		Container:          "ubiquiti-monitor-arm",
 		Identifier:         "ubiquiti-monitor-arm",
@@ -90,7 +121,7 @@ var Services = map[Service]ServiceConfig{
 
 //go:generate go-enum
 
-// ENUM(device-router, device-switch, device-access-point, backend-monitor-arm, backend-monitor-amd, invalid)
+// ENUM(device-router, device-switch, device-access-point, device-sensor, device-agent, device-meter, backend-monitor-arm, backend-monitor-amd, invalid)
 type Service string
 
 /*
@@ -100,6 +131,9 @@ ENUM(
 	http-stream = PROTOCOL_HTTP_STREAM
 	grpc = PROTOCOL_GRPC
 	grpc-stream = PROTOCOL_GRPC_STREAM
+	mqtt = PROTOCOL_MQTT
+	snmp = PROTOCOL_SNMP
+	plugin = PROTOCOL_PLUGIN
 
 )
 */
@@ -123,6 +157,12 @@ func (p *Protocol) Proto() monitorv1.Protocol {
 		return monitorv1.Protocol_PROTOCOL_GRPC
 	case ProtocolGrpcStream:
 		return monitorv1.Protocol_PROTOCOL_GRPC_STREAM
+	case ProtocolMqtt:
+		return monitorv1.Protocol_PROTOCOL_MQTT
+	case ProtocolSnmp:
+		return monitorv1.Protocol_PROTOCOL_SNMP
+	case ProtocolPlugin:
+		return monitorv1.Protocol_PROTOCOL_PLUGIN
 	default:
 		return monitorv1.Protocol_PROTOCOL_UNSPECIFIED
 	}
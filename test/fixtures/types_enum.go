@@ -20,6 +20,12 @@ const (
 	ProtocolGrpc Protocol = "PROTOCOL_GRPC"
 	// ProtocolGrpcStream is a Protocol of type grpc-stream.
 	ProtocolGrpcStream Protocol = "PROTOCOL_GRPC_STREAM"
+	// ProtocolMqtt is a Protocol of type mqtt.
+	ProtocolMqtt Protocol = "PROTOCOL_MQTT"
+	// ProtocolSnmp is a Protocol of type snmp.
+	ProtocolSnmp Protocol = "PROTOCOL_SNMP"
+	// ProtocolPlugin is a Protocol of type plugin.
+	ProtocolPlugin Protocol = "PROTOCOL_PLUGIN"
 )
 
 var ErrInvalidProtocol = errors.New("not a valid Protocol")
@@ -41,6 +47,9 @@ var _ProtocolValue = map[string]Protocol{
 	"PROTOCOL_HTTP_STREAM": ProtocolHttpStream,
 	"PROTOCOL_GRPC":        ProtocolGrpc,
 	"PROTOCOL_GRPC_STREAM": ProtocolGrpcStream,
+	"PROTOCOL_MQTT":        ProtocolMqtt,
+	"PROTOCOL_SNMP":        ProtocolSnmp,
+	"PROTOCOL_PLUGIN":      ProtocolPlugin,
 }
 
 // ParseProtocol attempts to convert a string to a Protocol.
@@ -58,6 +67,12 @@ const (
 	ServiceDeviceSwitch Service = "device-switch"
 	// ServiceDeviceAccessPoint is a Service of type device-access-point.
 	ServiceDeviceAccessPoint Service = "device-access-point"
+	// ServiceDeviceSensor is a Service of type device-sensor.
+	ServiceDeviceSensor Service = "device-sensor"
+	// ServiceDeviceAgent is a Service of type device-agent.
+	ServiceDeviceAgent Service = "device-agent"
+	// ServiceDeviceMeter is a Service of type device-meter.
+	ServiceDeviceMeter Service = "device-meter"
 	// ServiceBackendMonitorArm is a Service of type backend-monitor-arm.
 	ServiceBackendMonitorArm Service = "backend-monitor-arm"
 	// ServiceBackendMonitorAmd is a Service of type backend-monitor-amd.
@@ -84,6 +99,9 @@ var _ServiceValue = map[string]Service{
 	"device-router":       ServiceDeviceRouter,
 	"device-switch":       ServiceDeviceSwitch,
 	"device-access-point": ServiceDeviceAccessPoint,
+	"device-sensor":       ServiceDeviceSensor,
+	"device-agent":        ServiceDeviceAgent,
+	"device-meter":        ServiceDeviceMeter,
 	"backend-monitor-arm": ServiceBackendMonitorArm,
 	"backend-monitor-amd": ServiceBackendMonitorAmd,
 	"invalid":             ServiceInvalid,